@@ -9,8 +9,10 @@ import (
 	"net/http"
 	"strconv"
 
+	apieventing "github.com/linuxfoundation/lfx-v2-meeting-service/cmd/meeting-api/eventing"
 	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/logging"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/service"
 	itxservice "github.com/linuxfoundation/lfx-v2-meeting-service/internal/service/itx"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/constants"
@@ -27,6 +29,9 @@ type MeetingsAPI struct {
 	itxPastMeetingParticipantService *itxservice.PastMeetingParticipantService
 	itxMeetingAttachmentService      *itxservice.MeetingAttachmentService
 	itxPastMeetingAttachmentService  *itxservice.PastMeetingAttachmentService
+	serviceConfig                    func() *meetingsvc.ServiceConfig
+	health                           *healthChecker
+	occurrenceCalculator             *apieventing.OccurrenceCalculator
 }
 
 // NewMeetingsAPI creates a new MeetingsAPI.
@@ -39,6 +44,8 @@ func NewMeetingsAPI(
 	itxPastMeetingParticipantService *itxservice.PastMeetingParticipantService,
 	itxMeetingAttachmentService *itxservice.MeetingAttachmentService,
 	itxPastMeetingAttachmentService *itxservice.PastMeetingAttachmentService,
+	serviceConfig func() *meetingsvc.ServiceConfig,
+	health *healthChecker,
 ) *MeetingsAPI {
 	return &MeetingsAPI{
 		authService:                      authService,
@@ -49,6 +56,9 @@ func NewMeetingsAPI(
 		itxPastMeetingParticipantService: itxPastMeetingParticipantService,
 		itxMeetingAttachmentService:      itxMeetingAttachmentService,
 		itxPastMeetingAttachmentService:  itxPastMeetingAttachmentService,
+		serviceConfig:                    serviceConfig,
+		health:                           health,
+		occurrenceCalculator:             apieventing.NewOccurrenceCalculator(logging.Subsystem(logging.SubsystemEventing)),
 	}
 }
 
@@ -122,6 +132,17 @@ func (s *MeetingsAPI) Livez(_ context.Context) ([]byte, error) {
 	return []byte("OK\n"), nil
 }
 
+// GetServiceConfig returns the sanitized effective configuration. It is built on each request
+// so that log levels reloaded at runtime are reported.
+func (s *MeetingsAPI) GetServiceConfig(_ context.Context, _ *meetingsvc.GetServiceConfigPayload) (*meetingsvc.ServiceConfig, error) {
+	return s.serviceConfig(), nil
+}
+
+// GetServiceHealth runs the dependency checks and reports the status and latency of each.
+func (s *MeetingsAPI) GetServiceHealth(ctx context.Context, _ *meetingsvc.GetServiceHealthPayload) (*meetingsvc.ServiceHealth, error) {
+	return s.health.run(ctx), nil
+}
+
 // JWTAuth implements Auther interface for the JWT security scheme.
 func (s *MeetingsAPI) JWTAuth(ctx context.Context, bearerToken string, _ *security.JWTScheme) (context.Context, error) {
 	if !s.authService.ServiceReady() {
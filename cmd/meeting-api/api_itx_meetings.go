@@ -6,15 +6,21 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/cmd/meeting-api/service"
 	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/utils"
 )
 
 // CreateItxMeeting creates a meeting via ITX proxy
 func (s *MeetingsAPI) CreateItxMeeting(ctx context.Context, p *meetingsvc.CreateItxMeetingPayload) (*meetingsvc.ITXZoomMeetingResponse, error) {
+	if err := service.ResolveRecurrenceRRule(p.Recurrence); err != nil {
+		return nil, handleError(err)
+	}
 	req := service.ConvertCreateITXMeetingPayloadToDomain(p)
 	resp, err := s.itxMeetingService.CreateMeeting(ctx, req)
 	if err != nil {
@@ -32,8 +38,24 @@ func (s *MeetingsAPI) GetItxMeeting(ctx context.Context, p *meetingsvc.GetItxMee
 	return service.ConvertITXMeetingResponseToGoa(resp), nil
 }
 
+// CloneItxMeeting creates a copy of a meeting at a new start time via ITX proxy
+func (s *MeetingsAPI) CloneItxMeeting(ctx context.Context, p *meetingsvc.CloneItxMeetingPayload) (*meetingsvc.ITXZoomMeetingResponse, error) {
+	req := &models.CloneITXMeetingRequest{
+		StartTime: p.StartTime,
+		Title:     utils.StringValue(p.Title),
+	}
+	resp, err := s.itxMeetingService.CloneMeeting(ctx, p.MeetingID, req)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertITXMeetingResponseToGoa(resp), nil
+}
+
 // UpdateItxMeeting updates a meeting via ITX proxy
 func (s *MeetingsAPI) UpdateItxMeeting(ctx context.Context, p *meetingsvc.UpdateItxMeetingPayload) error {
+	if err := service.ResolveRecurrenceRRule(p.Recurrence); err != nil {
+		return handleError(err)
+	}
 	req := service.ConvertCreateITXMeetingPayloadToDomain(&meetingsvc.CreateItxMeetingPayload{
 		BearerToken:              p.BearerToken,
 		Version:                  p.Version,
@@ -87,6 +109,34 @@ func (s *MeetingsAPI) GetItxMeetingCount(ctx context.Context, p *meetingsvc.GetI
 	return &meetingsvc.ITXMeetingCountResponse{MeetingCount: resp.MeetingCount}, nil
 }
 
+// PreviewItxMeetingOccurrences computes the occurrences a recurrence definition will generate
+func (s *MeetingsAPI) PreviewItxMeetingOccurrences(ctx context.Context, p *meetingsvc.PreviewItxMeetingOccurrencesPayload) (*meetingsvc.ITXOccurrencePreviewResponse, error) {
+	if err := service.ResolveRecurrenceRRule(p.Recurrence); err != nil {
+		return nil, handleError(err)
+	}
+	if p.Recurrence == nil || p.Recurrence.Type == nil {
+		return nil, handleError(domain.NewValidationError("recurrence type or rrule is required"))
+	}
+	if err := service.ValidateOccurrencePreviewRecurrence(p); err != nil {
+		return nil, handleError(err)
+	}
+	// LoadLocation("") returns UTC, so an empty timezone must be rejected before it is loaded
+	if p.Timezone == "" {
+		return nil, handleError(domain.NewValidationError("timezone is required"))
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return nil, handleError(domain.NewValidationError(fmt.Sprintf("invalid timezone %q", p.Timezone)))
+	}
+
+	// Occurrences are computed from the start time onwards, regardless of the current time,
+	// so the preview shows exactly the dates the meeting will be created with.
+	occurrences, err := s.occurrenceCalculator.CalculateOccurrences(ctx, service.ConvertOccurrencePreviewPayloadToDomain(p), true, false, p.Count)
+	if err != nil {
+		return nil, handleError(domain.NewValidationError("invalid recurrence definition", err))
+	}
+	return &meetingsvc.ITXOccurrencePreviewResponse{Occurrences: service.ConvertOccurrencesToGoa(occurrences)}, nil
+}
+
 // GetItxJoinLink retrieves a join link for a meeting via ITX proxy
 func (s *MeetingsAPI) GetItxJoinLink(ctx context.Context, p *meetingsvc.GetItxJoinLinkPayload) (*meetingsvc.ITXZoomMeetingJoinLink, error) {
 	req := service.ConvertGetJoinLinkPayloadToITX(p)
@@ -97,6 +147,24 @@ func (s *MeetingsAPI) GetItxJoinLink(ctx context.Context, p *meetingsvc.GetItxJo
 	return service.ConvertITXJoinLinkResponseToGoa(resp), nil
 }
 
+// LaunchItxMeeting redirects the user to a meeting's join link via ITX proxy
+func (s *MeetingsAPI) LaunchItxMeeting(ctx context.Context, p *meetingsvc.LaunchItxMeetingPayload) (*meetingsvc.LaunchItxMeetingResult, error) {
+	req := service.ConvertGetJoinLinkPayloadToITX(&meetingsvc.GetItxJoinLinkPayload{
+		MeetingID:    p.MeetingID,
+		UseEmail:     p.UseEmail,
+		UserID:       p.UserID,
+		Name:         p.Name,
+		Email:        p.Email,
+		Register:     p.Register,
+		OccurrenceID: p.OccurrenceID,
+	})
+	location, err := s.itxMeetingService.GetMeetingLaunchLink(ctx, req, p.Client, utils.StringValue(p.UserAgent))
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &meetingsvc.LaunchItxMeetingResult{Location: location}, nil
+}
+
 // ResendItxMeetingInvitations resends meeting invitations to all registrants via ITX proxy
 func (s *MeetingsAPI) ResendItxMeetingInvitations(ctx context.Context, p *meetingsvc.ResendItxMeetingInvitationsPayload) error {
 	req := &itx.ResendMeetingInvitationsRequest{
@@ -120,8 +188,20 @@ func (s *MeetingsAPI) RegisterItxCommitteeMembers(ctx context.Context, p *meetin
 	return nil
 }
 
+// ListItxMeetingOccurrences lists a meeting's occurrences in a requested timezone via ITX proxy
+func (s *MeetingsAPI) ListItxMeetingOccurrences(ctx context.Context, p *meetingsvc.ListItxMeetingOccurrencesPayload) (*meetingsvc.ITXOccurrenceListResponse, error) {
+	list, err := s.itxMeetingService.ListOccurrences(ctx, service.ConvertListOccurrencesPayloadToDomain(p))
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertITXOccurrenceListToGoa(list), nil
+}
+
 // UpdateItxOccurrence updates a specific occurrence of a recurring meeting via ITX proxy
 func (s *MeetingsAPI) UpdateItxOccurrence(ctx context.Context, p *meetingsvc.UpdateItxOccurrencePayload) error {
+	if err := service.ResolveRecurrenceRRule(p.Recurrence); err != nil {
+		return handleError(err)
+	}
 	req := service.ConvertUpdateOccurrencePayloadToITX(p)
 	err := s.itxMeetingService.UpdateOccurrence(ctx, p.MeetingID, p.OccurrenceID, req)
 	if err != nil {
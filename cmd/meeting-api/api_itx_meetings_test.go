@@ -0,0 +1,109 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apieventing "github.com/linuxfoundation/lfx-v2-meeting-service/cmd/meeting-api/eventing"
+	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
+)
+
+func TestPreviewItxMeetingOccurrences(t *testing.T) {
+	api := &MeetingsAPI{occurrenceCalculator: apieventing.NewOccurrenceCalculator(slog.Default())}
+	weekly, interval, endTimes := 2, 1, 4
+
+	t.Run("weekly recurrence", func(t *testing.T) {
+		// Start times in the past must still be previewed from the start time onwards.
+		resp, err := api.PreviewItxMeetingOccurrences(context.Background(), &meetingsvc.PreviewItxMeetingOccurrencesPayload{
+			StartTime: "2024-03-05T15:00:00Z",
+			Timezone:  "America/New_York",
+			Duration:  30,
+			Recurrence: &meetingsvc.Recurrence{
+				Type:           &weekly,
+				RepeatInterval: &interval,
+				EndTimes:       &endTimes,
+			},
+			Count: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Occurrences, 4)
+
+		// The DST change on 2024-03-10 keeps the local wall-clock time, not the UTC time.
+		assert.Equal(t, "2024-03-05T15:00:00Z", *resp.Occurrences[0].StartTime)
+		assert.Equal(t, "2024-03-12T14:00:00Z", *resp.Occurrences[1].StartTime)
+		assert.Equal(t, "1709650800", *resp.Occurrences[0].OccurrenceID)
+		assert.Equal(t, 30, *resp.Occurrences[0].Duration)
+		assert.Equal(t, "available", *resp.Occurrences[0].Status)
+	})
+
+	t.Run("count limits the result", func(t *testing.T) {
+		resp, err := api.PreviewItxMeetingOccurrences(context.Background(), &meetingsvc.PreviewItxMeetingOccurrencesPayload{
+			StartTime:  "2024-03-05T15:00:00Z",
+			Timezone:   "UTC",
+			Duration:   30,
+			Recurrence: &meetingsvc.Recurrence{Type: &weekly, RepeatInterval: &interval},
+			Count:      3,
+		})
+		require.NoError(t, err)
+		assert.Len(t, resp.Occurrences, 3)
+	})
+
+	t.Run("rrule recurrence", func(t *testing.T) {
+		rule := "FREQ=MONTHLY;BYDAY=3TH;COUNT=3"
+		resp, err := api.PreviewItxMeetingOccurrences(context.Background(), &meetingsvc.PreviewItxMeetingOccurrencesPayload{
+			StartTime:  "2024-01-18T15:00:00Z",
+			Timezone:   "UTC",
+			Duration:   60,
+			Recurrence: &meetingsvc.Recurrence{Rrule: &rule},
+			Count:      10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Occurrences, 3)
+		assert.Equal(t, "2024-02-15T15:00:00Z", *resp.Occurrences[1].StartTime)
+		assert.Equal(t, "2024-03-21T15:00:00Z", *resp.Occurrences[2].StartTime)
+	})
+
+	rrule := "FREQ=WEEKLY"
+	tooManyRule := "FREQ=DAILY;COUNT=200000"
+	farEnd := "2600-01-01T00:00:00Z"
+	invalid := map[string]*meetingsvc.PreviewItxMeetingOccurrencesPayload{
+		"rrule count over the limit": {
+			StartTime: "2024-03-05T15:00:00Z", Timezone: "UTC", Duration: 30,
+			Recurrence: &meetingsvc.Recurrence{Rrule: &tooManyRule}, Count: 10,
+		},
+		"end date beyond the horizon": {
+			StartTime: "2024-03-05T15:00:00Z", Timezone: "UTC", Duration: 30,
+			Recurrence: &meetingsvc.Recurrence{Type: &weekly, EndDateTime: &farEnd}, Count: 10,
+		},
+		"rrule and type": {
+			StartTime: "2024-03-05T15:00:00Z", Timezone: "UTC", Duration: 30,
+			Recurrence: &meetingsvc.Recurrence{Type: &weekly, Rrule: &rrule}, Count: 10,
+		},
+		"missing recurrence type": {
+			StartTime: "2024-03-05T15:00:00Z", Timezone: "UTC", Duration: 30,
+			Recurrence: &meetingsvc.Recurrence{RepeatInterval: &interval}, Count: 10,
+		},
+		"empty timezone": {
+			StartTime: "2024-03-05T15:00:00Z", Duration: 30,
+			Recurrence: &meetingsvc.Recurrence{Type: &weekly}, Count: 10,
+		},
+		"unknown timezone": {
+			StartTime: "2024-03-05T15:00:00Z", Timezone: "Mars/Olympus_Mons", Duration: 30,
+			Recurrence: &meetingsvc.Recurrence{Type: &weekly}, Count: 10,
+		},
+	}
+	for name, p := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := api.PreviewItxMeetingOccurrences(context.Background(), p)
+			var badRequest *meetingsvc.BadRequestError
+			require.ErrorAs(t, err, &badRequest)
+		})
+	}
+}
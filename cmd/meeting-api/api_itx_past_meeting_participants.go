@@ -5,10 +5,14 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/cmd/meeting-api/service"
 	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/constants"
 )
 
 // CreateItxPastMeetingParticipant creates a past meeting participant via ITX proxy
@@ -24,6 +28,16 @@ func (s *MeetingsAPI) CreateItxPastMeetingParticipant(ctx context.Context, p *me
 }
 
 func (s *MeetingsAPI) UpdateItxPastMeetingParticipant(ctx context.Context, p *meetingsvc.UpdateItxPastMeetingParticipantPayload) (*meetingsvc.ITXPastMeetingParticipant, error) {
+	resp, err := s.updateItxPastMeetingParticipant(ctx, p)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return resp, nil
+}
+
+// updateItxPastMeetingParticipant applies one participant update, returning the domain error
+// unmapped so that callers can add context to it
+func (s *MeetingsAPI) updateItxPastMeetingParticipant(ctx context.Context, p *meetingsvc.UpdateItxPastMeetingParticipantPayload) (*meetingsvc.ITXPastMeetingParticipant, error) {
 	inviteeReq, attendeeReq := service.ConvertUpdateParticipantPayload(p)
 
 	var inviteeID, attendeeID string
@@ -43,12 +57,48 @@ func (s *MeetingsAPI) UpdateItxPastMeetingParticipant(ctx context.Context, p *me
 		IsAttended:    p.IsAttended,
 	}, inviteeReq, attendeeReq)
 	if err != nil {
-		return nil, handleError(err)
+		return nil, err
 	}
 
 	return service.ConvertParticipantResponseToGoa(resp), nil
 }
 
+// BulkUpdateItxPastMeetingParticipants applies participant corrections in order via ITX proxy.
+// ITX has no transactional bulk endpoint, so corrections applied before a failure are kept; the
+// error names the index and participant of the failed correction. Each applied correction is
+// audit logged with the requesting principal and the fields it set.
+func (s *MeetingsAPI) BulkUpdateItxPastMeetingParticipants(ctx context.Context, p *meetingsvc.BulkUpdateItxPastMeetingParticipantsPayload) (*meetingsvc.ITXPastMeetingParticipantBulkUpdateResponse, error) {
+	seen := make(map[string]struct{}, len(p.Participants))
+	for _, u := range p.Participants {
+		if _, ok := seen[u.ParticipantID]; ok {
+			return nil, handleError(domain.NewValidationError(fmt.Sprintf("participant %s appears more than once", u.ParticipantID)))
+		}
+		seen[u.ParticipantID] = struct{}{}
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	results := make([]*meetingsvc.ITXPastMeetingParticipant, 0, len(p.Participants))
+	for i, u := range p.Participants {
+		update := service.ConvertParticipantUpdateToPayload(p.PastMeetingID, u)
+		resp, err := s.updateItxPastMeetingParticipant(ctx, update)
+		if err != nil {
+			return nil, handleError(&domain.DomainError{
+				Type:    domain.GetErrorType(err),
+				Message: fmt.Sprintf("correction %d (participant %s) failed after %d of %d corrections were applied", i, u.ParticipantID, i, len(p.Participants)),
+				Err:     err,
+			})
+		}
+		slog.InfoContext(ctx, "past meeting participant corrected",
+			"principal", principal,
+			"past_meeting_id", p.PastMeetingID,
+			"participant_id", u.ParticipantID,
+			"changed_fields", service.ParticipantUpdateFields(u))
+		results = append(results, resp)
+	}
+
+	return &meetingsvc.ITXPastMeetingParticipantBulkUpdateResponse{Participants: results}, nil
+}
+
 // DeleteItxPastMeetingParticipant deletes a past meeting participant via ITX proxy
 func (s *MeetingsAPI) DeleteItxPastMeetingParticipant(ctx context.Context, p *meetingsvc.DeleteItxPastMeetingParticipantPayload) error {
 	err := s.itxPastMeetingParticipantService.DeleteParticipant(ctx, p.PastMeetingID, p.ParticipantID)
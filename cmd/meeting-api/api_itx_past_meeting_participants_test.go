@@ -0,0 +1,116 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	itxservice "github.com/linuxfoundation/lfx-v2-meeting-service/internal/service/itx"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/utils"
+)
+
+func TestBulkUpdateItxPastMeetingParticipants_RejectsDuplicates(t *testing.T) {
+	// No services are wired up: the duplicate check must fail before any ITX call is made.
+	api := &MeetingsAPI{}
+
+	_, err := api.BulkUpdateItxPastMeetingParticipants(context.Background(), &meetingsvc.BulkUpdateItxPastMeetingParticipantsPayload{
+		PastMeetingID: "123-456",
+		Participants: []*meetingsvc.ITXPastMeetingParticipantUpdate{
+			{ParticipantID: "p1"},
+			{ParticipantID: "p2"},
+			{ParticipantID: "p1"},
+		},
+	})
+	require.Error(t, err)
+	var badRequest *meetingsvc.BadRequestError
+	require.ErrorAs(t, err, &badRequest)
+	assert.Contains(t, badRequest.Message, "p1")
+}
+
+// fakeParticipantClient records invitee updates and fails those for failInviteeID
+type fakeParticipantClient struct {
+	domain.ITXPastMeetingParticipantClient
+	failInviteeID string
+	updated       []string
+}
+
+func (f *fakeParticipantClient) UpdateInvitee(_ context.Context, _, inviteeID string, req *itx.UpdateInviteeRequest) (*itx.InviteeResponse, error) {
+	if inviteeID == f.failInviteeID {
+		return nil, domain.NewUnavailableError("ITX service request failed")
+	}
+	f.updated = append(f.updated, inviteeID)
+	return &itx.InviteeResponse{UUID: inviteeID, FirstName: req.FirstName}, nil
+}
+
+// knownInviteeMapper reports every invitee ID as mapped to a v2 participant
+type knownInviteeMapper struct{ domain.IDMapper }
+
+func (knownInviteeMapper) MapInviteeIDToParticipantV2(_ context.Context, inviteeID string) (string, error) {
+	return inviteeID, nil
+}
+
+func newBulkUpdateTestAPI(client *fakeParticipantClient) *MeetingsAPI {
+	return &MeetingsAPI{itxPastMeetingParticipantService: itxservice.NewPastMeetingParticipantService(client, knownInviteeMapper{})}
+}
+
+func inviteeCorrection(id, firstName string) *meetingsvc.ITXPastMeetingParticipantUpdate {
+	return &meetingsvc.ITXPastMeetingParticipantUpdate{
+		ParticipantID: id,
+		InviteeID:     &id,
+		IsInvited:     utils.BoolPtr(true),
+		FirstName:     &firstName,
+	}
+}
+
+func TestBulkUpdateItxPastMeetingParticipants_AppliesInOrder(t *testing.T) {
+	var logs bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	client := &fakeParticipantClient{}
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "jdoe")
+
+	got, err := newBulkUpdateTestAPI(client).BulkUpdateItxPastMeetingParticipants(ctx, &meetingsvc.BulkUpdateItxPastMeetingParticipantsPayload{
+		PastMeetingID: "123-456",
+		Participants:  []*meetingsvc.ITXPastMeetingParticipantUpdate{inviteeCorrection("p1", "Ada"), inviteeCorrection("p2", "Grace")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p2"}, client.updated)
+	require.Len(t, got.Participants, 2)
+	assert.Equal(t, "Ada", *got.Participants[0].FirstName)
+	assert.Equal(t, "Grace", *got.Participants[1].FirstName)
+
+	assert.Equal(t, 2, strings.Count(logs.String(), `"msg":"past meeting participant corrected"`))
+	assert.Contains(t, logs.String(), `"principal":"jdoe"`)
+	assert.Contains(t, logs.String(), `"changed_fields":["invitee_id","is_invited","first_name"]`)
+}
+
+func TestBulkUpdateItxPastMeetingParticipants_ReportsFailedIndex(t *testing.T) {
+	client := &fakeParticipantClient{failInviteeID: "p2"}
+
+	_, err := newBulkUpdateTestAPI(client).BulkUpdateItxPastMeetingParticipants(context.Background(), &meetingsvc.BulkUpdateItxPastMeetingParticipantsPayload{
+		PastMeetingID: "123-456",
+		Participants: []*meetingsvc.ITXPastMeetingParticipantUpdate{
+			inviteeCorrection("p1", "Ada"),
+			inviteeCorrection("p2", "Grace"),
+			inviteeCorrection("p3", "Alan"),
+		},
+	})
+	var unavailable *meetingsvc.ServiceUnavailableError
+	require.ErrorAs(t, err, &unavailable, "the failed correction's error type is kept")
+	assert.Contains(t, unavailable.Message, "correction 1 (participant p2) failed after 1 of 3 corrections were applied")
+	assert.Equal(t, []string{"p1"}, client.updated, "corrections after the failure are not attempted")
+}
@@ -28,3 +28,30 @@ func (s *MeetingsAPI) UpdateItxPastMeetingSummary(ctx context.Context, p *meetin
 	}
 	return service.ConvertPastMeetingSummaryToGoa(resp), nil
 }
+
+// GetItxPastMeetingSummaryDiff retrieves a diff between AI-generated and edited summary content via ITX proxy
+func (s *MeetingsAPI) GetItxPastMeetingSummaryDiff(ctx context.Context, p *meetingsvc.GetItxPastMeetingSummaryDiffPayload) (*meetingsvc.PastMeetingSummaryDiff, error) {
+	resp, err := s.itxPastMeetingSummaryService.GetPastMeetingSummary(ctx, p.PastMeetingID, p.SummaryUID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertPastMeetingSummaryDiffToGoa(resp), nil
+}
+
+// ApproveItxPastMeetingSummary approves a past meeting summary via ITX proxy
+func (s *MeetingsAPI) ApproveItxPastMeetingSummary(ctx context.Context, p *meetingsvc.ApproveItxPastMeetingSummaryPayload) (*meetingsvc.PastMeetingSummary, error) {
+	resp, err := s.itxPastMeetingSummaryService.SetPastMeetingSummaryApproval(ctx, p.PastMeetingID, p.SummaryUID, true)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertPastMeetingSummaryToGoa(resp), nil
+}
+
+// RejectItxPastMeetingSummary rejects a past meeting summary via ITX proxy
+func (s *MeetingsAPI) RejectItxPastMeetingSummary(ctx context.Context, p *meetingsvc.RejectItxPastMeetingSummaryPayload) (*meetingsvc.PastMeetingSummary, error) {
+	resp, err := s.itxPastMeetingSummaryService.SetPastMeetingSummaryApproval(ctx, p.PastMeetingID, p.SummaryUID, false)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertPastMeetingSummaryToGoa(resp), nil
+}
@@ -57,6 +57,24 @@ func (s *MeetingsAPI) GetItxRegistrantIcs(ctx context.Context, p *meetingsvc.Get
 	return resp.Content, nil
 }
 
+// AddItxRegistrantHost grants a registrant host access via ITX proxy
+func (s *MeetingsAPI) AddItxRegistrantHost(ctx context.Context, p *meetingsvc.AddItxRegistrantHostPayload) (*meetingsvc.ITXZoomMeetingRegistrant, error) {
+	resp, err := s.itxRegistrantService.SetRegistrantHost(ctx, p.MeetingID, p.RegistrantID, true)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertITXRegistrantToGoa(resp), nil
+}
+
+// RemoveItxRegistrantHost revokes a registrant's host access via ITX proxy
+func (s *MeetingsAPI) RemoveItxRegistrantHost(ctx context.Context, p *meetingsvc.RemoveItxRegistrantHostPayload) (*meetingsvc.ITXZoomMeetingRegistrant, error) {
+	resp, err := s.itxRegistrantService.SetRegistrantHost(ctx, p.MeetingID, p.RegistrantID, false)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertITXRegistrantToGoa(resp), nil
+}
+
 // ResendItxRegistrantInvitation resends a meeting invitation to a registrant via ITX proxy
 func (s *MeetingsAPI) ResendItxRegistrantInvitation(ctx context.Context, p *meetingsvc.ResendItxRegistrantInvitationPayload) error {
 	err := s.itxRegistrantService.ResendRegistrantInvitation(ctx, p.MeetingID, p.RegistrantID)
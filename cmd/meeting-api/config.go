@@ -9,9 +9,12 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	apieventing "github.com/linuxfoundation/lfx-v2-meeting-service/cmd/meeting-api/eventing"
+	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/infrastructure/proxy"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/logging"
 )
 
@@ -33,6 +36,8 @@ type environment struct {
 	IDMappingDisabled  bool
 	EventConfig        eventConfig
 	InviteConfig       apieventing.InviteFeatureConfig
+	AttachmentConfig   attachmentConfig
+	JoinLinkCacheTTL   time.Duration
 }
 
 // itxConfig holds ITX proxy configuration
@@ -42,6 +47,7 @@ type itxConfig struct {
 	PrivateKey  string
 	Auth0Domain string
 	Audience    string
+	Resilience  proxy.ResilienceConfig
 }
 
 // userServiceConfig holds v1 user-service (API-gateway) client configuration for
@@ -51,6 +57,12 @@ type userServiceConfig struct {
 	BaseURL string
 }
 
+// attachmentConfig holds the limits applied to attachment uploads
+type attachmentConfig struct {
+	MaxFileSize         int64
+	AllowedContentTypes []string
+}
+
 // eventConfig holds event processing configuration
 type eventConfig struct {
 	Enabled              bool
@@ -61,6 +73,8 @@ type eventConfig struct {
 	AckWait              time.Duration
 	MaxAckPending        int
 	V1MappingsBucketName string
+	BreakerThreshold     int
+	BreakerCooldown      time.Duration
 }
 
 // parseFlags parses command line flags for the meeting service
@@ -118,6 +132,13 @@ func parseEnv() environment {
 
 	idMappingDisabled := os.Getenv("ID_MAPPING_DISABLED") == "true"
 
+	joinLinkCacheTTL := time.Minute
+	if joinLinkCacheTTLStr := os.Getenv("JOIN_LINK_CACHE_TTL"); joinLinkCacheTTLStr != "" {
+		if val, err := time.ParseDuration(joinLinkCacheTTLStr); err == nil {
+			joinLinkCacheTTL = val
+		}
+	}
+
 	return environment{
 		Port:               port,
 		LFXEnvironment:     lfxEnvironment,
@@ -128,6 +149,8 @@ func parseEnv() environment {
 		IDMappingDisabled:  idMappingDisabled,
 		EventConfig:        parseEventConfig(),
 		InviteConfig:       parseInviteConfig(lfxEnvironment),
+		AttachmentConfig:   parseAttachmentConfig(),
+		JoinLinkCacheTTL:   joinLinkCacheTTL,
 	}
 }
 
@@ -174,12 +197,45 @@ func parseITXConfig() itxConfig {
 		audience = "https://api.dev.itx.linuxfoundation.org/"
 	}
 
+	resilience := proxy.DefaultResilienceConfig()
+	if maxRetriesStr := os.Getenv("ITX_MAX_RETRIES"); maxRetriesStr != "" {
+		if val, err := strconv.Atoi(maxRetriesStr); err == nil {
+			resilience.MaxRetries = val
+		}
+	}
+	if attemptTimeoutStr := os.Getenv("ITX_ATTEMPT_TIMEOUT"); attemptTimeoutStr != "" {
+		if val, err := time.ParseDuration(attemptTimeoutStr); err == nil {
+			resilience.AttemptTimeout = val
+		}
+	}
+	if icsAttemptTimeoutStr := os.Getenv("ITX_ICS_ATTEMPT_TIMEOUT"); icsAttemptTimeoutStr != "" {
+		if val, err := time.ParseDuration(icsAttemptTimeoutStr); err == nil {
+			resilience.EndpointAttemptTimeouts[proxy.EndpointICS] = val
+		}
+	}
+	if attachmentAttemptTimeoutStr := os.Getenv("ITX_ATTACHMENT_ATTEMPT_TIMEOUT"); attachmentAttemptTimeoutStr != "" {
+		if val, err := time.ParseDuration(attachmentAttemptTimeoutStr); err == nil {
+			resilience.EndpointAttemptTimeouts[proxy.EndpointAttachments] = val
+		}
+	}
+	if breakerThresholdStr := os.Getenv("ITX_BREAKER_THRESHOLD"); breakerThresholdStr != "" {
+		if val, err := strconv.Atoi(breakerThresholdStr); err == nil {
+			resilience.BreakerThreshold = val
+		}
+	}
+	if breakerCooldownStr := os.Getenv("ITX_BREAKER_COOLDOWN"); breakerCooldownStr != "" {
+		if val, err := time.ParseDuration(breakerCooldownStr); err == nil {
+			resilience.BreakerCooldown = val
+		}
+	}
+
 	return itxConfig{
 		BaseURL:     baseURL,
 		ClientID:    clientID,
 		PrivateKey:  privateKey,
 		Auth0Domain: auth0Domain,
 		Audience:    audience,
+		Resilience:  resilience,
 	}
 }
 
@@ -258,6 +314,20 @@ func parseEventConfig() eventConfig {
 		v1MappingsBucketName = "v1-mappings"
 	}
 
+	breakerThreshold := 5
+	if breakerThresholdStr := os.Getenv("EVENT_BREAKER_THRESHOLD"); breakerThresholdStr != "" {
+		if val, err := strconv.Atoi(breakerThresholdStr); err == nil {
+			breakerThreshold = val
+		}
+	}
+
+	breakerCooldown := 30 * time.Second
+	if breakerCooldownStr := os.Getenv("EVENT_BREAKER_COOLDOWN"); breakerCooldownStr != "" {
+		if val, err := time.ParseDuration(breakerCooldownStr); err == nil {
+			breakerCooldown = val
+		}
+	}
+
 	return eventConfig{
 		Enabled:              enabled,
 		ConsumerName:         consumerName,
@@ -267,6 +337,33 @@ func parseEventConfig() eventConfig {
 		AckWait:              ackWait,
 		MaxAckPending:        maxAckPending,
 		V1MappingsBucketName: v1MappingsBucketName,
+		BreakerThreshold:     breakerThreshold,
+		BreakerCooldown:      breakerCooldown,
+	}
+}
+
+// parseAttachmentConfig parses attachment upload limits from environment variables.
+// Both limits are off by default.
+func parseAttachmentConfig() attachmentConfig {
+	var maxFileSize int64
+	if maxFileSizeStr := os.Getenv("ATTACHMENT_MAX_FILE_SIZE"); maxFileSizeStr != "" {
+		if val, err := strconv.ParseInt(maxFileSizeStr, 10, 64); err == nil {
+			maxFileSize = val
+		} else {
+			slog.With(logging.ErrKey, err).Warn("invalid ATTACHMENT_MAX_FILE_SIZE, attachment size is not limited")
+		}
+	}
+
+	allowedContentTypes := []string{}
+	for _, contentType := range strings.Split(os.Getenv("ATTACHMENT_ALLOWED_CONTENT_TYPES"), ",") {
+		if contentType = strings.ToLower(strings.TrimSpace(contentType)); contentType != "" {
+			allowedContentTypes = append(allowedContentTypes, contentType)
+		}
+	}
+
+	return attachmentConfig{
+		MaxFileSize:         maxFileSize,
+		AllowedContentTypes: allowedContentTypes,
 	}
 }
 
@@ -305,3 +402,81 @@ func parseInviteConfig(lfxEnvironment string) apieventing.InviteFeatureConfig {
 		SelfServeBaseURL: selfServeBaseURL,
 	}
 }
+
+// serviceConfig returns the sanitized view of the environment served by the diagnostics
+// endpoint. ITX credentials are omitted and any userinfo embedded in URLs is stripped. Log
+// levels are read when it is called, so callers build it per request to reflect SIGHUP reloads.
+func (e environment) serviceConfig(version string) *meetingsvc.ServiceConfig {
+	subsystemLogLevels := make(map[string]string)
+	for subsystem, level := range logging.SubsystemLevels() {
+		subsystemLogLevels[subsystem] = logLevelName(level)
+	}
+
+	allowedContentTypes := e.AttachmentConfig.AllowedContentTypes
+	if allowedContentTypes == nil {
+		allowedContentTypes = []string{}
+	}
+
+	resilience := e.ITXConfig.Resilience
+	endpointAttemptTimeouts := make(map[string]string, len(resilience.EndpointAttemptTimeouts))
+	for endpoint, timeout := range resilience.EndpointAttemptTimeouts {
+		endpointAttemptTimeouts[endpoint] = timeout.String()
+	}
+	return &meetingsvc.ServiceConfig{
+		Version:            version,
+		LfxEnvironment:     e.LFXEnvironment,
+		ItxBaseURL:         redactURL(e.ITXConfig.BaseURL),
+		ItxAuth0Domain:     e.ITXConfig.Auth0Domain,
+		ItxAudience:        redactURL(e.ITXConfig.Audience),
+		UserServiceBaseURL: redactURL(e.UserServiceConfig.BaseURL),
+		IDMappingEnabled:   !e.IDMappingDisabled,
+		EventProcessing: &meetingsvc.ServiceEventProcessingConfig{
+			Enabled:          e.EventConfig.Enabled,
+			ConsumerName:     e.EventConfig.ConsumerName,
+			StreamName:       e.EventConfig.StreamName,
+			FilterSubjects:   e.EventConfig.FilterSubjects,
+			MaxDeliver:       e.EventConfig.MaxDeliver,
+			AckWait:          e.EventConfig.AckWait.String(),
+			MaxAckPending:    e.EventConfig.MaxAckPending,
+			V1MappingsBucket: e.EventConfig.V1MappingsBucketName,
+			BreakerThreshold: e.EventConfig.BreakerThreshold,
+			BreakerCooldown:  e.EventConfig.BreakerCooldown.String(),
+		},
+		Invites: &meetingsvc.ServiceInvitesConfig{
+			Enabled:          e.InviteConfig.Enabled,
+			SelfServeBaseURL: redactURL(e.InviteConfig.SelfServeBaseURL),
+		},
+		ItxResilience: &meetingsvc.ServiceITXResilienceConfig{
+			MaxRetries:              resilience.MaxRetries,
+			RetryBaseDelay:          resilience.RetryBaseDelay.String(),
+			RetryMaxDelay:           resilience.RetryMaxDelay.String(),
+			AttemptTimeout:          resilience.AttemptTimeout.String(),
+			EndpointAttemptTimeouts: endpointAttemptTimeouts,
+			BreakerThreshold:        resilience.BreakerThreshold,
+			BreakerCooldown:         resilience.BreakerCooldown.String(),
+		},
+		Attachments: &meetingsvc.ServiceAttachmentsConfig{
+			MaxFileSize:         e.AttachmentConfig.MaxFileSize,
+			AllowedContentTypes: allowedContentTypes,
+		},
+		JoinLinkCacheTTL:   e.JoinLinkCacheTTL.String(),
+		LogLevel:           logLevelName(logging.Level()),
+		SubsystemLogLevels: subsystemLogLevels,
+	}
+}
+
+// logLevelName returns the LOG_LEVEL style name of level (e.g. "debug")
+func logLevelName(level slog.Level) string {
+	return strings.ToLower(level.String())
+}
+
+// redactURL removes any userinfo from raw so credentials passed inline in a URL are not
+// exposed. Values that do not parse as URLs are returned unchanged.
+func redactURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.User == nil {
+		return raw
+	}
+	parsed.User = nil
+	return parsed.String()
+}
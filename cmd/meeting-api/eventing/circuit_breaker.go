@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// halfOpenRetryDelay is the redelivery delay for messages that arrive while a half-open
+// breaker is waiting on the outcome of its probe message.
+const halfOpenRetryDelay = 5 * time.Second
+
+// typeBreakers is a set of circuit breakers keyed by v1 object type (the KV key prefix,
+// e.g. "itx-zoom-past-meetings-recordings"). When one object type keeps failing — for
+// example because a dependency it needs is down — its breaker opens and its messages are
+// NAKed with a delay without running the handler, so the consumer keeps making progress
+// on the other object types instead of spending its time on retries that cannot succeed.
+// A message on its final delivery is always handled, so deferrals never use up the
+// delivery attempts of a message that was not processed.
+//
+// After the cooldown the breaker lets a single probe message through; success closes it,
+// failure re-opens it for another cooldown.
+type typeBreakers struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	states    map[string]*breakerState
+}
+
+type breakerState struct {
+	consecutiveFailures int
+	openUntil           time.Time
+	probing             bool
+}
+
+// newTypeBreakers creates breakers that open after threshold consecutive retryable
+// failures of one object type. A threshold of zero or less disables the breakers.
+func newTypeBreakers(threshold int, cooldown time.Duration) *typeBreakers {
+	return &typeBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		states:    make(map[string]*breakerState),
+	}
+}
+
+// allow reports whether a message of the given object type should be handled now and
+// whether it is the half-open probe, whose outcome must be passed back to record. When it
+// returns false, the returned delay is how long the message should be NAKed for.
+func (b *typeBreakers) allow(objectType string) (allowed, probe bool, delay time.Duration) {
+	if b == nil || b.threshold <= 0 || objectType == "" {
+		return true, false, 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, ok := b.states[objectType]
+	if !ok || state.consecutiveFailures < b.threshold {
+		return true, false, 0
+	}
+	if remaining := state.openUntil.Sub(b.now()); remaining > 0 {
+		return false, false, remaining
+	}
+	if state.probing {
+		return false, false, halfOpenRetryDelay
+	}
+	state.probing = true
+	return true, true, 0
+}
+
+// record stores the outcome of handling a message of the given object type and reports
+// whether this outcome opened (or re-opened) the breaker. probe is the value allow returned
+// for the message; a failed probe re-opens the breaker for another cooldown. Messages
+// handled while the breaker is open (on their final delivery) neither end the probe nor,
+// when they succeed, close the breaker.
+func (b *typeBreakers) record(objectType string, probe, failed bool) (opened bool) {
+	if b == nil || b.threshold <= 0 || objectType == "" {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, ok := b.states[objectType]
+	if !ok {
+		state = &breakerState{}
+		b.states[objectType] = state
+	}
+
+	if probe {
+		state.probing = false
+	}
+	if !failed {
+		if probe || state.consecutiveFailures < b.threshold {
+			state.consecutiveFailures = 0
+			state.openUntil = time.Time{}
+		}
+		return false
+	}
+
+	state.consecutiveFailures++
+	if probe || state.consecutiveFailures == b.threshold {
+		state.openUntil = b.now().Add(b.cooldown)
+		return true
+	}
+	return false
+}
+
+// objectTypeFromSubject extracts the v1 object type from a KV subject of the form
+// $KV.<bucket>.<object-type>.<id>, returning "" for subjects that do not match.
+func objectTypeFromSubject(subject string) string {
+	parts := strings.SplitN(subject, ".", 4)
+	if len(parts) < 4 {
+		return ""
+	}
+	return parts[2]
+}
@@ -0,0 +1,125 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const recordingsType = "itx-zoom-past-meetings-recordings"
+
+func newTestBreakers(threshold int, cooldown time.Duration) (*typeBreakers, *time.Time) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newTypeBreakers(threshold, cooldown)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestTypeBreakers_OpensAfterThreshold(t *testing.T) {
+	b, _ := newTestBreakers(3, time.Minute)
+
+	assert.False(t, b.record(recordingsType, false, true))
+	assert.False(t, b.record(recordingsType, false, true))
+	assert.True(t, b.record(recordingsType, false, true), "third consecutive failure should open the breaker")
+
+	allowed, _, delay := b.allow(recordingsType)
+	assert.False(t, allowed)
+	assert.Equal(t, time.Minute, delay)
+
+	// Other object types are unaffected.
+	allowed, _, _ = b.allow("itx-zoom-past-meetings-attendees")
+	assert.True(t, allowed)
+}
+
+func TestTypeBreakers_SuccessResetsFailures(t *testing.T) {
+	b, _ := newTestBreakers(2, time.Minute)
+
+	b.record(recordingsType, false, true)
+	b.record(recordingsType, false, false)
+	assert.False(t, b.record(recordingsType, false, true), "failure count should restart after a success")
+
+	allowed, _, _ := b.allow(recordingsType)
+	assert.True(t, allowed)
+}
+
+func TestTypeBreakers_HalfOpenProbe(t *testing.T) {
+	b, now := newTestBreakers(1, time.Minute)
+	b.record(recordingsType, false, true)
+
+	*now = now.Add(time.Minute)
+	allowed, probe, _ := b.allow(recordingsType)
+	assert.True(t, allowed, "first message after cooldown is the probe")
+	assert.True(t, probe)
+
+	allowed, probe, delay := b.allow(recordingsType)
+	assert.False(t, allowed, "only one probe is allowed while half-open")
+	assert.False(t, probe)
+	assert.Equal(t, halfOpenRetryDelay, delay)
+
+	t.Run("non-probe failure keeps the probe outstanding", func(t *testing.T) {
+		assert.False(t, b.record(recordingsType, false, true))
+		allowed, _, delay := b.allow(recordingsType)
+		assert.False(t, allowed)
+		assert.Equal(t, halfOpenRetryDelay, delay)
+	})
+
+	t.Run("non-probe success keeps the probe outstanding", func(t *testing.T) {
+		assert.False(t, b.record(recordingsType, false, false))
+		allowed, _, delay := b.allow(recordingsType)
+		assert.False(t, allowed)
+		assert.Equal(t, halfOpenRetryDelay, delay)
+	})
+
+	t.Run("failed probe re-opens", func(t *testing.T) {
+		assert.True(t, b.record(recordingsType, true, true))
+		allowed, _, delay := b.allow(recordingsType)
+		assert.False(t, allowed)
+		assert.Equal(t, time.Minute, delay)
+	})
+
+	t.Run("successful probe closes", func(t *testing.T) {
+		*now = now.Add(time.Minute)
+		allowed, probe, _ := b.allow(recordingsType)
+		assert.True(t, allowed)
+		assert.False(t, b.record(recordingsType, probe, false))
+
+		allowed, probe, _ = b.allow(recordingsType)
+		assert.True(t, allowed)
+		assert.False(t, probe)
+	})
+}
+
+func TestTypeBreakers_FinalDeliverySuccessWhileOpen(t *testing.T) {
+	b, _ := newTestBreakers(1, time.Minute)
+	b.record(recordingsType, false, true)
+
+	// A message on its final delivery is handled even though the breaker is open.
+	assert.False(t, b.record(recordingsType, false, false))
+
+	allowed, _, delay := b.allow(recordingsType)
+	assert.False(t, allowed, "a final-delivery success does not close the breaker")
+	assert.Equal(t, time.Minute, delay, "nor does it reset the cooldown")
+}
+
+func TestTypeBreakers_Disabled(t *testing.T) {
+	b, _ := newTestBreakers(0, time.Minute)
+	for range 10 {
+		assert.False(t, b.record(recordingsType, false, true))
+	}
+	allowed, _, _ := b.allow(recordingsType)
+	assert.True(t, allowed)
+
+	var nilBreakers *typeBreakers
+	allowed, _, _ = nilBreakers.allow(recordingsType)
+	assert.True(t, allowed)
+}
+
+func TestObjectTypeFromSubject(t *testing.T) {
+	assert.Equal(t, recordingsType, objectTypeFromSubject("$KV.v1-objects.itx-zoom-past-meetings-recordings.abc-123"))
+	assert.Equal(t, "itx-zoom-meetings-v2", objectTypeFromSubject("$KV.v1-objects.itx-zoom-meetings-v2.123.4"))
+	assert.Equal(t, "", objectTypeFromSubject("$KV.v1-objects.key"))
+}
@@ -32,6 +32,7 @@ type EventProcessor struct {
 	logger       *slog.Logger
 	config       eventing.Config
 	handlers     *EventHandlers
+	breakers     *typeBreakers
 }
 
 // NewEventProcessor creates a new event processor
@@ -104,11 +105,22 @@ func NewEventProcessor(config eventing.Config, idMapper domain.IDMapper, logger
 		logger:       logger,
 		config:       config,
 		handlers:     handlers,
+		breakers:     newTypeBreakers(config.BreakerThreshold, config.BreakerCooldown),
 	}
 
 	return ep, nil
 }
 
+// Ping round-trips to the NATS server the processor consumes from
+func (ep *EventProcessor) Ping(ctx context.Context) error {
+	return ep.nc.FlushWithContext(ctx)
+}
+
+// KVBuckets returns the KV buckets the processor reads from and writes to
+func (ep *EventProcessor) KVBuckets() []jetstream.KeyValue {
+	return []jetstream.KeyValue{ep.v1ObjectsKV, ep.v1MappingsKV}
+}
+
 // Start begins processing events from the NATS JetStream.
 // If the consumer is deleted on the server at runtime, it is automatically
 // recreated and consumption resumes without requiring a service restart.
@@ -151,24 +163,51 @@ func (ep *EventProcessor) Start(ctx context.Context) error {
 // msgHandler returns the JetStream message handler closure.
 func (ep *EventProcessor) msgHandler(ctx context.Context) jetstream.MessageHandler {
 	return func(msg jetstream.Msg) {
+		objectType := objectTypeFromSubject(msg.Subject())
+		probe, recorded := false, false
+
 		defer func() {
 			if r := recover(); r != nil {
 				ep.logger.Error("panic in event handler, NAKing message", "subject", msg.Subject(), "panic", r)
+				// Count the panic as a failure so a panicking probe does not leave the
+				// breaker waiting on an outcome forever.
+				if !recorded {
+					ep.breakers.record(objectType, probe, true)
+				}
 				if err := msg.Nak(); err != nil {
 					ep.logger.With(logging.ErrKey, err).Error("failed to NAK message after panic")
 				}
 			}
 		}()
 
+		var numDelivered uint64
+		if metadata, err := msg.Metadata(); err != nil {
+			ep.logger.With(logging.ErrKey, err).Warn("failed to get message metadata, using default retry delay")
+		} else {
+			numDelivered = metadata.NumDelivered
+		}
+
+		// Defer messages of an object type whose breaker is open. Each deferral is a delivery
+		// counted toward MaxDeliver, so a message on its final delivery is handled anyway
+		// rather than being dropped without ever being processed.
+		finalDelivery := ep.config.MaxDeliver > 0 && numDelivered >= uint64(ep.config.MaxDeliver)
+		allowed, isProbe, delay := ep.breakers.allow(objectType)
+		probe = isProbe
+		if !allowed && !finalDelivery {
+			if err := msg.NakWithDelay(delay); err != nil {
+				ep.logger.With(logging.ErrKey, err).Error("failed to NAK message")
+			}
+			ep.logger.Debug("circuit breaker open, message deferred", "subject", msg.Subject(), "object_type", objectType, "delay", delay)
+			return
+		}
+
 		shouldRetry := kvHandler(ctx, msg, ep.handlers)
+		recorded = true
+		if ep.breakers.record(objectType, probe, shouldRetry) {
+			ep.logger.Warn("circuit breaker opened for object type", "object_type", objectType, "cooldown", ep.config.BreakerCooldown)
+		}
 
 		if shouldRetry {
-			var numDelivered uint64
-			if metadata, err := msg.Metadata(); err != nil {
-				ep.logger.With(logging.ErrKey, err).Warn("failed to get message metadata, using default retry delay")
-			} else {
-				numDelivered = metadata.NumDelivered
-			}
 			delay := getRetryDelay(numDelivered)
 			if err := msg.NakWithDelay(delay); err != nil {
 				ep.logger.With(logging.ErrKey, err).Error("failed to NAK message")
@@ -0,0 +1,98 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/infrastructure/eventing"
+)
+
+// fakeMsg is a jetstream.Msg that records how it was acknowledged.
+type fakeMsg struct {
+	jetstream.Msg
+	subject      string
+	numDelivered uint64
+	panicOnData  bool
+	acked        bool
+	naked        bool
+	nakDelay     time.Duration
+}
+
+func (m *fakeMsg) Subject() string      { return m.subject }
+func (m *fakeMsg) Headers() nats.Header { return nats.Header{} }
+func (m *fakeMsg) Data() []byte {
+	if m.panicOnData {
+		panic("handler bug")
+	}
+	return []byte("{}")
+}
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{NumDelivered: m.numDelivered}, nil
+}
+func (m *fakeMsg) Ack() error { m.acked = true; return nil }
+func (m *fakeMsg) Nak() error { m.naked = true; return nil }
+func (m *fakeMsg) NakWithDelay(d time.Duration) error {
+	m.naked, m.nakDelay = true, d
+	return nil
+}
+
+const breakerTestSubject = "$KV.v1-objects.itx-zoom-past-meetings-recordings.rec-1"
+
+func newBreakerTestProcessor(maxDeliver int) (*EventProcessor, *time.Time) {
+	breakers, now := newTestBreakers(1, time.Minute)
+	return &EventProcessor{
+		logger:   slog.Default(),
+		config:   eventing.Config{MaxDeliver: maxDeliver, BreakerCooldown: time.Minute},
+		handlers: &EventHandlers{logger: slog.Default()},
+		breakers: breakers,
+	}, now
+}
+
+func TestMsgHandler_OpenBreakerDefersUntilFinalDelivery(t *testing.T) {
+	ep, _ := newBreakerTestProcessor(3)
+	ep.breakers.record(recordingsType, false, true)
+	handle := ep.msgHandler(context.Background())
+
+	deferred := &fakeMsg{subject: breakerTestSubject, numDelivered: 2}
+	handle(deferred)
+	assert.True(t, deferred.naked)
+	assert.Equal(t, time.Minute, deferred.nakDelay)
+	assert.False(t, deferred.acked)
+
+	final := &fakeMsg{subject: breakerTestSubject, numDelivered: 3}
+	handle(final)
+	assert.True(t, final.acked, "the final delivery is handled even while the breaker is open")
+	assert.False(t, final.naked)
+}
+
+func TestMsgHandler_PanickingProbeReleasesBreaker(t *testing.T) {
+	ep, now := newBreakerTestProcessor(0)
+	ep.breakers.record(recordingsType, false, true)
+	handle := ep.msgHandler(context.Background())
+
+	*now = now.Add(time.Minute)
+	probe := &fakeMsg{subject: breakerTestSubject, numDelivered: 1, panicOnData: true}
+	handle(probe)
+	assert.True(t, probe.naked)
+
+	// The panic counts as a failed probe: the breaker re-opens for a full cooldown
+	// instead of waiting forever on the probe's outcome.
+	allowed, probing, delay := ep.breakers.allow(recordingsType)
+	assert.False(t, allowed)
+	assert.False(t, probing)
+	assert.Equal(t, time.Minute, delay)
+
+	*now = now.Add(time.Minute)
+	allowed, probing, _ = ep.breakers.allow(recordingsType)
+	assert.True(t, allowed)
+	assert.True(t, probing)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/vmihailenco/msgpack/v5"
@@ -32,6 +33,9 @@ type EventHandlers struct {
 	v1ObjectsKV   jetstream.KeyValue
 	v1MappingsKV  jetstream.KeyValue
 	logger        *slog.Logger
+	// now is the clock used for time-dependent indexing decisions such as which
+	// occurrences are still upcoming. Tests replace it to get deterministic results.
+	now func() time.Time
 
 	// Invite feature fields. inviteSender and userReader must be non-nil, and
 	// selfServeBaseURL must be non-empty, for invite sending to be active.
@@ -155,6 +159,7 @@ func NewEventHandlers(
 		v1ObjectsKV:   v1ObjectsKV,
 		v1MappingsKV:  v1MappingsKV,
 		logger:        logger,
+		now:           time.Now,
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -444,6 +444,7 @@ func convertMapToMeetingData(
 	v1Data map[string]interface{},
 	idMapper domain.IDMapper,
 	mappingsKV jetstream.KeyValue,
+	now func() time.Time,
 	logger *slog.Logger,
 ) (*models.MeetingEventData, error) {
 	// Convert map to JSON bytes, then to MeetingDBRaw
@@ -575,6 +576,7 @@ func convertMapToMeetingData(
 
 	// Calculate occurrences if recurring
 	calc := NewOccurrenceCalculator(logger)
+	calc.now = now
 
 	// Calculate 100 future occurrences (not including past ones)
 	occurrences, err := calc.CalculateOccurrences(
@@ -611,7 +613,7 @@ func convertMapToMeetingData(
 	// today's occurrences (e.g. repeat_interval:3 for quarterly), ensuring that Self Serve's
 	// cadence label (derived from this indexed rule) stays consistent with the occurrences.
 	if meeting.Recurrence != nil {
-		meeting.Recurrence = getEffectiveRecurrence(*meeting, time.Now())
+		meeting.Recurrence = getEffectiveRecurrence(*meeting, now())
 	}
 
 	return meeting, nil
@@ -627,7 +629,7 @@ func (h *EventHandlers) handleMeetingUpdate(
 	funcLogger.DebugContext(ctx, "processing meeting update")
 
 	// Convert v1Data to meeting event data
-	meetingData, err := convertMapToMeetingData(ctx, v1Data, h.idMapper, h.v1MappingsKV, funcLogger)
+	meetingData, err := convertMapToMeetingData(ctx, v1Data, h.idMapper, h.v1MappingsKV, h.now, funcLogger)
 	if err != nil {
 		funcLogger.With(logging.ErrKey, err).ErrorContext(ctx, "failed to convert v1Data to meeting")
 		return isTransientError(err)
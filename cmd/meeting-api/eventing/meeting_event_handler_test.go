@@ -4,11 +4,19 @@
 package eventing
 
 import (
+	"context"
 	"encoding/json"
+	"log/slog"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/nats-io/nats.go/jetstream"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
 )
 
 // v1 sometimes sends auto_email_reminder_time as a string; unmarshal must coerce it.
@@ -87,3 +95,49 @@ func TestMeetingDBRawUnmarshalUpdatedOccurrenceDuration(t *testing.T) {
 		})
 	}
 }
+
+// capturingMeetingPublisher records the last meeting event published.
+type capturingMeetingPublisher struct {
+	mockEventPublisher
+	meeting *models.MeetingEventData
+}
+
+func (p *capturingMeetingPublisher) PublishMeetingEvent(_ context.Context, _ string, m *models.MeetingEventData) error {
+	p.meeting = m
+	return nil
+}
+
+// TestHandleMeetingUpdate_UsesHandlerClock pins EventHandlers.now to check that the indexed
+// occurrences are the ones still upcoming at the handler's clock, not the wall clock.
+func TestHandleMeetingUpdate_UsesHandlerClock(t *testing.T) {
+	startTime := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	v1Data := map[string]interface{}{
+		"meeting_id": "m1",
+		"proj_id":    "a0A1",
+		"topic":      "Daily standup",
+		"start_time": startTime.Format(time.RFC3339),
+		"timezone":   "UTC",
+		"duration":   60,
+		"recurrence": map[string]interface{}{"type": 1, "repeat_interval": 1, "end_times": 5},
+	}
+
+	mappingsKV := &mockKeyValue{}
+	mappingsKV.On("Get", mock.Anything, "v1-mappings.meeting-mappings.m1").Return(nil, jetstream.ErrKeyNotFound)
+	mappingsKV.On("Get", mock.Anything, "v1_meetings.m1").Return(nil, jetstream.ErrKeyNotFound)
+	mappingsKV.On("Put", mock.Anything, "v1_meetings.m1", mock.Anything).Return(uint64(1), nil)
+	publisher := &capturingMeetingPublisher{}
+	h := &EventHandlers{
+		publisher:    publisher,
+		idMapper:     projectIDMapper{},
+		v1MappingsKV: mappingsKV,
+		logger:       slog.Default(),
+		// Two days in, the third occurrence has ended: only the last two are upcoming.
+		now: func() time.Time { return startTime.Add(48*time.Hour + 2*time.Hour) },
+	}
+
+	retry := h.handleMeetingUpdate(context.Background(), "itx-zoom-meetings-v2.m1", v1Data)
+	assert.False(t, retry)
+	require.NotNil(t, publisher.meeting)
+	require.Len(t, publisher.meeting.Occurrences, 2)
+	assert.Equal(t, strconv.FormatInt(startTime.Add(72*time.Hour).Unix(), 10), publisher.meeting.Occurrences[0].OccurrenceID)
+}
@@ -10,7 +10,6 @@ import (
 	"log/slog"
 	"slices"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
@@ -21,20 +20,20 @@ const (
 	meetingEndBuffer = 40 * time.Minute
 )
 
-var weekdaysABBRV = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
-var typeName = []string{"Daily", "Weekly", "Monthly"}
-
 // OccurrenceCalculator calculates meeting occurrences based on RRULE recurrence patterns.
 // The algorithm mirrors the canonical ITX CalculateOccurrencesV2 logic to ensure that
 // what is stored in OpenSearch matches what ITX computes.
 type OccurrenceCalculator struct {
 	logger *slog.Logger
+	// now is the clock used to decide which occurrences are already past.
+	now func() time.Time
 }
 
 // NewOccurrenceCalculator creates a new occurrence calculator
 func NewOccurrenceCalculator(logger *slog.Logger) *OccurrenceCalculator {
 	return &OccurrenceCalculator{
 		logger: logger,
+		now:    time.Now,
 	}
 }
 
@@ -62,6 +61,9 @@ type seriesSegment struct {
 //  4. Overlay single (non-all_following) updated occurrences.
 //  5. Sort by occurrence ID (unix timestamp) and apply the limit.
 //
+// A meeting without updated occurrences stops expanding at the limit instead of
+// expanding its whole rule.
+//
 // This is the canonical algorithm — diverging from it causes occurrence/rule mismatch
 // between OpenSearch (written here) and ITX (the source of truth).
 func (c *OccurrenceCalculator) CalculateOccurrences(
@@ -84,6 +86,12 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 		return cmp.Compare(a.startUnix, b.startUnix)
 	})
 
+	// Precompute the cancelled set once rather than scanning the slice per occurrence.
+	cancelled := make(map[string]struct{}, len(meeting.CancelledOccurrences))
+	for _, id := range meeting.CancelledOccurrences {
+		cancelled[id] = struct{}{}
+	}
+
 	// Build a global map: originalOccurrenceID → segment index, for replaced-occurrence dedup.
 	// An occurrence that appears here is "owned" by the replacing segment (not the base series).
 	oldOccurrenceToSegmentIndex := make(map[string]int)
@@ -94,6 +102,10 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 		}
 	}
 
+	// A single segment with no updated occurrences expands in chronological order with nothing
+	// overlaid afterwards, so once the limit is reached the rest of the rule can be skipped.
+	stopAtLimit := numOccurrencesToReturn > 0 && len(segments) == 1 && len(meeting.UpdatedOccurrences) == 0
+
 	// 2. Expand segments
 	occurrencesByID := make(map[string]models.Occurrence)
 	for si, seg := range segments {
@@ -132,21 +144,25 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 			segTimezone = "UTC"
 		}
 		segStart := time.Unix(seg.startUnix, 0)
-		rruleOccurrences, err := c.getRRuleOccurrences(segStart, segTimezone, seg.recurrence, nil)
+		next, err := c.getRRuleIterator(segStart, segTimezone, seg.recurrence, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get rrule occurrences for segment starting at %d: %w", seg.startUnix, err)
 		}
-		c.logger.DebugContext(ctx, "segment expanded",
-			"meeting_id", meeting.ID, "segment_idx", si,
-			"seg_start", seg.startUnix, "bound", boundUnix,
-			"occurrences_count", len(rruleOccurrences))
+		var anchorID string
+		if seg.oldOccurrenceUnix > 0 {
+			anchorID = strconv.FormatInt(seg.oldOccurrenceUnix, 10)
+		}
 
-		for _, o := range rruleOccurrences {
+		// Iterate lazily so a bounded segment stops expanding at its boundary instead of
+		// materializing the full (up to the COUNT safety cap) rule first.
+		expanded := 0
+		for o, ok := next(); ok; o, ok = next() {
 			// Stop when we reach the boundary of the next segment
 			if boundUnix > 0 && o.Unix() >= boundUnix {
 				break
 			}
-			if !pastOccurrences && isOccurrencePast(o, seg.duration) {
+			expanded++
+			if !pastOccurrences && c.isOccurrencePast(o, seg.duration) {
 				continue
 			}
 			occurrenceID := strconv.FormatInt(o.Unix(), 10)
@@ -154,9 +170,7 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 			// Global dedup: skip occurrences replaced by an all_following update,
 			// unless this is the anchor of the current segment (the occurrence that triggers the new pattern).
 			if segIdx, isReplaced := oldOccurrenceToSegmentIndex[occurrenceID]; isReplaced {
-				isCurrentAnchor := seg.oldOccurrenceUnix > 0 &&
-					occurrenceID == strconv.FormatInt(seg.oldOccurrenceUnix, 10) &&
-					segIdx == si
+				isCurrentAnchor := anchorID != "" && occurrenceID == anchorID && segIdx == si
 				if !isCurrentAnchor {
 					continue
 				}
@@ -188,7 +202,7 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 				Recurrence:   rec,
 			}
 
-			if slices.Contains(meeting.CancelledOccurrences, occurrenceID) {
+			if _, isCancelled := cancelled[occurrenceID]; isCancelled {
 				if !includeCancelled {
 					continue
 				}
@@ -196,7 +210,14 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 			}
 
 			occurrencesByID[occurrenceID] = occ
+			if stopAtLimit && len(occurrencesByID) >= numOccurrencesToReturn {
+				break
+			}
 		}
+		c.logger.DebugContext(ctx, "segment expanded",
+			"meeting_id", meeting.ID, "segment_idx", si,
+			"seg_start", seg.startUnix, "bound", boundUnix,
+			"occurrences_count", expanded)
 	}
 
 	// 3. Overlay single (non-all_following) updated occurrences.
@@ -253,7 +274,7 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 			duration = uo.Duration
 		}
 
-		if !pastOccurrences && isOccurrencePast(newStart, duration) {
+		if !pastOccurrences && c.isOccurrencePast(newStart, duration) {
 			continue
 		}
 
@@ -281,7 +302,7 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 			Description:  description,
 			Recurrence:   nil, // Single updates never start a new recurrence series
 		}
-		if slices.Contains(meeting.CancelledOccurrences, uo.NewOccurrenceID) {
+		if _, isCancelled := cancelled[uo.NewOccurrenceID]; isCancelled {
 			if !includeCancelled {
 				continue
 			}
@@ -295,10 +316,10 @@ func (c *OccurrenceCalculator) CalculateOccurrences(
 	for _, occ := range occurrencesByID {
 		result = append(result, occ)
 	}
+	// Every occurrence ID is the unix timestamp of its start time, so compare the parsed
+	// form directly instead of re-parsing IDs on each comparison.
 	slices.SortFunc(result, func(a, b models.Occurrence) int {
-		ai, _ := strconv.ParseInt(a.OccurrenceID, 10, 64)
-		bi, _ := strconv.ParseInt(b.OccurrenceID, 10, 64)
-		return cmp.Compare(ai, bi)
+		return cmp.Compare(a.StartTime.Unix(), b.StartTime.Unix())
 	})
 	if numOccurrencesToReturn > 0 && len(result) > numOccurrencesToReturn {
 		result = result[:numOccurrencesToReturn]
@@ -440,8 +461,8 @@ func getEffectiveRecurrence(meeting models.MeetingEventData, t time.Time) *model
 	return current
 }
 
-func isOccurrencePast(startTime time.Time, duration int) bool {
-	return startTime.Add(time.Duration(duration) * time.Minute).Add(meetingEndBuffer).Before(time.Now())
+func (c *OccurrenceCalculator) isOccurrencePast(startTime time.Time, duration int) bool {
+	return startTime.Add(time.Duration(duration) * time.Minute).Add(meetingEndBuffer).Before(c.now())
 }
 
 // timeInLocation returns error if name is invalid or empty.
@@ -459,6 +480,21 @@ func timeInLocation(t time.Time, name string) (time.Time, error) {
 // getRRuleOccurrences given a start time, optional timezone, and recurrence pattern, calculates and returns
 // the list of occurrence times
 func (c *OccurrenceCalculator) getRRuleOccurrences(startTime time.Time, timezone string, recurrence *models.ZoomMeetingRecurrence, endTime *time.Time) ([]time.Time, error) {
+	next, err := c.getRRuleIterator(startTime, timezone, recurrence, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var occurrences []time.Time
+	for t, ok := next(); ok; t, ok = next() {
+		occurrences = append(occurrences, t)
+	}
+	return occurrences, nil
+}
+
+// getRRuleIterator is like getRRuleOccurrences but returns an iterator over the occurrence
+// times in ascending order, so callers that only need a prefix can stop early.
+func (c *OccurrenceCalculator) getRRuleIterator(startTime time.Time, timezone string, recurrence *models.ZoomMeetingRecurrence, endTime *time.Time) (rrule.Next, error) {
 	rruleString, err := c.getRRule(recurrence, endTime)
 	if err != nil {
 		return nil, err
@@ -471,102 +507,25 @@ func (c *OccurrenceCalculator) getRRuleOccurrences(startTime time.Time, timezone
 		}
 	}
 
-	set := rrule.Set{}
 	r, err := rrule.StrToRRule(rruleString)
 	if err != nil {
 		return nil, err
 	}
 	r.DTStart(startTime)
-	set.RRule(r)
 
-	return set.All(), nil
+	return r.Iterator(), nil
 }
 
 // getRRule returns the recurrence rule for a meeting recurrence as a string
 func (c *OccurrenceCalculator) getRRule(reccurrence *models.ZoomMeetingRecurrence, endTime *time.Time) (string, error) {
-	var rrule strings.Builder
-
-	if reccurrence.Type < 1 || reccurrence.Type > 3 {
-		return "", fmt.Errorf("invalid recurrence type: %d", reccurrence.Type)
-	}
-
-	fmt.Fprintf(&rrule, "FREQ=%s;", strings.ToUpper(typeName[reccurrence.Type-1]))
-	rrule.WriteString("WKST=SU;")
-
-	if reccurrence.RepeatInterval != 0 {
-		fmt.Fprintf(&rrule, "INTERVAL=%d;", reccurrence.RepeatInterval)
-	}
-
-	if reccurrence.WeeklyDays != "" {
-		s, err := parseByDay(reccurrence.WeeklyDays)
-		if err != nil {
-			return "", err
-		}
-		fmt.Fprintf(&rrule, "BYDAY=%s;", s)
-	} else if reccurrence.MonthlyWeek != 0 && reccurrence.MonthlyWeekDay != 0 {
-		fmt.Fprintf(&rrule, "BYDAY=%d%s;", reccurrence.MonthlyWeek, weekdaysABBRV[reccurrence.MonthlyWeekDay-1])
-	}
-
-	if reccurrence.MonthlyDay != 0 {
-		switch reccurrence.MonthlyDay {
-		case 29:
-			rrule.WriteString("BYMONTHDAY=28,29;BYSETPOS=-1;") // fall back to the 28th on months with 28 days if recurrence set to every 29th
-		case 30:
-			rrule.WriteString("BYMONTHDAY=28,29,30;BYSETPOS=-1;")
-		case 31:
-			rrule.WriteString("BYMONTHDAY=28,29,30,31;BYSETPOS=-1;")
-		default:
-			fmt.Fprintf(&rrule, "BYMONTHDAY=%d;", reccurrence.MonthlyDay)
-		}
-	}
-
-	if endTime != nil {
-		fmt.Fprintf(&rrule, "UNTIL=%s;", endTime.Format("20060102T150405Z"))
-	} else {
-		// Use a local copy to avoid mutating the caller's recurrence object
-		endTimes := reccurrence.EndTimes
-		if reccurrence.EndDateTime != "" {
-			endTimes = 0
-			t, err := time.Parse(time.RFC3339, reccurrence.EndDateTime)
-			if err != nil {
-				return "", fmt.Errorf("failed to parse recurrence end_date_time %s: %w", reccurrence.EndDateTime, err)
-			}
-			fmt.Fprintf(&rrule, "UNTIL=%s;", t.Format("20060102T150405Z"))
-		}
-
-		if endTimes != 0 {
-			fmt.Fprintf(&rrule, "COUNT=%d;", endTimes)
-		} else if reccurrence.EndDateTime == "" {
-			// No terminal condition — add a safety cap to prevent set.All() from
-			// generating an unbounded sequence and exhausting memory.
-			rrule.WriteString("COUNT=1000;")
-		}
+	rule, bounded, err := models.FormatRRule(reccurrence, endTime)
+	if err != nil {
+		return "", err
 	}
-
-	return strings.TrimSuffix(rrule.String(), ";"), nil
-}
-
-// parseByDay takes a list of weekdays as a string and returns the list of
-// abbreviations as a string where 1 is Sunday and 7 is Saturday
-// (e.g. "2,3,6" -> "MO,TU,FR")
-func parseByDay(days string) (string, error) {
-	stringSlice := strings.Split(days, ",")
-	var weekdays strings.Builder
-	var hasWritten bool
-	for _, item := range stringSlice {
-		weekdayNum, err := strconv.Atoi(item)
-		if err != nil {
-			return "", err
-		}
-		// A weekday can only be 1-7. Skip numbers that are not in this range.
-		if weekdayNum < 1 || weekdayNum > 7 {
-			continue
-		}
-		if hasWritten {
-			weekdays.WriteString(",")
-		}
-		weekdays.WriteString(weekdaysABBRV[weekdayNum-1])
-		hasWritten = true
+	if !bounded {
+		// No terminal condition — add a safety cap to prevent set.All() from
+		// generating an unbounded sequence and exhausting memory.
+		rule += ";COUNT=1000"
 	}
-	return weekdays.String(), nil
+	return rule, nil
 }
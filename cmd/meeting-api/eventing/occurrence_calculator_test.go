@@ -205,6 +205,34 @@ func TestOccurrenceCalculator_WithEndDate(t *testing.T) {
 	}
 }
 
+// TestOccurrenceCalculator_StopsAtLimit verifies that a far-off end date only expands as many
+// occurrences as requested, and that cancelled occurrences do not count toward the limit.
+func TestOccurrenceCalculator_StopsAtLimit(t *testing.T) {
+	calc := NewOccurrenceCalculator(slog.Default())
+	startTime := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	meeting := models.MeetingEventData{
+		ID:        "test-meeting-1",
+		StartTime: startTime.Format(time.RFC3339),
+		Timezone:  "UTC",
+		Duration:  60,
+		Recurrence: &models.ZoomMeetingRecurrence{
+			Type:           1, // Daily
+			RepeatInterval: 1,
+			EndDateTime:    "2600-01-01T00:00:00Z",
+		},
+		CancelledOccurrences: []string{strconv.FormatInt(startTime.Add(24*time.Hour).Unix(), 10)},
+	}
+
+	occurrences, err := calc.CalculateOccurrences(context.Background(), meeting, true, false, 3)
+	require.NoError(t, err)
+
+	require.Len(t, occurrences, 3)
+	assert.Equal(t, startTime, occurrences[0].StartTime)
+	assert.Equal(t, startTime.Add(48*time.Hour), occurrences[1].StartTime, "the cancelled occurrence is skipped")
+	assert.Equal(t, startTime.Add(72*time.Hour), occurrences[2].StartTime)
+}
+
 func TestOccurrenceCalculator_CancelledOccurrences(t *testing.T) {
 	calc := NewOccurrenceCalculator(slog.Default())
 	startTime := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
@@ -273,25 +301,6 @@ func TestOccurrenceCalculator_BiWeeklyRecurrence(t *testing.T) {
 	}
 }
 
-func TestParseByDay(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected string
-	}{
-		{"1", "SU"},
-		{"2", "MO"},
-		{"1,3,5", "SU,TU,TH"},
-		{"2,3,4,5,6", "MO,TU,WE,TH,FR"},
-		{"1,7", "SU,SA"},
-	}
-
-	for _, tt := range tests {
-		result, err := parseByDay(tt.input)
-		require.NoError(t, err)
-		assert.Equal(t, tt.expected, result, "Input: %s", tt.input)
-	}
-}
-
 // TestOccurrenceCalculator_QuarterlyCadenceChange is the regression test for LFXV2-2066.
 // When a meeting's cadence is changed to quarterly via an all_following update, the
 // occurrence calculator must:
@@ -543,9 +552,281 @@ func TestOccurrenceCalculator_OutputIsSorted(t *testing.T) {
 	}
 }
 
+// TestOccurrenceCalculator_SkipsPastOccurrences pins the calculator's clock to verify that
+// occurrences are treated as past only once their duration and end buffer have elapsed.
+func TestOccurrenceCalculator_SkipsPastOccurrences(t *testing.T) {
+	calc := NewOccurrenceCalculator(slog.Default())
+	startTime := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	meeting := models.MeetingEventData{
+		ID:        "test-past",
+		StartTime: startTime.Format(time.RFC3339),
+		Timezone:  "UTC",
+		Duration:  60,
+		Recurrence: &models.ZoomMeetingRecurrence{
+			Type:           1, // Daily
+			RepeatInterval: 1,
+			EndTimes:       5,
+		},
+		CancelledOccurrences: []string{},
+		UpdatedOccurrences:   []models.UpdatedOccurrence{},
+	}
+
+	// Third occurrence started at 10:00 and lasts 60 minutes; with the 40 minute end
+	// buffer it is still upcoming at 11:40 and past one minute later.
+	third := startTime.Add(48 * time.Hour)
+
+	calc.now = func() time.Time { return third.Add(100 * time.Minute) }
+	occurrences, err := calc.CalculateOccurrences(context.Background(), meeting, false, false, 100)
+	require.NoError(t, err)
+	require.Len(t, occurrences, 3)
+	assert.Equal(t, third.Unix(), parseOccurrenceID(t, occurrences[0].OccurrenceID))
+
+	calc.now = func() time.Time { return third.Add(101 * time.Minute) }
+	occurrences, err = calc.CalculateOccurrences(context.Background(), meeting, false, false, 100)
+	require.NoError(t, err)
+	require.Len(t, occurrences, 2)
+	assert.Equal(t, third.Add(24*time.Hour).Unix(), parseOccurrenceID(t, occurrences[0].OccurrenceID))
+
+	// Including past occurrences ignores the clock entirely.
+	occurrences, err = calc.CalculateOccurrences(context.Background(), meeting, true, false, 100)
+	require.NoError(t, err)
+	assert.Len(t, occurrences, 5)
+}
+
+// TestOccurrenceCalculator_CalendarEdgeCases covers ordinal weekdays, month-end rollover and
+// DST transitions. Start times are given and expected as wall-clock times in the meeting's
+// timezone: occurrences must keep their local time across DST changes, not their UTC time.
+func TestOccurrenceCalculator_CalendarEdgeCases(t *testing.T) {
+	testCases := []struct {
+		name       string
+		timezone   string
+		start      string
+		recurrence models.ZoomMeetingRecurrence
+		want       []string
+	}{
+		{
+			name:       "weekly across US spring forward",
+			timezone:   "America/New_York",
+			start:      "2024-03-05T10:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 2, RepeatInterval: 1, WeeklyDays: "3", EndTimes: 3},
+			want:       []string{"2024-03-05T10:00", "2024-03-12T10:00", "2024-03-19T10:00"},
+		},
+		{
+			name:       "daily across EU fall back",
+			timezone:   "Europe/Berlin",
+			start:      "2024-10-26T09:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 1, RepeatInterval: 1, EndTimes: 3},
+			want:       []string{"2024-10-26T09:00", "2024-10-27T09:00", "2024-10-28T09:00"},
+		},
+		{
+			name:       "weekly across southern hemisphere DST end",
+			timezone:   "Australia/Sydney",
+			start:      "2024-03-31T10:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 2, RepeatInterval: 1, WeeklyDays: "1", EndTimes: 3},
+			want:       []string{"2024-03-31T10:00", "2024-04-07T10:00", "2024-04-14T10:00"},
+		},
+		{
+			// 02:30 does not exist on 2024-03-10; the RRULE expansion resolves it to 01:30 EST.
+			// This is pinned rather than corrected because occurrence IDs must match ITX's.
+			name:       "daily through a skipped local hour",
+			timezone:   "America/New_York",
+			start:      "2024-03-09T02:30",
+			recurrence: models.ZoomMeetingRecurrence{Type: 1, RepeatInterval: 1, EndTimes: 3},
+			want:       []string{"2024-03-09T02:30", "2024-03-10T01:30", "2024-03-11T02:30"},
+		},
+		{
+			name:       "biweekly on two days across DST",
+			timezone:   "America/Los_Angeles",
+			start:      "2024-10-28T08:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 2, RepeatInterval: 2, WeeklyDays: "2,4", EndTimes: 4},
+			want:       []string{"2024-10-28T08:00", "2024-10-30T08:00", "2024-11-11T08:00", "2024-11-13T08:00"},
+		},
+		{
+			name:       "monthly third thursday",
+			timezone:   "UTC",
+			start:      "2024-01-18T16:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyWeek: 3, MonthlyWeekDay: 5, EndTimes: 4},
+			want:       []string{"2024-01-18T16:00", "2024-02-15T16:00", "2024-03-21T16:00", "2024-04-18T16:00"},
+		},
+		{
+			name:       "monthly last friday",
+			timezone:   "UTC",
+			start:      "2024-01-26T16:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyWeek: -1, MonthlyWeekDay: 6, EndTimes: 4},
+			want:       []string{"2024-01-26T16:00", "2024-02-23T16:00", "2024-03-29T16:00", "2024-04-26T16:00"},
+		},
+		{
+			name:       "monthly first monday across UK DST",
+			timezone:   "Europe/London",
+			start:      "2024-03-04T09:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyWeek: 1, MonthlyWeekDay: 2, EndTimes: 2},
+			want:       []string{"2024-03-04T09:00", "2024-04-01T09:00"},
+		},
+		{
+			name:       "monthly on the 31st falls back to the last day",
+			timezone:   "UTC",
+			start:      "2024-01-31T12:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyDay: 31, EndTimes: 5},
+			want:       []string{"2024-01-31T12:00", "2024-02-29T12:00", "2024-03-31T12:00", "2024-04-30T12:00", "2024-05-31T12:00"},
+		},
+		{
+			name:       "monthly on the 30th in a non-leap year",
+			timezone:   "UTC",
+			start:      "2023-01-30T12:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyDay: 30, EndTimes: 3},
+			want:       []string{"2023-01-30T12:00", "2023-02-28T12:00", "2023-03-30T12:00"},
+		},
+		{
+			name:       "monthly on the 29th in a leap year",
+			timezone:   "UTC",
+			start:      "2024-01-29T12:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyDay: 29, EndTimes: 3},
+			want:       []string{"2024-01-29T12:00", "2024-02-29T12:00", "2024-03-29T12:00"},
+		},
+		{
+			name:       "quarterly on the 31st",
+			timezone:   "America/New_York",
+			start:      "2024-01-31T18:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 3, RepeatInterval: 3, MonthlyDay: 31, EndTimes: 4},
+			want:       []string{"2024-01-31T18:00", "2024-04-30T18:00", "2024-07-31T18:00", "2024-10-31T18:00"},
+		},
+		{
+			// 19:00 EST on Nov 3 (after fall back) is exactly the UTC end date, which is inclusive.
+			name:       "end date is inclusive across fall back",
+			timezone:   "America/New_York",
+			start:      "2024-11-01T19:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 1, RepeatInterval: 1, EndDateTime: "2024-11-04T00:00:00Z"},
+			want:       []string{"2024-11-01T19:00", "2024-11-02T19:00", "2024-11-03T19:00"},
+		},
+		{
+			name:       "end date with offset is compared in UTC",
+			timezone:   "America/New_York",
+			start:      "2024-11-01T19:00",
+			recurrence: models.ZoomMeetingRecurrence{Type: 1, RepeatInterval: 1, EndDateTime: "2024-11-03T18:59:00-05:00"},
+			want:       []string{"2024-11-01T19:00", "2024-11-02T19:00"},
+		},
+	}
+
+	calc := NewOccurrenceCalculator(slog.Default())
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tc.timezone)
+			require.NoError(t, err)
+			start, err := time.ParseInLocation("2006-01-02T15:04", tc.start, loc)
+			require.NoError(t, err)
+
+			recurrence := tc.recurrence
+			meeting := models.MeetingEventData{
+				ID:         "test-edge",
+				StartTime:  start.UTC().Format(time.RFC3339),
+				Timezone:   tc.timezone,
+				Duration:   60,
+				Recurrence: &recurrence,
+			}
+
+			occurrences, err := calc.CalculateOccurrences(context.Background(), meeting, true, false, 100)
+			require.NoError(t, err)
+
+			got := make([]string, len(occurrences))
+			for i, occ := range occurrences {
+				got[i] = occ.StartTime.In(loc).Format("2006-01-02T15:04")
+				assert.Equal(t, strconv.FormatInt(occ.StartTime.Unix(), 10), occ.OccurrenceID)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 // Helper function to parse occurrence ID (unix timestamp string) to int64
 func parseOccurrenceID(t *testing.T, occurrenceID string) int64 {
 	ts, err := strconv.ParseInt(occurrenceID, 10, 64)
 	require.NoError(t, err)
 	return ts
 }
+
+// benchmarkMeetings returns representative recurring meetings for the occurrence benchmarks.
+func benchmarkMeetings() map[string]models.MeetingEventData {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	// Long horizon: daily with no terminal condition expands to the 1000-occurrence safety cap.
+	longHorizon := models.MeetingEventData{
+		ID:         "bench-long-horizon",
+		Title:      "Daily Standup",
+		StartTime:  start.Format(time.RFC3339),
+		Timezone:   "America/New_York",
+		Duration:   15,
+		Recurrence: &models.ZoomMeetingRecurrence{Type: 1, RepeatInterval: 1},
+	}
+
+	// Complex weekly: Mon/Wed/Fri every other week with every fourth occurrence cancelled.
+	complexWeekly := models.MeetingEventData{
+		ID:        "bench-complex-weekly",
+		Title:     "Working Group",
+		StartTime: start.Format(time.RFC3339),
+		Timezone:  "Europe/Berlin",
+		Duration:  60,
+		Recurrence: &models.ZoomMeetingRecurrence{
+			Type:           2,
+			RepeatInterval: 2,
+			WeeklyDays:     "2,4,6",
+			EndTimes:       300,
+		},
+	}
+	calc := NewOccurrenceCalculator(slog.Default())
+	all, err := calc.getRRuleOccurrences(start, complexWeekly.Timezone, complexWeekly.Recurrence, nil)
+	if err != nil {
+		panic(err)
+	}
+	for i, o := range all {
+		if i%4 == 0 {
+			complexWeekly.CancelledOccurrences = append(complexWeekly.CancelledOccurrences, strconv.FormatInt(o.Unix(), 10))
+		}
+	}
+
+	// Cadence changes: weekly meeting rescheduled with several all_following and single updates.
+	cadenceChanges := models.MeetingEventData{
+		ID:         "bench-cadence-changes",
+		Title:      "TSC Meeting",
+		StartTime:  start.Format(time.RFC3339),
+		Timezone:   "UTC",
+		Duration:   60,
+		Recurrence: &models.ZoomMeetingRecurrence{Type: 2, RepeatInterval: 1, WeeklyDays: "2", EndTimes: 500},
+	}
+	for i := 1; i <= 5; i++ {
+		old := start.AddDate(0, 0, 7*20*i)
+		cadenceChanges.UpdatedOccurrences = append(cadenceChanges.UpdatedOccurrences, models.UpdatedOccurrence{
+			OldOccurrenceID: strconv.FormatInt(old.Unix(), 10),
+			NewOccurrenceID: strconv.FormatInt(old.Add(time.Hour).Unix(), 10),
+			AllFollowing:    true,
+		})
+		single := start.AddDate(0, 0, 7*(20*i+3))
+		cadenceChanges.UpdatedOccurrences = append(cadenceChanges.UpdatedOccurrences, models.UpdatedOccurrence{
+			OldOccurrenceID: strconv.FormatInt(single.Unix(), 10),
+			NewOccurrenceID: strconv.FormatInt(single.Add(30*time.Minute).Unix(), 10),
+			Duration:        90,
+		})
+	}
+
+	return map[string]models.MeetingEventData{
+		"LongHorizon":    longHorizon,
+		"ComplexWeekly":  complexWeekly,
+		"CadenceChanges": cadenceChanges,
+	}
+}
+
+func BenchmarkOccurrenceCalculator_CalculateOccurrences(b *testing.B) {
+	calc := NewOccurrenceCalculator(slog.New(slog.DiscardHandler))
+	ctx := context.Background()
+
+	for name, meeting := range benchmarkMeetings() {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := calc.CalculateOccurrences(ctx, meeting, true, true, 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -135,23 +135,21 @@ func (h *EventHandlers) handlePastMeetingInviteeUpdate(
 	// If an attendee cross-reference exists for this participant, preserve is_attended=true
 	// and carry over attendee-only fields so a late-arriving invitee upsert doesn't overwrite
 	// values that the attendee handler already set (e.g. is_unknown, is_ai_reconciled).
-	if participantData.Username != "" {
-		attendeeXrefKey := fmt.Sprintf("v1_participant_by_meeting_user.attendee.%s.%s",
-			participantData.MeetingAndOccurrenceID, participantData.Username)
-		if xrefEntry, err := h.v1MappingsKV.Get(ctx, attendeeXrefKey); err == nil && !entryIsTombstoned(xrefEntry) {
-			participantData.IsAttended = true
-			attendeeID := string(xrefEntry.Value())
-			if attendeeEntry, err := h.v1ObjectsKV.Get(ctx, fmt.Sprintf("itx-zoom-past-meetings-attendees.%s", attendeeID)); err == nil {
-				if attendeeMap, err := decodeData(attendeeEntry.Value()); err == nil {
-					if jsonBytes, err := json.Marshal(attendeeMap); err == nil {
-						var rawAttendee AttendeeDBRaw
-						if err := json.Unmarshal(jsonBytes, &rawAttendee); err == nil {
-							participantData.IsUnknown = rawAttendee.IsUnknown
-							participantData.IsAIReconciled = rawAttendee.IsAIReconciled
-							participantData.IsAutoMatched = rawAttendee.IsAutoMatched
-							participantData.ZoomUserName = rawAttendee.ZoomUserName
-							participantData.MappedInviteeName = rawAttendee.MappedInviteeName
-						}
+	// Attendees without an LF username are matched by their Zoom registrant ID.
+	registrantID := utils.GetString(v1Data["registrant_id"])
+	if attendeeID, ok := h.findSiblingParticipant(ctx, participantKindAttendee,
+		participantData.MeetingAndOccurrenceID, participantData.Username, registrantID); ok {
+		participantData.IsAttended = true
+		if attendeeEntry, err := h.v1ObjectsKV.Get(ctx, fmt.Sprintf("itx-zoom-past-meetings-attendees.%s", attendeeID)); err == nil {
+			if attendeeMap, err := decodeData(attendeeEntry.Value()); err == nil {
+				if jsonBytes, err := json.Marshal(attendeeMap); err == nil {
+					var rawAttendee AttendeeDBRaw
+					if err := json.Unmarshal(jsonBytes, &rawAttendee); err == nil {
+						participantData.IsUnknown = rawAttendee.IsUnknown
+						participantData.IsAIReconciled = rawAttendee.IsAIReconciled
+						participantData.IsAutoMatched = rawAttendee.IsAutoMatched
+						participantData.ZoomUserName = rawAttendee.ZoomUserName
+						participantData.MappedInviteeName = rawAttendee.MappedInviteeName
 					}
 				}
 			}
@@ -257,6 +255,7 @@ func (h *EventHandlers) handlePastMeetingInviteeUpdate(
 			funcLogger.With(logging.ErrKey, err).WarnContext(ctx, "failed to store invitee cross-reference mapping")
 		}
 	}
+	h.storeRegistrantXref(ctx, funcLogger, participantKindInvitee, participantData.MeetingAndOccurrenceID, registrantID, participantData.UID)
 
 	funcLogger.InfoContext(ctx, "successfully processed past meeting invitee")
 	return false
@@ -276,7 +275,7 @@ func (h *EventHandlers) handlePastMeetingInviteeDelete(ctx context.Context, key
 		return false
 	}
 
-	var username, meetingAndOccurrenceID string
+	var username, meetingAndOccurrenceID, registrantID string
 	if v1Data == nil {
 		// Hard NATS deletes arrive with nil v1Data; recover username and meeting ID
 		// from the rich mapping written by the update handler.
@@ -291,21 +290,18 @@ func (h *EventHandlers) handlePastMeetingInviteeDelete(ctx context.Context, key
 	} else {
 		username = utils.GetString(v1Data["lf_sso"])
 		meetingAndOccurrenceID = utils.GetString(v1Data["meeting_and_occurrence_id"])
+		registrantID = utils.GetString(v1Data["registrant_id"])
 	}
 
 	// Check if an attendee record still exists for this participant.
-	if username != "" && meetingAndOccurrenceID != "" {
-		attendeeXrefKey := fmt.Sprintf("v1_participant_by_meeting_user.attendee.%s.%s", meetingAndOccurrenceID, username)
-		if entry, err := h.v1MappingsKV.Get(ctx, attendeeXrefKey); err == nil && !entryIsTombstoned(entry) {
-			survivingAttendeeID := string(entry.Value())
-			funcLogger.DebugContext(ctx, "participant has active attendee record; applying partial invitee delete",
-				"surviving_attendee_id", survivingAttendeeID)
-			return h.handlePartialInviteeDelete(ctx, funcLogger, key, inviteeID, survivingAttendeeID, meetingAndOccurrenceID, username)
-		}
+	if survivingAttendeeID, ok := h.findSiblingParticipant(ctx, participantKindAttendee, meetingAndOccurrenceID, username, registrantID); ok {
+		funcLogger.DebugContext(ctx, "participant has active attendee record; applying partial invitee delete",
+			"surviving_attendee_id", survivingAttendeeID)
+		return h.handlePartialInviteeDelete(ctx, funcLogger, key, inviteeID, survivingAttendeeID, meetingAndOccurrenceID, username, registrantID)
 	}
 
 	// Full delete — no attendee record survives.
-	return h.fullDeleteInvitee(ctx, funcLogger, key, inviteeID, meetingAndOccurrenceID, username)
+	return h.fullDeleteInvitee(ctx, funcLogger, key, inviteeID, meetingAndOccurrenceID, username, registrantID)
 }
 
 // fullDeleteInvitee performs a full indexer delete and FGA member_remove for an invitee
@@ -314,7 +310,7 @@ func (h *EventHandlers) handlePastMeetingInviteeDelete(ctx context.Context, key
 func (h *EventHandlers) fullDeleteInvitee(
 	ctx context.Context,
 	funcLogger *slog.Logger,
-	key, inviteeID, meetingAndOccurrenceID, username string,
+	key, inviteeID, meetingAndOccurrenceID, username, registrantID string,
 ) (retry bool) {
 	var accessPayload []byte
 	var deleteAccessSubject string
@@ -335,6 +331,9 @@ func (h *EventHandlers) fullDeleteInvitee(
 	if !result && username != "" && meetingAndOccurrenceID != "" {
 		h.tombstoneMapping(ctx, fmt.Sprintf("v1_participant_by_meeting_user.invitee.%s.%s", meetingAndOccurrenceID, username))
 	}
+	if !result {
+		h.tombstoneRegistrantXref(ctx, participantKindInvitee, meetingAndOccurrenceID, registrantID)
+	}
 	return result
 }
 
@@ -344,7 +343,7 @@ func (h *EventHandlers) fullDeleteInvitee(
 func (h *EventHandlers) handlePartialInviteeDelete(
 	ctx context.Context,
 	funcLogger *slog.Logger,
-	key, inviteeID, survivingAttendeeID, meetingAndOccurrenceID, username string,
+	key, inviteeID, survivingAttendeeID, meetingAndOccurrenceID, username, registrantID string,
 ) (retry bool) {
 	// Fetch the surviving attendee data to build an accurate participant record.
 	attendeeEntry, err := h.v1ObjectsKV.Get(ctx, fmt.Sprintf("itx-zoom-past-meetings-attendees.%s", survivingAttendeeID))
@@ -356,7 +355,7 @@ func (h *EventHandlers) handlePartialInviteeDelete(
 		// Sibling attendee is gone — fall back to a full invitee delete.
 		funcLogger.WarnContext(ctx, "surviving attendee not found during partial invitee delete; falling back to full delete",
 			"surviving_attendee_id", survivingAttendeeID)
-		return h.fullDeleteInvitee(ctx, funcLogger, key, inviteeID, meetingAndOccurrenceID, username)
+		return h.fullDeleteInvitee(ctx, funcLogger, key, inviteeID, meetingAndOccurrenceID, username, registrantID)
 	}
 	attendeeData, err := decodeData(attendeeEntry.Value())
 	if err != nil {
@@ -383,10 +382,13 @@ func (h *EventHandlers) handlePartialInviteeDelete(
 		return isTransientError(err)
 	}
 
-	// Tombstone the invitee mapping and cross-reference; the attendee's records remain active.
+	// Tombstone the invitee mapping and cross-references; the attendee's records remain active.
+	// Participants matched by registrant ID have no username cross-reference to tombstone.
 	h.tombstoneMapping(ctx, fmt.Sprintf("v1_past_meeting_invitees.%s", inviteeID))
-	xrefKey := fmt.Sprintf("v1_participant_by_meeting_user.invitee.%s.%s", meetingAndOccurrenceID, username)
-	h.tombstoneMapping(ctx, xrefKey)
+	if username != "" {
+		h.tombstoneMapping(ctx, fmt.Sprintf("v1_participant_by_meeting_user.invitee.%s.%s", meetingAndOccurrenceID, username))
+	}
+	h.tombstoneRegistrantXref(ctx, participantKindInvitee, meetingAndOccurrenceID, registrantID)
 
 	funcLogger.InfoContext(ctx, "successfully applied partial invitee delete (attendee record remains active)")
 	return false
@@ -664,6 +666,8 @@ func (h *EventHandlers) handlePastMeetingAttendeeUpdate(
 			funcLogger.With(logging.ErrKey, err).WarnContext(ctx, "failed to store attendee cross-reference mapping")
 		}
 	}
+	h.storeRegistrantXref(ctx, funcLogger, participantKindAttendee, participantData.MeetingAndOccurrenceID,
+		utils.GetString(v1Data["registrant_id"]), participantData.UID)
 
 	funcLogger.InfoContext(ctx, "successfully processed past meeting attendee")
 	return false
@@ -687,7 +691,7 @@ func (h *EventHandlers) handlePastMeetingAttendeeDelete(
 		return false
 	}
 
-	var username, meetingAndOccurrenceID string
+	var username, meetingAndOccurrenceID, registrantID string
 	if v1Data == nil {
 		// Hard NATS deletes arrive with nil v1Data; recover username and meeting ID
 		// from the rich mapping written by the update handler.
@@ -702,21 +706,18 @@ func (h *EventHandlers) handlePastMeetingAttendeeDelete(
 	} else {
 		username = utils.GetString(v1Data["lf_sso"])
 		meetingAndOccurrenceID = utils.GetString(v1Data["meeting_and_occurrence_id"])
+		registrantID = utils.GetString(v1Data["registrant_id"])
 	}
 
 	// Check if an invitee record still exists for this participant.
-	if username != "" && meetingAndOccurrenceID != "" {
-		inviteeXrefKey := fmt.Sprintf("v1_participant_by_meeting_user.invitee.%s.%s", meetingAndOccurrenceID, username)
-		if entry, err := h.v1MappingsKV.Get(ctx, inviteeXrefKey); err == nil && !entryIsTombstoned(entry) {
-			survivingInviteeID := string(entry.Value())
-			funcLogger.DebugContext(ctx, "participant has active invitee record; applying partial attendee delete",
-				"surviving_invitee_id", survivingInviteeID)
-			return h.handlePartialAttendeeDelete(ctx, funcLogger, key, attendeeID, survivingInviteeID, meetingAndOccurrenceID, username)
-		}
+	if survivingInviteeID, ok := h.findSiblingParticipant(ctx, participantKindInvitee, meetingAndOccurrenceID, username, registrantID); ok {
+		funcLogger.DebugContext(ctx, "participant has active invitee record; applying partial attendee delete",
+			"surviving_invitee_id", survivingInviteeID)
+		return h.handlePartialAttendeeDelete(ctx, funcLogger, key, attendeeID, survivingInviteeID, meetingAndOccurrenceID, username, registrantID)
 	}
 
 	// Full delete — no invitee record survives.
-	return h.fullDeleteAttendee(ctx, funcLogger, key, attendeeID, meetingAndOccurrenceID, username)
+	return h.fullDeleteAttendee(ctx, funcLogger, key, attendeeID, meetingAndOccurrenceID, username, registrantID)
 }
 
 // fullDeleteAttendee performs a full indexer delete and FGA member_remove for an attendee
@@ -725,7 +726,7 @@ func (h *EventHandlers) handlePastMeetingAttendeeDelete(
 func (h *EventHandlers) fullDeleteAttendee(
 	ctx context.Context,
 	funcLogger *slog.Logger,
-	key, attendeeID, meetingAndOccurrenceID, username string,
+	key, attendeeID, meetingAndOccurrenceID, username, registrantID string,
 ) (retry bool) {
 	var accessPayload []byte
 	var deleteAccessSubject string
@@ -748,6 +749,9 @@ func (h *EventHandlers) fullDeleteAttendee(
 	if !result && username != "" && meetingAndOccurrenceID != "" {
 		h.tombstoneMapping(ctx, fmt.Sprintf("v1_participant_by_meeting_user.attendee.%s.%s", meetingAndOccurrenceID, username))
 	}
+	if !result {
+		h.tombstoneRegistrantXref(ctx, participantKindAttendee, meetingAndOccurrenceID, registrantID)
+	}
 	return result
 }
 
@@ -757,7 +761,7 @@ func (h *EventHandlers) fullDeleteAttendee(
 func (h *EventHandlers) handlePartialAttendeeDelete(
 	ctx context.Context,
 	funcLogger *slog.Logger,
-	key, attendeeID, survivingInviteeID, meetingAndOccurrenceID, username string,
+	key, attendeeID, survivingInviteeID, meetingAndOccurrenceID, username, registrantID string,
 ) (retry bool) {
 	// Fetch the surviving invitee data to build an accurate participant record.
 	inviteeEntry, err := h.v1ObjectsKV.Get(ctx, fmt.Sprintf("itx-zoom-past-meetings-invitees.%s", survivingInviteeID))
@@ -769,7 +773,7 @@ func (h *EventHandlers) handlePartialAttendeeDelete(
 		// Sibling invitee is gone — fall back to a full attendee delete.
 		funcLogger.WarnContext(ctx, "surviving invitee not found during partial attendee delete; falling back to full delete",
 			"surviving_invitee_id", survivingInviteeID)
-		return h.fullDeleteAttendee(ctx, funcLogger, key, attendeeID, meetingAndOccurrenceID, username)
+		return h.fullDeleteAttendee(ctx, funcLogger, key, attendeeID, meetingAndOccurrenceID, username, registrantID)
 	}
 	inviteeData, err := decodeData(inviteeEntry.Value())
 	if err != nil {
@@ -796,10 +800,13 @@ func (h *EventHandlers) handlePartialAttendeeDelete(
 		return isTransientError(err)
 	}
 
-	// Tombstone the attendee mapping and cross-reference; the invitee's records remain active.
+	// Tombstone the attendee mapping and cross-references; the invitee's records remain active.
+	// Participants matched by registrant ID have no username cross-reference to tombstone.
 	h.tombstoneMapping(ctx, fmt.Sprintf("v1_past_meeting_attendees.%s", attendeeID))
-	xrefKey := fmt.Sprintf("v1_participant_by_meeting_user.attendee.%s.%s", meetingAndOccurrenceID, username)
-	h.tombstoneMapping(ctx, xrefKey)
+	if username != "" {
+		h.tombstoneMapping(ctx, fmt.Sprintf("v1_participant_by_meeting_user.attendee.%s.%s", meetingAndOccurrenceID, username))
+	}
+	h.tombstoneRegistrantXref(ctx, participantKindAttendee, meetingAndOccurrenceID, registrantID)
 
 	funcLogger.InfoContext(ctx, "successfully applied partial attendee delete (invitee record remains active)")
 	return false
@@ -1106,3 +1113,85 @@ func resolveProjectFields(
 	}
 	return sfid, slug, nil
 }
+
+// =============================================================================
+// Participant Cross-Reference Helpers
+// =============================================================================
+
+// Record kinds of a past meeting participant, used in cross-reference keys.
+const (
+	participantKindInvitee  = "invitee"
+	participantKindAttendee = "attendee"
+)
+
+// participantObjectPrefixes maps a participant record kind to its v1 objects KV key prefix.
+var participantObjectPrefixes = map[string]string{
+	participantKindInvitee:  "itx-zoom-past-meetings-invitees.",
+	participantKindAttendee: "itx-zoom-past-meetings-attendees.",
+}
+
+// registrantXrefKey returns the mappings KV key that cross-references a Zoom registrant of a
+// past meeting occurrence to its invitee or attendee record. Unlike the username
+// cross-reference it also correlates participants without an LF username, e.g. guests whose
+// corporate SSO hides their email from Zoom.
+func registrantXrefKey(kind, meetingAndOccurrenceID, registrantID string) string {
+	return fmt.Sprintf("v1_participant_by_meeting_registrant.%s.%s.%s", kind, meetingAndOccurrenceID, registrantID)
+}
+
+// findSiblingParticipant returns the ID of the active record of the given kind (invitee or
+// attendee) that belongs to the same participant of the past meeting occurrence. It matches
+// on LF username first and falls back to the Zoom registrant ID. A registrant match is only
+// returned while the sibling's v1 record still exists, since hard deletes carry no registrant
+// ID and cannot tombstone the registrant cross-reference.
+func (h *EventHandlers) findSiblingParticipant(
+	ctx context.Context,
+	kind, meetingAndOccurrenceID, username, registrantID string,
+) (string, bool) {
+	if meetingAndOccurrenceID == "" {
+		return "", false
+	}
+
+	if username != "" {
+		xrefKey := fmt.Sprintf("v1_participant_by_meeting_user.%s.%s.%s", kind, meetingAndOccurrenceID, username)
+		if entry, err := h.v1MappingsKV.Get(ctx, xrefKey); err == nil && !entryIsTombstoned(entry) {
+			return string(entry.Value()), true
+		}
+	}
+
+	if registrantID != "" {
+		entry, err := h.v1MappingsKV.Get(ctx, registrantXrefKey(kind, meetingAndOccurrenceID, registrantID))
+		if err != nil || entryIsTombstoned(entry) {
+			return "", false
+		}
+		siblingID := string(entry.Value())
+		if _, err := h.v1ObjectsKV.Get(ctx, participantObjectPrefixes[kind]+siblingID); err != nil {
+			return "", false
+		}
+		return siblingID, true
+	}
+
+	return "", false
+}
+
+// storeRegistrantXref records the registrant cross-reference of an invitee or attendee record.
+// Failures are logged only: the username cross-reference remains the primary match.
+func (h *EventHandlers) storeRegistrantXref(
+	ctx context.Context,
+	funcLogger *slog.Logger,
+	kind, meetingAndOccurrenceID, registrantID, recordID string,
+) {
+	if registrantID == "" || meetingAndOccurrenceID == "" {
+		return
+	}
+	if _, err := h.v1MappingsKV.Put(ctx, registrantXrefKey(kind, meetingAndOccurrenceID, registrantID), []byte(recordID)); err != nil {
+		funcLogger.With(logging.ErrKey, err).WarnContext(ctx, "failed to store registrant cross-reference mapping", "kind", kind)
+	}
+}
+
+// tombstoneRegistrantXref tombstones the registrant cross-reference of a deleted record
+func (h *EventHandlers) tombstoneRegistrantXref(ctx context.Context, kind, meetingAndOccurrenceID, registrantID string) {
+	if registrantID == "" || meetingAndOccurrenceID == "" {
+		return
+	}
+	h.tombstoneMapping(ctx, registrantXrefKey(kind, meetingAndOccurrenceID, registrantID))
+}
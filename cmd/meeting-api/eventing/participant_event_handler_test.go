@@ -0,0 +1,263 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
+)
+
+const (
+	testMeetingAndOccurrenceID = "12345-1700000000"
+	testRegistrantID           = "reg-1"
+	testInviteeJSON            = `{"invitee_id":"inv-1","meeting_and_occurrence_id":"12345-1700000000","proj_id":"a0A1","project_slug":"proj","registrant_id":"reg-1"}`
+	testAttendeeJSON           = `{"id":"att-1","meeting_and_occurrence_id":"12345-1700000000","proj_id":"a0A1","project_slug":"proj","registrant_id":"reg-1","is_unknown":true,"zoom_user_name":"Guest"}`
+)
+
+// projectIDMapper maps every v1 project to the same v2 project so participant records are synced.
+type projectIDMapper struct{ stubIDMapper }
+
+func (projectIDMapper) MapProjectV1ToV2(_ context.Context, _ string) (string, error) {
+	return "project-uid", nil
+}
+
+// isUsernameXref matches any write to a username cross-reference key
+func isUsernameXref(key string) bool {
+	return strings.HasPrefix(key, "v1_participant_by_meeting_user.")
+}
+
+func newParticipantTestHandlers(mappingsKV, objectsKV *mockKeyValue, publisher *mockEventPublisher) *EventHandlers {
+	return &EventHandlers{
+		publisher:    publisher,
+		userLookup:   stubV1UserLookup{},
+		idMapper:     projectIDMapper{},
+		v1MappingsKV: mappingsKV,
+		v1ObjectsKV:  objectsKV,
+		logger:       slog.Default(),
+	}
+}
+
+// TestFindSiblingParticipant verifies that an invitee's attendee record is found by LF
+// username first and by Zoom registrant ID for participants without a username.
+func TestFindSiblingParticipant(t *testing.T) {
+	const (
+		meetingAndOccurrenceID = "12345-1700000000"
+		registrantID           = "reg-1"
+	)
+	usernameXref := "v1_participant_by_meeting_user.attendee." + meetingAndOccurrenceID + ".alice"
+	registrantXref := registrantXrefKey(participantKindAttendee, meetingAndOccurrenceID, registrantID)
+
+	tests := []struct {
+		name     string
+		username string
+		setup    func(mappingsKV, objectsKV *mockKeyValue)
+		wantID   string
+		wantOK   bool
+	}{
+		{
+			name:     "matched by username",
+			username: "alice",
+			setup: func(mappingsKV, _ *mockKeyValue) {
+				mappingsKV.On("Get", mock.Anything, usernameXref).
+					Return(mockKeyValueEntry{key: usernameXref, value: []byte("att-1")}, nil)
+			},
+			wantID: "att-1",
+			wantOK: true,
+		},
+		{
+			name:     "falls back to registrant when username does not match",
+			username: "alice",
+			setup: func(mappingsKV, objectsKV *mockKeyValue) {
+				mappingsKV.On("Get", mock.Anything, usernameXref).Return(nil, jetstream.ErrKeyNotFound)
+				mappingsKV.On("Get", mock.Anything, registrantXref).
+					Return(mockKeyValueEntry{key: registrantXref, value: []byte("att-2")}, nil)
+				objectsKV.On("Get", mock.Anything, "itx-zoom-past-meetings-attendees.att-2").
+					Return(mockKeyValueEntry{key: "itx-zoom-past-meetings-attendees.att-2", value: []byte("{}")}, nil)
+			},
+			wantID: "att-2",
+			wantOK: true,
+		},
+		{
+			name: "matched by registrant without username",
+			setup: func(mappingsKV, objectsKV *mockKeyValue) {
+				mappingsKV.On("Get", mock.Anything, registrantXref).
+					Return(mockKeyValueEntry{key: registrantXref, value: []byte("att-2")}, nil)
+				objectsKV.On("Get", mock.Anything, "itx-zoom-past-meetings-attendees.att-2").
+					Return(mockKeyValueEntry{key: "itx-zoom-past-meetings-attendees.att-2", value: []byte("{}")}, nil)
+			},
+			wantID: "att-2",
+			wantOK: true,
+		},
+		{
+			name: "tombstoned registrant cross-reference",
+			setup: func(mappingsKV, _ *mockKeyValue) {
+				mappingsKV.On("Get", mock.Anything, registrantXref).
+					Return(mockKeyValueEntry{key: registrantXref, value: []byte(tombstoneMarker)}, nil)
+			},
+		},
+		{
+			name: "registrant cross-reference to a hard-deleted attendee",
+			setup: func(mappingsKV, objectsKV *mockKeyValue) {
+				mappingsKV.On("Get", mock.Anything, registrantXref).
+					Return(mockKeyValueEntry{key: registrantXref, value: []byte("att-2")}, nil)
+				objectsKV.On("Get", mock.Anything, "itx-zoom-past-meetings-attendees.att-2").
+					Return(nil, jetstream.ErrKeyNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mappingsKV := &mockKeyValue{}
+			objectsKV := &mockKeyValue{}
+			tt.setup(mappingsKV, objectsKV)
+
+			h := &EventHandlers{
+				v1MappingsKV: mappingsKV,
+				v1ObjectsKV:  objectsKV,
+				logger:       slog.Default(),
+			}
+
+			id, ok := h.findSiblingParticipant(context.Background(), participantKindAttendee,
+				meetingAndOccurrenceID, tt.username, registrantID)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantID, id)
+			mappingsKV.AssertExpectations(t)
+			objectsKV.AssertExpectations(t)
+		})
+	}
+}
+
+// TestHandlePastMeetingInviteeUpdate_RegistrantCorrelated verifies that an invitee without an
+// LF username picks up its attendee record through the registrant cross-reference, and that
+// only registrant cross-references are written for it.
+func TestHandlePastMeetingInviteeUpdate_RegistrantCorrelated(t *testing.T) {
+	mappingsKV := &mockKeyValue{}
+	objectsKV := &mockKeyValue{}
+	publisher := &mockEventPublisher{}
+
+	attendeeXref := registrantXrefKey(participantKindAttendee, testMeetingAndOccurrenceID, testRegistrantID)
+	inviteeXref := registrantXrefKey(participantKindInvitee, testMeetingAndOccurrenceID, testRegistrantID)
+	mappingsKV.On("Get", mock.Anything, attendeeXref).
+		Return(mockKeyValueEntry{key: attendeeXref, value: []byte("att-1")}, nil)
+	mappingsKV.On("Get", mock.Anything, "v1_past_meeting_invitees.inv-1").Return(nil, jetstream.ErrKeyNotFound)
+	mappingsKV.On("Put", mock.Anything, "v1_past_meeting_invitees.inv-1", mock.Anything).Return(uint64(1), nil)
+	mappingsKV.On("Put", mock.Anything, inviteeXref, []byte("inv-1")).Return(uint64(1), nil)
+	objectsKV.On("Get", mock.Anything, "itx-zoom-past-meetings-attendees.att-1").
+		Return(mockKeyValueEntry{key: "itx-zoom-past-meetings-attendees.att-1", value: []byte(testAttendeeJSON)}, nil)
+	objectsKV.On("Get", mock.Anything, "itx-zoom-meetings-registrants-v2."+testRegistrantID).Return(nil, jetstream.ErrKeyNotFound)
+	publisher.On("PublishPastMeetingParticipantEvent", mock.Anything, "created",
+		mock.MatchedBy(func(p *models.PastMeetingParticipantEventData) bool {
+			return p.UID == "inv-1" && p.IsInvited && p.IsAttended && p.IsUnknown && p.ZoomUserName == "Guest"
+		})).Return(nil)
+
+	h := newParticipantTestHandlers(mappingsKV, objectsKV, publisher)
+	v1Data, err := decodeData([]byte(testInviteeJSON))
+	assert.NoError(t, err)
+
+	retry := h.handlePastMeetingInviteeUpdate(context.Background(), "itx-zoom-past-meetings-invitees.inv-1", v1Data)
+
+	assert.False(t, retry)
+	mappingsKV.AssertExpectations(t)
+	objectsKV.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+	mappingsKV.AssertNotCalled(t, "Put", mock.Anything, mock.MatchedBy(isUsernameXref), mock.Anything)
+}
+
+// TestHandlePastMeetingParticipantDelete_PartialByRegistrant verifies the partial delete paths
+// for participants correlated only by registrant ID: the surviving record is re-published, the
+// deleted record's mapping and registrant cross-reference are tombstoned, and no username
+// cross-reference is tombstoned since there is no username.
+func TestHandlePastMeetingParticipantDelete_PartialByRegistrant(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		deletedKind   string
+		survivingKind string
+		deletedJSON   string
+		survivingID   string
+		survivingJSON string
+		wantInvited   bool
+		wantAttended  bool
+		handleDelete  func(h *EventHandlers, key string, v1Data map[string]interface{}) bool
+	}{
+		{
+			name:          "invitee deleted, attendee remains",
+			key:           "itx-zoom-past-meetings-invitees.inv-1",
+			deletedKind:   participantKindInvitee,
+			survivingKind: participantKindAttendee,
+			deletedJSON:   testInviteeJSON,
+			survivingID:   "att-1",
+			survivingJSON: testAttendeeJSON,
+			wantInvited:   false,
+			wantAttended:  true,
+			handleDelete: func(h *EventHandlers, key string, v1Data map[string]interface{}) bool {
+				return h.handlePastMeetingInviteeDelete(context.Background(), key, v1Data)
+			},
+		},
+		{
+			name:          "attendee deleted, invitee remains",
+			key:           "itx-zoom-past-meetings-attendees.att-1",
+			deletedKind:   participantKindAttendee,
+			survivingKind: participantKindInvitee,
+			deletedJSON:   testAttendeeJSON,
+			survivingID:   "inv-1",
+			survivingJSON: testInviteeJSON,
+			wantInvited:   true,
+			wantAttended:  false,
+			handleDelete: func(h *EventHandlers, key string, v1Data map[string]interface{}) bool {
+				return h.handlePastMeetingAttendeeDelete(context.Background(), key, v1Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mappingsKV := &mockKeyValue{}
+			objectsKV := &mockKeyValue{}
+			publisher := &mockEventPublisher{}
+
+			deletedID := strings.TrimPrefix(tt.key, participantObjectPrefixes[tt.deletedKind])
+			mappingKey := "v1_past_meeting_" + tt.deletedKind + "s." + deletedID
+			survivingXref := registrantXrefKey(tt.survivingKind, testMeetingAndOccurrenceID, testRegistrantID)
+			deletedXref := registrantXrefKey(tt.deletedKind, testMeetingAndOccurrenceID, testRegistrantID)
+			survivingKey := participantObjectPrefixes[tt.survivingKind] + tt.survivingID
+
+			mappingsKV.On("Get", mock.Anything, mappingKey).Return(nil, jetstream.ErrKeyNotFound)
+			mappingsKV.On("Get", mock.Anything, survivingXref).
+				Return(mockKeyValueEntry{key: survivingXref, value: []byte(tt.survivingID)}, nil)
+			mappingsKV.On("Put", mock.Anything, mappingKey, []byte(tombstoneMarker)).Return(uint64(1), nil)
+			mappingsKV.On("Put", mock.Anything, deletedXref, []byte(tombstoneMarker)).Return(uint64(1), nil)
+			objectsKV.On("Get", mock.Anything, survivingKey).
+				Return(mockKeyValueEntry{key: survivingKey, value: []byte(tt.survivingJSON)}, nil)
+			objectsKV.On("Get", mock.Anything, "itx-zoom-meetings-registrants-v2."+testRegistrantID).
+				Return(nil, jetstream.ErrKeyNotFound).Maybe()
+			publisher.On("PublishPastMeetingParticipantEvent", mock.Anything, "updated",
+				mock.MatchedBy(func(p *models.PastMeetingParticipantEventData) bool {
+					return p.UID == tt.survivingID && p.IsInvited == tt.wantInvited && p.IsAttended == tt.wantAttended
+				})).Return(nil)
+
+			h := newParticipantTestHandlers(mappingsKV, objectsKV, publisher)
+			v1Data, err := decodeData([]byte(tt.deletedJSON))
+			assert.NoError(t, err)
+
+			retry := tt.handleDelete(h, tt.key, v1Data)
+
+			assert.False(t, retry)
+			mappingsKV.AssertExpectations(t)
+			objectsKV.AssertExpectations(t)
+			publisher.AssertExpectations(t)
+			mappingsKV.AssertNotCalled(t, "Put", mock.Anything, mock.MatchedBy(isUsernameXref), mock.Anything)
+		})
+	}
+}
@@ -64,8 +64,8 @@ func (m *mockEventPublisher) PublishInviteResponseEvent(_ context.Context, _ str
 func (m *mockEventPublisher) PublishPastMeetingEvent(_ context.Context, _ string, _ *models.PastMeetingEventData) error {
 	return nil
 }
-func (m *mockEventPublisher) PublishPastMeetingParticipantEvent(_ context.Context, _ string, _ *models.PastMeetingParticipantEventData) error {
-	return nil
+func (m *mockEventPublisher) PublishPastMeetingParticipantEvent(ctx context.Context, action string, p *models.PastMeetingParticipantEventData) error {
+	return m.Called(ctx, action, p).Error(0)
 }
 func (m *mockEventPublisher) PublishPastMeetingRecordingEvent(_ context.Context, _ string, _ *models.RecordingEventData) error {
 	return nil
@@ -0,0 +1,104 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/utils"
+)
+
+// healthCheckTimeout bounds each dependency check so one hung dependency cannot stall the
+// health details response.
+const healthCheckTimeout = 3 * time.Second
+
+// healthCheck probes a single dependency for the health details endpoint.
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// healthChecker runs the dependency checks of the service. Dependencies that are not
+// configured (e.g. NATS_URL unset) have no check and are left out of the report.
+type healthChecker struct {
+	checks  []healthCheck
+	timeout time.Duration
+}
+
+func newHealthChecker() *healthChecker {
+	return &healthChecker{timeout: healthCheckTimeout}
+}
+
+// add registers another dependency check
+func (h *healthChecker) add(name string, check func(ctx context.Context) error) {
+	h.checks = append(h.checks, healthCheck{name: name, check: check})
+}
+
+// addNATSConn registers a round-trip check of a NATS connection, skipping nil connections
+func (h *healthChecker) addNATSConn(name string, nc *natsgo.Conn) {
+	if nc == nil {
+		return
+	}
+	h.add(name, func(ctx context.Context) error {
+		if nc.IsClosed() {
+			return natsgo.ErrConnectionClosed
+		}
+		return nc.FlushWithContext(ctx)
+	})
+}
+
+// addKVBucket registers a status check of a JetStream KV bucket
+func (h *healthChecker) addKVBucket(kv jetstream.KeyValue) {
+	h.add("kv:"+kv.Bucket(), func(ctx context.Context) error {
+		_, err := kv.Status(ctx)
+		return err
+	})
+}
+
+// run executes every check concurrently and reports them in registration order
+func (h *healthChecker) run(ctx context.Context) *meetingsvc.ServiceHealth {
+	results := make([]*meetingsvc.DependencyHealth, len(h.checks))
+
+	var wg sync.WaitGroup
+	for i, c := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = h.runCheck(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	status := "ok"
+	for _, r := range results {
+		if r.Status != "ok" {
+			status = "degraded"
+		}
+	}
+	return &meetingsvc.ServiceHealth{Status: status, Checks: results}
+}
+
+func (h *healthChecker) runCheck(ctx context.Context, c healthCheck) *meetingsvc.DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := c.check(ctx)
+	result := &meetingsvc.DependencyHealth{
+		Name:      c.name,
+		Status:    "ok",
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = "error"
+		result.Error = utils.StringPtr(err.Error())
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthChecker_Run(t *testing.T) {
+	h := newHealthChecker()
+	h.timeout = 20 * time.Millisecond
+	h.add("ok", func(context.Context) error { return nil })
+	h.add("failing", func(context.Context) error { return errors.New("connection refused") })
+	h.add("hung", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	h.addNATSConn("nats:unconfigured", (*natsgo.Conn)(nil))
+
+	health := h.run(context.Background())
+
+	assert.Equal(t, "degraded", health.Status)
+	require.Len(t, health.Checks, 3, "nil connections are not reported")
+	assert.Equal(t, "ok", health.Checks[0].Name)
+	assert.Equal(t, "ok", health.Checks[0].Status)
+	assert.Nil(t, health.Checks[0].Error)
+
+	assert.Equal(t, "failing", health.Checks[1].Name)
+	assert.Equal(t, "error", health.Checks[1].Status)
+	require.NotNil(t, health.Checks[1].Error)
+	assert.Equal(t, "connection refused", *health.Checks[1].Error)
+
+	assert.Equal(t, "hung", health.Checks[2].Name)
+	assert.Equal(t, "error", health.Checks[2].Status)
+	require.NotNil(t, health.Checks[2].Error)
+	assert.Equal(t, context.DeadlineExceeded.Error(), *health.Checks[2].Error)
+	assert.GreaterOrEqual(t, health.Checks[2].LatencyMs, int64(20))
+}
+
+func TestHealthChecker_AllOK(t *testing.T) {
+	h := newHealthChecker()
+	h.add("itx_auth", func(context.Context) error { return nil })
+
+	health := h.run(context.Background())
+
+	assert.Equal(t, "ok", health.Status)
+	require.Len(t, health.Checks, 1)
+
+	empty := newHealthChecker().run(context.Background())
+	assert.Equal(t, "ok", empty.Status)
+	assert.Empty(t, empty.Checks)
+}
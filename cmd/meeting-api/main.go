@@ -17,6 +17,7 @@ import (
 	natsgo "github.com/nats-io/nats.go"
 
 	apieventing "github.com/linuxfoundation/lfx-v2-meeting-service/cmd/meeting-api/eventing"
+	meetingsvc "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/infrastructure/eventing"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/infrastructure/idmapper"
@@ -78,8 +79,12 @@ func run() int {
 	defer cancel()
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	logging.WatchSubsystemLevels(ctx)
 	gracefulCloseWG := sync.WaitGroup{}
 
+	// Dependency checks for the health details endpoint, registered as each dependency is set up
+	health := newHealthChecker()
+
 	// Initialize ID mapper for v1/v2 ID conversions
 	var idMapper domain.IDMapper
 	if env.IDMappingDisabled {
@@ -100,6 +105,7 @@ func run() int {
 			} else {
 				defer natsMapper.Close()
 				idMapper = natsMapper
+				health.add("nats:id_mapper", natsMapper.Ping)
 				slog.InfoContext(ctx, "ID mapping enabled - using NATS mapper for v1/v2 ID conversions")
 			}
 		} else {
@@ -128,12 +134,13 @@ func run() int {
 				"failed to connect to NATS for user metadata reader; meeting created_by profile enrichment unavailable")
 		} else {
 			userMetadataNatsConn = nc
-			userMetadataReader = natsinfra.NewUserMetadataReader(nc, slog.Default())
+			userMetadataReader = natsinfra.NewUserMetadataReader(nc, logging.Subsystem(logging.SubsystemUserMetadata))
 		}
 	}
 	if userMetadataNatsConn != nil {
 		defer userMetadataNatsConn.Close()
 	}
+	health.addNATSConn("nats:user_metadata", userMetadataNatsConn)
 
 	// Initialize ITX proxy client and services
 	itxProxyConfig := proxy.Config{
@@ -143,16 +150,20 @@ func run() int {
 		Auth0Domain: env.ITXConfig.Auth0Domain,
 		Audience:    env.ITXConfig.Audience,
 		Timeout:     30 * time.Second,
+		Resilience:  env.ITXConfig.Resilience,
 	}
 	itxProxyClient := proxy.NewClient(itxProxyConfig)
-	itxMeetingService := itxservice.NewMeetingService(itxProxyClient, idMapper, userMetadataReader)
-	itxRegistrantService := itxservice.NewRegistrantService(itxProxyClient, idMapper)
+	joinLinks := itxservice.NewJoinLinkCache(env.JoinLinkCacheTTL)
+	itxMeetingService := itxservice.NewMeetingService(itxProxyClient, idMapper, userMetadataReader, itxservice.WithJoinLinkCache(joinLinks))
+	itxRegistrantService := itxservice.NewRegistrantService(itxProxyClient, idMapper, itxservice.WithRegistrantJoinLinkCache(joinLinks))
 	itxPastMeetingService := itxservice.NewPastMeetingService(itxProxyClient, idMapper)
 	itxPastMeetingSummaryService := itxservice.NewPastMeetingSummaryService(itxProxyClient)
 	itxPastMeetingParticipantService := itxservice.NewPastMeetingParticipantService(itxProxyClient, idMapper)
-	itxMeetingAttachmentService := itxservice.NewMeetingAttachmentService(itxProxyClient)
-	itxPastMeetingAttachmentService := itxservice.NewPastMeetingAttachmentService(itxProxyClient)
+	attachmentPolicy := itxservice.NewAttachmentPolicy(env.AttachmentConfig.MaxFileSize, env.AttachmentConfig.AllowedContentTypes)
+	itxMeetingAttachmentService := itxservice.NewMeetingAttachmentService(itxProxyClient, attachmentPolicy)
+	itxPastMeetingAttachmentService := itxservice.NewPastMeetingAttachmentService(itxProxyClient, attachmentPolicy)
 	authService := service.NewAuthService(jwtAuth)
+	health.add("itx_auth", itxProxyClient.CheckAuth)
 	slog.InfoContext(ctx, "ITX proxy client initialized")
 
 	// Start invite_accepted subscriber independently of KV event processing.
@@ -167,7 +178,7 @@ func run() int {
 				slog.With(logging.ErrKey, err).WarnContext(ctx,
 					"failed to connect to NATS for invite_accepted subscriber; continuing without enrichment")
 			} else {
-				sub := apieventing.NewInviteAcceptedSubscriber(nc, itxProxyClient, slog.Default())
+				sub := apieventing.NewInviteAcceptedSubscriber(nc, itxProxyClient, logging.Subsystem(logging.SubsystemInvites))
 				if err := sub.Start(ctx); err != nil {
 					nc.Close()
 					slog.With(logging.ErrKey, err).WarnContext(ctx,
@@ -183,6 +194,8 @@ func run() int {
 	// Start preferred-email RPC responder (LFXV2-2599) independently of KV event processing.
 	// It proxies get/set of a user's preferred meeting-invite email to the v1 user-service.
 	preferredEmailResponder, preferredEmailNatsConn := startPreferredEmailResponder(ctx, env, natsURL)
+	health.addNATSConn("nats:invites", inviteNatsConn)
+	health.addNATSConn("nats:preferred_email", preferredEmailNatsConn)
 
 	// Initialize event processor if enabled
 	var eventProcessor *apieventing.EventProcessor
@@ -203,14 +216,20 @@ func run() int {
 				AckWait:              env.EventConfig.AckWait,
 				MaxAckPending:        env.EventConfig.MaxAckPending,
 				V1MappingsBucketName: env.EventConfig.V1MappingsBucketName,
+				BreakerThreshold:     env.EventConfig.BreakerThreshold,
+				BreakerCooldown:      env.EventConfig.BreakerCooldown,
 			}
 
-			ep, err := apieventing.NewEventProcessor(eventConfig, idMapper, slog.Default(), env.InviteConfig)
+			ep, err := apieventing.NewEventProcessor(eventConfig, idMapper, logging.Subsystem(logging.SubsystemEventing), env.InviteConfig)
 			if err != nil {
 				slog.With(logging.ErrKey, err).Error("failed to create event processor")
 				return 1
 			}
 			eventProcessor = ep
+			health.add("nats:event_processor", ep.Ping)
+			for _, kv := range ep.KVBuckets() {
+				health.addKVBucket(kv)
+			}
 
 			// Start event processor in background
 			eventProcessorCtx, cancelFunc := context.WithCancel(ctx)
@@ -237,6 +256,8 @@ func run() int {
 		itxPastMeetingParticipantService,
 		itxMeetingAttachmentService,
 		itxPastMeetingAttachmentService,
+		func() *meetingsvc.ServiceConfig { return env.serviceConfig(Version) },
+		health,
 	)
 
 	httpServer := setupHTTPServer(flags, svc, &gracefulCloseWG)
@@ -344,8 +365,9 @@ func startPreferredEmailResponder(ctx context.Context, env environment, natsURL
 		return nil, nil
 	}
 
-	preferredEmailService := service.NewPreferredEmailService(userServiceClient, slog.Default())
-	responder := natsinfra.NewPreferredEmailResponder(nc, preferredEmailService, slog.Default())
+	preferredEmailLogger := logging.Subsystem(logging.SubsystemPreferredEmail)
+	preferredEmailService := service.NewPreferredEmailService(userServiceClient, preferredEmailLogger)
+	responder := natsinfra.NewPreferredEmailResponder(nc, preferredEmailService, preferredEmailLogger)
 	if err := responder.Start(ctx); err != nil {
 		nc.Close()
 		slog.With(logging.ErrKey, err).WarnContext(ctx, "failed to start preferred_email responder; continuing without it")
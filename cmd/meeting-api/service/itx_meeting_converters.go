@@ -4,7 +4,11 @@
 package service
 
 import (
+	"fmt"
+	"time"
+
 	meetingservice "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/utils"
@@ -131,6 +135,19 @@ func ConvertITXMeetingResponseToGoa(resp *itx.ZoomMeetingResponse) *meetingservi
 			EndTimes:       utils.IntPtrOmitZero(resp.Recurrence.EndTimes),
 			EndDateTime:    utils.StringPtrOmitEmpty(resp.Recurrence.EndDateTime),
 		}
+		// A recurrence ITX returns that cannot be expressed as an RRULE is still returned, without one
+		if rule, err := models.RecurrenceToRRule(&models.ZoomMeetingRecurrence{
+			Type:           int(resp.Recurrence.Type),
+			RepeatInterval: resp.Recurrence.RepeatInterval,
+			WeeklyDays:     resp.Recurrence.WeeklyDays,
+			MonthlyDay:     resp.Recurrence.MonthlyDay,
+			MonthlyWeek:    resp.Recurrence.MonthlyWeek,
+			MonthlyWeekDay: resp.Recurrence.MonthlyWeekDay,
+			EndTimes:       resp.Recurrence.EndTimes,
+			EndDateTime:    resp.Recurrence.EndDateTime,
+		}); err == nil {
+			goaResp.Recurrence.Rrule = &rule
+		}
 	}
 
 	// Convert occurrences
@@ -175,6 +192,9 @@ func ConvertGetJoinLinkPayloadToITX(p *meetingservice.GetItxJoinLinkPayload) *it
 	if p.Register != nil {
 		req.Register = *p.Register
 	}
+	if p.OccurrenceID != nil {
+		req.OccurrenceID = *p.OccurrenceID
+	}
 
 	return req
 }
@@ -182,7 +202,10 @@ func ConvertGetJoinLinkPayloadToITX(p *meetingservice.GetItxJoinLinkPayload) *it
 // ConvertITXJoinLinkResponseToGoa converts ITX join link response to Goa response
 func ConvertITXJoinLinkResponseToGoa(resp *itx.ZoomMeetingJoinLink) *meetingservice.ITXZoomMeetingJoinLink {
 	return &meetingservice.ITXZoomMeetingJoinLink{
-		Link: resp.Link,
+		Link:                resp.Link,
+		OccurrenceID:        utils.StringPtrOmitEmpty(resp.OccurrenceID),
+		OccurrenceStartTime: utils.StringPtrOmitEmpty(resp.OccurrenceStartTime),
+		OccurrenceDuration:  utils.IntPtrOmitZero(resp.OccurrenceDuration),
 	}
 }
 
@@ -261,3 +284,143 @@ func filterVotingStatuses(filters []itx.CommitteeFilter) []meetingservice.Allowe
 	}
 	return result
 }
+
+// ConvertOccurrencePreviewPayloadToDomain converts an occurrence preview payload to the
+// meeting event data consumed by the occurrence calculator
+func ConvertOccurrencePreviewPayloadToDomain(p *meetingservice.PreviewItxMeetingOccurrencesPayload) models.MeetingEventData {
+	meeting := models.MeetingEventData{
+		StartTime: p.StartTime,
+		Timezone:  p.Timezone,
+		Duration:  p.Duration,
+	}
+	if p.Recurrence != nil {
+		meeting.Recurrence = &models.ZoomMeetingRecurrence{
+			Type:           utils.IntValue(p.Recurrence.Type),
+			RepeatInterval: utils.IntValue(p.Recurrence.RepeatInterval),
+			WeeklyDays:     utils.StringValue(p.Recurrence.WeeklyDays),
+			MonthlyDay:     utils.IntValue(p.Recurrence.MonthlyDay),
+			MonthlyWeek:    utils.IntValue(p.Recurrence.MonthlyWeek),
+			MonthlyWeekDay: utils.IntValue(p.Recurrence.MonthlyWeekDay),
+			EndTimes:       utils.IntValue(p.Recurrence.EndTimes),
+			EndDateTime:    utils.StringValue(p.Recurrence.EndDateTime),
+		}
+	}
+	return meeting
+}
+
+// Bounds on the recurrence of an occurrence preview. The design caps end_times on every
+// request; these also cover counts taken from an rrule and bound the end date, which the
+// design cannot express.
+const (
+	maxPreviewEndTimes = 1000
+	maxPreviewHorizon  = 10 * 365 * 24 * time.Hour
+)
+
+// ValidateOccurrencePreviewRecurrence rejects preview recurrences whose occurrence count or
+// end date exceed the preview bounds
+func ValidateOccurrencePreviewRecurrence(p *meetingservice.PreviewItxMeetingOccurrencesPayload) error {
+	if p.Recurrence == nil {
+		return nil
+	}
+	if utils.IntValue(p.Recurrence.EndTimes) > maxPreviewEndTimes {
+		return domain.NewValidationError(fmt.Sprintf("recurrence end_times must be at most %d", maxPreviewEndTimes))
+	}
+	if p.Recurrence.EndDateTime == nil {
+		return nil
+	}
+	start, err := time.Parse(time.RFC3339, p.StartTime)
+	if err != nil {
+		return domain.NewValidationError("invalid start_time", err)
+	}
+	end, err := time.Parse(time.RFC3339, *p.Recurrence.EndDateTime)
+	if err != nil {
+		return domain.NewValidationError("invalid recurrence end_date_time", err)
+	}
+	if end.Sub(start) > maxPreviewHorizon {
+		return domain.NewValidationError("recurrence end_date_time must be at most 10 years after start_time")
+	}
+	return nil
+}
+
+// ConvertOccurrencesToGoa converts calculated occurrences to Goa occurrence types
+func ConvertOccurrencesToGoa(occurrences []models.Occurrence) []*meetingservice.ITXOccurrence {
+	result := make([]*meetingservice.ITXOccurrence, len(occurrences))
+	for i, o := range occurrences {
+		occurrenceID := o.OccurrenceID
+		startTime := o.StartTime.UTC().Format(time.RFC3339)
+		duration := o.Duration
+		status := string(itx.OccurrenceStatusAvailable)
+		if o.IsCancelled {
+			status = string(itx.OccurrenceStatusCancel)
+		}
+		result[i] = &meetingservice.ITXOccurrence{
+			OccurrenceID: &occurrenceID,
+			StartTime:    &startTime,
+			Duration:     &duration,
+			Status:       &status,
+		}
+	}
+	return result
+}
+
+// ConvertListOccurrencesPayloadToDomain converts an occurrence list payload to the domain request.
+// from and to are validated as RFC3339 by the generated decoder.
+func ConvertListOccurrencesPayloadToDomain(p *meetingservice.ListItxMeetingOccurrencesPayload) *models.ListITXOccurrencesRequest {
+	req := &models.ListITXOccurrencesRequest{
+		MeetingID: p.MeetingID,
+		Timezone:  utils.StringValue(p.Tz),
+	}
+	if p.From != nil {
+		req.From, _ = time.Parse(time.RFC3339, *p.From)
+	}
+	if p.To != nil {
+		req.To, _ = time.Parse(time.RFC3339, *p.To)
+	}
+	return req
+}
+
+// ConvertITXOccurrenceListToGoa converts a domain occurrence list to the Goa response
+func ConvertITXOccurrenceListToGoa(list *models.ITXOccurrenceList) *meetingservice.ITXOccurrenceListResponse {
+	resp := &meetingservice.ITXOccurrenceListResponse{
+		Timezone:    list.Timezone,
+		Occurrences: make([]*meetingservice.ITXLocalOccurrence, len(list.Occurrences)),
+	}
+	for i, o := range list.Occurrences {
+		resp.Occurrences[i] = &meetingservice.ITXLocalOccurrence{
+			OccurrenceID:   o.OccurrenceID,
+			StartTime:      o.StartTime.UTC().Format(time.RFC3339),
+			LocalStartTime: o.StartTime.Format(time.RFC3339),
+			LocalEndTime:   o.EndTime.Format(time.RFC3339),
+			Duration:       o.Duration,
+			Status:         string(o.Status),
+		}
+	}
+	return resp
+}
+
+// ResolveRecurrenceRRule fills the Zoom-shaped fields of a request recurrence from its
+// rrule, if one was sent, and clears the rrule. Sending both an rrule and a type is rejected
+// so that a stale rrule cannot silently override an edited recurrence.
+func ResolveRecurrenceRRule(r *meetingservice.Recurrence) error {
+	if r == nil || r.Rrule == nil {
+		return nil
+	}
+	if r.Type != nil {
+		return domain.NewValidationError("recurrence must set either rrule or type, not both")
+	}
+	rec, err := models.RecurrenceFromRRule(*r.Rrule)
+	if err != nil {
+		return domain.NewValidationError("invalid recurrence rrule", err)
+	}
+	*r = meetingservice.Recurrence{
+		Type:           utils.IntPtrOmitZero(rec.Type),
+		RepeatInterval: utils.IntPtrOmitZero(rec.RepeatInterval),
+		WeeklyDays:     utils.StringPtrOmitEmpty(rec.WeeklyDays),
+		MonthlyDay:     utils.IntPtrOmitZero(rec.MonthlyDay),
+		MonthlyWeek:    utils.IntPtrOmitZero(rec.MonthlyWeek),
+		MonthlyWeekDay: utils.IntPtrOmitZero(rec.MonthlyWeekDay),
+		EndTimes:       utils.IntPtrOmitZero(rec.EndTimes),
+		EndDateTime:    utils.StringPtrOmitEmpty(rec.EndDateTime),
+	}
+	return nil
+}
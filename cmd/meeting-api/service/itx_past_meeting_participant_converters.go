@@ -140,6 +140,58 @@ func ConvertCreateParticipantPayload(payload *meetingservice.CreateItxPastMeetin
 	return inviteeReq, attendeeReq
 }
 
+// ConvertParticipantUpdateToPayload converts one correction of a bulk participant update into
+// the equivalent single-participant update payload
+func ConvertParticipantUpdateToPayload(pastMeetingID string, u *meetingservice.ITXPastMeetingParticipantUpdate) *meetingservice.UpdateItxPastMeetingParticipantPayload {
+	return &meetingservice.UpdateItxPastMeetingParticipantPayload{
+		PastMeetingID:         pastMeetingID,
+		ParticipantID:         u.ParticipantID,
+		InviteeID:             u.InviteeID,
+		AttendeeID:            u.AttendeeID,
+		IsInvited:             u.IsInvited,
+		IsAttended:            u.IsAttended,
+		Email:                 u.Email,
+		Username:              u.Username,
+		LfUserID:              u.LfUserID,
+		FirstName:             u.FirstName,
+		LastName:              u.LastName,
+		OrgName:               u.OrgName,
+		JobTitle:              u.JobTitle,
+		CommitteeRole:         u.CommitteeRole,
+		CommitteeVotingStatus: u.CommitteeVotingStatus,
+		IsVerified:            u.IsVerified,
+	}
+}
+
+// ParticipantUpdateFields returns the API names of the fields a bulk participant correction sets
+func ParticipantUpdateFields(u *meetingservice.ITXPastMeetingParticipantUpdate) []string {
+	var fields []string
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"invitee_id", u.InviteeID != nil},
+		{"attendee_id", u.AttendeeID != nil},
+		{"is_invited", u.IsInvited != nil},
+		{"is_attended", u.IsAttended != nil},
+		{"email", u.Email != nil},
+		{"username", u.Username != nil},
+		{"lf_user_id", u.LfUserID != nil},
+		{"first_name", u.FirstName != nil},
+		{"last_name", u.LastName != nil},
+		{"org_name", u.OrgName != nil},
+		{"job_title", u.JobTitle != nil},
+		{"committee_role", u.CommitteeRole != nil},
+		{"committee_voting_status", u.CommitteeVotingStatus != nil},
+		{"is_verified", u.IsVerified != nil},
+	} {
+		if f.set {
+			fields = append(fields, f.name)
+		}
+	}
+	return fields
+}
+
 // ConvertUpdateParticipantPayload converts Goa update participant payload to ITX invitee and attendee update requests
 func ConvertUpdateParticipantPayload(payload *meetingservice.UpdateItxPastMeetingParticipantPayload) (*itx.UpdateInviteeRequest, *itx.UpdateAttendeeRequest) {
 	var inviteeReq *itx.UpdateInviteeRequest
@@ -78,6 +78,43 @@ func ConvertPastMeetingSummaryToGoa(resp *itx.PastMeetingSummaryResponse) *meeti
 	return goaResp
 }
 
+// ConvertPastMeetingSummaryDiffToGoa builds a line diff between the AI-generated and edited
+// summary content. A summary without edited content diffs against itself, so every line is equal.
+func ConvertPastMeetingSummaryDiffToGoa(resp *itx.PastMeetingSummaryResponse) *meetingservice.PastMeetingSummaryDiff {
+	content := buildContentFromITX(resp)
+	editedContent := buildEditedContentFromITX(resp)
+	if editedContent == "" {
+		editedContent = content
+	}
+
+	goaResp := &meetingservice.PastMeetingSummaryDiff{
+		UID:           resp.ID,
+		PastMeetingID: resp.MeetingAndOccurrenceID,
+		Lines:         []*meetingservice.SummaryDiffLine{},
+	}
+
+	lines, approximate := utils.DiffLines(content, editedContent)
+	goaResp.Approximate = approximate
+	for _, line := range lines {
+		diffLine := &meetingservice.SummaryDiffLine{
+			Operation:    string(line.Operation),
+			Text:         line.Text,
+			OriginalLine: utils.IntPtrOmitZero(line.OriginalLine),
+			EditedLine:   utils.IntPtrOmitZero(line.EditedLine),
+		}
+		switch line.Operation {
+		case utils.DiffOperationInsert:
+			goaResp.Additions++
+		case utils.DiffOperationDelete:
+			goaResp.Deletions++
+		}
+		goaResp.Lines = append(goaResp.Lines, diffLine)
+	}
+	goaResp.HasEdits = goaResp.Additions > 0 || goaResp.Deletions > 0
+
+	return goaResp
+}
+
 // buildContentFromITX combines ITX summary parts into a single content string
 func buildContentFromITX(resp *itx.PastMeetingSummaryResponse) string {
 	var parts []string
@@ -183,10 +183,15 @@ var Recurrence = Type("Recurrence", func() {
 	Attribute("monthly_day", Int, "Day of month for monthly recurrence")
 	Attribute("monthly_week", Int, "Week of month for monthly recurrence")
 	Attribute("monthly_week_day", Int, "Day of week for monthly recurrence")
-	Attribute("end_times", Int, "Number of occurrences")
-	Attribute("end_date_time", String, "End date/time in RFC3339", func() {
+	Attribute("end_times", Int, "Number of occurrences", func() {
+		Maximum(1000)
+	})
+	Attribute("end_date_time", String, "End date/time in RFC3339. Occurrence previews reject end dates more than 10 years after the start time", func() {
 		Format(FormatDateTime)
 	})
+	Attribute("rrule", String, "RFC 5545 RRULE equivalent of the recurrence. Set on responses unless the recurrence ITX returns has an invalid type or end_date_time. On requests it may be sent instead of type and the other fields above, for rules Zoom can represent", func() {
+		Example("FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12")
+	})
 })
 
 // ITXProjectUIDAttribute is the DSL attribute for project UID.
@@ -290,6 +295,48 @@ var ITXMeetingCountResponse = Type("ITXMeetingCountResponse", func() {
 	Required("meeting_count")
 })
 
+// ITXLocalOccurrence represents a meeting occurrence with times in a requested timezone
+var ITXLocalOccurrence = Type("ITXLocalOccurrence", func() {
+	Description("Meeting occurrence with start and end times in the list's timezone")
+	Attribute("occurrence_id", String, "Unix timestamp", func() {
+		Example("1640995200")
+	})
+	Attribute("start_time", String, "RFC3339 start time in UTC", func() {
+		Example("2022-01-01T00:00:00Z")
+		Format(FormatDateTime)
+	})
+	Attribute("local_start_time", String, "RFC3339 start time in the list's timezone", func() {
+		Example("2022-01-01T01:00:00+01:00")
+		Format(FormatDateTime)
+	})
+	Attribute("local_end_time", String, "RFC3339 end time in the list's timezone", func() {
+		Example("2022-01-01T02:00:00+01:00")
+		Format(FormatDateTime)
+	})
+	Attribute("duration", Int, "Duration in minutes")
+	Attribute("status", String, "available or cancel", func() {
+		Enum("available", "cancel")
+	})
+	Required("occurrence_id", "start_time", "local_start_time", "local_end_time", "duration", "status")
+})
+
+// ITXOccurrenceListResponse represents a meeting's occurrences in one timezone
+var ITXOccurrenceListResponse = Type("ITXOccurrenceListResponse", func() {
+	Description("Meeting occurrences in start time order")
+	Attribute("timezone", String, "IANA timezone of the local times", func() {
+		Example("Europe/Berlin")
+	})
+	Attribute("occurrences", ArrayOf(ITXLocalOccurrence), "Occurrences")
+	Required("timezone", "occurrences")
+})
+
+// ITXOccurrencePreviewResponse represents the computed occurrences for a recurrence definition
+var ITXOccurrencePreviewResponse = Type("ITXOccurrencePreviewResponse", func() {
+	Description("Occurrences a recurrence definition will generate, in start time order")
+	Attribute("occurrences", ArrayOf(ITXOccurrence), "Computed occurrences")
+	Required("occurrences")
+})
+
 // ForbiddenError is the DSL type for a forbidden error (403).
 var ForbiddenError = Type("ForbiddenError", func() {
 	Attribute("code", String, "HTTP status code", func() {
@@ -383,6 +430,16 @@ var ITXZoomMeetingJoinLink = Type("ITXZoomMeetingJoinLink", func() {
 		Example("https://zoom.us/j/1234567891?pwd=NTNubnB4bnpPTm9zT2VLZFJnQ1RkUT11")
 		Format(FormatURI)
 	})
+	Attribute("occurrence_id", String, "Occurrence the link was requested for (Unix timestamp)", func() {
+		Example("1640995200")
+	})
+	Attribute("occurrence_start_time", String, "Start time of the occurrence in RFC3339 format", func() {
+		Example("2022-01-01T00:00:00Z")
+		Format(FormatDateTime)
+	})
+	Attribute("occurrence_duration", Int, "Duration of the occurrence in minutes", func() {
+		Example(60)
+	})
 	Required("link")
 })
 
@@ -545,6 +602,55 @@ var PastMeetingSummary = Type("PastMeetingSummary", func() {
 		"requires_approval", "approved", "email_sent", "created_at", "updated_at")
 })
 
+// SummaryDiffLine represents a single line of a summary diff
+var SummaryDiffLine = Type("SummaryDiffLine", func() {
+	Description("A single line of a line-oriented diff between AI-generated and edited summary content")
+
+	Attribute("operation", String, "How the line changed from the AI-generated content to the edited content", func() {
+		Enum("equal", "insert", "delete")
+		Example("insert")
+	})
+	Attribute("text", String, "The line text", func() {
+		Example("- Finance team to draft budget proposal by end of week")
+	})
+	Attribute("original_line", Int, "1-based line number in the AI-generated content (omitted for inserted lines)", func() {
+		Example(4)
+	})
+	Attribute("edited_line", Int, "1-based line number in the edited content (omitted for deleted lines)", func() {
+		Example(5)
+	})
+
+	Required("operation", "text")
+})
+
+// PastMeetingSummaryDiff represents the diff between AI-generated and edited summary content
+var PastMeetingSummaryDiff = Type("PastMeetingSummaryDiff", func() {
+	Description("Structured diff between the AI-generated summary content and the user-edited content")
+
+	Attribute("uid", String, "The unique identifier of the summary", func() {
+		Example("456e7890-e89b-12d3-a456-426614174000")
+		Format(FormatUUID)
+	})
+	Attribute("past_meeting_id", String, "The past meeting identifier (meeting_id-occurrence_id)", func() {
+		Example("12343245463-1630560600000")
+	})
+	Attribute("has_edits", Boolean, "Whether the summary has edited content that differs from the AI-generated content", func() {
+		Example(true)
+	})
+	Attribute("additions", Int, "Number of lines added in the edited content", func() {
+		Example(2)
+	})
+	Attribute("deletions", Int, "Number of lines removed from the AI-generated content", func() {
+		Example(1)
+	})
+	Attribute("approximate", Boolean, "Whether the changed region was too large to diff line by line, in which case its AI-generated lines are reported as deleted and its edited lines as inserted", func() {
+		Example(false)
+	})
+	Attribute("lines", ArrayOf(SummaryDiffLine), "Diff lines in document order")
+
+	Required("uid", "past_meeting_id", "has_edits", "additions", "deletions", "approximate", "lines")
+})
+
 // ParticipantSession represents a single join/leave session
 var ParticipantSession = Type("ParticipantSession", func() {
 	Description("A single join/leave session of a participant in a meeting")
@@ -871,3 +977,67 @@ var ITXAttachmentDownloadResponse = Type("ITXAttachmentDownloadResponse", func()
 	Attribute("download_url", String, "Presigned S3 URL for file download (valid for 60 minutes)")
 	Required("download_url")
 })
+
+// PastMeetingParticipantUpdateAttributes are the optional fields of a past meeting participant
+// update, shared by the single and bulk update endpoints.
+func PastMeetingParticipantUpdateAttributes() {
+	Attribute("invitee_id", String, "Optional invitee ID to use directly (avoids ID mapping lookup)", func() {
+		Example("inv_abc123")
+	})
+	Attribute("attendee_id", String, "Optional attendee ID to use directly (avoids ID mapping lookup)", func() {
+		Example("att_xyz789")
+	})
+
+	// Status flags
+	Attribute("is_invited", Boolean, "Whether the participant is invited (if false, invitee record will be deleted)")
+	Attribute("is_attended", Boolean, "Whether the participant attended (if false, attendee record will be deleted)")
+
+	// Identity fields (used for creating invitee/attendee if they don't exist)
+	Attribute("email", String, "Email address (used for creation)", func() {
+		Example("john.doe@example.com")
+	})
+	Attribute("username", String, "LF SSO username (used for creation)", func() {
+		Example("johndoe")
+	})
+	Attribute("lf_user_id", String, "LF user ID (used for creation)", func() {
+		Example("abc123")
+	})
+
+	// Updatable fields
+	Attribute("first_name", String, "First name (required for invitee updates)", func() {
+		Example("John")
+	})
+	Attribute("last_name", String, "Last name (required for invitee updates)", func() {
+		Example("Doe")
+	})
+	Attribute("org_name", String, "Organization name", func() {
+		Example("Microsoft")
+	})
+	Attribute("job_title", String, "Job title", func() {
+		Example("Senior Software Engineer")
+	})
+	Attribute("committee_role", String, "Role within committee", func() {
+		Example("Lead Developer")
+	})
+	Attribute("committee_voting_status", String, "Voting status in committee", func() {
+		Example("Alt Voting Rep")
+	})
+	Attribute("is_verified", Boolean, "Whether the attendee has been verified (attendee only)")
+}
+
+// ITXPastMeetingParticipantUpdate is a single correction in a bulk participant update
+var ITXPastMeetingParticipantUpdate = Type("ITXPastMeetingParticipantUpdate", func() {
+	Description("Correction for one past meeting participant")
+	Attribute("participant_id", String, "Participant ID (invitee_id or attendee_id)", func() {
+		Example("ea1e8536-a985-4cf5-b981-a170927a1d11")
+	})
+	PastMeetingParticipantUpdateAttributes()
+	Required("participant_id")
+})
+
+// ITXPastMeetingParticipantBulkUpdateResponse is the result of a bulk participant update
+var ITXPastMeetingParticipantBulkUpdateResponse = Type("ITXPastMeetingParticipantBulkUpdateResponse", func() {
+	Description("Updated participants, in the order the corrections were given")
+	Attribute("participants", ArrayOf(ITXPastMeetingParticipant), "Updated participants")
+	Required("participants")
+})
@@ -45,6 +45,64 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("get-service-config", func() {
+		Description("Get the sanitized effective service configuration for diagnostics")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+		})
+
+		Result(ServiceConfig)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/_meetings/config")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get-service-health", func() {
+		Description("Check each dependency of the service (ITX auth, NATS connections, KV buckets) and report its status and latency for diagnostics")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+		})
+
+		Result(ServiceHealth)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/_meetings/health")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	// ITX Zoom API Proxy endpoints
 	Method("create-itx-meeting", func() {
 		Description("Create a Zoom meeting through ITX API proxy")
@@ -175,6 +233,47 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("clone-itx-meeting", func() {
+		Description("Create a new Zoom meeting with the settings of an existing meeting at a new start time through ITX API proxy. Registrants and attachments are not copied.")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("meeting_id", String, "The Zoom meeting ID to clone", func() {
+				Example("1234567890")
+			})
+			StartTimeAttribute()
+			Attribute("title", String, "Title of the new meeting; defaults to the source meeting title")
+			Required("meeting_id", "start_time")
+		})
+
+		Result(ITXZoomMeetingResponse)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Meeting not found")
+		Error("Conflict", ConflictError, "Conflict with existing meeting")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/itx/meetings/{meeting_id}/clone")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusCreated)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	Method("update-itx-meeting", func() {
 		Description("Update a Zoom meeting through ITX API proxy")
 
@@ -271,6 +370,43 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("preview-itx-meeting-occurrences", func() {
+		Description("Preview the occurrences a recurrence definition will generate, without creating a meeting. Occurrences are computed with the same algorithm used to index ITX meetings.")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			StartTimeAttribute()
+			TimezoneAttribute()
+			DurationAttribute()
+			RecurrenceAttribute()
+			Attribute("count", Int, "Maximum number of occurrences to return", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(10)
+			})
+			Required("start_time", "timezone", "duration", "recurrence")
+		})
+
+		Result(ITXOccurrencePreviewResponse)
+
+		Error("BadRequest", BadRequestError, "Invalid recurrence definition")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+
+		HTTP(func() {
+			POST("/itx/occurrence_preview")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+
 	Method("create-itx-registrant", func() {
 		Description("Create a meeting registrant through ITX API proxy")
 
@@ -451,6 +587,9 @@ var _ = Service("Meeting Service", func() {
 				Format(FormatEmail)
 			})
 			Attribute("register", Boolean, "Register user as guest if not already registered")
+			Attribute("occurrence_id", String, "Occurrence (Unix timestamp) to check and return the schedule of; the link itself is the same for every occurrence", func() {
+				Example("1640995200")
+			})
 			Required("meeting_id")
 		})
 
@@ -459,7 +598,8 @@ var _ = Service("Meeting Service", func() {
 		Error("BadRequest", BadRequestError, "Bad request")
 		Error("Unauthorized", UnauthorizedError, "Unauthorized")
 		Error("Forbidden", ForbiddenError, "Forbidden")
-		Error("NotFound", NotFoundError, "Meeting not found")
+		Error("NotFound", NotFoundError, "Meeting or occurrence not found")
+		Error("Conflict", ConflictError, "Occurrence is cancelled")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -471,12 +611,86 @@ var _ = Service("Meeting Service", func() {
 			Param("name")
 			Param("email")
 			Param("register")
+			Param("occurrence_id")
 			Header("bearer_token:Authorization")
 			Response(StatusOK)
 			Response("BadRequest", StatusBadRequest)
 			Response("Unauthorized", StatusUnauthorized)
 			Response("Forbidden", StatusForbidden)
 			Response("NotFound", StatusNotFound)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("launch-itx-meeting", func() {
+		Description("Redirect to a meeting's join link through ITX API proxy, as a zoommtg:// deep link for desktop clients or the web link otherwise")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("meeting_id", String, "The ID of the meeting", func() {
+				Example("1234567890")
+			})
+			Attribute("use_email", Boolean, "Use email for identification instead of user_id")
+			Attribute("user_id", String, "LF user ID", func() {
+				Example("user123")
+			})
+			Attribute("name", String, "User's full name", func() {
+				Example("John Doe")
+			})
+			Attribute("email", String, "User's email address", func() {
+				Example("john.doe@example.com")
+				Format(FormatEmail)
+			})
+			Attribute("register", Boolean, "Register user as guest if not already registered")
+			Attribute("occurrence_id", String, "Occurrence (Unix timestamp) to check and return the schedule of; the link itself is the same for every occurrence", func() {
+				Example("1640995200")
+			})
+			Attribute("client", String, "Which Zoom client to launch; auto picks based on the User-Agent", func() {
+				Enum("auto", "native", "web")
+				Default("auto")
+			})
+			Attribute("user_agent", String, "User-Agent of the requesting browser")
+			Required("meeting_id")
+		})
+
+		Result(func() {
+			Attribute("location", String, "Join link to redirect to")
+			Required("location")
+		})
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Meeting or occurrence not found")
+		Error("Conflict", ConflictError, "Occurrence is cancelled")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/itx/meetings/{meeting_id}/launch")
+			Param("version:v")
+			Param("use_email")
+			Param("user_id")
+			Param("name")
+			Param("email")
+			Param("register")
+			Param("occurrence_id")
+			Param("client")
+			Header("bearer_token:Authorization")
+			Header("user_agent:User-Agent")
+			Response(StatusFound, func() {
+				Header("location:Location")
+			})
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("Conflict", StatusConflict)
 			Response("InternalServerError", StatusInternalServerError)
 			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
@@ -524,6 +738,86 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("add-itx-registrant-host", func() {
+		Description("Grant a registrant host access (the meeting host key) through ITX API proxy. Idempotent.")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("meeting_id", String, "The ID of the meeting", func() {
+				Example("1234567890")
+			})
+			Attribute("registrant_id", String, "The ID of the registrant", func() {
+				Example("zjkfsdfjdfhg")
+			})
+			Required("meeting_id", "registrant_id")
+		})
+
+		Result(ITXZoomMeetingRegistrant)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Registrant not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			PUT("/itx/meetings/{meeting_id}/registrants/{registrant_id}/host")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("remove-itx-registrant-host", func() {
+		Description("Revoke a registrant's host access through ITX API proxy. Idempotent.")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("meeting_id", String, "The ID of the meeting", func() {
+				Example("1234567890")
+			})
+			Attribute("registrant_id", String, "The ID of the registrant", func() {
+				Example("zjkfsdfjdfhg")
+			})
+			Required("meeting_id", "registrant_id")
+		})
+
+		Result(ITXZoomMeetingRegistrant)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Registrant not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			DELETE("/itx/meetings/{meeting_id}/registrants/{registrant_id}/host")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	Method("resend-itx-registrant-invitation", func() {
 		Description("Resend meeting invitation to a registrant through ITX API proxy")
 
@@ -635,6 +929,56 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("list-itx-meeting-occurrences", func() {
+		Description("List a meeting's occurrences through ITX API proxy, with start and end times converted to a requested timezone")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("meeting_id", String, "The Zoom meeting ID", func() {
+				Example("1234567890")
+			})
+			Attribute("tz", String, "IANA timezone for local times; defaults to the meeting's timezone", func() {
+				Example("Europe/Berlin")
+			})
+			Attribute("from", String, "Only include occurrences starting at or after this time (RFC3339)", func() {
+				Format(FormatDateTime)
+			})
+			Attribute("to", String, "Only include occurrences starting before this time (RFC3339)", func() {
+				Format(FormatDateTime)
+			})
+			Required("meeting_id")
+		})
+
+		Result(ITXOccurrenceListResponse)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Meeting not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/itx/meetings/{meeting_id}/occurrences")
+			Param("version:v")
+			Param("meeting_id")
+			Param("tz")
+			Param("from")
+			Param("to")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	Method("update-itx-occurrence", func() {
 		Description("Update a specific occurrence of a recurring meeting through ITX API proxy")
 
@@ -1017,6 +1361,47 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("get-itx-past-meeting-summary-diff", func() {
+		Description("Get a structured diff between the AI-generated and edited content of a past meeting summary through ITX API proxy")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("past_meeting_id", String, "Past meeting ID (meeting_id-occurrence_id)", func() {
+				Example("12343245463-1630560600000")
+			})
+			Attribute("summary_uid", String, "Summary UID", func() {
+				Example("456e7890-e89b-12d3-a456-426614174000")
+				Format(FormatUUID)
+			})
+			Required("past_meeting_id", "summary_uid")
+		})
+
+		Result(PastMeetingSummaryDiff)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Summary not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/diff")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	Method("update-itx-past-meeting-summary", func() {
 		Description("Update a past meeting summary through ITX API proxy")
 
@@ -1060,6 +1445,92 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("approve-itx-past-meeting-summary", func() {
+		Description("Approve a past meeting summary that requires approval through ITX API proxy")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("past_meeting_id", String, "Past meeting ID (meeting_id-occurrence_id)", func() {
+				Example("12343245463-1630560600000")
+			})
+			Attribute("summary_uid", String, "Summary UID", func() {
+				Example("456e7890-e89b-12d3-a456-426614174000")
+				Format(FormatUUID)
+			})
+			Required("past_meeting_id", "summary_uid")
+		})
+
+		Result(PastMeetingSummary)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Summary not found")
+		Error("Conflict", ConflictError, "Summary does not require approval")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/approve")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("reject-itx-past-meeting-summary", func() {
+		Description("Reject (un-approve) a past meeting summary that requires approval through ITX API proxy")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("past_meeting_id", String, "Past meeting ID (meeting_id-occurrence_id)", func() {
+				Example("12343245463-1630560600000")
+			})
+			Attribute("summary_uid", String, "Summary UID", func() {
+				Example("456e7890-e89b-12d3-a456-426614174000")
+				Format(FormatUUID)
+			})
+			Required("past_meeting_id", "summary_uid")
+		})
+
+		Result(PastMeetingSummary)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Summary not found")
+		Error("Conflict", ConflictError, "Summary does not require approval")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/reject")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	// Past Meeting Participant Endpoints (unified invitee/attendee interface)
 	Method("create-itx-past-meeting-participant", func() {
 		Description("Create a past meeting participant through ITX API proxy - routes to invitee and/or attendee endpoints based on flags")
@@ -1171,48 +1642,7 @@ var _ = Service("Meeting Service", func() {
 			Attribute("participant_id", String, "Participant ID (invitee_id or attendee_id)", func() {
 				Example("ea1e8536-a985-4cf5-b981-a170927a1d11")
 			})
-			Attribute("invitee_id", String, "Optional invitee ID to use directly (avoids ID mapping lookup)", func() {
-				Example("inv_abc123")
-			})
-			Attribute("attendee_id", String, "Optional attendee ID to use directly (avoids ID mapping lookup)", func() {
-				Example("att_xyz789")
-			})
-
-			// Status flags
-			Attribute("is_invited", Boolean, "Whether the participant is invited (if false, invitee record will be deleted)")
-			Attribute("is_attended", Boolean, "Whether the participant attended (if false, attendee record will be deleted)")
-
-			// Identity fields (used for creating invitee/attendee if they don't exist)
-			Attribute("email", String, "Email address (used for creation)", func() {
-				Example("john.doe@example.com")
-			})
-			Attribute("username", String, "LF SSO username (used for creation)", func() {
-				Example("johndoe")
-			})
-			Attribute("lf_user_id", String, "LF user ID (used for creation)", func() {
-				Example("abc123")
-			})
-
-			// Updatable fields
-			Attribute("first_name", String, "First name (required for invitee updates)", func() {
-				Example("John")
-			})
-			Attribute("last_name", String, "Last name (required for invitee updates)", func() {
-				Example("Doe")
-			})
-			Attribute("org_name", String, "Organization name", func() {
-				Example("Microsoft")
-			})
-			Attribute("job_title", String, "Job title", func() {
-				Example("Senior Software Engineer")
-			})
-			Attribute("committee_role", String, "Role within committee", func() {
-				Example("Lead Developer")
-			})
-			Attribute("committee_voting_status", String, "Voting status in committee", func() {
-				Example("Alt Voting Rep")
-			})
-			Attribute("is_verified", Boolean, "Whether the attendee has been verified (attendee only)")
+			PastMeetingParticipantUpdateAttributes()
 
 			Required("past_meeting_id", "participant_id")
 		})
@@ -1240,6 +1670,45 @@ var _ = Service("Meeting Service", func() {
 		})
 	})
 
+	Method("bulk-update-itx-past-meeting-participants", func() {
+		Description("Apply corrections to many past meeting participants in one request through ITX API proxy. Each correction is applied like update-itx-past-meeting-participant, in order; the request is not atomic.")
+
+		Security(JWTAuth)
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("past_meeting_id", String, "Past meeting ID (meeting_id-occurrence_id format)", func() {
+				Example("12343245463-1630560600000")
+			})
+			Attribute("participants", ArrayOf(ITXPastMeetingParticipantUpdate), "Participant corrections", func() {
+				MinLength(1)
+				MaxLength(200)
+			})
+			Required("past_meeting_id", "participants")
+		})
+
+		Result(ITXPastMeetingParticipantBulkUpdateResponse)
+
+		Error("BadRequest", BadRequestError, "Invalid request")
+		Error("Unauthorized", UnauthorizedError, "Unauthorized")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			PATCH("/itx/past_meetings/{past_meeting_id}/participants/bulk")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	Method("delete-itx-past-meeting-participant", func() {
 		Description("Delete a past meeting participant through ITX API proxy - deletes invitee and/or attendee records as needed")
 
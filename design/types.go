@@ -191,3 +191,165 @@ var ZoomWebhookResponse = Type("ZoomWebhookResponse", func() {
 	})
 	// No required fields - all fields are optional to support different response types
 })
+
+// ServiceConfig is the sanitized effective configuration returned by the diagnostics endpoint.
+// Credentials (ITX client ID and private key) are never included.
+var ServiceConfig = Type("ServiceConfig", func() {
+	Description("Sanitized effective service configuration")
+	Attribute("version", String, "Service build version", func() {
+		Example("v0.5.2")
+	})
+	Attribute("lfx_environment", String, "Normalized LFX environment", func() {
+		Enum("dev", "staging", "prod")
+		Example("dev")
+	})
+	Attribute("itx_base_url", String, "ITX API base URL", func() {
+		Example("https://api.dev.itx.linuxfoundation.org")
+	})
+	Attribute("itx_auth0_domain", String, "Auth0 domain used for ITX M2M tokens", func() {
+		Example("linuxfoundation-dev.auth0.com")
+	})
+	Attribute("itx_audience", String, "Audience of the ITX M2M tokens", func() {
+		Example("https://api.dev.itx.linuxfoundation.org/")
+	})
+	Attribute("user_service_base_url", String, "v1 user-service (API gateway) base URL", func() {
+		Example("https://api-gw.dev.platform.linuxfoundation.org")
+	})
+	Attribute("id_mapping_enabled", Boolean, "Whether v1/v2 ID mapping through NATS is enabled", func() {
+		Example(true)
+	})
+	Attribute("event_processing", ServiceEventProcessingConfig, "Event processing configuration")
+	Attribute("invites", ServiceInvitesConfig, "LFID invite feature configuration")
+	Attribute("itx_resilience", ServiceITXResilienceConfig, "ITX client timeout, retry and circuit breaker configuration")
+	Attribute("attachments", ServiceAttachmentsConfig, "Attachment upload limits")
+	Attribute("join_link_cache_ttl", String, "How long ITX join links are cached, as a Go duration (0s when caching is disabled)", func() {
+		Example("1m0s")
+	})
+	Attribute("log_level", String, "Global log level (LOG_LEVEL)", func() {
+		Enum("debug", "info", "warn", "error")
+		Example("info")
+	})
+	Attribute("subsystem_log_levels", MapOf(String, String), "Effective per-subsystem log level overrides", func() {
+		Example(map[string]string{"eventing": "debug"})
+	})
+	Required("version", "lfx_environment", "itx_base_url", "itx_auth0_domain", "itx_audience",
+		"user_service_base_url", "id_mapping_enabled", "event_processing", "invites", "itx_resilience",
+		"attachments", "join_link_cache_ttl", "log_level", "subsystem_log_levels")
+})
+
+// ServiceITXResilienceConfig is the ITX client resilience part of ServiceConfig.
+var ServiceITXResilienceConfig = Type("ServiceITXResilienceConfig", func() {
+	Description("Effective ITX client timeout, retry and circuit breaker configuration")
+	Attribute("max_retries", Int, "Retries of an idempotent request after a transient failure (0 disables retries)", func() {
+		Example(2)
+	})
+	Attribute("retry_base_delay", String, "Base retry backoff as a Go duration", func() {
+		Example("100ms")
+	})
+	Attribute("retry_max_delay", String, "Maximum retry backoff as a Go duration", func() {
+		Example("2s")
+	})
+	Attribute("attempt_timeout", String, "Timeout of a single attempt of an idempotent request as a Go duration (0s means no per-attempt bound)", func() {
+		Example("10s")
+	})
+	Attribute("endpoint_attempt_timeouts", MapOf(String, String), "attempt_timeout overrides by endpoint group (ics, attachments) as Go durations", func() {
+		Example(map[string]string{"ics": "25s", "attachments": "25s"})
+	})
+	Attribute("breaker_threshold", Int, "Consecutive failures that open the ITX circuit breaker (0 disables)", func() {
+		Example(5)
+	})
+	Attribute("breaker_cooldown", String, "Circuit breaker cooldown as a Go duration", func() {
+		Example("30s")
+	})
+	Required("max_retries", "retry_base_delay", "retry_max_delay", "attempt_timeout", "endpoint_attempt_timeouts",
+		"breaker_threshold", "breaker_cooldown")
+})
+
+// ServiceAttachmentsConfig is the attachment upload part of ServiceConfig.
+var ServiceAttachmentsConfig = Type("ServiceAttachmentsConfig", func() {
+	Description("Effective attachment upload limits")
+	Attribute("max_file_size", Int64, "Maximum attachment size in bytes (0 means no limit)", func() {
+		Example(104857600)
+	})
+	Attribute("allowed_content_types", ArrayOf(String), "Accepted attachment content types (empty means any)", func() {
+		Example([]string{"application/pdf", "image/*"})
+	})
+	Required("max_file_size", "allowed_content_types")
+})
+
+// ServiceEventProcessingConfig is the event processing part of ServiceConfig.
+var ServiceEventProcessingConfig = Type("ServiceEventProcessingConfig", func() {
+	Description("Effective event processing configuration")
+	Attribute("enabled", Boolean, "Whether the v1-objects KV consumer is started", func() {
+		Example(true)
+	})
+	Attribute("consumer_name", String, "JetStream consumer name", func() {
+		Example("meeting-service-kv-consumer")
+	})
+	Attribute("stream_name", String, "JetStream stream name", func() {
+		Example("KV_v1-objects")
+	})
+	Attribute("filter_subjects", ArrayOf(String), "KV subjects the consumer is filtered to")
+	Attribute("max_deliver", Int, "Maximum delivery attempts per message", func() {
+		Example(3)
+	})
+	Attribute("ack_wait", String, "Ack wait timeout as a Go duration", func() {
+		Example("30s")
+	})
+	Attribute("max_ack_pending", Int, "Maximum unacknowledged messages", func() {
+		Example(1000)
+	})
+	Attribute("v1_mappings_bucket", String, "KV bucket used for v1 mappings", func() {
+		Example("v1-mappings")
+	})
+	Attribute("breaker_threshold", Int, "Consecutive failures that open an object type's circuit breaker (0 disables)", func() {
+		Example(5)
+	})
+	Attribute("breaker_cooldown", String, "Circuit breaker cooldown as a Go duration", func() {
+		Example("30s")
+	})
+	Required("enabled", "consumer_name", "stream_name", "filter_subjects", "max_deliver", "ack_wait",
+		"max_ack_pending", "v1_mappings_bucket", "breaker_threshold", "breaker_cooldown")
+})
+
+// ServiceInvitesConfig is the LFID invite part of ServiceConfig.
+var ServiceInvitesConfig = Type("ServiceInvitesConfig", func() {
+	Description("Effective LFID invite feature configuration")
+	Attribute("enabled", Boolean, "Whether outbound LFID invites are enabled", func() {
+		Example(false)
+	})
+	Attribute("self_serve_base_url", String, "LFX self-serve base URL used in invite links (empty when invalid)", func() {
+		Example("https://app.dev.lfx.dev")
+	})
+	Required("enabled", "self_serve_base_url")
+})
+
+// ServiceHealth is the result of the service health details endpoint.
+var ServiceHealth = Type("ServiceHealth", func() {
+	Description("Per-dependency health of the service")
+	Attribute("status", String, "ok when every dependency check passed, degraded otherwise", func() {
+		Enum("ok", "degraded")
+		Example("degraded")
+	})
+	Attribute("checks", ArrayOf(DependencyHealth), "Result of each dependency check")
+	Required("status", "checks")
+})
+
+// DependencyHealth is the result of a single dependency check in ServiceHealth.
+var DependencyHealth = Type("DependencyHealth", func() {
+	Description("Health of a single dependency")
+	Attribute("name", String, "Dependency name", func() {
+		Example("kv:v1-mappings")
+	})
+	Attribute("status", String, "Check outcome", func() {
+		Enum("ok", "error")
+		Example("ok")
+	})
+	Attribute("latency_ms", Int64, "How long the check took, in milliseconds", func() {
+		Example(3)
+	})
+	Attribute("error", String, "Why the check failed (only when status is error)", func() {
+		Example("context deadline exceeded")
+	})
+	Required("name", "status", "latency_ms")
+})
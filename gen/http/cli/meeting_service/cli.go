@@ -23,7 +23,7 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"meeting-service (readyz|livez|create-itx-meeting|get-itx-meeting|delete-itx-meeting|update-itx-meeting|get-itx-meeting-count|create-itx-registrant|get-itx-registrant|update-itx-registrant|delete-itx-registrant|get-itx-join-link|get-itx-registrant-ics|resend-itx-registrant-invitation|resend-itx-meeting-invitations|register-itx-committee-members|update-itx-occurrence|delete-itx-occurrence|submit-itx-meeting-response|create-itx-past-meeting|get-itx-past-meeting|delete-itx-past-meeting|update-itx-past-meeting|get-itx-past-meeting-summary|update-itx-past-meeting-summary|create-itx-past-meeting-participant|update-itx-past-meeting-participant|delete-itx-past-meeting-participant|create-itx-meeting-attachment|get-itx-meeting-attachment|update-itx-meeting-attachment|delete-itx-meeting-attachment|create-itx-meeting-attachment-presign|get-itx-meeting-attachment-download|create-itx-past-meeting-attachment|get-itx-past-meeting-attachment|update-itx-past-meeting-attachment|delete-itx-past-meeting-attachment|create-itx-past-meeting-attachment-presign|get-itx-past-meeting-attachment-download)",
+		"meeting-service (readyz|livez|get-service-config|get-service-health|create-itx-meeting|get-itx-meeting|delete-itx-meeting|clone-itx-meeting|update-itx-meeting|get-itx-meeting-count|preview-itx-meeting-occurrences|create-itx-registrant|get-itx-registrant|update-itx-registrant|delete-itx-registrant|get-itx-join-link|launch-itx-meeting|get-itx-registrant-ics|add-itx-registrant-host|remove-itx-registrant-host|resend-itx-registrant-invitation|resend-itx-meeting-invitations|register-itx-committee-members|list-itx-meeting-occurrences|update-itx-occurrence|delete-itx-occurrence|submit-itx-meeting-response|create-itx-past-meeting|get-itx-past-meeting|delete-itx-past-meeting|update-itx-past-meeting|get-itx-past-meeting-summary|get-itx-past-meeting-summary-diff|update-itx-past-meeting-summary|approve-itx-past-meeting-summary|reject-itx-past-meeting-summary|create-itx-past-meeting-participant|update-itx-past-meeting-participant|bulk-update-itx-past-meeting-participants|delete-itx-past-meeting-participant|create-itx-meeting-attachment|get-itx-meeting-attachment|update-itx-meeting-attachment|delete-itx-meeting-attachment|create-itx-meeting-attachment-presign|get-itx-meeting-attachment-download|create-itx-past-meeting-attachment|get-itx-past-meeting-attachment|update-itx-past-meeting-attachment|delete-itx-past-meeting-attachment|create-itx-past-meeting-attachment-presign|get-itx-past-meeting-attachment-download)",
 	}
 }
 
@@ -49,6 +49,14 @@ func ParseEndpoint(
 
 		meetingServiceLivezFlags = flag.NewFlagSet("livez", flag.ExitOnError)
 
+		meetingServiceGetServiceConfigFlags           = flag.NewFlagSet("get-service-config", flag.ExitOnError)
+		meetingServiceGetServiceConfigVersionFlag     = meetingServiceGetServiceConfigFlags.String("version", "", "")
+		meetingServiceGetServiceConfigBearerTokenFlag = meetingServiceGetServiceConfigFlags.String("bearer-token", "", "")
+
+		meetingServiceGetServiceHealthFlags           = flag.NewFlagSet("get-service-health", flag.ExitOnError)
+		meetingServiceGetServiceHealthVersionFlag     = meetingServiceGetServiceHealthFlags.String("version", "", "")
+		meetingServiceGetServiceHealthBearerTokenFlag = meetingServiceGetServiceHealthFlags.String("bearer-token", "", "")
+
 		meetingServiceCreateItxMeetingFlags           = flag.NewFlagSet("create-itx-meeting", flag.ExitOnError)
 		meetingServiceCreateItxMeetingBodyFlag        = meetingServiceCreateItxMeetingFlags.String("body", "REQUIRED", "")
 		meetingServiceCreateItxMeetingVersionFlag     = meetingServiceCreateItxMeetingFlags.String("version", "", "")
@@ -65,6 +73,12 @@ func ParseEndpoint(
 		meetingServiceDeleteItxMeetingVersionFlag     = meetingServiceDeleteItxMeetingFlags.String("version", "", "")
 		meetingServiceDeleteItxMeetingBearerTokenFlag = meetingServiceDeleteItxMeetingFlags.String("bearer-token", "", "")
 
+		meetingServiceCloneItxMeetingFlags           = flag.NewFlagSet("clone-itx-meeting", flag.ExitOnError)
+		meetingServiceCloneItxMeetingBodyFlag        = meetingServiceCloneItxMeetingFlags.String("body", "REQUIRED", "")
+		meetingServiceCloneItxMeetingMeetingIDFlag   = meetingServiceCloneItxMeetingFlags.String("meeting-id", "REQUIRED", "The Zoom meeting ID to clone")
+		meetingServiceCloneItxMeetingVersionFlag     = meetingServiceCloneItxMeetingFlags.String("version", "", "")
+		meetingServiceCloneItxMeetingBearerTokenFlag = meetingServiceCloneItxMeetingFlags.String("bearer-token", "", "")
+
 		meetingServiceUpdateItxMeetingFlags           = flag.NewFlagSet("update-itx-meeting", flag.ExitOnError)
 		meetingServiceUpdateItxMeetingBodyFlag        = meetingServiceUpdateItxMeetingFlags.String("body", "REQUIRED", "")
 		meetingServiceUpdateItxMeetingMeetingIDFlag   = meetingServiceUpdateItxMeetingFlags.String("meeting-id", "REQUIRED", "The Zoom meeting ID")
@@ -77,6 +91,11 @@ func ParseEndpoint(
 		meetingServiceGetItxMeetingCountProjectUIDFlag  = meetingServiceGetItxMeetingCountFlags.String("project-uid", "REQUIRED", "")
 		meetingServiceGetItxMeetingCountBearerTokenFlag = meetingServiceGetItxMeetingCountFlags.String("bearer-token", "", "")
 
+		meetingServicePreviewItxMeetingOccurrencesFlags           = flag.NewFlagSet("preview-itx-meeting-occurrences", flag.ExitOnError)
+		meetingServicePreviewItxMeetingOccurrencesBodyFlag        = meetingServicePreviewItxMeetingOccurrencesFlags.String("body", "REQUIRED", "")
+		meetingServicePreviewItxMeetingOccurrencesVersionFlag     = meetingServicePreviewItxMeetingOccurrencesFlags.String("version", "", "")
+		meetingServicePreviewItxMeetingOccurrencesBearerTokenFlag = meetingServicePreviewItxMeetingOccurrencesFlags.String("bearer-token", "", "")
+
 		meetingServiceCreateItxRegistrantFlags           = flag.NewFlagSet("create-itx-registrant", flag.ExitOnError)
 		meetingServiceCreateItxRegistrantBodyFlag        = meetingServiceCreateItxRegistrantFlags.String("body", "REQUIRED", "")
 		meetingServiceCreateItxRegistrantMeetingIDFlag   = meetingServiceCreateItxRegistrantFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
@@ -102,15 +121,29 @@ func ParseEndpoint(
 		meetingServiceDeleteItxRegistrantVersionFlag      = meetingServiceDeleteItxRegistrantFlags.String("version", "", "")
 		meetingServiceDeleteItxRegistrantBearerTokenFlag  = meetingServiceDeleteItxRegistrantFlags.String("bearer-token", "", "")
 
-		meetingServiceGetItxJoinLinkFlags           = flag.NewFlagSet("get-itx-join-link", flag.ExitOnError)
-		meetingServiceGetItxJoinLinkMeetingIDFlag   = meetingServiceGetItxJoinLinkFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
-		meetingServiceGetItxJoinLinkVersionFlag     = meetingServiceGetItxJoinLinkFlags.String("version", "", "")
-		meetingServiceGetItxJoinLinkUseEmailFlag    = meetingServiceGetItxJoinLinkFlags.String("use-email", "", "")
-		meetingServiceGetItxJoinLinkUserIDFlag      = meetingServiceGetItxJoinLinkFlags.String("user-id", "", "")
-		meetingServiceGetItxJoinLinkNameFlag        = meetingServiceGetItxJoinLinkFlags.String("name", "", "")
-		meetingServiceGetItxJoinLinkEmailFlag       = meetingServiceGetItxJoinLinkFlags.String("email", "", "")
-		meetingServiceGetItxJoinLinkRegisterFlag    = meetingServiceGetItxJoinLinkFlags.String("register", "", "")
-		meetingServiceGetItxJoinLinkBearerTokenFlag = meetingServiceGetItxJoinLinkFlags.String("bearer-token", "", "")
+		meetingServiceGetItxJoinLinkFlags            = flag.NewFlagSet("get-itx-join-link", flag.ExitOnError)
+		meetingServiceGetItxJoinLinkMeetingIDFlag    = meetingServiceGetItxJoinLinkFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
+		meetingServiceGetItxJoinLinkVersionFlag      = meetingServiceGetItxJoinLinkFlags.String("version", "", "")
+		meetingServiceGetItxJoinLinkUseEmailFlag     = meetingServiceGetItxJoinLinkFlags.String("use-email", "", "")
+		meetingServiceGetItxJoinLinkUserIDFlag       = meetingServiceGetItxJoinLinkFlags.String("user-id", "", "")
+		meetingServiceGetItxJoinLinkNameFlag         = meetingServiceGetItxJoinLinkFlags.String("name", "", "")
+		meetingServiceGetItxJoinLinkEmailFlag        = meetingServiceGetItxJoinLinkFlags.String("email", "", "")
+		meetingServiceGetItxJoinLinkRegisterFlag     = meetingServiceGetItxJoinLinkFlags.String("register", "", "")
+		meetingServiceGetItxJoinLinkOccurrenceIDFlag = meetingServiceGetItxJoinLinkFlags.String("occurrence-id", "", "")
+		meetingServiceGetItxJoinLinkBearerTokenFlag  = meetingServiceGetItxJoinLinkFlags.String("bearer-token", "", "")
+
+		meetingServiceLaunchItxMeetingFlags            = flag.NewFlagSet("launch-itx-meeting", flag.ExitOnError)
+		meetingServiceLaunchItxMeetingMeetingIDFlag    = meetingServiceLaunchItxMeetingFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
+		meetingServiceLaunchItxMeetingVersionFlag      = meetingServiceLaunchItxMeetingFlags.String("version", "", "")
+		meetingServiceLaunchItxMeetingUseEmailFlag     = meetingServiceLaunchItxMeetingFlags.String("use-email", "", "")
+		meetingServiceLaunchItxMeetingUserIDFlag       = meetingServiceLaunchItxMeetingFlags.String("user-id", "", "")
+		meetingServiceLaunchItxMeetingNameFlag         = meetingServiceLaunchItxMeetingFlags.String("name", "", "")
+		meetingServiceLaunchItxMeetingEmailFlag        = meetingServiceLaunchItxMeetingFlags.String("email", "", "")
+		meetingServiceLaunchItxMeetingRegisterFlag     = meetingServiceLaunchItxMeetingFlags.String("register", "", "")
+		meetingServiceLaunchItxMeetingOccurrenceIDFlag = meetingServiceLaunchItxMeetingFlags.String("occurrence-id", "", "")
+		meetingServiceLaunchItxMeetingClientFlag       = meetingServiceLaunchItxMeetingFlags.String("client", "auto", "")
+		meetingServiceLaunchItxMeetingBearerTokenFlag  = meetingServiceLaunchItxMeetingFlags.String("bearer-token", "", "")
+		meetingServiceLaunchItxMeetingUserAgentFlag    = meetingServiceLaunchItxMeetingFlags.String("user-agent", "", "")
 
 		meetingServiceGetItxRegistrantIcsFlags            = flag.NewFlagSet("get-itx-registrant-ics", flag.ExitOnError)
 		meetingServiceGetItxRegistrantIcsMeetingIDFlag    = meetingServiceGetItxRegistrantIcsFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
@@ -118,6 +151,18 @@ func ParseEndpoint(
 		meetingServiceGetItxRegistrantIcsVersionFlag      = meetingServiceGetItxRegistrantIcsFlags.String("version", "", "")
 		meetingServiceGetItxRegistrantIcsBearerTokenFlag  = meetingServiceGetItxRegistrantIcsFlags.String("bearer-token", "", "")
 
+		meetingServiceAddItxRegistrantHostFlags            = flag.NewFlagSet("add-itx-registrant-host", flag.ExitOnError)
+		meetingServiceAddItxRegistrantHostMeetingIDFlag    = meetingServiceAddItxRegistrantHostFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
+		meetingServiceAddItxRegistrantHostRegistrantIDFlag = meetingServiceAddItxRegistrantHostFlags.String("registrant-id", "REQUIRED", "The ID of the registrant")
+		meetingServiceAddItxRegistrantHostVersionFlag      = meetingServiceAddItxRegistrantHostFlags.String("version", "", "")
+		meetingServiceAddItxRegistrantHostBearerTokenFlag  = meetingServiceAddItxRegistrantHostFlags.String("bearer-token", "", "")
+
+		meetingServiceRemoveItxRegistrantHostFlags            = flag.NewFlagSet("remove-itx-registrant-host", flag.ExitOnError)
+		meetingServiceRemoveItxRegistrantHostMeetingIDFlag    = meetingServiceRemoveItxRegistrantHostFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
+		meetingServiceRemoveItxRegistrantHostRegistrantIDFlag = meetingServiceRemoveItxRegistrantHostFlags.String("registrant-id", "REQUIRED", "The ID of the registrant")
+		meetingServiceRemoveItxRegistrantHostVersionFlag      = meetingServiceRemoveItxRegistrantHostFlags.String("version", "", "")
+		meetingServiceRemoveItxRegistrantHostBearerTokenFlag  = meetingServiceRemoveItxRegistrantHostFlags.String("bearer-token", "", "")
+
 		meetingServiceResendItxRegistrantInvitationFlags            = flag.NewFlagSet("resend-itx-registrant-invitation", flag.ExitOnError)
 		meetingServiceResendItxRegistrantInvitationMeetingIDFlag    = meetingServiceResendItxRegistrantInvitationFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
 		meetingServiceResendItxRegistrantInvitationRegistrantIDFlag = meetingServiceResendItxRegistrantInvitationFlags.String("registrant-id", "REQUIRED", "The ID of the registrant")
@@ -135,6 +180,14 @@ func ParseEndpoint(
 		meetingServiceRegisterItxCommitteeMembersVersionFlag     = meetingServiceRegisterItxCommitteeMembersFlags.String("version", "", "")
 		meetingServiceRegisterItxCommitteeMembersBearerTokenFlag = meetingServiceRegisterItxCommitteeMembersFlags.String("bearer-token", "", "")
 
+		meetingServiceListItxMeetingOccurrencesFlags           = flag.NewFlagSet("list-itx-meeting-occurrences", flag.ExitOnError)
+		meetingServiceListItxMeetingOccurrencesMeetingIDFlag   = meetingServiceListItxMeetingOccurrencesFlags.String("meeting-id", "REQUIRED", "The Zoom meeting ID")
+		meetingServiceListItxMeetingOccurrencesVersionFlag     = meetingServiceListItxMeetingOccurrencesFlags.String("version", "", "")
+		meetingServiceListItxMeetingOccurrencesTzFlag          = meetingServiceListItxMeetingOccurrencesFlags.String("tz", "", "")
+		meetingServiceListItxMeetingOccurrencesFromFlag        = meetingServiceListItxMeetingOccurrencesFlags.String("from", "", "")
+		meetingServiceListItxMeetingOccurrencesToFlag          = meetingServiceListItxMeetingOccurrencesFlags.String("to", "", "")
+		meetingServiceListItxMeetingOccurrencesBearerTokenFlag = meetingServiceListItxMeetingOccurrencesFlags.String("bearer-token", "", "")
+
 		meetingServiceUpdateItxOccurrenceFlags            = flag.NewFlagSet("update-itx-occurrence", flag.ExitOnError)
 		meetingServiceUpdateItxOccurrenceBodyFlag         = meetingServiceUpdateItxOccurrenceFlags.String("body", "REQUIRED", "")
 		meetingServiceUpdateItxOccurrenceMeetingIDFlag    = meetingServiceUpdateItxOccurrenceFlags.String("meeting-id", "REQUIRED", "The ID of the meeting")
@@ -181,6 +234,12 @@ func ParseEndpoint(
 		meetingServiceGetItxPastMeetingSummaryVersionFlag       = meetingServiceGetItxPastMeetingSummaryFlags.String("version", "", "")
 		meetingServiceGetItxPastMeetingSummaryBearerTokenFlag   = meetingServiceGetItxPastMeetingSummaryFlags.String("bearer-token", "", "")
 
+		meetingServiceGetItxPastMeetingSummaryDiffFlags             = flag.NewFlagSet("get-itx-past-meeting-summary-diff", flag.ExitOnError)
+		meetingServiceGetItxPastMeetingSummaryDiffPastMeetingIDFlag = meetingServiceGetItxPastMeetingSummaryDiffFlags.String("past-meeting-id", "REQUIRED", "Past meeting ID (meeting_id-occurrence_id)")
+		meetingServiceGetItxPastMeetingSummaryDiffSummaryUIDFlag    = meetingServiceGetItxPastMeetingSummaryDiffFlags.String("summary-uid", "REQUIRED", "Summary UID")
+		meetingServiceGetItxPastMeetingSummaryDiffVersionFlag       = meetingServiceGetItxPastMeetingSummaryDiffFlags.String("version", "", "")
+		meetingServiceGetItxPastMeetingSummaryDiffBearerTokenFlag   = meetingServiceGetItxPastMeetingSummaryDiffFlags.String("bearer-token", "", "")
+
 		meetingServiceUpdateItxPastMeetingSummaryFlags             = flag.NewFlagSet("update-itx-past-meeting-summary", flag.ExitOnError)
 		meetingServiceUpdateItxPastMeetingSummaryBodyFlag          = meetingServiceUpdateItxPastMeetingSummaryFlags.String("body", "REQUIRED", "")
 		meetingServiceUpdateItxPastMeetingSummaryPastMeetingIDFlag = meetingServiceUpdateItxPastMeetingSummaryFlags.String("past-meeting-id", "REQUIRED", "Past meeting ID (meeting_id-occurrence_id)")
@@ -188,6 +247,18 @@ func ParseEndpoint(
 		meetingServiceUpdateItxPastMeetingSummaryVersionFlag       = meetingServiceUpdateItxPastMeetingSummaryFlags.String("version", "", "")
 		meetingServiceUpdateItxPastMeetingSummaryBearerTokenFlag   = meetingServiceUpdateItxPastMeetingSummaryFlags.String("bearer-token", "", "")
 
+		meetingServiceApproveItxPastMeetingSummaryFlags             = flag.NewFlagSet("approve-itx-past-meeting-summary", flag.ExitOnError)
+		meetingServiceApproveItxPastMeetingSummaryPastMeetingIDFlag = meetingServiceApproveItxPastMeetingSummaryFlags.String("past-meeting-id", "REQUIRED", "Past meeting ID (meeting_id-occurrence_id)")
+		meetingServiceApproveItxPastMeetingSummarySummaryUIDFlag    = meetingServiceApproveItxPastMeetingSummaryFlags.String("summary-uid", "REQUIRED", "Summary UID")
+		meetingServiceApproveItxPastMeetingSummaryVersionFlag       = meetingServiceApproveItxPastMeetingSummaryFlags.String("version", "", "")
+		meetingServiceApproveItxPastMeetingSummaryBearerTokenFlag   = meetingServiceApproveItxPastMeetingSummaryFlags.String("bearer-token", "", "")
+
+		meetingServiceRejectItxPastMeetingSummaryFlags             = flag.NewFlagSet("reject-itx-past-meeting-summary", flag.ExitOnError)
+		meetingServiceRejectItxPastMeetingSummaryPastMeetingIDFlag = meetingServiceRejectItxPastMeetingSummaryFlags.String("past-meeting-id", "REQUIRED", "Past meeting ID (meeting_id-occurrence_id)")
+		meetingServiceRejectItxPastMeetingSummarySummaryUIDFlag    = meetingServiceRejectItxPastMeetingSummaryFlags.String("summary-uid", "REQUIRED", "Summary UID")
+		meetingServiceRejectItxPastMeetingSummaryVersionFlag       = meetingServiceRejectItxPastMeetingSummaryFlags.String("version", "", "")
+		meetingServiceRejectItxPastMeetingSummaryBearerTokenFlag   = meetingServiceRejectItxPastMeetingSummaryFlags.String("bearer-token", "", "")
+
 		meetingServiceCreateItxPastMeetingParticipantFlags             = flag.NewFlagSet("create-itx-past-meeting-participant", flag.ExitOnError)
 		meetingServiceCreateItxPastMeetingParticipantBodyFlag          = meetingServiceCreateItxPastMeetingParticipantFlags.String("body", "REQUIRED", "")
 		meetingServiceCreateItxPastMeetingParticipantPastMeetingIDFlag = meetingServiceCreateItxPastMeetingParticipantFlags.String("past-meeting-id", "REQUIRED", "Past meeting ID (meeting_id-occurrence_id format)")
@@ -201,6 +272,12 @@ func ParseEndpoint(
 		meetingServiceUpdateItxPastMeetingParticipantVersionFlag       = meetingServiceUpdateItxPastMeetingParticipantFlags.String("version", "", "")
 		meetingServiceUpdateItxPastMeetingParticipantBearerTokenFlag   = meetingServiceUpdateItxPastMeetingParticipantFlags.String("bearer-token", "", "")
 
+		meetingServiceBulkUpdateItxPastMeetingParticipantsFlags             = flag.NewFlagSet("bulk-update-itx-past-meeting-participants", flag.ExitOnError)
+		meetingServiceBulkUpdateItxPastMeetingParticipantsBodyFlag          = meetingServiceBulkUpdateItxPastMeetingParticipantsFlags.String("body", "REQUIRED", "")
+		meetingServiceBulkUpdateItxPastMeetingParticipantsPastMeetingIDFlag = meetingServiceBulkUpdateItxPastMeetingParticipantsFlags.String("past-meeting-id", "REQUIRED", "Past meeting ID (meeting_id-occurrence_id format)")
+		meetingServiceBulkUpdateItxPastMeetingParticipantsVersionFlag       = meetingServiceBulkUpdateItxPastMeetingParticipantsFlags.String("version", "", "")
+		meetingServiceBulkUpdateItxPastMeetingParticipantsBearerTokenFlag   = meetingServiceBulkUpdateItxPastMeetingParticipantsFlags.String("bearer-token", "", "")
+
 		meetingServiceDeleteItxPastMeetingParticipantFlags             = flag.NewFlagSet("delete-itx-past-meeting-participant", flag.ExitOnError)
 		meetingServiceDeleteItxPastMeetingParticipantPastMeetingIDFlag = meetingServiceDeleteItxPastMeetingParticipantFlags.String("past-meeting-id", "REQUIRED", "Past meeting ID (meeting_id-occurrence_id format)")
 		meetingServiceDeleteItxPastMeetingParticipantParticipantIDFlag = meetingServiceDeleteItxPastMeetingParticipantFlags.String("participant-id", "REQUIRED", "Participant ID (invitee_id or attendee_id)")
@@ -284,20 +361,28 @@ func ParseEndpoint(
 	meetingServiceFlags.Usage = meetingServiceUsage
 	meetingServiceReadyzFlags.Usage = meetingServiceReadyzUsage
 	meetingServiceLivezFlags.Usage = meetingServiceLivezUsage
+	meetingServiceGetServiceConfigFlags.Usage = meetingServiceGetServiceConfigUsage
+	meetingServiceGetServiceHealthFlags.Usage = meetingServiceGetServiceHealthUsage
 	meetingServiceCreateItxMeetingFlags.Usage = meetingServiceCreateItxMeetingUsage
 	meetingServiceGetItxMeetingFlags.Usage = meetingServiceGetItxMeetingUsage
 	meetingServiceDeleteItxMeetingFlags.Usage = meetingServiceDeleteItxMeetingUsage
+	meetingServiceCloneItxMeetingFlags.Usage = meetingServiceCloneItxMeetingUsage
 	meetingServiceUpdateItxMeetingFlags.Usage = meetingServiceUpdateItxMeetingUsage
 	meetingServiceGetItxMeetingCountFlags.Usage = meetingServiceGetItxMeetingCountUsage
+	meetingServicePreviewItxMeetingOccurrencesFlags.Usage = meetingServicePreviewItxMeetingOccurrencesUsage
 	meetingServiceCreateItxRegistrantFlags.Usage = meetingServiceCreateItxRegistrantUsage
 	meetingServiceGetItxRegistrantFlags.Usage = meetingServiceGetItxRegistrantUsage
 	meetingServiceUpdateItxRegistrantFlags.Usage = meetingServiceUpdateItxRegistrantUsage
 	meetingServiceDeleteItxRegistrantFlags.Usage = meetingServiceDeleteItxRegistrantUsage
 	meetingServiceGetItxJoinLinkFlags.Usage = meetingServiceGetItxJoinLinkUsage
+	meetingServiceLaunchItxMeetingFlags.Usage = meetingServiceLaunchItxMeetingUsage
 	meetingServiceGetItxRegistrantIcsFlags.Usage = meetingServiceGetItxRegistrantIcsUsage
+	meetingServiceAddItxRegistrantHostFlags.Usage = meetingServiceAddItxRegistrantHostUsage
+	meetingServiceRemoveItxRegistrantHostFlags.Usage = meetingServiceRemoveItxRegistrantHostUsage
 	meetingServiceResendItxRegistrantInvitationFlags.Usage = meetingServiceResendItxRegistrantInvitationUsage
 	meetingServiceResendItxMeetingInvitationsFlags.Usage = meetingServiceResendItxMeetingInvitationsUsage
 	meetingServiceRegisterItxCommitteeMembersFlags.Usage = meetingServiceRegisterItxCommitteeMembersUsage
+	meetingServiceListItxMeetingOccurrencesFlags.Usage = meetingServiceListItxMeetingOccurrencesUsage
 	meetingServiceUpdateItxOccurrenceFlags.Usage = meetingServiceUpdateItxOccurrenceUsage
 	meetingServiceDeleteItxOccurrenceFlags.Usage = meetingServiceDeleteItxOccurrenceUsage
 	meetingServiceSubmitItxMeetingResponseFlags.Usage = meetingServiceSubmitItxMeetingResponseUsage
@@ -306,9 +391,13 @@ func ParseEndpoint(
 	meetingServiceDeleteItxPastMeetingFlags.Usage = meetingServiceDeleteItxPastMeetingUsage
 	meetingServiceUpdateItxPastMeetingFlags.Usage = meetingServiceUpdateItxPastMeetingUsage
 	meetingServiceGetItxPastMeetingSummaryFlags.Usage = meetingServiceGetItxPastMeetingSummaryUsage
+	meetingServiceGetItxPastMeetingSummaryDiffFlags.Usage = meetingServiceGetItxPastMeetingSummaryDiffUsage
 	meetingServiceUpdateItxPastMeetingSummaryFlags.Usage = meetingServiceUpdateItxPastMeetingSummaryUsage
+	meetingServiceApproveItxPastMeetingSummaryFlags.Usage = meetingServiceApproveItxPastMeetingSummaryUsage
+	meetingServiceRejectItxPastMeetingSummaryFlags.Usage = meetingServiceRejectItxPastMeetingSummaryUsage
 	meetingServiceCreateItxPastMeetingParticipantFlags.Usage = meetingServiceCreateItxPastMeetingParticipantUsage
 	meetingServiceUpdateItxPastMeetingParticipantFlags.Usage = meetingServiceUpdateItxPastMeetingParticipantUsage
+	meetingServiceBulkUpdateItxPastMeetingParticipantsFlags.Usage = meetingServiceBulkUpdateItxPastMeetingParticipantsUsage
 	meetingServiceDeleteItxPastMeetingParticipantFlags.Usage = meetingServiceDeleteItxPastMeetingParticipantUsage
 	meetingServiceCreateItxMeetingAttachmentFlags.Usage = meetingServiceCreateItxMeetingAttachmentUsage
 	meetingServiceGetItxMeetingAttachmentFlags.Usage = meetingServiceGetItxMeetingAttachmentUsage
@@ -363,6 +452,12 @@ func ParseEndpoint(
 			case "livez":
 				epf = meetingServiceLivezFlags
 
+			case "get-service-config":
+				epf = meetingServiceGetServiceConfigFlags
+
+			case "get-service-health":
+				epf = meetingServiceGetServiceHealthFlags
+
 			case "create-itx-meeting":
 				epf = meetingServiceCreateItxMeetingFlags
 
@@ -372,12 +467,18 @@ func ParseEndpoint(
 			case "delete-itx-meeting":
 				epf = meetingServiceDeleteItxMeetingFlags
 
+			case "clone-itx-meeting":
+				epf = meetingServiceCloneItxMeetingFlags
+
 			case "update-itx-meeting":
 				epf = meetingServiceUpdateItxMeetingFlags
 
 			case "get-itx-meeting-count":
 				epf = meetingServiceGetItxMeetingCountFlags
 
+			case "preview-itx-meeting-occurrences":
+				epf = meetingServicePreviewItxMeetingOccurrencesFlags
+
 			case "create-itx-registrant":
 				epf = meetingServiceCreateItxRegistrantFlags
 
@@ -393,9 +494,18 @@ func ParseEndpoint(
 			case "get-itx-join-link":
 				epf = meetingServiceGetItxJoinLinkFlags
 
+			case "launch-itx-meeting":
+				epf = meetingServiceLaunchItxMeetingFlags
+
 			case "get-itx-registrant-ics":
 				epf = meetingServiceGetItxRegistrantIcsFlags
 
+			case "add-itx-registrant-host":
+				epf = meetingServiceAddItxRegistrantHostFlags
+
+			case "remove-itx-registrant-host":
+				epf = meetingServiceRemoveItxRegistrantHostFlags
+
 			case "resend-itx-registrant-invitation":
 				epf = meetingServiceResendItxRegistrantInvitationFlags
 
@@ -405,6 +515,9 @@ func ParseEndpoint(
 			case "register-itx-committee-members":
 				epf = meetingServiceRegisterItxCommitteeMembersFlags
 
+			case "list-itx-meeting-occurrences":
+				epf = meetingServiceListItxMeetingOccurrencesFlags
+
 			case "update-itx-occurrence":
 				epf = meetingServiceUpdateItxOccurrenceFlags
 
@@ -429,15 +542,27 @@ func ParseEndpoint(
 			case "get-itx-past-meeting-summary":
 				epf = meetingServiceGetItxPastMeetingSummaryFlags
 
+			case "get-itx-past-meeting-summary-diff":
+				epf = meetingServiceGetItxPastMeetingSummaryDiffFlags
+
 			case "update-itx-past-meeting-summary":
 				epf = meetingServiceUpdateItxPastMeetingSummaryFlags
 
+			case "approve-itx-past-meeting-summary":
+				epf = meetingServiceApproveItxPastMeetingSummaryFlags
+
+			case "reject-itx-past-meeting-summary":
+				epf = meetingServiceRejectItxPastMeetingSummaryFlags
+
 			case "create-itx-past-meeting-participant":
 				epf = meetingServiceCreateItxPastMeetingParticipantFlags
 
 			case "update-itx-past-meeting-participant":
 				epf = meetingServiceUpdateItxPastMeetingParticipantFlags
 
+			case "bulk-update-itx-past-meeting-participants":
+				epf = meetingServiceBulkUpdateItxPastMeetingParticipantsFlags
+
 			case "delete-itx-past-meeting-participant":
 				epf = meetingServiceDeleteItxPastMeetingParticipantFlags
 
@@ -506,6 +631,12 @@ func ParseEndpoint(
 				endpoint = c.Readyz()
 			case "livez":
 				endpoint = c.Livez()
+			case "get-service-config":
+				endpoint = c.GetServiceConfig()
+				data, err = meetingservicec.BuildGetServiceConfigPayload(*meetingServiceGetServiceConfigVersionFlag, *meetingServiceGetServiceConfigBearerTokenFlag)
+			case "get-service-health":
+				endpoint = c.GetServiceHealth()
+				data, err = meetingservicec.BuildGetServiceHealthPayload(*meetingServiceGetServiceHealthVersionFlag, *meetingServiceGetServiceHealthBearerTokenFlag)
 			case "create-itx-meeting":
 				endpoint = c.CreateItxMeeting()
 				data, err = meetingservicec.BuildCreateItxMeetingPayload(*meetingServiceCreateItxMeetingBodyFlag, *meetingServiceCreateItxMeetingVersionFlag, *meetingServiceCreateItxMeetingBearerTokenFlag, *meetingServiceCreateItxMeetingXSyncFlag)
@@ -515,12 +646,18 @@ func ParseEndpoint(
 			case "delete-itx-meeting":
 				endpoint = c.DeleteItxMeeting()
 				data, err = meetingservicec.BuildDeleteItxMeetingPayload(*meetingServiceDeleteItxMeetingMeetingIDFlag, *meetingServiceDeleteItxMeetingVersionFlag, *meetingServiceDeleteItxMeetingBearerTokenFlag)
+			case "clone-itx-meeting":
+				endpoint = c.CloneItxMeeting()
+				data, err = meetingservicec.BuildCloneItxMeetingPayload(*meetingServiceCloneItxMeetingBodyFlag, *meetingServiceCloneItxMeetingMeetingIDFlag, *meetingServiceCloneItxMeetingVersionFlag, *meetingServiceCloneItxMeetingBearerTokenFlag)
 			case "update-itx-meeting":
 				endpoint = c.UpdateItxMeeting()
 				data, err = meetingservicec.BuildUpdateItxMeetingPayload(*meetingServiceUpdateItxMeetingBodyFlag, *meetingServiceUpdateItxMeetingMeetingIDFlag, *meetingServiceUpdateItxMeetingVersionFlag, *meetingServiceUpdateItxMeetingBearerTokenFlag, *meetingServiceUpdateItxMeetingXSyncFlag)
 			case "get-itx-meeting-count":
 				endpoint = c.GetItxMeetingCount()
 				data, err = meetingservicec.BuildGetItxMeetingCountPayload(*meetingServiceGetItxMeetingCountVersionFlag, *meetingServiceGetItxMeetingCountProjectUIDFlag, *meetingServiceGetItxMeetingCountBearerTokenFlag)
+			case "preview-itx-meeting-occurrences":
+				endpoint = c.PreviewItxMeetingOccurrences()
+				data, err = meetingservicec.BuildPreviewItxMeetingOccurrencesPayload(*meetingServicePreviewItxMeetingOccurrencesBodyFlag, *meetingServicePreviewItxMeetingOccurrencesVersionFlag, *meetingServicePreviewItxMeetingOccurrencesBearerTokenFlag)
 			case "create-itx-registrant":
 				endpoint = c.CreateItxRegistrant()
 				data, err = meetingservicec.BuildCreateItxRegistrantPayload(*meetingServiceCreateItxRegistrantBodyFlag, *meetingServiceCreateItxRegistrantMeetingIDFlag, *meetingServiceCreateItxRegistrantVersionFlag, *meetingServiceCreateItxRegistrantBearerTokenFlag)
@@ -535,10 +672,19 @@ func ParseEndpoint(
 				data, err = meetingservicec.BuildDeleteItxRegistrantPayload(*meetingServiceDeleteItxRegistrantMeetingIDFlag, *meetingServiceDeleteItxRegistrantRegistrantIDFlag, *meetingServiceDeleteItxRegistrantVersionFlag, *meetingServiceDeleteItxRegistrantBearerTokenFlag)
 			case "get-itx-join-link":
 				endpoint = c.GetItxJoinLink()
-				data, err = meetingservicec.BuildGetItxJoinLinkPayload(*meetingServiceGetItxJoinLinkMeetingIDFlag, *meetingServiceGetItxJoinLinkVersionFlag, *meetingServiceGetItxJoinLinkUseEmailFlag, *meetingServiceGetItxJoinLinkUserIDFlag, *meetingServiceGetItxJoinLinkNameFlag, *meetingServiceGetItxJoinLinkEmailFlag, *meetingServiceGetItxJoinLinkRegisterFlag, *meetingServiceGetItxJoinLinkBearerTokenFlag)
+				data, err = meetingservicec.BuildGetItxJoinLinkPayload(*meetingServiceGetItxJoinLinkMeetingIDFlag, *meetingServiceGetItxJoinLinkVersionFlag, *meetingServiceGetItxJoinLinkUseEmailFlag, *meetingServiceGetItxJoinLinkUserIDFlag, *meetingServiceGetItxJoinLinkNameFlag, *meetingServiceGetItxJoinLinkEmailFlag, *meetingServiceGetItxJoinLinkRegisterFlag, *meetingServiceGetItxJoinLinkOccurrenceIDFlag, *meetingServiceGetItxJoinLinkBearerTokenFlag)
+			case "launch-itx-meeting":
+				endpoint = c.LaunchItxMeeting()
+				data, err = meetingservicec.BuildLaunchItxMeetingPayload(*meetingServiceLaunchItxMeetingMeetingIDFlag, *meetingServiceLaunchItxMeetingVersionFlag, *meetingServiceLaunchItxMeetingUseEmailFlag, *meetingServiceLaunchItxMeetingUserIDFlag, *meetingServiceLaunchItxMeetingNameFlag, *meetingServiceLaunchItxMeetingEmailFlag, *meetingServiceLaunchItxMeetingRegisterFlag, *meetingServiceLaunchItxMeetingOccurrenceIDFlag, *meetingServiceLaunchItxMeetingClientFlag, *meetingServiceLaunchItxMeetingBearerTokenFlag, *meetingServiceLaunchItxMeetingUserAgentFlag)
 			case "get-itx-registrant-ics":
 				endpoint = c.GetItxRegistrantIcs()
 				data, err = meetingservicec.BuildGetItxRegistrantIcsPayload(*meetingServiceGetItxRegistrantIcsMeetingIDFlag, *meetingServiceGetItxRegistrantIcsRegistrantIDFlag, *meetingServiceGetItxRegistrantIcsVersionFlag, *meetingServiceGetItxRegistrantIcsBearerTokenFlag)
+			case "add-itx-registrant-host":
+				endpoint = c.AddItxRegistrantHost()
+				data, err = meetingservicec.BuildAddItxRegistrantHostPayload(*meetingServiceAddItxRegistrantHostMeetingIDFlag, *meetingServiceAddItxRegistrantHostRegistrantIDFlag, *meetingServiceAddItxRegistrantHostVersionFlag, *meetingServiceAddItxRegistrantHostBearerTokenFlag)
+			case "remove-itx-registrant-host":
+				endpoint = c.RemoveItxRegistrantHost()
+				data, err = meetingservicec.BuildRemoveItxRegistrantHostPayload(*meetingServiceRemoveItxRegistrantHostMeetingIDFlag, *meetingServiceRemoveItxRegistrantHostRegistrantIDFlag, *meetingServiceRemoveItxRegistrantHostVersionFlag, *meetingServiceRemoveItxRegistrantHostBearerTokenFlag)
 			case "resend-itx-registrant-invitation":
 				endpoint = c.ResendItxRegistrantInvitation()
 				data, err = meetingservicec.BuildResendItxRegistrantInvitationPayload(*meetingServiceResendItxRegistrantInvitationMeetingIDFlag, *meetingServiceResendItxRegistrantInvitationRegistrantIDFlag, *meetingServiceResendItxRegistrantInvitationVersionFlag, *meetingServiceResendItxRegistrantInvitationBearerTokenFlag)
@@ -548,6 +694,9 @@ func ParseEndpoint(
 			case "register-itx-committee-members":
 				endpoint = c.RegisterItxCommitteeMembers()
 				data, err = meetingservicec.BuildRegisterItxCommitteeMembersPayload(*meetingServiceRegisterItxCommitteeMembersMeetingIDFlag, *meetingServiceRegisterItxCommitteeMembersVersionFlag, *meetingServiceRegisterItxCommitteeMembersBearerTokenFlag)
+			case "list-itx-meeting-occurrences":
+				endpoint = c.ListItxMeetingOccurrences()
+				data, err = meetingservicec.BuildListItxMeetingOccurrencesPayload(*meetingServiceListItxMeetingOccurrencesMeetingIDFlag, *meetingServiceListItxMeetingOccurrencesVersionFlag, *meetingServiceListItxMeetingOccurrencesTzFlag, *meetingServiceListItxMeetingOccurrencesFromFlag, *meetingServiceListItxMeetingOccurrencesToFlag, *meetingServiceListItxMeetingOccurrencesBearerTokenFlag)
 			case "update-itx-occurrence":
 				endpoint = c.UpdateItxOccurrence()
 				data, err = meetingservicec.BuildUpdateItxOccurrencePayload(*meetingServiceUpdateItxOccurrenceBodyFlag, *meetingServiceUpdateItxOccurrenceMeetingIDFlag, *meetingServiceUpdateItxOccurrenceOccurrenceIDFlag, *meetingServiceUpdateItxOccurrenceVersionFlag, *meetingServiceUpdateItxOccurrenceBearerTokenFlag)
@@ -572,15 +721,27 @@ func ParseEndpoint(
 			case "get-itx-past-meeting-summary":
 				endpoint = c.GetItxPastMeetingSummary()
 				data, err = meetingservicec.BuildGetItxPastMeetingSummaryPayload(*meetingServiceGetItxPastMeetingSummaryPastMeetingIDFlag, *meetingServiceGetItxPastMeetingSummarySummaryUIDFlag, *meetingServiceGetItxPastMeetingSummaryVersionFlag, *meetingServiceGetItxPastMeetingSummaryBearerTokenFlag)
+			case "get-itx-past-meeting-summary-diff":
+				endpoint = c.GetItxPastMeetingSummaryDiff()
+				data, err = meetingservicec.BuildGetItxPastMeetingSummaryDiffPayload(*meetingServiceGetItxPastMeetingSummaryDiffPastMeetingIDFlag, *meetingServiceGetItxPastMeetingSummaryDiffSummaryUIDFlag, *meetingServiceGetItxPastMeetingSummaryDiffVersionFlag, *meetingServiceGetItxPastMeetingSummaryDiffBearerTokenFlag)
 			case "update-itx-past-meeting-summary":
 				endpoint = c.UpdateItxPastMeetingSummary()
 				data, err = meetingservicec.BuildUpdateItxPastMeetingSummaryPayload(*meetingServiceUpdateItxPastMeetingSummaryBodyFlag, *meetingServiceUpdateItxPastMeetingSummaryPastMeetingIDFlag, *meetingServiceUpdateItxPastMeetingSummarySummaryUIDFlag, *meetingServiceUpdateItxPastMeetingSummaryVersionFlag, *meetingServiceUpdateItxPastMeetingSummaryBearerTokenFlag)
+			case "approve-itx-past-meeting-summary":
+				endpoint = c.ApproveItxPastMeetingSummary()
+				data, err = meetingservicec.BuildApproveItxPastMeetingSummaryPayload(*meetingServiceApproveItxPastMeetingSummaryPastMeetingIDFlag, *meetingServiceApproveItxPastMeetingSummarySummaryUIDFlag, *meetingServiceApproveItxPastMeetingSummaryVersionFlag, *meetingServiceApproveItxPastMeetingSummaryBearerTokenFlag)
+			case "reject-itx-past-meeting-summary":
+				endpoint = c.RejectItxPastMeetingSummary()
+				data, err = meetingservicec.BuildRejectItxPastMeetingSummaryPayload(*meetingServiceRejectItxPastMeetingSummaryPastMeetingIDFlag, *meetingServiceRejectItxPastMeetingSummarySummaryUIDFlag, *meetingServiceRejectItxPastMeetingSummaryVersionFlag, *meetingServiceRejectItxPastMeetingSummaryBearerTokenFlag)
 			case "create-itx-past-meeting-participant":
 				endpoint = c.CreateItxPastMeetingParticipant()
 				data, err = meetingservicec.BuildCreateItxPastMeetingParticipantPayload(*meetingServiceCreateItxPastMeetingParticipantBodyFlag, *meetingServiceCreateItxPastMeetingParticipantPastMeetingIDFlag, *meetingServiceCreateItxPastMeetingParticipantVersionFlag, *meetingServiceCreateItxPastMeetingParticipantBearerTokenFlag)
 			case "update-itx-past-meeting-participant":
 				endpoint = c.UpdateItxPastMeetingParticipant()
 				data, err = meetingservicec.BuildUpdateItxPastMeetingParticipantPayload(*meetingServiceUpdateItxPastMeetingParticipantBodyFlag, *meetingServiceUpdateItxPastMeetingParticipantPastMeetingIDFlag, *meetingServiceUpdateItxPastMeetingParticipantParticipantIDFlag, *meetingServiceUpdateItxPastMeetingParticipantVersionFlag, *meetingServiceUpdateItxPastMeetingParticipantBearerTokenFlag)
+			case "bulk-update-itx-past-meeting-participants":
+				endpoint = c.BulkUpdateItxPastMeetingParticipants()
+				data, err = meetingservicec.BuildBulkUpdateItxPastMeetingParticipantsPayload(*meetingServiceBulkUpdateItxPastMeetingParticipantsBodyFlag, *meetingServiceBulkUpdateItxPastMeetingParticipantsPastMeetingIDFlag, *meetingServiceBulkUpdateItxPastMeetingParticipantsVersionFlag, *meetingServiceBulkUpdateItxPastMeetingParticipantsBearerTokenFlag)
 			case "delete-itx-past-meeting-participant":
 				endpoint = c.DeleteItxPastMeetingParticipant()
 				data, err = meetingservicec.BuildDeleteItxPastMeetingParticipantPayload(*meetingServiceDeleteItxPastMeetingParticipantPastMeetingIDFlag, *meetingServiceDeleteItxPastMeetingParticipantParticipantIDFlag, *meetingServiceDeleteItxPastMeetingParticipantVersionFlag, *meetingServiceDeleteItxPastMeetingParticipantBearerTokenFlag)
@@ -638,20 +799,28 @@ func meetingServiceUsage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    readyz: Check if the service is able to take inbound requests.`)
 	fmt.Fprintln(os.Stderr, `    livez: Check if the service is alive.`)
+	fmt.Fprintln(os.Stderr, `    get-service-config: Get the sanitized effective service configuration for diagnostics`)
+	fmt.Fprintln(os.Stderr, `    get-service-health: Check each dependency of the service (ITX auth, NATS connections, KV buckets) and report its status and latency for diagnostics`)
 	fmt.Fprintln(os.Stderr, `    create-itx-meeting: Create a Zoom meeting through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    get-itx-meeting: Get a Zoom meeting through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    delete-itx-meeting: Delete a Zoom meeting through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    clone-itx-meeting: Create a new Zoom meeting with the settings of an existing meeting at a new start time through ITX API proxy. Registrants and attachments are not copied.`)
 	fmt.Fprintln(os.Stderr, `    update-itx-meeting: Update a Zoom meeting through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    get-itx-meeting-count: Get the count of Zoom meetings for a project through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    preview-itx-meeting-occurrences: Preview the occurrences a recurrence definition will generate, without creating a meeting. Occurrences are computed with the same algorithm used to index ITX meetings.`)
 	fmt.Fprintln(os.Stderr, `    create-itx-registrant: Create a meeting registrant through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    get-itx-registrant: Get a meeting registrant through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    update-itx-registrant: Update a meeting registrant through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    delete-itx-registrant: Delete a meeting registrant through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    get-itx-join-link: Get join link for a meeting through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    launch-itx-meeting: Redirect to a meeting's join link through ITX API proxy, as a zoommtg:// deep link for desktop clients or the web link otherwise`)
 	fmt.Fprintln(os.Stderr, `    get-itx-registrant-ics: Get ICS calendar file for a meeting registrant through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    add-itx-registrant-host: Grant a registrant host access (the meeting host key) through ITX API proxy. Idempotent.`)
+	fmt.Fprintln(os.Stderr, `    remove-itx-registrant-host: Revoke a registrant's host access through ITX API proxy. Idempotent.`)
 	fmt.Fprintln(os.Stderr, `    resend-itx-registrant-invitation: Resend meeting invitation to a registrant through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    resend-itx-meeting-invitations: Resend meeting invitations to all registrants through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    register-itx-committee-members: Register committee members to a meeting asynchronously through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    list-itx-meeting-occurrences: List a meeting's occurrences through ITX API proxy, with start and end times converted to a requested timezone`)
 	fmt.Fprintln(os.Stderr, `    update-itx-occurrence: Update a specific occurrence of a recurring meeting through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    delete-itx-occurrence: Delete a specific occurrence of a recurring meeting through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    submit-itx-meeting-response: Submit a meeting response (invite response) for a meeting or occurrence through ITX API proxy`)
@@ -660,9 +829,13 @@ func meetingServiceUsage() {
 	fmt.Fprintln(os.Stderr, `    delete-itx-past-meeting: Delete a past meeting through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    update-itx-past-meeting: Update a past meeting through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    get-itx-past-meeting-summary: Get a specific past meeting summary through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    get-itx-past-meeting-summary-diff: Get a structured diff between the AI-generated and edited content of a past meeting summary through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    update-itx-past-meeting-summary: Update a past meeting summary through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    approve-itx-past-meeting-summary: Approve a past meeting summary that requires approval through ITX API proxy`)
+	fmt.Fprintln(os.Stderr, `    reject-itx-past-meeting-summary: Reject (un-approve) a past meeting summary that requires approval through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    create-itx-past-meeting-participant: Create a past meeting participant through ITX API proxy - routes to invitee and/or attendee endpoints based on flags`)
 	fmt.Fprintln(os.Stderr, `    update-itx-past-meeting-participant: Update a past meeting participant through ITX API proxy - updates invitee and/or attendee records as needed`)
+	fmt.Fprintln(os.Stderr, `    bulk-update-itx-past-meeting-participants: Apply corrections to many past meeting participants in one request through ITX API proxy. Each correction is applied like update-itx-past-meeting-participant, in order; the request is not atomic.`)
 	fmt.Fprintln(os.Stderr, `    delete-itx-past-meeting-participant: Delete a past meeting participant through ITX API proxy - deletes invitee and/or attendee records as needed`)
 	fmt.Fprintln(os.Stderr, `    create-itx-meeting-attachment: Create a meeting attachment through ITX API proxy`)
 	fmt.Fprintln(os.Stderr, `    get-itx-meeting-attachment: Get a meeting attachment through ITX API proxy`)
@@ -712,6 +885,46 @@ func meetingServiceLivezUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service livez")
 }
 
+func meetingServiceGetServiceConfigUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service get-service-config", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the sanitized effective service configuration for diagnostics`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-service-config --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func meetingServiceGetServiceHealthUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service get-service-health", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Check each dependency of the service (ITX auth, NATS connections, KV buckets) and report its status and latency for diagnostics`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-service-health --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
 func meetingServiceCreateItxMeetingUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service create-itx-meeting", os.Args[0])
@@ -733,7 +946,7 @@ func meetingServiceCreateItxMeetingUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-meeting --body '{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"meeting_hosts\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"uaq\",\n      \"duration\": 263,\n      \"early_join_time_minutes\": 28,\n      \"meeting_type\": \"Marketing\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"2006-08-02T09:48:40Z\",\n         \"end_times\": 1743448645631853479,\n         \"monthly_day\": 6681397425469462938,\n         \"monthly_week\": 6645035424863629342,\n         \"monthly_week_day\": 3613921200484800227,\n         \"repeat_interval\": 3388645659450458814,\n         \"type\": 2,\n         \"weekly_days\": \"Necessitatibus deleniti natus possimus.\"\n      },\n      \"require_ai_summary_approval\": true,\n      \"restricted\": false,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Porro iste non commodi sint sed est.\",\n      \"title\": \"Quasi ipsam fugiat quis qui quam.\",\n      \"transcript_enabled\": false,\n      \"visibility\": \"public\",\n      \"youtube_upload_enabled\": true\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-meeting --body '{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"meeting_hosts\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"dxn\",\n      \"duration\": 320,\n      \"early_join_time_minutes\": 12,\n      \"meeting_type\": \"Legal\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"require_ai_summary_approval\": true,\n      \"restricted\": false,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Maiores amet fugiat consequatur consectetur eum eum.\",\n      \"title\": \"Omnis impedit vel aut.\",\n      \"transcript_enabled\": true,\n      \"visibility\": \"public\",\n      \"youtube_upload_enabled\": false\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
 }
 
 func meetingServiceGetItxMeetingUsage() {
@@ -780,6 +993,30 @@ func meetingServiceDeleteItxMeetingUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service delete-itx-meeting --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
+func meetingServiceCloneItxMeetingUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service clone-itx-meeting", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create a new Zoom meeting with the settings of an existing meeting at a new start time through ITX API proxy. Registrants and attachments are not copied.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -meeting-id STRING: The Zoom meeting ID to clone`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service clone-itx-meeting --body '{\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"title\": \"Aut amet odio.\"\n   }' --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
 func meetingServiceUpdateItxMeetingUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service update-itx-meeting", os.Args[0])
@@ -803,7 +1040,7 @@ func meetingServiceUpdateItxMeetingUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-meeting --body '{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"t3c\",\n      \"duration\": 36,\n      \"early_join_time_minutes\": 19,\n      \"meeting_type\": \"Board\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"2006-08-02T09:48:40Z\",\n         \"end_times\": 1743448645631853479,\n         \"monthly_day\": 6681397425469462938,\n         \"monthly_week\": 6645035424863629342,\n         \"monthly_week_day\": 3613921200484800227,\n         \"repeat_interval\": 3388645659450458814,\n         \"type\": 2,\n         \"weekly_days\": \"Necessitatibus deleniti natus possimus.\"\n      },\n      \"require_ai_summary_approval\": true,\n      \"restricted\": false,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Eius ut sed dolorem eum eaque.\",\n      \"title\": \"Id qui blanditiis qui maiores adipisci odio.\",\n      \"transcript_enabled\": false,\n      \"update_note\": \"nto\",\n      \"visibility\": \"public\",\n      \"youtube_upload_enabled\": false\n   }' --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-meeting --body '{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"meeting_hosts\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"mhc\",\n      \"duration\": 140,\n      \"early_join_time_minutes\": 21,\n      \"meeting_type\": \"Marketing\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"require_ai_summary_approval\": false,\n      \"restricted\": true,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Mollitia vel sit non quaerat harum.\",\n      \"title\": \"Cum itaque magni fugit.\",\n      \"transcript_enabled\": true,\n      \"update_note\": \"p7b\",\n      \"visibility\": \"private\",\n      \"youtube_upload_enabled\": false\n   }' --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
 }
 
 func meetingServiceGetItxMeetingCountUsage() {
@@ -828,6 +1065,28 @@ func meetingServiceGetItxMeetingCountUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-meeting-count --version \"1\" --project-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --bearer-token \"eyJhbGci...\"")
 }
 
+func meetingServicePreviewItxMeetingOccurrencesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service preview-itx-meeting-occurrences", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Preview the occurrences a recurrence definition will generate, without creating a meeting. Occurrences are computed with the same algorithm used to index ITX meetings.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service preview-itx-meeting-occurrences --body '{\n      \"count\": 36,\n      \"duration\": 52,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Necessitatibus quod vel eum aut.\"\n   }' --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
 func meetingServiceCreateItxRegistrantUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service create-itx-registrant", os.Args[0])
@@ -849,7 +1108,7 @@ func meetingServiceCreateItxRegistrantUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-registrant --body '{\n      \"attended_occurrence_count\": 3412427569359350717,\n      \"committee_uid\": \"Provident expedita veritatis eaque explicabo.\",\n      \"created_at\": \"Animi voluptatem.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Nihil illo ut non aut.\",\n      \"last_invite_delivery_status\": \"Ipsum eligendi vero.\",\n      \"last_invite_received_message_id\": \"Magnam et esse sed ad assumenda est.\",\n      \"last_invite_received_time\": \"Quia et voluptas dolor.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Et atque dolor aperiam.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Velit quod recusandae aut incidunt.\",\n      \"total_occurrence_count\": 8867872198606554721,\n      \"type\": \"direct\",\n      \"uid\": \"Veritatis fugiat exercitationem.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }' --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-registrant --body '{\n      \"attended_occurrence_count\": 4362760078868173455,\n      \"committee_uid\": \"Est et ea consequatur a.\",\n      \"created_at\": \"Rerum deserunt omnis.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Quos sint quos.\",\n      \"last_invite_delivery_status\": \"Ea voluptas animi.\",\n      \"last_invite_received_message_id\": \"Nam fugiat corrupti in error.\",\n      \"last_invite_received_time\": \"Accusamus quia.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Et magni ut nobis aspernatur et eum.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Molestiae voluptatem qui aut.\",\n      \"total_occurrence_count\": 230783524081813140,\n      \"type\": \"committee\",\n      \"uid\": \"Maiores adipisci corporis.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }' --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceGetItxRegistrantUsage() {
@@ -899,7 +1158,7 @@ func meetingServiceUpdateItxRegistrantUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-registrant --body '{\n      \"attended_occurrence_count\": 437006580780085388,\n      \"committee_uid\": \"Laboriosam enim.\",\n      \"created_at\": \"Dolorem exercitationem delectus ut et cum itaque.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Rerum deleniti est et occaecati fugit.\",\n      \"last_invite_delivery_status\": \"Vitae ducimus debitis libero.\",\n      \"last_invite_received_message_id\": \"Aut iure aspernatur laborum voluptatem a dolor.\",\n      \"last_invite_received_time\": \"Facere beatae.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Fugit exercitationem qui mollitia vel sit non.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Officiis qui ut dicta.\",\n      \"total_occurrence_count\": 1834127355695980732,\n      \"type\": \"committee\",\n      \"uid\": \"Aperiam repudiandae.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }' --meeting-id \"1234567890\" --registrant-id \"zjkfsdfjdfhg\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-registrant --body '{\n      \"attended_occurrence_count\": 1501333704169604349,\n      \"committee_uid\": \"Illum aut sit quaerat.\",\n      \"created_at\": \"Quisquam suscipit rerum laudantium.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Et in doloremque veritatis consequuntur.\",\n      \"last_invite_delivery_status\": \"Accusantium reprehenderit voluptatum occaecati.\",\n      \"last_invite_received_message_id\": \"Ea natus quod velit ea ullam.\",\n      \"last_invite_received_time\": \"Saepe architecto.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Ea in in dicta voluptas adipisci.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Vel sit voluptatem recusandae voluptatem.\",\n      \"total_occurrence_count\": 4306541817862693821,\n      \"type\": \"direct\",\n      \"uid\": \"Optio occaecati veritatis iure quidem.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }' --meeting-id \"1234567890\" --registrant-id \"zjkfsdfjdfhg\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceDeleteItxRegistrantUsage() {
@@ -936,6 +1195,7 @@ func meetingServiceGetItxJoinLinkUsage() {
 	fmt.Fprint(os.Stderr, " -name STRING")
 	fmt.Fprint(os.Stderr, " -email STRING")
 	fmt.Fprint(os.Stderr, " -register BOOL")
+	fmt.Fprint(os.Stderr, " -occurrence-id STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
@@ -951,11 +1211,50 @@ func meetingServiceGetItxJoinLinkUsage() {
 	fmt.Fprintln(os.Stderr, `    -name STRING: `)
 	fmt.Fprintln(os.Stderr, `    -email STRING: `)
 	fmt.Fprintln(os.Stderr, `    -register BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -occurrence-id STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-join-link --meeting-id \"1234567890\" --version \"1\" --use-email true --user-id \"user123\" --name \"John Doe\" --email \"john.doe@example.com\" --register false --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-join-link --meeting-id \"1234567890\" --version \"1\" --use-email false --user-id \"user123\" --name \"John Doe\" --email \"john.doe@example.com\" --register true --occurrence-id \"1640995200\" --bearer-token \"eyJhbGci...\"")
+}
+
+func meetingServiceLaunchItxMeetingUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service launch-itx-meeting", os.Args[0])
+	fmt.Fprint(os.Stderr, " -meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -use-email BOOL")
+	fmt.Fprint(os.Stderr, " -user-id STRING")
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprint(os.Stderr, " -email STRING")
+	fmt.Fprint(os.Stderr, " -register BOOL")
+	fmt.Fprint(os.Stderr, " -occurrence-id STRING")
+	fmt.Fprint(os.Stderr, " -client STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -user-agent STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Redirect to a meeting's join link through ITX API proxy, as a zoommtg:// deep link for desktop clients or the web link otherwise`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -meeting-id STRING: The ID of the meeting`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -use-email BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -user-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -name STRING: `)
+	fmt.Fprintln(os.Stderr, `    -email STRING: `)
+	fmt.Fprintln(os.Stderr, `    -register BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -occurrence-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -client STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -user-agent STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service launch-itx-meeting --meeting-id \"1234567890\" --version \"1\" --use-email false --user-id \"user123\" --name \"John Doe\" --email \"john.doe@example.com\" --register false --occurrence-id \"1640995200\" --client \"auto\" --bearer-token \"eyJhbGci...\" --user-agent \"Atque dolor eaque omnis dolores voluptatum.\"")
 }
 
 func meetingServiceGetItxRegistrantIcsUsage() {
@@ -982,6 +1281,54 @@ func meetingServiceGetItxRegistrantIcsUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-registrant-ics --meeting-id \"1234567890\" --registrant-id \"zjkfsdfjdfhg\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
+func meetingServiceAddItxRegistrantHostUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service add-itx-registrant-host", os.Args[0])
+	fmt.Fprint(os.Stderr, " -meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -registrant-id STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Grant a registrant host access (the meeting host key) through ITX API proxy. Idempotent.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -meeting-id STRING: The ID of the meeting`)
+	fmt.Fprintln(os.Stderr, `    -registrant-id STRING: The ID of the registrant`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service add-itx-registrant-host --meeting-id \"1234567890\" --registrant-id \"zjkfsdfjdfhg\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func meetingServiceRemoveItxRegistrantHostUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service remove-itx-registrant-host", os.Args[0])
+	fmt.Fprint(os.Stderr, " -meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -registrant-id STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revoke a registrant's host access through ITX API proxy. Idempotent.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -meeting-id STRING: The ID of the meeting`)
+	fmt.Fprintln(os.Stderr, `    -registrant-id STRING: The ID of the registrant`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service remove-itx-registrant-host --meeting-id \"1234567890\" --registrant-id \"zjkfsdfjdfhg\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
 func meetingServiceResendItxRegistrantInvitationUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service resend-itx-registrant-invitation", os.Args[0])
@@ -1052,6 +1399,34 @@ func meetingServiceRegisterItxCommitteeMembersUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service register-itx-committee-members --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
+func meetingServiceListItxMeetingOccurrencesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service list-itx-meeting-occurrences", os.Args[0])
+	fmt.Fprint(os.Stderr, " -meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -tz STRING")
+	fmt.Fprint(os.Stderr, " -from STRING")
+	fmt.Fprint(os.Stderr, " -to STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List a meeting's occurrences through ITX API proxy, with start and end times converted to a requested timezone`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -meeting-id STRING: The Zoom meeting ID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -tz STRING: `)
+	fmt.Fprintln(os.Stderr, `    -from STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service list-itx-meeting-occurrences --meeting-id \"1234567890\" --version \"1\" --tz \"Europe/Berlin\" --from \"1982-08-16T03:43:47Z\" --to \"1997-10-08T04:13:25Z\" --bearer-token \"eyJhbGci...\"")
+}
+
 func meetingServiceUpdateItxOccurrenceUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service update-itx-occurrence", os.Args[0])
@@ -1075,7 +1450,7 @@ func meetingServiceUpdateItxOccurrenceUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-occurrence --body '{\n      \"agenda\": \"Quod vel eum aut.\",\n      \"duration\": 60,\n      \"recurrence\": {\n         \"end_date_time\": \"2006-08-02T09:48:40Z\",\n         \"end_times\": 1743448645631853479,\n         \"monthly_day\": 6681397425469462938,\n         \"monthly_week\": 6645035424863629342,\n         \"monthly_week_day\": 3613921200484800227,\n         \"repeat_interval\": 3388645659450458814,\n         \"type\": 2,\n         \"weekly_days\": \"Necessitatibus deleniti natus possimus.\"\n      },\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"topic\": \"Neque dignissimos inventore at velit.\"\n   }' --meeting-id \"1234567890\" --occurrence-id \"1640995200\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-occurrence --body '{\n      \"agenda\": \"Qui rerum blanditiis aut ullam velit.\",\n      \"duration\": 60,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"topic\": \"Provident corporis ut quod et eius.\"\n   }' --meeting-id \"1234567890\" --occurrence-id \"1640995200\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceDeleteItxOccurrenceUsage() {
@@ -1145,7 +1520,7 @@ func meetingServiceCreateItxPastMeetingUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting --body '{\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"ncr\",\n      \"duration\": 52,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"Other\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": false,\n      \"restricted\": true,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Dolores non.\",\n      \"title\": \"Voluptatem qui aut delectus assumenda explicabo.\",\n      \"transcript_enabled\": true,\n      \"visibility\": \"public\"\n   }' --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting --body '{\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"jhx\",\n      \"duration\": 565,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"Board\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": false,\n      \"restricted\": true,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Officiis praesentium.\",\n      \"title\": \"Non quibusdam.\",\n      \"transcript_enabled\": false,\n      \"visibility\": \"public\"\n   }' --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceGetItxPastMeetingUsage() {
@@ -1213,7 +1588,7 @@ func meetingServiceUpdateItxPastMeetingUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting --body '{\n      \"artifact_visibility\": \"meeting_participants\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"Id ea et adipisci tempore ut.\",\n      \"duration\": 60,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"webinar\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"a09eaa48-231b-43e5-93ba-91c2e0a0e5f1\",\n      \"recording_enabled\": true,\n      \"restricted\": false,\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"timezone\": \"UTC\",\n      \"title\": \"Laudantium occaecati quia aut aut.\",\n      \"transcript_enabled\": true,\n      \"visibility\": \"public\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting --body '{\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"Earum cumque.\",\n      \"duration\": 60,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"regular\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"a09eaa48-231b-43e5-93ba-91c2e0a0e5f1\",\n      \"recording_enabled\": true,\n      \"restricted\": false,\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"timezone\": \"UTC\",\n      \"title\": \"Aut tenetur.\",\n      \"transcript_enabled\": false,\n      \"visibility\": \"private\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceGetItxPastMeetingSummaryUsage() {
@@ -1240,6 +1615,30 @@ func meetingServiceGetItxPastMeetingSummaryUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-past-meeting-summary --past-meeting-id \"12343245463-1630560600000\" --summary-uid \"456e7890-e89b-12d3-a456-426614174000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
+func meetingServiceGetItxPastMeetingSummaryDiffUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service get-itx-past-meeting-summary-diff", os.Args[0])
+	fmt.Fprint(os.Stderr, " -past-meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -summary-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a structured diff between the AI-generated and edited content of a past meeting summary through ITX API proxy`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -past-meeting-id STRING: Past meeting ID (meeting_id-occurrence_id)`)
+	fmt.Fprintln(os.Stderr, `    -summary-uid STRING: Summary UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-past-meeting-summary-diff --past-meeting-id \"12343245463-1630560600000\" --summary-uid \"456e7890-e89b-12d3-a456-426614174000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
 func meetingServiceUpdateItxPastMeetingSummaryUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service update-itx-past-meeting-summary", os.Args[0])
@@ -1263,7 +1662,55 @@ func meetingServiceUpdateItxPastMeetingSummaryUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting-summary --body '{\n      \"approved\": true,\n      \"edited_content\": \"Et perferendis omnis possimus voluptas.\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --summary-uid \"456e7890-e89b-12d3-a456-426614174000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting-summary --body '{\n      \"approved\": true,\n      \"edited_content\": \"Perspiciatis molestiae ut.\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --summary-uid \"456e7890-e89b-12d3-a456-426614174000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func meetingServiceApproveItxPastMeetingSummaryUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service approve-itx-past-meeting-summary", os.Args[0])
+	fmt.Fprint(os.Stderr, " -past-meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -summary-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Approve a past meeting summary that requires approval through ITX API proxy`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -past-meeting-id STRING: Past meeting ID (meeting_id-occurrence_id)`)
+	fmt.Fprintln(os.Stderr, `    -summary-uid STRING: Summary UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service approve-itx-past-meeting-summary --past-meeting-id \"12343245463-1630560600000\" --summary-uid \"456e7890-e89b-12d3-a456-426614174000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func meetingServiceRejectItxPastMeetingSummaryUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service reject-itx-past-meeting-summary", os.Args[0])
+	fmt.Fprint(os.Stderr, " -past-meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -summary-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Reject (un-approve) a past meeting summary that requires approval through ITX API proxy`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -past-meeting-id STRING: Past meeting ID (meeting_id-occurrence_id)`)
+	fmt.Fprintln(os.Stderr, `    -summary-uid STRING: Summary UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service reject-itx-past-meeting-summary --past-meeting-id \"12343245463-1630560600000\" --summary-uid \"456e7890-e89b-12d3-a456-426614174000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceCreateItxPastMeetingParticipantUsage() {
@@ -1287,7 +1734,7 @@ func meetingServiceCreateItxPastMeetingParticipantUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting-participant --body '{\n      \"avatar_url\": \"https://avatars.example.com/jdoe.jpg\",\n      \"committee_id\": \"088cffda-799e-4380-83f2-14c44997346e\",\n      \"committee_role\": \"Developer Seat\",\n      \"committee_voting_status\": \"Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"is_attended\": true,\n      \"is_invited\": true,\n      \"is_unknown\": false,\n      \"is_verified\": true,\n      \"job_title\": \"Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"003P000001cRZVVI9A\",\n      \"org_is_member\": false,\n      \"org_is_project_member\": true,\n      \"org_name\": \"Google\",\n      \"sessions\": [\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Est reiciendis tempore dolorem neque aperiam voluptatem.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Quis autem quia non et.\"\n         },\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Est reiciendis tempore dolorem neque aperiam voluptatem.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Quis autem quia non et.\"\n         }\n      ],\n      \"username\": \"jdoe\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting-participant --body '{\n      \"avatar_url\": \"https://avatars.example.com/jdoe.jpg\",\n      \"committee_id\": \"70770dc9-16f6-4c2e-9d8b-2c9bf29fd052\",\n      \"committee_role\": \"Developer Seat\",\n      \"committee_voting_status\": \"Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"is_attended\": true,\n      \"is_invited\": true,\n      \"is_unknown\": false,\n      \"is_verified\": true,\n      \"job_title\": \"Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"003P000001cRZVVI9A\",\n      \"org_is_member\": false,\n      \"org_is_project_member\": false,\n      \"org_name\": \"Google\",\n      \"sessions\": [\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Ut aut voluptas quidem recusandae molestiae tempore.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Dignissimos aut.\"\n         },\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Ut aut voluptas quidem recusandae molestiae tempore.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Dignissimos aut.\"\n         },\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Ut aut voluptas quidem recusandae molestiae tempore.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Dignissimos aut.\"\n         }\n      ],\n      \"username\": \"jdoe\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceUpdateItxPastMeetingParticipantUsage() {
@@ -1313,7 +1760,31 @@ func meetingServiceUpdateItxPastMeetingParticipantUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting-participant --body '{\n      \"attendee_id\": \"att_xyz789\",\n      \"committee_role\": \"Lead Developer\",\n      \"committee_voting_status\": \"Alt Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"invitee_id\": \"inv_abc123\",\n      \"is_attended\": true,\n      \"is_invited\": true,\n      \"is_verified\": false,\n      \"job_title\": \"Senior Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"abc123\",\n      \"org_name\": \"Microsoft\",\n      \"username\": \"johndoe\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --participant-id \"ea1e8536-a985-4cf5-b981-a170927a1d11\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting-participant --body '{\n      \"attendee_id\": \"att_xyz789\",\n      \"committee_role\": \"Lead Developer\",\n      \"committee_voting_status\": \"Alt Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"invitee_id\": \"inv_abc123\",\n      \"is_attended\": true,\n      \"is_invited\": false,\n      \"is_verified\": false,\n      \"job_title\": \"Senior Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"abc123\",\n      \"org_name\": \"Microsoft\",\n      \"username\": \"johndoe\"\n   }' --past-meeting-id \"12343245463-1630560600000\" --participant-id \"ea1e8536-a985-4cf5-b981-a170927a1d11\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func meetingServiceBulkUpdateItxPastMeetingParticipantsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] meeting-service bulk-update-itx-past-meeting-participants", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -past-meeting-id STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Apply corrections to many past meeting participants in one request through ITX API proxy. Each correction is applied like update-itx-past-meeting-participant, in order; the request is not atomic.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -past-meeting-id STRING: Past meeting ID (meeting_id-occurrence_id format)`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service bulk-update-itx-past-meeting-participants --body '{\n      \"participants\": [\n         {\n            \"attendee_id\": \"att_xyz789\",\n            \"committee_role\": \"Lead Developer\",\n            \"committee_voting_status\": \"Alt Voting Rep\",\n            \"email\": \"john.doe@example.com\",\n            \"first_name\": \"John\",\n            \"invitee_id\": \"inv_abc123\",\n            \"is_attended\": false,\n            \"is_invited\": true,\n            \"is_verified\": true,\n            \"job_title\": \"Senior Software Engineer\",\n            \"last_name\": \"Doe\",\n            \"lf_user_id\": \"abc123\",\n            \"org_name\": \"Microsoft\",\n            \"participant_id\": \"ea1e8536-a985-4cf5-b981-a170927a1d11\",\n            \"username\": \"johndoe\"\n         },\n         {\n            \"attendee_id\": \"att_xyz789\",\n            \"committee_role\": \"Lead Developer\",\n            \"committee_voting_status\": \"Alt Voting Rep\",\n            \"email\": \"john.doe@example.com\",\n            \"first_name\": \"John\",\n            \"invitee_id\": \"inv_abc123\",\n            \"is_attended\": false,\n            \"is_invited\": true,\n            \"is_verified\": true,\n            \"job_title\": \"Senior Software Engineer\",\n            \"last_name\": \"Doe\",\n            \"lf_user_id\": \"abc123\",\n            \"org_name\": \"Microsoft\",\n            \"participant_id\": \"ea1e8536-a985-4cf5-b981-a170927a1d11\",\n            \"username\": \"johndoe\"\n         },\n         {\n            \"attendee_id\": \"att_xyz789\",\n            \"committee_role\": \"Lead Developer\",\n            \"committee_voting_status\": \"Alt Voting Rep\",\n            \"email\": \"john.doe@example.com\",\n            \"first_name\": \"John\",\n            \"invitee_id\": \"inv_abc123\",\n            \"is_attended\": false,\n            \"is_invited\": true,\n            \"is_verified\": true,\n            \"job_title\": \"Senior Software Engineer\",\n            \"last_name\": \"Doe\",\n            \"lf_user_id\": \"abc123\",\n            \"org_name\": \"Microsoft\",\n            \"participant_id\": \"ea1e8536-a985-4cf5-b981-a170927a1d11\",\n            \"username\": \"johndoe\"\n         }\n      ]\n   }' --past-meeting-id \"12343245463-1630560600000\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceDeleteItxPastMeetingParticipantUsage() {
@@ -1361,7 +1832,7 @@ func meetingServiceCreateItxMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-meeting-attachment --body '{\n      \"category\": \"Notes\",\n      \"description\": \"Beatae iste.\",\n      \"link\": \"Velit non.\",\n      \"name\": \"dva\",\n      \"type\": \"file\"\n   }' --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-meeting-attachment --body '{\n      \"category\": \"Presentation\",\n      \"description\": \"Iure molestiae ipsum blanditiis commodi sunt perspiciatis.\",\n      \"link\": \"Sapiente autem.\",\n      \"name\": \"gz\",\n      \"type\": \"link\"\n   }' --meeting-id \"1234567890\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceGetItxMeetingAttachmentUsage() {
@@ -1385,7 +1856,7 @@ func meetingServiceGetItxMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-meeting-attachment --meeting-id \"Quod facere pariatur perferendis deleniti alias.\" --attachment-id \"268a1472-7498-4fd9-8087-4b89fcb4f750\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-meeting-attachment --meeting-id \"Doloremque quidem placeat eveniet non.\" --attachment-id \"88a6f4b3-420d-4949-b48f-fbad7d71842c\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceUpdateItxMeetingAttachmentUsage() {
@@ -1411,7 +1882,7 @@ func meetingServiceUpdateItxMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-meeting-attachment --body '{\n      \"category\": \"Other\",\n      \"description\": \"Delectus a consequuntur quaerat.\",\n      \"link\": \"Eveniet aut dolorem.\",\n      \"name\": \"Minima consequatur error doloribus fugit.\",\n      \"type\": \"link\"\n   }' --meeting-id \"Velit non ipsa voluptas consequuntur.\" --attachment-id \"f9283be7-096b-4bad-b3d1-923c5a11a04c\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-meeting-attachment --body '{\n      \"category\": \"Notes\",\n      \"description\": \"Culpa quisquam quibusdam.\",\n      \"link\": \"Ut blanditiis.\",\n      \"name\": \"Et iste eum.\",\n      \"type\": \"link\"\n   }' --meeting-id \"Earum est.\" --attachment-id \"09b035c1-6877-4ea2-9cc9-ec9843579415\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceDeleteItxMeetingAttachmentUsage() {
@@ -1435,7 +1906,7 @@ func meetingServiceDeleteItxMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service delete-itx-meeting-attachment --meeting-id \"Dolores in quas vero.\" --attachment-id \"4a8f572c-8bdc-4a8b-86ab-5710851bbb4e\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service delete-itx-meeting-attachment --meeting-id \"Non dolore et incidunt eum aut ullam.\" --attachment-id \"2691af62-9dce-433b-8068-7cc46ea99ccd\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceCreateItxMeetingAttachmentPresignUsage() {
@@ -1459,7 +1930,7 @@ func meetingServiceCreateItxMeetingAttachmentPresignUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-meeting-attachment-presign --body '{\n      \"category\": \"Presentation\",\n      \"description\": \"Fuga ut doloremque quidem placeat.\",\n      \"file_size\": 729562237212051371,\n      \"file_type\": \"Temporibus eum aut tempore eius voluptatem.\",\n      \"name\": \"Quis error eveniet.\"\n   }' --meeting-id \"Aut aut.\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-meeting-attachment-presign --body '{\n      \"category\": \"Presentation\",\n      \"description\": \"Aut quam tempore.\",\n      \"file_size\": 3211365611609508223,\n      \"file_type\": \"Dignissimos dicta illum quasi repudiandae et doloremque.\",\n      \"name\": \"Omnis sed.\"\n   }' --meeting-id \"Excepturi suscipit animi.\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceGetItxMeetingAttachmentDownloadUsage() {
@@ -1483,7 +1954,7 @@ func meetingServiceGetItxMeetingAttachmentDownloadUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-meeting-attachment-download --meeting-id \"Voluptatum numquam fuga illum aut voluptatem fugiat.\" --attachment-id \"96d25ac3-aa0a-414a-89cb-742185c1fd3b\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-meeting-attachment-download --meeting-id \"Quo quasi.\" --attachment-id \"dd61c9f5-bdd0-4cbc-a593-6b7653b099da\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceCreateItxPastMeetingAttachmentUsage() {
@@ -1507,7 +1978,7 @@ func meetingServiceCreateItxPastMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting-attachment --body '{\n      \"category\": \"Presentation\",\n      \"description\": \"Expedita sit deleniti itaque.\",\n      \"link\": \"Sint quia corrupti error sint ut vitae.\",\n      \"name\": \"jq5\",\n      \"type\": \"file\"\n   }' --meeting-and-occurrence-id \"Voluptate corporis.\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting-attachment --body '{\n      \"category\": \"Other\",\n      \"description\": \"Minima in cupiditate autem dolorum aut.\",\n      \"link\": \"Quaerat unde quos debitis delectus animi sequi.\",\n      \"name\": \"t5\",\n      \"type\": \"link\"\n   }' --meeting-and-occurrence-id \"Et repellendus maxime est quo quasi qui.\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceGetItxPastMeetingAttachmentUsage() {
@@ -1531,7 +2002,7 @@ func meetingServiceGetItxPastMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-past-meeting-attachment --meeting-and-occurrence-id \"Rerum pariatur maxime.\" --attachment-id \"44b37415-9e1d-4691-b0fe-755d785caecf\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-past-meeting-attachment --meeting-and-occurrence-id \"Beatae exercitationem.\" --attachment-id \"e2132b4d-3ab9-41a8-a58b-99087a4c4192\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceUpdateItxPastMeetingAttachmentUsage() {
@@ -1557,7 +2028,7 @@ func meetingServiceUpdateItxPastMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting-attachment --body '{\n      \"category\": \"Notes\",\n      \"description\": \"Nam hic.\",\n      \"link\": \"Veritatis enim quaerat itaque in.\",\n      \"name\": \"Ut quia sed unde illo qui a.\",\n      \"type\": \"link\"\n   }' --meeting-and-occurrence-id \"Aut et sed est.\" --attachment-id \"0ff2a72c-7606-4b23-afe2-600cef769522\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service update-itx-past-meeting-attachment --body '{\n      \"category\": \"Presentation\",\n      \"description\": \"Facere eos expedita laborum voluptatem.\",\n      \"link\": \"Et nihil.\",\n      \"name\": \"Tempora facere.\",\n      \"type\": \"link\"\n   }' --meeting-and-occurrence-id \"Non debitis voluptate.\" --attachment-id \"2bac19d5-d4ed-4bcc-a9da-2236a42c1241\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceDeleteItxPastMeetingAttachmentUsage() {
@@ -1581,7 +2052,7 @@ func meetingServiceDeleteItxPastMeetingAttachmentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service delete-itx-past-meeting-attachment --meeting-and-occurrence-id \"Sit fuga ratione quibusdam.\" --attachment-id \"e20a092b-16cb-4a67-9156-264dd06300d6\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service delete-itx-past-meeting-attachment --meeting-and-occurrence-id \"Et magni dolorum aut commodi dolore.\" --attachment-id \"6b158c50-8818-4ddb-b212-1e5d5699f992\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceCreateItxPastMeetingAttachmentPresignUsage() {
@@ -1605,7 +2076,7 @@ func meetingServiceCreateItxPastMeetingAttachmentPresignUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting-attachment-presign --body '{\n      \"category\": \"Meeting Minutes\",\n      \"description\": \"Libero similique id.\",\n      \"file_size\": 7626376824938201178,\n      \"file_type\": \"Corrupti molestias.\",\n      \"name\": \"In beatae exercitationem tempora eos.\"\n   }' --meeting-and-occurrence-id \"Quis et aut illum explicabo cum.\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service create-itx-past-meeting-attachment-presign --body '{\n      \"category\": \"Presentation\",\n      \"description\": \"Veniam eum.\",\n      \"file_size\": 5765615079929783327,\n      \"file_type\": \"Quod aut impedit voluptatum.\",\n      \"name\": \"Cupiditate ut corrupti dolor.\"\n   }' --meeting-and-occurrence-id \"Velit distinctio et est ipsum minus.\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
 func meetingServiceGetItxPastMeetingAttachmentDownloadUsage() {
@@ -1629,5 +2100,5 @@ func meetingServiceGetItxPastMeetingAttachmentDownloadUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-past-meeting-attachment-download --meeting-and-occurrence-id \"Temporibus error nisi aut incidunt rerum.\" --attachment-id \"62684bf0-ad23-43ce-9c25-4ad9ab238814\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "meeting-service get-itx-past-meeting-attachment-download --meeting-and-occurrence-id \"Fuga aut quas quasi incidunt cupiditate.\" --attachment-id \"f367673a-5892-4d4c-bdc6-6a3cf963e3bf\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
@@ -17,6 +17,64 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// BuildGetServiceConfigPayload builds the payload for the Meeting Service
+// get-service-config endpoint from CLI flags.
+func BuildGetServiceConfigPayload(meetingServiceGetServiceConfigVersion string, meetingServiceGetServiceConfigBearerToken string) (*meetingservice.GetServiceConfigPayload, error) {
+	var err error
+	var version *string
+	{
+		if meetingServiceGetServiceConfigVersion != "" {
+			version = &meetingServiceGetServiceConfigVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceGetServiceConfigBearerToken != "" {
+			bearerToken = &meetingServiceGetServiceConfigBearerToken
+		}
+	}
+	v := &meetingservice.GetServiceConfigPayload{}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetServiceHealthPayload builds the payload for the Meeting Service
+// get-service-health endpoint from CLI flags.
+func BuildGetServiceHealthPayload(meetingServiceGetServiceHealthVersion string, meetingServiceGetServiceHealthBearerToken string) (*meetingservice.GetServiceHealthPayload, error) {
+	var err error
+	var version *string
+	{
+		if meetingServiceGetServiceHealthVersion != "" {
+			version = &meetingServiceGetServiceHealthVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceGetServiceHealthBearerToken != "" {
+			bearerToken = &meetingServiceGetServiceHealthBearerToken
+		}
+	}
+	v := &meetingservice.GetServiceHealthPayload{}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildCreateItxMeetingPayload builds the payload for the Meeting Service
 // create-itx-meeting endpoint from CLI flags.
 func BuildCreateItxMeetingPayload(meetingServiceCreateItxMeetingBody string, meetingServiceCreateItxMeetingVersion string, meetingServiceCreateItxMeetingBearerToken string, meetingServiceCreateItxMeetingXSync string) (*meetingservice.CreateItxMeetingPayload, error) {
@@ -25,7 +83,7 @@ func BuildCreateItxMeetingPayload(meetingServiceCreateItxMeetingBody string, mee
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxMeetingBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"meeting_hosts\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"uaq\",\n      \"duration\": 263,\n      \"early_join_time_minutes\": 28,\n      \"meeting_type\": \"Marketing\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"2006-08-02T09:48:40Z\",\n         \"end_times\": 1743448645631853479,\n         \"monthly_day\": 6681397425469462938,\n         \"monthly_week\": 6645035424863629342,\n         \"monthly_week_day\": 3613921200484800227,\n         \"repeat_interval\": 3388645659450458814,\n         \"type\": 2,\n         \"weekly_days\": \"Necessitatibus deleniti natus possimus.\"\n      },\n      \"require_ai_summary_approval\": true,\n      \"restricted\": false,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Porro iste non commodi sint sed est.\",\n      \"title\": \"Quasi ipsam fugiat quis qui quam.\",\n      \"transcript_enabled\": false,\n      \"visibility\": \"public\",\n      \"youtube_upload_enabled\": true\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"meeting_hosts\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"dxn\",\n      \"duration\": 320,\n      \"early_join_time_minutes\": 12,\n      \"meeting_type\": \"Legal\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"require_ai_summary_approval\": true,\n      \"restricted\": false,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Maiores amet fugiat consequatur consectetur eum eum.\",\n      \"title\": \"Omnis impedit vel aut.\",\n      \"transcript_enabled\": true,\n      \"visibility\": \"public\",\n      \"youtube_upload_enabled\": false\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", body.StartTime, goa.FormatDateTime))
 		if body.Duration < 0 {
@@ -213,6 +271,54 @@ func BuildDeleteItxMeetingPayload(meetingServiceDeleteItxMeetingMeetingID string
 	return v, nil
 }
 
+// BuildCloneItxMeetingPayload builds the payload for the Meeting Service
+// clone-itx-meeting endpoint from CLI flags.
+func BuildCloneItxMeetingPayload(meetingServiceCloneItxMeetingBody string, meetingServiceCloneItxMeetingMeetingID string, meetingServiceCloneItxMeetingVersion string, meetingServiceCloneItxMeetingBearerToken string) (*meetingservice.CloneItxMeetingPayload, error) {
+	var err error
+	var body CloneItxMeetingRequestBody
+	{
+		err = json.Unmarshal([]byte(meetingServiceCloneItxMeetingBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"title\": \"Aut amet odio.\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", body.StartTime, goa.FormatDateTime))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var meetingID string
+	{
+		meetingID = meetingServiceCloneItxMeetingMeetingID
+	}
+	var version *string
+	{
+		if meetingServiceCloneItxMeetingVersion != "" {
+			version = &meetingServiceCloneItxMeetingVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceCloneItxMeetingBearerToken != "" {
+			bearerToken = &meetingServiceCloneItxMeetingBearerToken
+		}
+	}
+	v := &meetingservice.CloneItxMeetingPayload{
+		StartTime: body.StartTime,
+		Title:     body.Title,
+	}
+	v.MeetingID = meetingID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildUpdateItxMeetingPayload builds the payload for the Meeting Service
 // update-itx-meeting endpoint from CLI flags.
 func BuildUpdateItxMeetingPayload(meetingServiceUpdateItxMeetingBody string, meetingServiceUpdateItxMeetingMeetingID string, meetingServiceUpdateItxMeetingVersion string, meetingServiceUpdateItxMeetingBearerToken string, meetingServiceUpdateItxMeetingXSync string) (*meetingservice.UpdateItxMeetingPayload, error) {
@@ -221,7 +327,7 @@ func BuildUpdateItxMeetingPayload(meetingServiceUpdateItxMeetingBody string, mee
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxMeetingBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"t3c\",\n      \"duration\": 36,\n      \"early_join_time_minutes\": 19,\n      \"meeting_type\": \"Board\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"2006-08-02T09:48:40Z\",\n         \"end_times\": 1743448645631853479,\n         \"monthly_day\": 6681397425469462938,\n         \"monthly_week\": 6645035424863629342,\n         \"monthly_week_day\": 3613921200484800227,\n         \"repeat_interval\": 3388645659450458814,\n         \"type\": 2,\n         \"weekly_days\": \"Necessitatibus deleniti natus possimus.\"\n      },\n      \"require_ai_summary_approval\": true,\n      \"restricted\": false,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Eius ut sed dolorem eum eaque.\",\n      \"title\": \"Id qui blanditiis qui maiores adipisci odio.\",\n      \"transcript_enabled\": false,\n      \"update_note\": \"nto\",\n      \"visibility\": \"public\",\n      \"youtube_upload_enabled\": false\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ai_summary_enabled\": true,\n      \"artifact_visibility\": \"meeting_hosts\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"mhc\",\n      \"duration\": 140,\n      \"early_join_time_minutes\": 21,\n      \"meeting_type\": \"Marketing\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": true,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"require_ai_summary_approval\": false,\n      \"restricted\": true,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Mollitia vel sit non quaerat harum.\",\n      \"title\": \"Cum itaque magni fugit.\",\n      \"transcript_enabled\": true,\n      \"update_note\": \"p7b\",\n      \"visibility\": \"private\",\n      \"youtube_upload_enabled\": false\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", body.StartTime, goa.FormatDateTime))
 		if body.Duration < 0 {
@@ -386,6 +492,80 @@ func BuildGetItxMeetingCountPayload(meetingServiceGetItxMeetingCountVersion stri
 	return v, nil
 }
 
+// BuildPreviewItxMeetingOccurrencesPayload builds the payload for the Meeting
+// Service preview-itx-meeting-occurrences endpoint from CLI flags.
+func BuildPreviewItxMeetingOccurrencesPayload(meetingServicePreviewItxMeetingOccurrencesBody string, meetingServicePreviewItxMeetingOccurrencesVersion string, meetingServicePreviewItxMeetingOccurrencesBearerToken string) (*meetingservice.PreviewItxMeetingOccurrencesPayload, error) {
+	var err error
+	var body PreviewItxMeetingOccurrencesRequestBody
+	{
+		err = json.Unmarshal([]byte(meetingServicePreviewItxMeetingOccurrencesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"count\": 36,\n      \"duration\": 52,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Necessitatibus quod vel eum aut.\"\n   }'")
+		}
+		if body.Recurrence == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("recurrence", "body"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", body.StartTime, goa.FormatDateTime))
+		if body.Duration < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", body.Duration, 0, true))
+		}
+		if body.Duration > 600 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", body.Duration, 600, false))
+		}
+		if body.Recurrence != nil {
+			if err2 := ValidateRecurrenceRequestBody(body.Recurrence); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.Count < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.count", body.Count, 1, true))
+		}
+		if body.Count > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.count", body.Count, 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if meetingServicePreviewItxMeetingOccurrencesVersion != "" {
+			version = &meetingServicePreviewItxMeetingOccurrencesVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServicePreviewItxMeetingOccurrencesBearerToken != "" {
+			bearerToken = &meetingServicePreviewItxMeetingOccurrencesBearerToken
+		}
+	}
+	v := &meetingservice.PreviewItxMeetingOccurrencesPayload{
+		StartTime: body.StartTime,
+		Timezone:  body.Timezone,
+		Duration:  body.Duration,
+		Count:     body.Count,
+	}
+	if body.Recurrence != nil {
+		v.Recurrence = marshalRecurrenceRequestBodyToMeetingserviceRecurrence(body.Recurrence)
+	}
+	{
+		var zero int
+		if v.Count == zero {
+			v.Count = 10
+		}
+	}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildCreateItxRegistrantPayload builds the payload for the Meeting Service
 // create-itx-registrant endpoint from CLI flags.
 func BuildCreateItxRegistrantPayload(meetingServiceCreateItxRegistrantBody string, meetingServiceCreateItxRegistrantMeetingID string, meetingServiceCreateItxRegistrantVersion string, meetingServiceCreateItxRegistrantBearerToken string) (*meetingservice.CreateItxRegistrantPayload, error) {
@@ -394,7 +574,7 @@ func BuildCreateItxRegistrantPayload(meetingServiceCreateItxRegistrantBody strin
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxRegistrantBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attended_occurrence_count\": 3412427569359350717,\n      \"committee_uid\": \"Provident expedita veritatis eaque explicabo.\",\n      \"created_at\": \"Animi voluptatem.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Nihil illo ut non aut.\",\n      \"last_invite_delivery_status\": \"Ipsum eligendi vero.\",\n      \"last_invite_received_message_id\": \"Magnam et esse sed ad assumenda est.\",\n      \"last_invite_received_time\": \"Quia et voluptas dolor.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Et atque dolor aperiam.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Velit quod recusandae aut incidunt.\",\n      \"total_occurrence_count\": 8867872198606554721,\n      \"type\": \"direct\",\n      \"uid\": \"Veritatis fugiat exercitationem.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attended_occurrence_count\": 4362760078868173455,\n      \"committee_uid\": \"Est et ea consequatur a.\",\n      \"created_at\": \"Rerum deserunt omnis.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Quos sint quos.\",\n      \"last_invite_delivery_status\": \"Ea voluptas animi.\",\n      \"last_invite_received_message_id\": \"Nam fugiat corrupti in error.\",\n      \"last_invite_received_time\": \"Accusamus quia.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Et magni ut nobis aspernatur et eum.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Molestiae voluptatem qui aut.\",\n      \"total_occurrence_count\": 230783524081813140,\n      \"type\": \"committee\",\n      \"uid\": \"Maiores adipisci corporis.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }'")
 		}
 		if body.Type != nil {
 			if !(*body.Type == "direct" || *body.Type == "committee") {
@@ -522,7 +702,7 @@ func BuildUpdateItxRegistrantPayload(meetingServiceUpdateItxRegistrantBody strin
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxRegistrantBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attended_occurrence_count\": 437006580780085388,\n      \"committee_uid\": \"Laboriosam enim.\",\n      \"created_at\": \"Dolorem exercitationem delectus ut et cum itaque.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Rerum deleniti est et occaecati fugit.\",\n      \"last_invite_delivery_status\": \"Vitae ducimus debitis libero.\",\n      \"last_invite_received_message_id\": \"Aut iure aspernatur laborum voluptatem a dolor.\",\n      \"last_invite_received_time\": \"Facere beatae.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Fugit exercitationem qui mollitia vel sit non.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Officiis qui ut dicta.\",\n      \"total_occurrence_count\": 1834127355695980732,\n      \"type\": \"committee\",\n      \"uid\": \"Aperiam repudiandae.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attended_occurrence_count\": 1501333704169604349,\n      \"committee_uid\": \"Illum aut sit quaerat.\",\n      \"created_at\": \"Quisquam suscipit rerum laudantium.\",\n      \"created_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"email\": \"bobsmith@gmail.com\",\n      \"first_name\": \"Bob\",\n      \"host\": true,\n      \"job_title\": \"developer\",\n      \"last_invite_delivery_description\": \"Et in doloremque veritatis consequuntur.\",\n      \"last_invite_delivery_status\": \"Accusantium reprehenderit voluptatum occaecati.\",\n      \"last_invite_received_message_id\": \"Ea natus quod velit ea ullam.\",\n      \"last_invite_received_time\": \"Saepe architecto.\",\n      \"last_name\": \"Smith\",\n      \"modified_at\": \"Ea in in dicta voluptas adipisci.\",\n      \"occurrence\": \"1666848600\",\n      \"org\": \"google\",\n      \"profile_picture\": \"Vel sit voluptatem recusandae voluptatem.\",\n      \"total_occurrence_count\": 4306541817862693821,\n      \"type\": \"direct\",\n      \"uid\": \"Optio occaecati veritatis iure quidem.\",\n      \"updated_by\": {\n         \"email\": \"john.doe@example.com\",\n         \"name\": \"John Doe\",\n         \"profile_picture\": \"https://example.com/avatar.jpg\",\n         \"username\": \"jdoe\"\n      },\n      \"username\": \"testuser\"\n   }'")
 		}
 		if body.Type != nil {
 			if !(*body.Type == "direct" || *body.Type == "committee") {
@@ -649,7 +829,7 @@ func BuildDeleteItxRegistrantPayload(meetingServiceDeleteItxRegistrantMeetingID
 
 // BuildGetItxJoinLinkPayload builds the payload for the Meeting Service
 // get-itx-join-link endpoint from CLI flags.
-func BuildGetItxJoinLinkPayload(meetingServiceGetItxJoinLinkMeetingID string, meetingServiceGetItxJoinLinkVersion string, meetingServiceGetItxJoinLinkUseEmail string, meetingServiceGetItxJoinLinkUserID string, meetingServiceGetItxJoinLinkName string, meetingServiceGetItxJoinLinkEmail string, meetingServiceGetItxJoinLinkRegister string, meetingServiceGetItxJoinLinkBearerToken string) (*meetingservice.GetItxJoinLinkPayload, error) {
+func BuildGetItxJoinLinkPayload(meetingServiceGetItxJoinLinkMeetingID string, meetingServiceGetItxJoinLinkVersion string, meetingServiceGetItxJoinLinkUseEmail string, meetingServiceGetItxJoinLinkUserID string, meetingServiceGetItxJoinLinkName string, meetingServiceGetItxJoinLinkEmail string, meetingServiceGetItxJoinLinkRegister string, meetingServiceGetItxJoinLinkOccurrenceID string, meetingServiceGetItxJoinLinkBearerToken string) (*meetingservice.GetItxJoinLinkPayload, error) {
 	var err error
 	var meetingID string
 	{
@@ -711,6 +891,12 @@ func BuildGetItxJoinLinkPayload(meetingServiceGetItxJoinLinkMeetingID string, me
 			}
 		}
 	}
+	var occurrenceID *string
+	{
+		if meetingServiceGetItxJoinLinkOccurrenceID != "" {
+			occurrenceID = &meetingServiceGetItxJoinLinkOccurrenceID
+		}
+	}
 	var bearerToken *string
 	{
 		if meetingServiceGetItxJoinLinkBearerToken != "" {
@@ -725,7 +911,118 @@ func BuildGetItxJoinLinkPayload(meetingServiceGetItxJoinLinkMeetingID string, me
 	v.Name = name
 	v.Email = email
 	v.Register = register
+	v.OccurrenceID = occurrenceID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildLaunchItxMeetingPayload builds the payload for the Meeting Service
+// launch-itx-meeting endpoint from CLI flags.
+func BuildLaunchItxMeetingPayload(meetingServiceLaunchItxMeetingMeetingID string, meetingServiceLaunchItxMeetingVersion string, meetingServiceLaunchItxMeetingUseEmail string, meetingServiceLaunchItxMeetingUserID string, meetingServiceLaunchItxMeetingName string, meetingServiceLaunchItxMeetingEmail string, meetingServiceLaunchItxMeetingRegister string, meetingServiceLaunchItxMeetingOccurrenceID string, meetingServiceLaunchItxMeetingClient string, meetingServiceLaunchItxMeetingBearerToken string, meetingServiceLaunchItxMeetingUserAgent string) (*meetingservice.LaunchItxMeetingPayload, error) {
+	var err error
+	var meetingID string
+	{
+		meetingID = meetingServiceLaunchItxMeetingMeetingID
+	}
+	var version *string
+	{
+		if meetingServiceLaunchItxMeetingVersion != "" {
+			version = &meetingServiceLaunchItxMeetingVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var useEmail *bool
+	{
+		if meetingServiceLaunchItxMeetingUseEmail != "" {
+			var val bool
+			val, err = strconv.ParseBool(meetingServiceLaunchItxMeetingUseEmail)
+			useEmail = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for useEmail, must be BOOL")
+			}
+		}
+	}
+	var userID *string
+	{
+		if meetingServiceLaunchItxMeetingUserID != "" {
+			userID = &meetingServiceLaunchItxMeetingUserID
+		}
+	}
+	var name *string
+	{
+		if meetingServiceLaunchItxMeetingName != "" {
+			name = &meetingServiceLaunchItxMeetingName
+		}
+	}
+	var email *string
+	{
+		if meetingServiceLaunchItxMeetingEmail != "" {
+			email = &meetingServiceLaunchItxMeetingEmail
+			err = goa.MergeErrors(err, goa.ValidateFormat("email", *email, goa.FormatEmail))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var register *bool
+	{
+		if meetingServiceLaunchItxMeetingRegister != "" {
+			var val bool
+			val, err = strconv.ParseBool(meetingServiceLaunchItxMeetingRegister)
+			register = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for register, must be BOOL")
+			}
+		}
+	}
+	var occurrenceID *string
+	{
+		if meetingServiceLaunchItxMeetingOccurrenceID != "" {
+			occurrenceID = &meetingServiceLaunchItxMeetingOccurrenceID
+		}
+	}
+	var client string
+	{
+		if meetingServiceLaunchItxMeetingClient != "" {
+			client = meetingServiceLaunchItxMeetingClient
+			if !(client == "auto" || client == "native" || client == "web") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("client", client, []any{"auto", "native", "web"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceLaunchItxMeetingBearerToken != "" {
+			bearerToken = &meetingServiceLaunchItxMeetingBearerToken
+		}
+	}
+	var userAgent *string
+	{
+		if meetingServiceLaunchItxMeetingUserAgent != "" {
+			userAgent = &meetingServiceLaunchItxMeetingUserAgent
+		}
+	}
+	v := &meetingservice.LaunchItxMeetingPayload{}
+	v.MeetingID = meetingID
+	v.Version = version
+	v.UseEmail = useEmail
+	v.UserID = userID
+	v.Name = name
+	v.Email = email
+	v.Register = register
+	v.OccurrenceID = occurrenceID
+	v.Client = client
 	v.BearerToken = bearerToken
+	v.UserAgent = userAgent
 
 	return v, nil
 }
@@ -769,6 +1066,84 @@ func BuildGetItxRegistrantIcsPayload(meetingServiceGetItxRegistrantIcsMeetingID
 	return v, nil
 }
 
+// BuildAddItxRegistrantHostPayload builds the payload for the Meeting Service
+// add-itx-registrant-host endpoint from CLI flags.
+func BuildAddItxRegistrantHostPayload(meetingServiceAddItxRegistrantHostMeetingID string, meetingServiceAddItxRegistrantHostRegistrantID string, meetingServiceAddItxRegistrantHostVersion string, meetingServiceAddItxRegistrantHostBearerToken string) (*meetingservice.AddItxRegistrantHostPayload, error) {
+	var err error
+	var meetingID string
+	{
+		meetingID = meetingServiceAddItxRegistrantHostMeetingID
+	}
+	var registrantID string
+	{
+		registrantID = meetingServiceAddItxRegistrantHostRegistrantID
+	}
+	var version *string
+	{
+		if meetingServiceAddItxRegistrantHostVersion != "" {
+			version = &meetingServiceAddItxRegistrantHostVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceAddItxRegistrantHostBearerToken != "" {
+			bearerToken = &meetingServiceAddItxRegistrantHostBearerToken
+		}
+	}
+	v := &meetingservice.AddItxRegistrantHostPayload{}
+	v.MeetingID = meetingID
+	v.RegistrantID = registrantID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildRemoveItxRegistrantHostPayload builds the payload for the Meeting
+// Service remove-itx-registrant-host endpoint from CLI flags.
+func BuildRemoveItxRegistrantHostPayload(meetingServiceRemoveItxRegistrantHostMeetingID string, meetingServiceRemoveItxRegistrantHostRegistrantID string, meetingServiceRemoveItxRegistrantHostVersion string, meetingServiceRemoveItxRegistrantHostBearerToken string) (*meetingservice.RemoveItxRegistrantHostPayload, error) {
+	var err error
+	var meetingID string
+	{
+		meetingID = meetingServiceRemoveItxRegistrantHostMeetingID
+	}
+	var registrantID string
+	{
+		registrantID = meetingServiceRemoveItxRegistrantHostRegistrantID
+	}
+	var version *string
+	{
+		if meetingServiceRemoveItxRegistrantHostVersion != "" {
+			version = &meetingServiceRemoveItxRegistrantHostVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceRemoveItxRegistrantHostBearerToken != "" {
+			bearerToken = &meetingServiceRemoveItxRegistrantHostBearerToken
+		}
+	}
+	v := &meetingservice.RemoveItxRegistrantHostPayload{}
+	v.MeetingID = meetingID
+	v.RegistrantID = registrantID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildResendItxRegistrantInvitationPayload builds the payload for the Meeting
 // Service resend-itx-registrant-invitation endpoint from CLI flags.
 func BuildResendItxRegistrantInvitationPayload(meetingServiceResendItxRegistrantInvitationMeetingID string, meetingServiceResendItxRegistrantInvitationRegistrantID string, meetingServiceResendItxRegistrantInvitationVersion string, meetingServiceResendItxRegistrantInvitationBearerToken string) (*meetingservice.ResendItxRegistrantInvitationPayload, error) {
@@ -889,6 +1264,69 @@ func BuildRegisterItxCommitteeMembersPayload(meetingServiceRegisterItxCommitteeM
 	return v, nil
 }
 
+// BuildListItxMeetingOccurrencesPayload builds the payload for the Meeting
+// Service list-itx-meeting-occurrences endpoint from CLI flags.
+func BuildListItxMeetingOccurrencesPayload(meetingServiceListItxMeetingOccurrencesMeetingID string, meetingServiceListItxMeetingOccurrencesVersion string, meetingServiceListItxMeetingOccurrencesTz string, meetingServiceListItxMeetingOccurrencesFrom string, meetingServiceListItxMeetingOccurrencesTo string, meetingServiceListItxMeetingOccurrencesBearerToken string) (*meetingservice.ListItxMeetingOccurrencesPayload, error) {
+	var err error
+	var meetingID string
+	{
+		meetingID = meetingServiceListItxMeetingOccurrencesMeetingID
+	}
+	var version *string
+	{
+		if meetingServiceListItxMeetingOccurrencesVersion != "" {
+			version = &meetingServiceListItxMeetingOccurrencesVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tz *string
+	{
+		if meetingServiceListItxMeetingOccurrencesTz != "" {
+			tz = &meetingServiceListItxMeetingOccurrencesTz
+		}
+	}
+	var from *string
+	{
+		if meetingServiceListItxMeetingOccurrencesFrom != "" {
+			from = &meetingServiceListItxMeetingOccurrencesFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if meetingServiceListItxMeetingOccurrencesTo != "" {
+			to = &meetingServiceListItxMeetingOccurrencesTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceListItxMeetingOccurrencesBearerToken != "" {
+			bearerToken = &meetingServiceListItxMeetingOccurrencesBearerToken
+		}
+	}
+	v := &meetingservice.ListItxMeetingOccurrencesPayload{}
+	v.MeetingID = meetingID
+	v.Version = version
+	v.Tz = tz
+	v.From = from
+	v.To = to
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildUpdateItxOccurrencePayload builds the payload for the Meeting Service
 // update-itx-occurrence endpoint from CLI flags.
 func BuildUpdateItxOccurrencePayload(meetingServiceUpdateItxOccurrenceBody string, meetingServiceUpdateItxOccurrenceMeetingID string, meetingServiceUpdateItxOccurrenceOccurrenceID string, meetingServiceUpdateItxOccurrenceVersion string, meetingServiceUpdateItxOccurrenceBearerToken string) (*meetingservice.UpdateItxOccurrencePayload, error) {
@@ -897,7 +1335,7 @@ func BuildUpdateItxOccurrencePayload(meetingServiceUpdateItxOccurrenceBody strin
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxOccurrenceBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"agenda\": \"Quod vel eum aut.\",\n      \"duration\": 60,\n      \"recurrence\": {\n         \"end_date_time\": \"2006-08-02T09:48:40Z\",\n         \"end_times\": 1743448645631853479,\n         \"monthly_day\": 6681397425469462938,\n         \"monthly_week\": 6645035424863629342,\n         \"monthly_week_day\": 3613921200484800227,\n         \"repeat_interval\": 3388645659450458814,\n         \"type\": 2,\n         \"weekly_days\": \"Necessitatibus deleniti natus possimus.\"\n      },\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"topic\": \"Neque dignissimos inventore at velit.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"agenda\": \"Qui rerum blanditiis aut ullam velit.\",\n      \"duration\": 60,\n      \"recurrence\": {\n         \"end_date_time\": \"1990-09-13T23:55:35Z\",\n         \"end_times\": -1823383193357337742,\n         \"monthly_day\": 1443106685303663363,\n         \"monthly_week\": 3189107661375760920,\n         \"monthly_week_day\": 1504404216404274088,\n         \"repeat_interval\": 6971819523864198959,\n         \"rrule\": \"FREQ=MONTHLY;INTERVAL=1;BYDAY=3TH;COUNT=12\",\n         \"type\": 2,\n         \"weekly_days\": \"Et atque perferendis temporibus laboriosam vel eos.\"\n      },\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"topic\": \"Provident corporis ut quod et eius.\"\n   }'")
 		}
 		if body.StartTime != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
@@ -1062,7 +1500,7 @@ func BuildCreateItxPastMeetingPayload(meetingServiceCreateItxPastMeetingBody str
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxPastMeetingBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"ncr\",\n      \"duration\": 52,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"Other\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": false,\n      \"restricted\": true,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Dolores non.\",\n      \"title\": \"Voluptatem qui aut delectus assumenda explicabo.\",\n      \"transcript_enabled\": true,\n      \"visibility\": \"public\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"jhx\",\n      \"duration\": 565,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"Board\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"recording_enabled\": false,\n      \"restricted\": true,\n      \"start_time\": \"2021-01-01T00:00:00Z\",\n      \"timezone\": \"Officiis praesentium.\",\n      \"title\": \"Non quibusdam.\",\n      \"transcript_enabled\": false,\n      \"visibility\": \"public\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", body.StartTime, goa.FormatDateTime))
 		if body.Duration < 0 {
@@ -1228,7 +1666,7 @@ func BuildUpdateItxPastMeetingPayload(meetingServiceUpdateItxPastMeetingBody str
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxPastMeetingBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"artifact_visibility\": \"meeting_participants\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"voting_rep\",\n               \"none\",\n               \"observer\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"Id ea et adipisci tempore ut.\",\n      \"duration\": 60,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"webinar\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"a09eaa48-231b-43e5-93ba-91c2e0a0e5f1\",\n      \"recording_enabled\": true,\n      \"restricted\": false,\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"timezone\": \"UTC\",\n      \"title\": \"Laudantium occaecati quia aut aut.\",\n      \"transcript_enabled\": true,\n      \"visibility\": \"public\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"artifact_visibility\": \"public\",\n      \"committees\": [\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         },\n         {\n            \"allowed_voting_statuses\": [\n               \"alt_voting_rep\",\n               \"emeritus\",\n               \"observer\",\n               \"emeritus\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n         }\n      ],\n      \"description\": \"Earum cumque.\",\n      \"duration\": 60,\n      \"meeting_id\": \"12343245463\",\n      \"meeting_type\": \"regular\",\n      \"occurrence_id\": \"1630560600000\",\n      \"project_uid\": \"a09eaa48-231b-43e5-93ba-91c2e0a0e5f1\",\n      \"recording_enabled\": true,\n      \"restricted\": false,\n      \"start_time\": \"2024-01-15T10:00:00Z\",\n      \"timezone\": \"UTC\",\n      \"title\": \"Aut tenetur.\",\n      \"transcript_enabled\": false,\n      \"visibility\": \"private\"\n   }'")
 		}
 		if body.StartTime != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
@@ -1362,6 +1800,49 @@ func BuildGetItxPastMeetingSummaryPayload(meetingServiceGetItxPastMeetingSummary
 	return v, nil
 }
 
+// BuildGetItxPastMeetingSummaryDiffPayload builds the payload for the Meeting
+// Service get-itx-past-meeting-summary-diff endpoint from CLI flags.
+func BuildGetItxPastMeetingSummaryDiffPayload(meetingServiceGetItxPastMeetingSummaryDiffPastMeetingID string, meetingServiceGetItxPastMeetingSummaryDiffSummaryUID string, meetingServiceGetItxPastMeetingSummaryDiffVersion string, meetingServiceGetItxPastMeetingSummaryDiffBearerToken string) (*meetingservice.GetItxPastMeetingSummaryDiffPayload, error) {
+	var err error
+	var pastMeetingID string
+	{
+		pastMeetingID = meetingServiceGetItxPastMeetingSummaryDiffPastMeetingID
+	}
+	var summaryUID string
+	{
+		summaryUID = meetingServiceGetItxPastMeetingSummaryDiffSummaryUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if meetingServiceGetItxPastMeetingSummaryDiffVersion != "" {
+			version = &meetingServiceGetItxPastMeetingSummaryDiffVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceGetItxPastMeetingSummaryDiffBearerToken != "" {
+			bearerToken = &meetingServiceGetItxPastMeetingSummaryDiffBearerToken
+		}
+	}
+	v := &meetingservice.GetItxPastMeetingSummaryDiffPayload{}
+	v.PastMeetingID = pastMeetingID
+	v.SummaryUID = summaryUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildUpdateItxPastMeetingSummaryPayload builds the payload for the Meeting
 // Service update-itx-past-meeting-summary endpoint from CLI flags.
 func BuildUpdateItxPastMeetingSummaryPayload(meetingServiceUpdateItxPastMeetingSummaryBody string, meetingServiceUpdateItxPastMeetingSummaryPastMeetingID string, meetingServiceUpdateItxPastMeetingSummarySummaryUID string, meetingServiceUpdateItxPastMeetingSummaryVersion string, meetingServiceUpdateItxPastMeetingSummaryBearerToken string) (*meetingservice.UpdateItxPastMeetingSummaryPayload, error) {
@@ -1370,7 +1851,7 @@ func BuildUpdateItxPastMeetingSummaryPayload(meetingServiceUpdateItxPastMeetingS
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxPastMeetingSummaryBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"approved\": true,\n      \"edited_content\": \"Et perferendis omnis possimus voluptas.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"approved\": true,\n      \"edited_content\": \"Perspiciatis molestiae ut.\"\n   }'")
 		}
 	}
 	var pastMeetingID string
@@ -1415,6 +1896,92 @@ func BuildUpdateItxPastMeetingSummaryPayload(meetingServiceUpdateItxPastMeetingS
 	return v, nil
 }
 
+// BuildApproveItxPastMeetingSummaryPayload builds the payload for the Meeting
+// Service approve-itx-past-meeting-summary endpoint from CLI flags.
+func BuildApproveItxPastMeetingSummaryPayload(meetingServiceApproveItxPastMeetingSummaryPastMeetingID string, meetingServiceApproveItxPastMeetingSummarySummaryUID string, meetingServiceApproveItxPastMeetingSummaryVersion string, meetingServiceApproveItxPastMeetingSummaryBearerToken string) (*meetingservice.ApproveItxPastMeetingSummaryPayload, error) {
+	var err error
+	var pastMeetingID string
+	{
+		pastMeetingID = meetingServiceApproveItxPastMeetingSummaryPastMeetingID
+	}
+	var summaryUID string
+	{
+		summaryUID = meetingServiceApproveItxPastMeetingSummarySummaryUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if meetingServiceApproveItxPastMeetingSummaryVersion != "" {
+			version = &meetingServiceApproveItxPastMeetingSummaryVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceApproveItxPastMeetingSummaryBearerToken != "" {
+			bearerToken = &meetingServiceApproveItxPastMeetingSummaryBearerToken
+		}
+	}
+	v := &meetingservice.ApproveItxPastMeetingSummaryPayload{}
+	v.PastMeetingID = pastMeetingID
+	v.SummaryUID = summaryUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildRejectItxPastMeetingSummaryPayload builds the payload for the Meeting
+// Service reject-itx-past-meeting-summary endpoint from CLI flags.
+func BuildRejectItxPastMeetingSummaryPayload(meetingServiceRejectItxPastMeetingSummaryPastMeetingID string, meetingServiceRejectItxPastMeetingSummarySummaryUID string, meetingServiceRejectItxPastMeetingSummaryVersion string, meetingServiceRejectItxPastMeetingSummaryBearerToken string) (*meetingservice.RejectItxPastMeetingSummaryPayload, error) {
+	var err error
+	var pastMeetingID string
+	{
+		pastMeetingID = meetingServiceRejectItxPastMeetingSummaryPastMeetingID
+	}
+	var summaryUID string
+	{
+		summaryUID = meetingServiceRejectItxPastMeetingSummarySummaryUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if meetingServiceRejectItxPastMeetingSummaryVersion != "" {
+			version = &meetingServiceRejectItxPastMeetingSummaryVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceRejectItxPastMeetingSummaryBearerToken != "" {
+			bearerToken = &meetingServiceRejectItxPastMeetingSummaryBearerToken
+		}
+	}
+	v := &meetingservice.RejectItxPastMeetingSummaryPayload{}
+	v.PastMeetingID = pastMeetingID
+	v.SummaryUID = summaryUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildCreateItxPastMeetingParticipantPayload builds the payload for the
 // Meeting Service create-itx-past-meeting-participant endpoint from CLI flags.
 func BuildCreateItxPastMeetingParticipantPayload(meetingServiceCreateItxPastMeetingParticipantBody string, meetingServiceCreateItxPastMeetingParticipantPastMeetingID string, meetingServiceCreateItxPastMeetingParticipantVersion string, meetingServiceCreateItxPastMeetingParticipantBearerToken string) (*meetingservice.CreateItxPastMeetingParticipantPayload, error) {
@@ -1423,7 +1990,7 @@ func BuildCreateItxPastMeetingParticipantPayload(meetingServiceCreateItxPastMeet
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxPastMeetingParticipantBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"https://avatars.example.com/jdoe.jpg\",\n      \"committee_id\": \"088cffda-799e-4380-83f2-14c44997346e\",\n      \"committee_role\": \"Developer Seat\",\n      \"committee_voting_status\": \"Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"is_attended\": true,\n      \"is_invited\": true,\n      \"is_unknown\": false,\n      \"is_verified\": true,\n      \"job_title\": \"Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"003P000001cRZVVI9A\",\n      \"org_is_member\": false,\n      \"org_is_project_member\": true,\n      \"org_name\": \"Google\",\n      \"sessions\": [\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Est reiciendis tempore dolorem neque aperiam voluptatem.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Quis autem quia non et.\"\n         },\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Est reiciendis tempore dolorem neque aperiam voluptatem.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Quis autem quia non et.\"\n         }\n      ],\n      \"username\": \"jdoe\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"https://avatars.example.com/jdoe.jpg\",\n      \"committee_id\": \"70770dc9-16f6-4c2e-9d8b-2c9bf29fd052\",\n      \"committee_role\": \"Developer Seat\",\n      \"committee_voting_status\": \"Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"is_attended\": true,\n      \"is_invited\": true,\n      \"is_unknown\": false,\n      \"is_verified\": true,\n      \"job_title\": \"Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"003P000001cRZVVI9A\",\n      \"org_is_member\": false,\n      \"org_is_project_member\": false,\n      \"org_name\": \"Google\",\n      \"sessions\": [\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Ut aut voluptas quidem recusandae molestiae tempore.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Dignissimos aut.\"\n         },\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Ut aut voluptas quidem recusandae molestiae tempore.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Dignissimos aut.\"\n         },\n         {\n            \"join_time\": \"2021-06-27T05:30:37Z\",\n            \"leave_reason\": \"Ut aut voluptas quidem recusandae molestiae tempore.\",\n            \"leave_time\": \"2021-06-27T05:59:12Z\",\n            \"participant_uuid\": \"Dignissimos aut.\"\n         }\n      ],\n      \"username\": \"jdoe\"\n   }'")
 		}
 		if body.Email != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
@@ -1511,7 +2078,7 @@ func BuildUpdateItxPastMeetingParticipantPayload(meetingServiceUpdateItxPastMeet
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxPastMeetingParticipantBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attendee_id\": \"att_xyz789\",\n      \"committee_role\": \"Lead Developer\",\n      \"committee_voting_status\": \"Alt Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"invitee_id\": \"inv_abc123\",\n      \"is_attended\": true,\n      \"is_invited\": true,\n      \"is_verified\": false,\n      \"job_title\": \"Senior Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"abc123\",\n      \"org_name\": \"Microsoft\",\n      \"username\": \"johndoe\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attendee_id\": \"att_xyz789\",\n      \"committee_role\": \"Lead Developer\",\n      \"committee_voting_status\": \"Alt Voting Rep\",\n      \"email\": \"john.doe@example.com\",\n      \"first_name\": \"John\",\n      \"invitee_id\": \"inv_abc123\",\n      \"is_attended\": true,\n      \"is_invited\": false,\n      \"is_verified\": false,\n      \"job_title\": \"Senior Software Engineer\",\n      \"last_name\": \"Doe\",\n      \"lf_user_id\": \"abc123\",\n      \"org_name\": \"Microsoft\",\n      \"username\": \"johndoe\"\n   }'")
 		}
 	}
 	var pastMeetingID string
@@ -1564,6 +2131,72 @@ func BuildUpdateItxPastMeetingParticipantPayload(meetingServiceUpdateItxPastMeet
 	return v, nil
 }
 
+// BuildBulkUpdateItxPastMeetingParticipantsPayload builds the payload for the
+// Meeting Service bulk-update-itx-past-meeting-participants endpoint from CLI
+// flags.
+func BuildBulkUpdateItxPastMeetingParticipantsPayload(meetingServiceBulkUpdateItxPastMeetingParticipantsBody string, meetingServiceBulkUpdateItxPastMeetingParticipantsPastMeetingID string, meetingServiceBulkUpdateItxPastMeetingParticipantsVersion string, meetingServiceBulkUpdateItxPastMeetingParticipantsBearerToken string) (*meetingservice.BulkUpdateItxPastMeetingParticipantsPayload, error) {
+	var err error
+	var body BulkUpdateItxPastMeetingParticipantsRequestBody
+	{
+		err = json.Unmarshal([]byte(meetingServiceBulkUpdateItxPastMeetingParticipantsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"participants\": [\n         {\n            \"attendee_id\": \"att_xyz789\",\n            \"committee_role\": \"Lead Developer\",\n            \"committee_voting_status\": \"Alt Voting Rep\",\n            \"email\": \"john.doe@example.com\",\n            \"first_name\": \"John\",\n            \"invitee_id\": \"inv_abc123\",\n            \"is_attended\": false,\n            \"is_invited\": true,\n            \"is_verified\": true,\n            \"job_title\": \"Senior Software Engineer\",\n            \"last_name\": \"Doe\",\n            \"lf_user_id\": \"abc123\",\n            \"org_name\": \"Microsoft\",\n            \"participant_id\": \"ea1e8536-a985-4cf5-b981-a170927a1d11\",\n            \"username\": \"johndoe\"\n         },\n         {\n            \"attendee_id\": \"att_xyz789\",\n            \"committee_role\": \"Lead Developer\",\n            \"committee_voting_status\": \"Alt Voting Rep\",\n            \"email\": \"john.doe@example.com\",\n            \"first_name\": \"John\",\n            \"invitee_id\": \"inv_abc123\",\n            \"is_attended\": false,\n            \"is_invited\": true,\n            \"is_verified\": true,\n            \"job_title\": \"Senior Software Engineer\",\n            \"last_name\": \"Doe\",\n            \"lf_user_id\": \"abc123\",\n            \"org_name\": \"Microsoft\",\n            \"participant_id\": \"ea1e8536-a985-4cf5-b981-a170927a1d11\",\n            \"username\": \"johndoe\"\n         },\n         {\n            \"attendee_id\": \"att_xyz789\",\n            \"committee_role\": \"Lead Developer\",\n            \"committee_voting_status\": \"Alt Voting Rep\",\n            \"email\": \"john.doe@example.com\",\n            \"first_name\": \"John\",\n            \"invitee_id\": \"inv_abc123\",\n            \"is_attended\": false,\n            \"is_invited\": true,\n            \"is_verified\": true,\n            \"job_title\": \"Senior Software Engineer\",\n            \"last_name\": \"Doe\",\n            \"lf_user_id\": \"abc123\",\n            \"org_name\": \"Microsoft\",\n            \"participant_id\": \"ea1e8536-a985-4cf5-b981-a170927a1d11\",\n            \"username\": \"johndoe\"\n         }\n      ]\n   }'")
+		}
+		if body.Participants == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("participants", "body"))
+		}
+		if len(body.Participants) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.participants", body.Participants, len(body.Participants), 1, true))
+		}
+		if len(body.Participants) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.participants", body.Participants, len(body.Participants), 200, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var pastMeetingID string
+	{
+		pastMeetingID = meetingServiceBulkUpdateItxPastMeetingParticipantsPastMeetingID
+	}
+	var version *string
+	{
+		if meetingServiceBulkUpdateItxPastMeetingParticipantsVersion != "" {
+			version = &meetingServiceBulkUpdateItxPastMeetingParticipantsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if meetingServiceBulkUpdateItxPastMeetingParticipantsBearerToken != "" {
+			bearerToken = &meetingServiceBulkUpdateItxPastMeetingParticipantsBearerToken
+		}
+	}
+	v := &meetingservice.BulkUpdateItxPastMeetingParticipantsPayload{}
+	if body.Participants != nil {
+		v.Participants = make([]*meetingservice.ITXPastMeetingParticipantUpdate, len(body.Participants))
+		for i, val := range body.Participants {
+			if val == nil {
+				v.Participants[i] = nil
+				continue
+			}
+			v.Participants[i] = marshalITXPastMeetingParticipantUpdateRequestBodyToMeetingserviceITXPastMeetingParticipantUpdate(val)
+		}
+	} else {
+		v.Participants = []*meetingservice.ITXPastMeetingParticipantUpdate{}
+	}
+	v.PastMeetingID = pastMeetingID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildDeleteItxPastMeetingParticipantPayload builds the payload for the
 // Meeting Service delete-itx-past-meeting-participant endpoint from CLI flags.
 func BuildDeleteItxPastMeetingParticipantPayload(meetingServiceDeleteItxPastMeetingParticipantPastMeetingID string, meetingServiceDeleteItxPastMeetingParticipantParticipantID string, meetingServiceDeleteItxPastMeetingParticipantVersion string, meetingServiceDeleteItxPastMeetingParticipantBearerToken string) (*meetingservice.DeleteItxPastMeetingParticipantPayload, error) {
@@ -1611,7 +2244,7 @@ func BuildCreateItxMeetingAttachmentPayload(meetingServiceCreateItxMeetingAttach
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxMeetingAttachmentBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Notes\",\n      \"description\": \"Beatae iste.\",\n      \"link\": \"Velit non.\",\n      \"name\": \"dva\",\n      \"type\": \"file\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Presentation\",\n      \"description\": \"Iure molestiae ipsum blanditiis commodi sunt perspiciatis.\",\n      \"link\": \"Sapiente autem.\",\n      \"name\": \"gz\",\n      \"type\": \"link\"\n   }'")
 		}
 		if !(body.Type == "file" || body.Type == "link") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"file", "link"}))
@@ -1713,7 +2346,7 @@ func BuildUpdateItxMeetingAttachmentPayload(meetingServiceUpdateItxMeetingAttach
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxMeetingAttachmentBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Other\",\n      \"description\": \"Delectus a consequuntur quaerat.\",\n      \"link\": \"Eveniet aut dolorem.\",\n      \"name\": \"Minima consequatur error doloribus fugit.\",\n      \"type\": \"link\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Notes\",\n      \"description\": \"Culpa quisquam quibusdam.\",\n      \"link\": \"Ut blanditiis.\",\n      \"name\": \"Et iste eum.\",\n      \"type\": \"link\"\n   }'")
 		}
 		if !(body.Type == "file" || body.Type == "link") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"file", "link"}))
@@ -1822,7 +2455,7 @@ func BuildCreateItxMeetingAttachmentPresignPayload(meetingServiceCreateItxMeetin
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxMeetingAttachmentPresignBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Presentation\",\n      \"description\": \"Fuga ut doloremque quidem placeat.\",\n      \"file_size\": 729562237212051371,\n      \"file_type\": \"Temporibus eum aut tempore eius voluptatem.\",\n      \"name\": \"Quis error eveniet.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Presentation\",\n      \"description\": \"Aut quam tempore.\",\n      \"file_size\": 3211365611609508223,\n      \"file_type\": \"Dignissimos dicta illum quasi repudiandae et doloremque.\",\n      \"name\": \"Omnis sed.\"\n   }'")
 		}
 		if body.Category != nil {
 			if !(*body.Category == "Meeting Minutes" || *body.Category == "Notes" || *body.Category == "Presentation" || *body.Category == "Other") {
@@ -1920,7 +2553,7 @@ func BuildCreateItxPastMeetingAttachmentPayload(meetingServiceCreateItxPastMeeti
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxPastMeetingAttachmentBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Presentation\",\n      \"description\": \"Expedita sit deleniti itaque.\",\n      \"link\": \"Sint quia corrupti error sint ut vitae.\",\n      \"name\": \"jq5\",\n      \"type\": \"file\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Other\",\n      \"description\": \"Minima in cupiditate autem dolorum aut.\",\n      \"link\": \"Quaerat unde quos debitis delectus animi sequi.\",\n      \"name\": \"t5\",\n      \"type\": \"link\"\n   }'")
 		}
 		if !(body.Type == "file" || body.Type == "link") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"file", "link"}))
@@ -2022,7 +2655,7 @@ func BuildUpdateItxPastMeetingAttachmentPayload(meetingServiceUpdateItxPastMeeti
 	{
 		err = json.Unmarshal([]byte(meetingServiceUpdateItxPastMeetingAttachmentBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Notes\",\n      \"description\": \"Nam hic.\",\n      \"link\": \"Veritatis enim quaerat itaque in.\",\n      \"name\": \"Ut quia sed unde illo qui a.\",\n      \"type\": \"link\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Presentation\",\n      \"description\": \"Facere eos expedita laborum voluptatem.\",\n      \"link\": \"Et nihil.\",\n      \"name\": \"Tempora facere.\",\n      \"type\": \"link\"\n   }'")
 		}
 		if !(body.Type == "file" || body.Type == "link") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"file", "link"}))
@@ -2131,7 +2764,7 @@ func BuildCreateItxPastMeetingAttachmentPresignPayload(meetingServiceCreateItxPa
 	{
 		err = json.Unmarshal([]byte(meetingServiceCreateItxPastMeetingAttachmentPresignBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Meeting Minutes\",\n      \"description\": \"Libero similique id.\",\n      \"file_size\": 7626376824938201178,\n      \"file_type\": \"Corrupti molestias.\",\n      \"name\": \"In beatae exercitationem tempora eos.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Presentation\",\n      \"description\": \"Veniam eum.\",\n      \"file_size\": 5765615079929783327,\n      \"file_type\": \"Quod aut impedit voluptatum.\",\n      \"name\": \"Cupiditate ut corrupti dolor.\"\n   }'")
 		}
 		if body.Category != nil {
 			if !(*body.Category == "Meeting Minutes" || *body.Category == "Notes" || *body.Category == "Presentation" || *body.Category == "Other") {
@@ -23,6 +23,14 @@ type Client struct {
 	// Livez Doer is the HTTP client used to make requests to the livez endpoint.
 	LivezDoer goahttp.Doer
 
+	// GetServiceConfig Doer is the HTTP client used to make requests to the
+	// get-service-config endpoint.
+	GetServiceConfigDoer goahttp.Doer
+
+	// GetServiceHealth Doer is the HTTP client used to make requests to the
+	// get-service-health endpoint.
+	GetServiceHealthDoer goahttp.Doer
+
 	// CreateItxMeeting Doer is the HTTP client used to make requests to the
 	// create-itx-meeting endpoint.
 	CreateItxMeetingDoer goahttp.Doer
@@ -35,6 +43,10 @@ type Client struct {
 	// delete-itx-meeting endpoint.
 	DeleteItxMeetingDoer goahttp.Doer
 
+	// CloneItxMeeting Doer is the HTTP client used to make requests to the
+	// clone-itx-meeting endpoint.
+	CloneItxMeetingDoer goahttp.Doer
+
 	// UpdateItxMeeting Doer is the HTTP client used to make requests to the
 	// update-itx-meeting endpoint.
 	UpdateItxMeetingDoer goahttp.Doer
@@ -43,6 +55,10 @@ type Client struct {
 	// get-itx-meeting-count endpoint.
 	GetItxMeetingCountDoer goahttp.Doer
 
+	// PreviewItxMeetingOccurrences Doer is the HTTP client used to make requests
+	// to the preview-itx-meeting-occurrences endpoint.
+	PreviewItxMeetingOccurrencesDoer goahttp.Doer
+
 	// CreateItxRegistrant Doer is the HTTP client used to make requests to the
 	// create-itx-registrant endpoint.
 	CreateItxRegistrantDoer goahttp.Doer
@@ -63,10 +79,22 @@ type Client struct {
 	// get-itx-join-link endpoint.
 	GetItxJoinLinkDoer goahttp.Doer
 
+	// LaunchItxMeeting Doer is the HTTP client used to make requests to the
+	// launch-itx-meeting endpoint.
+	LaunchItxMeetingDoer goahttp.Doer
+
 	// GetItxRegistrantIcs Doer is the HTTP client used to make requests to the
 	// get-itx-registrant-ics endpoint.
 	GetItxRegistrantIcsDoer goahttp.Doer
 
+	// AddItxRegistrantHost Doer is the HTTP client used to make requests to the
+	// add-itx-registrant-host endpoint.
+	AddItxRegistrantHostDoer goahttp.Doer
+
+	// RemoveItxRegistrantHost Doer is the HTTP client used to make requests to the
+	// remove-itx-registrant-host endpoint.
+	RemoveItxRegistrantHostDoer goahttp.Doer
+
 	// ResendItxRegistrantInvitation Doer is the HTTP client used to make requests
 	// to the resend-itx-registrant-invitation endpoint.
 	ResendItxRegistrantInvitationDoer goahttp.Doer
@@ -79,6 +107,10 @@ type Client struct {
 	// the register-itx-committee-members endpoint.
 	RegisterItxCommitteeMembersDoer goahttp.Doer
 
+	// ListItxMeetingOccurrences Doer is the HTTP client used to make requests to
+	// the list-itx-meeting-occurrences endpoint.
+	ListItxMeetingOccurrencesDoer goahttp.Doer
+
 	// UpdateItxOccurrence Doer is the HTTP client used to make requests to the
 	// update-itx-occurrence endpoint.
 	UpdateItxOccurrenceDoer goahttp.Doer
@@ -111,10 +143,22 @@ type Client struct {
 	// the get-itx-past-meeting-summary endpoint.
 	GetItxPastMeetingSummaryDoer goahttp.Doer
 
+	// GetItxPastMeetingSummaryDiff Doer is the HTTP client used to make requests
+	// to the get-itx-past-meeting-summary-diff endpoint.
+	GetItxPastMeetingSummaryDiffDoer goahttp.Doer
+
 	// UpdateItxPastMeetingSummary Doer is the HTTP client used to make requests to
 	// the update-itx-past-meeting-summary endpoint.
 	UpdateItxPastMeetingSummaryDoer goahttp.Doer
 
+	// ApproveItxPastMeetingSummary Doer is the HTTP client used to make requests
+	// to the approve-itx-past-meeting-summary endpoint.
+	ApproveItxPastMeetingSummaryDoer goahttp.Doer
+
+	// RejectItxPastMeetingSummary Doer is the HTTP client used to make requests to
+	// the reject-itx-past-meeting-summary endpoint.
+	RejectItxPastMeetingSummaryDoer goahttp.Doer
+
 	// CreateItxPastMeetingParticipant Doer is the HTTP client used to make
 	// requests to the create-itx-past-meeting-participant endpoint.
 	CreateItxPastMeetingParticipantDoer goahttp.Doer
@@ -123,6 +167,10 @@ type Client struct {
 	// requests to the update-itx-past-meeting-participant endpoint.
 	UpdateItxPastMeetingParticipantDoer goahttp.Doer
 
+	// BulkUpdateItxPastMeetingParticipants Doer is the HTTP client used to make
+	// requests to the bulk-update-itx-past-meeting-participants endpoint.
+	BulkUpdateItxPastMeetingParticipantsDoer goahttp.Doer
+
 	// DeleteItxPastMeetingParticipant Doer is the HTTP client used to make
 	// requests to the delete-itx-past-meeting-participant endpoint.
 	DeleteItxPastMeetingParticipantDoer goahttp.Doer
@@ -198,20 +246,28 @@ func NewClient(
 	return &Client{
 		ReadyzDoer:                                doer,
 		LivezDoer:                                 doer,
+		GetServiceConfigDoer:                      doer,
+		GetServiceHealthDoer:                      doer,
 		CreateItxMeetingDoer:                      doer,
 		GetItxMeetingDoer:                         doer,
 		DeleteItxMeetingDoer:                      doer,
+		CloneItxMeetingDoer:                       doer,
 		UpdateItxMeetingDoer:                      doer,
 		GetItxMeetingCountDoer:                    doer,
+		PreviewItxMeetingOccurrencesDoer:          doer,
 		CreateItxRegistrantDoer:                   doer,
 		GetItxRegistrantDoer:                      doer,
 		UpdateItxRegistrantDoer:                   doer,
 		DeleteItxRegistrantDoer:                   doer,
 		GetItxJoinLinkDoer:                        doer,
+		LaunchItxMeetingDoer:                      doer,
 		GetItxRegistrantIcsDoer:                   doer,
+		AddItxRegistrantHostDoer:                  doer,
+		RemoveItxRegistrantHostDoer:               doer,
 		ResendItxRegistrantInvitationDoer:         doer,
 		ResendItxMeetingInvitationsDoer:           doer,
 		RegisterItxCommitteeMembersDoer:           doer,
+		ListItxMeetingOccurrencesDoer:             doer,
 		UpdateItxOccurrenceDoer:                   doer,
 		DeleteItxOccurrenceDoer:                   doer,
 		SubmitItxMeetingResponseDoer:              doer,
@@ -220,9 +276,13 @@ func NewClient(
 		DeleteItxPastMeetingDoer:                  doer,
 		UpdateItxPastMeetingDoer:                  doer,
 		GetItxPastMeetingSummaryDoer:              doer,
+		GetItxPastMeetingSummaryDiffDoer:          doer,
 		UpdateItxPastMeetingSummaryDoer:           doer,
+		ApproveItxPastMeetingSummaryDoer:          doer,
+		RejectItxPastMeetingSummaryDoer:           doer,
 		CreateItxPastMeetingParticipantDoer:       doer,
 		UpdateItxPastMeetingParticipantDoer:       doer,
+		BulkUpdateItxPastMeetingParticipantsDoer:  doer,
 		DeleteItxPastMeetingParticipantDoer:       doer,
 		CreateItxMeetingAttachmentDoer:            doer,
 		GetItxMeetingAttachmentDoer:               doer,
@@ -282,6 +342,54 @@ func (c *Client) Livez() goa.Endpoint {
 	}
 }
 
+// GetServiceConfig returns an endpoint that makes HTTP requests to the Meeting
+// Service service get-service-config server.
+func (c *Client) GetServiceConfig() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetServiceConfigRequest(c.encoder)
+		decodeResponse = DecodeGetServiceConfigResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetServiceConfigRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetServiceConfigDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "get-service-config", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetServiceHealth returns an endpoint that makes HTTP requests to the Meeting
+// Service service get-service-health server.
+func (c *Client) GetServiceHealth() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetServiceHealthRequest(c.encoder)
+		decodeResponse = DecodeGetServiceHealthResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetServiceHealthRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetServiceHealthDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "get-service-health", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CreateItxMeeting returns an endpoint that makes HTTP requests to the Meeting
 // Service service create-itx-meeting server.
 func (c *Client) CreateItxMeeting() goa.Endpoint {
@@ -354,6 +462,30 @@ func (c *Client) DeleteItxMeeting() goa.Endpoint {
 	}
 }
 
+// CloneItxMeeting returns an endpoint that makes HTTP requests to the Meeting
+// Service service clone-itx-meeting server.
+func (c *Client) CloneItxMeeting() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCloneItxMeetingRequest(c.encoder)
+		decodeResponse = DecodeCloneItxMeetingResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCloneItxMeetingRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CloneItxMeetingDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "clone-itx-meeting", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // UpdateItxMeeting returns an endpoint that makes HTTP requests to the Meeting
 // Service service update-itx-meeting server.
 func (c *Client) UpdateItxMeeting() goa.Endpoint {
@@ -402,6 +534,30 @@ func (c *Client) GetItxMeetingCount() goa.Endpoint {
 	}
 }
 
+// PreviewItxMeetingOccurrences returns an endpoint that makes HTTP requests to
+// the Meeting Service service preview-itx-meeting-occurrences server.
+func (c *Client) PreviewItxMeetingOccurrences() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePreviewItxMeetingOccurrencesRequest(c.encoder)
+		decodeResponse = DecodePreviewItxMeetingOccurrencesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPreviewItxMeetingOccurrencesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PreviewItxMeetingOccurrencesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "preview-itx-meeting-occurrences", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CreateItxRegistrant returns an endpoint that makes HTTP requests to the
 // Meeting Service service create-itx-registrant server.
 func (c *Client) CreateItxRegistrant() goa.Endpoint {
@@ -522,6 +678,30 @@ func (c *Client) GetItxJoinLink() goa.Endpoint {
 	}
 }
 
+// LaunchItxMeeting returns an endpoint that makes HTTP requests to the Meeting
+// Service service launch-itx-meeting server.
+func (c *Client) LaunchItxMeeting() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeLaunchItxMeetingRequest(c.encoder)
+		decodeResponse = DecodeLaunchItxMeetingResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLaunchItxMeetingRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LaunchItxMeetingDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "launch-itx-meeting", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetItxRegistrantIcs returns an endpoint that makes HTTP requests to the
 // Meeting Service service get-itx-registrant-ics server.
 func (c *Client) GetItxRegistrantIcs() goa.Endpoint {
@@ -546,6 +726,54 @@ func (c *Client) GetItxRegistrantIcs() goa.Endpoint {
 	}
 }
 
+// AddItxRegistrantHost returns an endpoint that makes HTTP requests to the
+// Meeting Service service add-itx-registrant-host server.
+func (c *Client) AddItxRegistrantHost() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddItxRegistrantHostRequest(c.encoder)
+		decodeResponse = DecodeAddItxRegistrantHostResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddItxRegistrantHostRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddItxRegistrantHostDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "add-itx-registrant-host", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveItxRegistrantHost returns an endpoint that makes HTTP requests to the
+// Meeting Service service remove-itx-registrant-host server.
+func (c *Client) RemoveItxRegistrantHost() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveItxRegistrantHostRequest(c.encoder)
+		decodeResponse = DecodeRemoveItxRegistrantHostResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveItxRegistrantHostRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveItxRegistrantHostDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "remove-itx-registrant-host", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ResendItxRegistrantInvitation returns an endpoint that makes HTTP requests
 // to the Meeting Service service resend-itx-registrant-invitation server.
 func (c *Client) ResendItxRegistrantInvitation() goa.Endpoint {
@@ -618,6 +846,30 @@ func (c *Client) RegisterItxCommitteeMembers() goa.Endpoint {
 	}
 }
 
+// ListItxMeetingOccurrences returns an endpoint that makes HTTP requests to
+// the Meeting Service service list-itx-meeting-occurrences server.
+func (c *Client) ListItxMeetingOccurrences() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListItxMeetingOccurrencesRequest(c.encoder)
+		decodeResponse = DecodeListItxMeetingOccurrencesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListItxMeetingOccurrencesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListItxMeetingOccurrencesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "list-itx-meeting-occurrences", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // UpdateItxOccurrence returns an endpoint that makes HTTP requests to the
 // Meeting Service service update-itx-occurrence server.
 func (c *Client) UpdateItxOccurrence() goa.Endpoint {
@@ -810,6 +1062,30 @@ func (c *Client) GetItxPastMeetingSummary() goa.Endpoint {
 	}
 }
 
+// GetItxPastMeetingSummaryDiff returns an endpoint that makes HTTP requests to
+// the Meeting Service service get-itx-past-meeting-summary-diff server.
+func (c *Client) GetItxPastMeetingSummaryDiff() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetItxPastMeetingSummaryDiffRequest(c.encoder)
+		decodeResponse = DecodeGetItxPastMeetingSummaryDiffResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetItxPastMeetingSummaryDiffRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetItxPastMeetingSummaryDiffDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // UpdateItxPastMeetingSummary returns an endpoint that makes HTTP requests to
 // the Meeting Service service update-itx-past-meeting-summary server.
 func (c *Client) UpdateItxPastMeetingSummary() goa.Endpoint {
@@ -834,6 +1110,54 @@ func (c *Client) UpdateItxPastMeetingSummary() goa.Endpoint {
 	}
 }
 
+// ApproveItxPastMeetingSummary returns an endpoint that makes HTTP requests to
+// the Meeting Service service approve-itx-past-meeting-summary server.
+func (c *Client) ApproveItxPastMeetingSummary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeApproveItxPastMeetingSummaryRequest(c.encoder)
+		decodeResponse = DecodeApproveItxPastMeetingSummaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildApproveItxPastMeetingSummaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ApproveItxPastMeetingSummaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "approve-itx-past-meeting-summary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RejectItxPastMeetingSummary returns an endpoint that makes HTTP requests to
+// the Meeting Service service reject-itx-past-meeting-summary server.
+func (c *Client) RejectItxPastMeetingSummary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRejectItxPastMeetingSummaryRequest(c.encoder)
+		decodeResponse = DecodeRejectItxPastMeetingSummaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRejectItxPastMeetingSummaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RejectItxPastMeetingSummaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "reject-itx-past-meeting-summary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CreateItxPastMeetingParticipant returns an endpoint that makes HTTP requests
 // to the Meeting Service service create-itx-past-meeting-participant server.
 func (c *Client) CreateItxPastMeetingParticipant() goa.Endpoint {
@@ -882,6 +1206,31 @@ func (c *Client) UpdateItxPastMeetingParticipant() goa.Endpoint {
 	}
 }
 
+// BulkUpdateItxPastMeetingParticipants returns an endpoint that makes HTTP
+// requests to the Meeting Service service
+// bulk-update-itx-past-meeting-participants server.
+func (c *Client) BulkUpdateItxPastMeetingParticipants() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBulkUpdateItxPastMeetingParticipantsRequest(c.encoder)
+		decodeResponse = DecodeBulkUpdateItxPastMeetingParticipantsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBulkUpdateItxPastMeetingParticipantsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BulkUpdateItxPastMeetingParticipantsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // DeleteItxPastMeetingParticipant returns an endpoint that makes HTTP requests
 // to the Meeting Service service delete-itx-past-meeting-participant server.
 func (c *Client) DeleteItxPastMeetingParticipant() goa.Endpoint {
@@ -19,6 +19,7 @@ import (
 
 	meetingservice "github.com/linuxfoundation/lfx-v2-meeting-service/gen/meeting_service"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildReadyzRequest instantiates a HTTP request object with method and path
@@ -138,6 +139,292 @@ func DecodeLivezResponse(decoder func(*http.Response) goahttp.Decoder, restoreBo
 	}
 }
 
+// BuildGetServiceConfigRequest instantiates a HTTP request object with method
+// and path set to call the "Meeting Service" service "get-service-config"
+// endpoint
+func (c *Client) BuildGetServiceConfigRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetServiceConfigMeetingServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-service-config", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetServiceConfigRequest returns an encoder for requests sent to the
+// Meeting Service get-service-config server.
+func EncodeGetServiceConfigRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.GetServiceConfigPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "get-service-config", "*meetingservice.GetServiceConfigPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetServiceConfigResponse returns a decoder for responses returned by
+// the Meeting Service get-service-config endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetServiceConfigResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetServiceConfigResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetServiceConfigResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-config", err)
+			}
+			err = ValidateGetServiceConfigResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-config", err)
+			}
+			res := NewGetServiceConfigServiceConfigOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetServiceConfigBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-config", err)
+			}
+			err = ValidateGetServiceConfigBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-config", err)
+			}
+			return nil, NewGetServiceConfigBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetServiceConfigForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-config", err)
+			}
+			err = ValidateGetServiceConfigForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-config", err)
+			}
+			return nil, NewGetServiceConfigForbidden(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetServiceConfigServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-config", err)
+			}
+			err = ValidateGetServiceConfigServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-config", err)
+			}
+			return nil, NewGetServiceConfigServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetServiceConfigUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-config", err)
+			}
+			err = ValidateGetServiceConfigUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-config", err)
+			}
+			return nil, NewGetServiceConfigUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-service-config", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetServiceHealthRequest instantiates a HTTP request object with method
+// and path set to call the "Meeting Service" service "get-service-health"
+// endpoint
+func (c *Client) BuildGetServiceHealthRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetServiceHealthMeetingServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-service-health", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetServiceHealthRequest returns an encoder for requests sent to the
+// Meeting Service get-service-health server.
+func EncodeGetServiceHealthRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.GetServiceHealthPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "get-service-health", "*meetingservice.GetServiceHealthPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetServiceHealthResponse returns a decoder for responses returned by
+// the Meeting Service get-service-health endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetServiceHealthResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetServiceHealthResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetServiceHealthResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-health", err)
+			}
+			err = ValidateGetServiceHealthResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-health", err)
+			}
+			res := NewGetServiceHealthServiceHealthOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetServiceHealthBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-health", err)
+			}
+			err = ValidateGetServiceHealthBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-health", err)
+			}
+			return nil, NewGetServiceHealthBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetServiceHealthForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-health", err)
+			}
+			err = ValidateGetServiceHealthForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-health", err)
+			}
+			return nil, NewGetServiceHealthForbidden(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetServiceHealthServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-health", err)
+			}
+			err = ValidateGetServiceHealthServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-health", err)
+			}
+			return nil, NewGetServiceHealthServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetServiceHealthUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-service-health", err)
+			}
+			err = ValidateGetServiceHealthUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-service-health", err)
+			}
+			return nil, NewGetServiceHealthUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-service-health", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildCreateItxMeetingRequest instantiates a HTTP request object with method
 // and path set to call the "Meeting Service" service "create-itx-meeting"
 // endpoint
@@ -672,24 +959,24 @@ func DecodeDeleteItxMeetingResponse(decoder func(*http.Response) goahttp.Decoder
 	}
 }
 
-// BuildUpdateItxMeetingRequest instantiates a HTTP request object with method
-// and path set to call the "Meeting Service" service "update-itx-meeting"
+// BuildCloneItxMeetingRequest instantiates a HTTP request object with method
+// and path set to call the "Meeting Service" service "clone-itx-meeting"
 // endpoint
-func (c *Client) BuildUpdateItxMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+func (c *Client) BuildCloneItxMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		meetingID string
 	)
 	{
-		p, ok := v.(*meetingservice.UpdateItxMeetingPayload)
+		p, ok := v.(*meetingservice.CloneItxMeetingPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-meeting", "*meetingservice.UpdateItxMeetingPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "clone-itx-meeting", "*meetingservice.CloneItxMeetingPayload", v)
 		}
 		meetingID = p.MeetingID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxMeetingMeetingServicePath(meetingID)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CloneItxMeetingMeetingServicePath(meetingID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-meeting", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "clone-itx-meeting", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -698,13 +985,13 @@ func (c *Client) BuildUpdateItxMeetingRequest(ctx context.Context, v any) (*http
 	return req, nil
 }
 
-// EncodeUpdateItxMeetingRequest returns an encoder for requests sent to the
-// Meeting Service update-itx-meeting server.
-func EncodeUpdateItxMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCloneItxMeetingRequest returns an encoder for requests sent to the
+// Meeting Service clone-itx-meeting server.
+func EncodeCloneItxMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.UpdateItxMeetingPayload)
+		p, ok := v.(*meetingservice.CloneItxMeetingPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "update-itx-meeting", "*meetingservice.UpdateItxMeetingPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "clone-itx-meeting", "*meetingservice.CloneItxMeetingPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -714,28 +1001,23 @@ func EncodeUpdateItxMeetingRequest(encoder func(*http.Request) goahttp.Encoder)
 				req.Header.Set("Authorization", head)
 			}
 		}
-		if p.XSync != nil {
-			head := *p.XSync
-			headStr := strconv.FormatBool(head)
-			req.Header.Set("X-Sync", headStr)
-		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewUpdateItxMeetingRequestBody(p)
+		body := NewCloneItxMeetingRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "update-itx-meeting", err)
+			return goahttp.ErrEncodingError("Meeting Service", "clone-itx-meeting", err)
 		}
 		return nil
 	}
 }
 
-// DecodeUpdateItxMeetingResponse returns a decoder for responses returned by
-// the Meeting Service update-itx-meeting endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeUpdateItxMeetingResponse may return the following errors:
+// DecodeCloneItxMeetingResponse returns a decoder for responses returned by
+// the Meeting Service clone-itx-meeting endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeCloneItxMeetingResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
@@ -744,7 +1026,7 @@ func EncodeUpdateItxMeetingRequest(encoder func(*http.Request) goahttp.Encoder)
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeUpdateItxMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCloneItxMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -759,48 +1041,255 @@ func DecodeUpdateItxMeetingResponse(decoder func(*http.Response) goahttp.Decoder
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
-		case http.StatusBadRequest:
+		case http.StatusCreated:
 			var (
-				body UpdateItxMeetingBadRequestResponseBody
+				body CloneItxMeetingResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
 			}
-			err = ValidateUpdateItxMeetingBadRequestResponseBody(&body)
+			err = ValidateCloneItxMeetingResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
 			}
-			return nil, NewUpdateItxMeetingBadRequest(&body)
-		case http.StatusConflict:
+			res := NewCloneItxMeetingITXZoomMeetingResponseCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
 			var (
-				body UpdateItxMeetingConflictResponseBody
+				body CloneItxMeetingBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
 			}
-			err = ValidateUpdateItxMeetingConflictResponseBody(&body)
+			err = ValidateCloneItxMeetingBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
 			}
-			return nil, NewUpdateItxMeetingConflict(&body)
-		case http.StatusForbidden:
+			return nil, NewCloneItxMeetingBadRequest(&body)
+		case http.StatusConflict:
 			var (
-				body UpdateItxMeetingForbiddenResponseBody
+				body CloneItxMeetingConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
 			}
-			err = ValidateUpdateItxMeetingForbiddenResponseBody(&body)
+			err = ValidateCloneItxMeetingConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
+			}
+			return nil, NewCloneItxMeetingConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CloneItxMeetingForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
+			}
+			err = ValidateCloneItxMeetingForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
+			}
+			return nil, NewCloneItxMeetingForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CloneItxMeetingInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
+			}
+			err = ValidateCloneItxMeetingInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
+			}
+			return nil, NewCloneItxMeetingInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CloneItxMeetingNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
+			}
+			err = ValidateCloneItxMeetingNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
+			}
+			return nil, NewCloneItxMeetingNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CloneItxMeetingServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
+			}
+			err = ValidateCloneItxMeetingServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
+			}
+			return nil, NewCloneItxMeetingServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CloneItxMeetingUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "clone-itx-meeting", err)
+			}
+			err = ValidateCloneItxMeetingUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "clone-itx-meeting", err)
+			}
+			return nil, NewCloneItxMeetingUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "clone-itx-meeting", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateItxMeetingRequest instantiates a HTTP request object with method
+// and path set to call the "Meeting Service" service "update-itx-meeting"
+// endpoint
+func (c *Client) BuildUpdateItxMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		meetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.UpdateItxMeetingPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-meeting", "*meetingservice.UpdateItxMeetingPayload", v)
+		}
+		meetingID = p.MeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxMeetingMeetingServicePath(meetingID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-meeting", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateItxMeetingRequest returns an encoder for requests sent to the
+// Meeting Service update-itx-meeting server.
+func EncodeUpdateItxMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.UpdateItxMeetingPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "update-itx-meeting", "*meetingservice.UpdateItxMeetingPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewUpdateItxMeetingRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "update-itx-meeting", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateItxMeetingResponse returns a decoder for responses returned by
+// the Meeting Service update-itx-meeting endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeUpdateItxMeetingResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateItxMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateItxMeetingBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-meeting", err)
+			}
+			err = ValidateUpdateItxMeetingBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-meeting", err)
+			}
+			return nil, NewUpdateItxMeetingBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateItxMeetingConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-meeting", err)
+			}
+			err = ValidateUpdateItxMeetingConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-meeting", err)
+			}
+			return nil, NewUpdateItxMeetingConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateItxMeetingForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-meeting", err)
+			}
+			err = ValidateUpdateItxMeetingForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-meeting", err)
 			}
 			return nil, NewUpdateItxMeetingForbidden(&body)
 		case http.StatusInternalServerError:
@@ -1040,24 +1529,14 @@ func DecodeGetItxMeetingCountResponse(decoder func(*http.Response) goahttp.Decod
 	}
 }
 
-// BuildCreateItxRegistrantRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "create-itx-registrant" endpoint
-func (c *Client) BuildCreateItxRegistrantRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		meetingID string
-	)
-	{
-		p, ok := v.(*meetingservice.CreateItxRegistrantPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "create-itx-registrant", "*meetingservice.CreateItxRegistrantPayload", v)
-		}
-		meetingID = p.MeetingID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateItxRegistrantMeetingServicePath(meetingID)}
+// BuildPreviewItxMeetingOccurrencesRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "preview-itx-meeting-occurrences" endpoint
+func (c *Client) BuildPreviewItxMeetingOccurrencesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PreviewItxMeetingOccurrencesMeetingServicePath()}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "create-itx-registrant", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "preview-itx-meeting-occurrences", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1066,13 +1545,13 @@ func (c *Client) BuildCreateItxRegistrantRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeCreateItxRegistrantRequest returns an encoder for requests sent to the
-// Meeting Service create-itx-registrant server.
-func EncodeCreateItxRegistrantRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodePreviewItxMeetingOccurrencesRequest returns an encoder for requests
+// sent to the Meeting Service preview-itx-meeting-occurrences server.
+func EncodePreviewItxMeetingOccurrencesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.CreateItxRegistrantPayload)
+		p, ok := v.(*meetingservice.PreviewItxMeetingOccurrencesPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "create-itx-registrant", "*meetingservice.CreateItxRegistrantPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "preview-itx-meeting-occurrences", "*meetingservice.PreviewItxMeetingOccurrencesPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1087,27 +1566,24 @@ func EncodeCreateItxRegistrantRequest(encoder func(*http.Request) goahttp.Encode
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewCreateItxRegistrantRequestBody(p)
+		body := NewPreviewItxMeetingOccurrencesRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "create-itx-registrant", err)
+			return goahttp.ErrEncodingError("Meeting Service", "preview-itx-meeting-occurrences", err)
 		}
 		return nil
 	}
 }
 
-// DecodeCreateItxRegistrantResponse returns a decoder for responses returned
-// by the Meeting Service create-itx-registrant endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeCreateItxRegistrantResponse may return the following errors:
+// DecodePreviewItxMeetingOccurrencesResponse returns a decoder for responses
+// returned by the Meeting Service preview-itx-meeting-occurrences endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodePreviewItxMeetingOccurrencesResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
-//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
-//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeCreateItxRegistrantResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodePreviewItxMeetingOccurrencesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1122,77 +1598,223 @@ func DecodeCreateItxRegistrantResponse(decoder func(*http.Response) goahttp.Deco
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body CreateItxRegistrantResponseBody
+				body PreviewItxMeetingOccurrencesResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxRegistrantResponseBody(&body)
+			err = ValidatePreviewItxMeetingOccurrencesResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			res := NewCreateItxRegistrantITXZoomMeetingRegistrantCreated(&body)
+			res := NewPreviewItxMeetingOccurrencesITXOccurrencePreviewResponseOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body CreateItxRegistrantBadRequestResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
-			}
-			err = ValidateCreateItxRegistrantBadRequestResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
-			}
-			return nil, NewCreateItxRegistrantBadRequest(&body)
-		case http.StatusConflict:
-			var (
-				body CreateItxRegistrantConflictResponseBody
+				body PreviewItxMeetingOccurrencesBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxRegistrantConflictResponseBody(&body)
+			err = ValidatePreviewItxMeetingOccurrencesBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxRegistrantConflict(&body)
-		case http.StatusForbidden:
+			return nil, NewPreviewItxMeetingOccurrencesBadRequest(&body)
+		case http.StatusInternalServerError:
 			var (
-				body CreateItxRegistrantForbiddenResponseBody
+				body PreviewItxMeetingOccurrencesInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxRegistrantForbiddenResponseBody(&body)
+			err = ValidatePreviewItxMeetingOccurrencesInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxRegistrantForbidden(&body)
-		case http.StatusInternalServerError:
+			return nil, NewPreviewItxMeetingOccurrencesInternalServerError(&body)
+		case http.StatusUnauthorized:
 			var (
-				body CreateItxRegistrantInternalServerErrorResponseBody
+				body PreviewItxMeetingOccurrencesUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxRegistrantInternalServerErrorResponseBody(&body)
+			err = ValidatePreviewItxMeetingOccurrencesUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "preview-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxRegistrantInternalServerError(&body)
+			return nil, NewPreviewItxMeetingOccurrencesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "preview-itx-meeting-occurrences", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateItxRegistrantRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "create-itx-registrant" endpoint
+func (c *Client) BuildCreateItxRegistrantRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		meetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.CreateItxRegistrantPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "create-itx-registrant", "*meetingservice.CreateItxRegistrantPayload", v)
+		}
+		meetingID = p.MeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateItxRegistrantMeetingServicePath(meetingID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "create-itx-registrant", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateItxRegistrantRequest returns an encoder for requests sent to the
+// Meeting Service create-itx-registrant server.
+func EncodeCreateItxRegistrantRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.CreateItxRegistrantPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "create-itx-registrant", "*meetingservice.CreateItxRegistrantPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewCreateItxRegistrantRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "create-itx-registrant", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateItxRegistrantResponse returns a decoder for responses returned
+// by the Meeting Service create-itx-registrant endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeCreateItxRegistrantResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateItxRegistrantResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateItxRegistrantResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+			}
+			err = ValidateCreateItxRegistrantResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+			}
+			res := NewCreateItxRegistrantITXZoomMeetingRegistrantCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateItxRegistrantBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+			}
+			err = ValidateCreateItxRegistrantBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+			}
+			return nil, NewCreateItxRegistrantBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CreateItxRegistrantConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+			}
+			err = ValidateCreateItxRegistrantConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+			}
+			return nil, NewCreateItxRegistrantConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateItxRegistrantForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+			}
+			err = ValidateCreateItxRegistrantForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+			}
+			return nil, NewCreateItxRegistrantForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateItxRegistrantInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-registrant", err)
+			}
+			err = ValidateCreateItxRegistrantInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-registrant", err)
+			}
+			return nil, NewCreateItxRegistrantInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
 				body CreateItxRegistrantNotFoundResponseBody
@@ -1836,6 +2458,9 @@ func EncodeGetItxJoinLinkRequest(encoder func(*http.Request) goahttp.Encoder) fu
 		if p.Register != nil {
 			values.Add("register", fmt.Sprintf("%v", *p.Register))
 		}
+		if p.OccurrenceID != nil {
+			values.Add("occurrence_id", *p.OccurrenceID)
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -1846,6 +2471,7 @@ func EncodeGetItxJoinLinkRequest(encoder func(*http.Request) goahttp.Encoder) fu
 // response body should be restored after having been read.
 // DecodeGetItxJoinLinkResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
@@ -1896,6 +2522,20 @@ func DecodeGetItxJoinLinkResponse(decoder func(*http.Response) goahttp.Decoder,
 				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-join-link", err)
 			}
 			return nil, NewGetItxJoinLinkBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body GetItxJoinLinkConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-join-link", err)
+			}
+			err = ValidateGetItxJoinLinkConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-join-link", err)
+			}
+			return nil, NewGetItxJoinLinkConflict(&body)
 		case http.StatusForbidden:
 			var (
 				body GetItxJoinLinkForbiddenResponseBody
@@ -1973,26 +2613,24 @@ func DecodeGetItxJoinLinkResponse(decoder func(*http.Response) goahttp.Decoder,
 	}
 }
 
-// BuildGetItxRegistrantIcsRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "get-itx-registrant-ics" endpoint
-func (c *Client) BuildGetItxRegistrantIcsRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildLaunchItxMeetingRequest instantiates a HTTP request object with method
+// and path set to call the "Meeting Service" service "launch-itx-meeting"
+// endpoint
+func (c *Client) BuildLaunchItxMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		meetingID    string
-		registrantID string
+		meetingID string
 	)
 	{
-		p, ok := v.(*meetingservice.GetItxRegistrantIcsPayload)
+		p, ok := v.(*meetingservice.LaunchItxMeetingPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "get-itx-registrant-ics", "*meetingservice.GetItxRegistrantIcsPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "launch-itx-meeting", "*meetingservice.LaunchItxMeetingPayload", v)
 		}
 		meetingID = p.MeetingID
-		registrantID = p.RegistrantID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetItxRegistrantIcsMeetingServicePath(meetingID, registrantID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LaunchItxMeetingMeetingServicePath(meetingID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-itx-registrant-ics", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "launch-itx-meeting", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2001,13 +2639,13 @@ func (c *Client) BuildGetItxRegistrantIcsRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeGetItxRegistrantIcsRequest returns an encoder for requests sent to the
-// Meeting Service get-itx-registrant-ics server.
-func EncodeGetItxRegistrantIcsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeLaunchItxMeetingRequest returns an encoder for requests sent to the
+// Meeting Service launch-itx-meeting server.
+func EncodeLaunchItxMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.GetItxRegistrantIcsPayload)
+		p, ok := v.(*meetingservice.LaunchItxMeetingPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "get-itx-registrant-ics", "*meetingservice.GetItxRegistrantIcsPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "launch-itx-meeting", "*meetingservice.LaunchItxMeetingPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2017,27 +2655,51 @@ func EncodeGetItxRegistrantIcsRequest(encoder func(*http.Request) goahttp.Encode
 				req.Header.Set("Authorization", head)
 			}
 		}
+		if p.UserAgent != nil {
+			head := *p.UserAgent
+			req.Header.Set("User-Agent", head)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
+		if p.UseEmail != nil {
+			values.Add("use_email", fmt.Sprintf("%v", *p.UseEmail))
+		}
+		if p.UserID != nil {
+			values.Add("user_id", *p.UserID)
+		}
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.Email != nil {
+			values.Add("email", *p.Email)
+		}
+		if p.Register != nil {
+			values.Add("register", fmt.Sprintf("%v", *p.Register))
+		}
+		if p.OccurrenceID != nil {
+			values.Add("occurrence_id", *p.OccurrenceID)
+		}
+		values.Add("client", p.Client)
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeGetItxRegistrantIcsResponse returns a decoder for responses returned
-// by the Meeting Service get-itx-registrant-ics endpoint. restoreBody controls
+// DecodeLaunchItxMeetingResponse returns a decoder for responses returned by
+// the Meeting Service launch-itx-meeting endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeGetItxRegistrantIcsResponse may return the following errors:
+// DecodeLaunchItxMeetingResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeGetItxRegistrantIcsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeLaunchItxMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2052,127 +2714,146 @@ func DecodeGetItxRegistrantIcsResponse(decoder func(*http.Response) goahttp.Deco
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusFound:
 			var (
-				body []byte
+				location string
+				err      error
+			)
+			locationRaw := resp.Header.Get("Location")
+			if locationRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("location", "header"))
+			}
+			location = locationRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
+			}
+			res := NewLaunchItxMeetingResultFound(location)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body LaunchItxMeetingBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "launch-itx-meeting", err)
 			}
-			return body, nil
-		case http.StatusBadRequest:
+			err = ValidateLaunchItxMeetingBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
+			}
+			return nil, NewLaunchItxMeetingBadRequest(&body)
+		case http.StatusConflict:
 			var (
-				body GetItxRegistrantIcsBadRequestResponseBody
+				body LaunchItxMeetingConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "launch-itx-meeting", err)
 			}
-			err = ValidateGetItxRegistrantIcsBadRequestResponseBody(&body)
+			err = ValidateLaunchItxMeetingConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
 			}
-			return nil, NewGetItxRegistrantIcsBadRequest(&body)
+			return nil, NewLaunchItxMeetingConflict(&body)
 		case http.StatusForbidden:
 			var (
-				body GetItxRegistrantIcsForbiddenResponseBody
+				body LaunchItxMeetingForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "launch-itx-meeting", err)
 			}
-			err = ValidateGetItxRegistrantIcsForbiddenResponseBody(&body)
+			err = ValidateLaunchItxMeetingForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
 			}
-			return nil, NewGetItxRegistrantIcsForbidden(&body)
+			return nil, NewLaunchItxMeetingForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetItxRegistrantIcsInternalServerErrorResponseBody
+				body LaunchItxMeetingInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "launch-itx-meeting", err)
 			}
-			err = ValidateGetItxRegistrantIcsInternalServerErrorResponseBody(&body)
+			err = ValidateLaunchItxMeetingInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
 			}
-			return nil, NewGetItxRegistrantIcsInternalServerError(&body)
+			return nil, NewLaunchItxMeetingInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetItxRegistrantIcsNotFoundResponseBody
+				body LaunchItxMeetingNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "launch-itx-meeting", err)
 			}
-			err = ValidateGetItxRegistrantIcsNotFoundResponseBody(&body)
+			err = ValidateLaunchItxMeetingNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
 			}
-			return nil, NewGetItxRegistrantIcsNotFound(&body)
+			return nil, NewLaunchItxMeetingNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetItxRegistrantIcsServiceUnavailableResponseBody
+				body LaunchItxMeetingServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "launch-itx-meeting", err)
 			}
-			err = ValidateGetItxRegistrantIcsServiceUnavailableResponseBody(&body)
+			err = ValidateLaunchItxMeetingServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
 			}
-			return nil, NewGetItxRegistrantIcsServiceUnavailable(&body)
+			return nil, NewLaunchItxMeetingServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body GetItxRegistrantIcsUnauthorizedResponseBody
+				body LaunchItxMeetingUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "launch-itx-meeting", err)
 			}
-			err = ValidateGetItxRegistrantIcsUnauthorizedResponseBody(&body)
+			err = ValidateLaunchItxMeetingUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "launch-itx-meeting", err)
 			}
-			return nil, NewGetItxRegistrantIcsUnauthorized(&body)
+			return nil, NewLaunchItxMeetingUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-itx-registrant-ics", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "launch-itx-meeting", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildResendItxRegistrantInvitationRequest instantiates a HTTP request object
-// with method and path set to call the "Meeting Service" service
-// "resend-itx-registrant-invitation" endpoint
-func (c *Client) BuildResendItxRegistrantInvitationRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetItxRegistrantIcsRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "get-itx-registrant-ics" endpoint
+func (c *Client) BuildGetItxRegistrantIcsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		meetingID    string
 		registrantID string
 	)
 	{
-		p, ok := v.(*meetingservice.ResendItxRegistrantInvitationPayload)
+		p, ok := v.(*meetingservice.GetItxRegistrantIcsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "resend-itx-registrant-invitation", "*meetingservice.ResendItxRegistrantInvitationPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "get-itx-registrant-ics", "*meetingservice.GetItxRegistrantIcsPayload", v)
 		}
 		meetingID = p.MeetingID
 		registrantID = p.RegistrantID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResendItxRegistrantInvitationMeetingServicePath(meetingID, registrantID)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetItxRegistrantIcsMeetingServicePath(meetingID, registrantID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "resend-itx-registrant-invitation", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-itx-registrant-ics", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2181,13 +2862,13 @@ func (c *Client) BuildResendItxRegistrantInvitationRequest(ctx context.Context,
 	return req, nil
 }
 
-// EncodeResendItxRegistrantInvitationRequest returns an encoder for requests
-// sent to the Meeting Service resend-itx-registrant-invitation server.
-func EncodeResendItxRegistrantInvitationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetItxRegistrantIcsRequest returns an encoder for requests sent to the
+// Meeting Service get-itx-registrant-ics server.
+func EncodeGetItxRegistrantIcsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.ResendItxRegistrantInvitationPayload)
+		p, ok := v.(*meetingservice.GetItxRegistrantIcsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "resend-itx-registrant-invitation", "*meetingservice.ResendItxRegistrantInvitationPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "get-itx-registrant-ics", "*meetingservice.GetItxRegistrantIcsPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2206,11 +2887,10 @@ func EncodeResendItxRegistrantInvitationRequest(encoder func(*http.Request) goah
 	}
 }
 
-// DecodeResendItxRegistrantInvitationResponse returns a decoder for responses
-// returned by the Meeting Service resend-itx-registrant-invitation endpoint.
-// restoreBody controls whether the response body should be restored after
-// having been read.
-// DecodeResendItxRegistrantInvitationResponse may return the following errors:
+// DecodeGetItxRegistrantIcsResponse returns a decoder for responses returned
+// by the Meeting Service get-itx-registrant-ics endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetItxRegistrantIcsResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -2218,7 +2898,7 @@ func EncodeResendItxRegistrantInvitationRequest(encoder func(*http.Request) goah
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeResendItxRegistrantInvitationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetItxRegistrantIcsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2233,117 +2913,127 @@ func DecodeResendItxRegistrantInvitationResponse(decoder func(*http.Response) go
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
+			}
+			return body, nil
 		case http.StatusBadRequest:
 			var (
-				body ResendItxRegistrantInvitationBadRequestResponseBody
+				body GetItxRegistrantIcsBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			err = ValidateResendItxRegistrantInvitationBadRequestResponseBody(&body)
+			err = ValidateGetItxRegistrantIcsBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			return nil, NewResendItxRegistrantInvitationBadRequest(&body)
+			return nil, NewGetItxRegistrantIcsBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body ResendItxRegistrantInvitationForbiddenResponseBody
+				body GetItxRegistrantIcsForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			err = ValidateResendItxRegistrantInvitationForbiddenResponseBody(&body)
+			err = ValidateGetItxRegistrantIcsForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			return nil, NewResendItxRegistrantInvitationForbidden(&body)
+			return nil, NewGetItxRegistrantIcsForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ResendItxRegistrantInvitationInternalServerErrorResponseBody
+				body GetItxRegistrantIcsInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			err = ValidateResendItxRegistrantInvitationInternalServerErrorResponseBody(&body)
+			err = ValidateGetItxRegistrantIcsInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			return nil, NewResendItxRegistrantInvitationInternalServerError(&body)
+			return nil, NewGetItxRegistrantIcsInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body ResendItxRegistrantInvitationNotFoundResponseBody
+				body GetItxRegistrantIcsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			err = ValidateResendItxRegistrantInvitationNotFoundResponseBody(&body)
+			err = ValidateGetItxRegistrantIcsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			return nil, NewResendItxRegistrantInvitationNotFound(&body)
+			return nil, NewGetItxRegistrantIcsNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body ResendItxRegistrantInvitationServiceUnavailableResponseBody
+				body GetItxRegistrantIcsServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			err = ValidateResendItxRegistrantInvitationServiceUnavailableResponseBody(&body)
+			err = ValidateGetItxRegistrantIcsServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			return nil, NewResendItxRegistrantInvitationServiceUnavailable(&body)
+			return nil, NewGetItxRegistrantIcsServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body ResendItxRegistrantInvitationUnauthorizedResponseBody
+				body GetItxRegistrantIcsUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			err = ValidateResendItxRegistrantInvitationUnauthorizedResponseBody(&body)
+			err = ValidateGetItxRegistrantIcsUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-registrant-ics", err)
 			}
-			return nil, NewResendItxRegistrantInvitationUnauthorized(&body)
+			return nil, NewGetItxRegistrantIcsUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "resend-itx-registrant-invitation", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-itx-registrant-ics", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildResendItxMeetingInvitationsRequest instantiates a HTTP request object
-// with method and path set to call the "Meeting Service" service
-// "resend-itx-meeting-invitations" endpoint
-func (c *Client) BuildResendItxMeetingInvitationsRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildAddItxRegistrantHostRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "add-itx-registrant-host" endpoint
+func (c *Client) BuildAddItxRegistrantHostRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		meetingID string
+		meetingID    string
+		registrantID string
 	)
 	{
-		p, ok := v.(*meetingservice.ResendItxMeetingInvitationsPayload)
+		p, ok := v.(*meetingservice.AddItxRegistrantHostPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "resend-itx-meeting-invitations", "*meetingservice.ResendItxMeetingInvitationsPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "add-itx-registrant-host", "*meetingservice.AddItxRegistrantHostPayload", v)
 		}
 		meetingID = p.MeetingID
+		registrantID = p.RegistrantID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResendItxMeetingInvitationsMeetingServicePath(meetingID)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddItxRegistrantHostMeetingServicePath(meetingID, registrantID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "resend-itx-meeting-invitations", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "add-itx-registrant-host", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2352,13 +3042,13 @@ func (c *Client) BuildResendItxMeetingInvitationsRequest(ctx context.Context, v
 	return req, nil
 }
 
-// EncodeResendItxMeetingInvitationsRequest returns an encoder for requests
-// sent to the Meeting Service resend-itx-meeting-invitations server.
-func EncodeResendItxMeetingInvitationsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeAddItxRegistrantHostRequest returns an encoder for requests sent to
+// the Meeting Service add-itx-registrant-host server.
+func EncodeAddItxRegistrantHostRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.ResendItxMeetingInvitationsPayload)
+		p, ok := v.(*meetingservice.AddItxRegistrantHostPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "resend-itx-meeting-invitations", "*meetingservice.ResendItxMeetingInvitationsPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "add-itx-registrant-host", "*meetingservice.AddItxRegistrantHostPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2373,19 +3063,14 @@ func EncodeResendItxMeetingInvitationsRequest(encoder func(*http.Request) goahtt
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewResendItxMeetingInvitationsRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "resend-itx-meeting-invitations", err)
-		}
 		return nil
 	}
 }
 
-// DecodeResendItxMeetingInvitationsResponse returns a decoder for responses
-// returned by the Meeting Service resend-itx-meeting-invitations endpoint.
-// restoreBody controls whether the response body should be restored after
-// having been read.
-// DecodeResendItxMeetingInvitationsResponse may return the following errors:
+// DecodeAddItxRegistrantHostResponse returns a decoder for responses returned
+// by the Meeting Service add-itx-registrant-host endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeAddItxRegistrantHostResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -2393,7 +3078,7 @@ func EncodeResendItxMeetingInvitationsRequest(encoder func(*http.Request) goahtt
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeResendItxMeetingInvitationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeAddItxRegistrantHostResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2408,117 +3093,132 @@ func DecodeResendItxMeetingInvitationsResponse(decoder func(*http.Response) goah
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
+		case http.StatusOK:
+			var (
+				body AddItxRegistrantHostResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "add-itx-registrant-host", err)
+			}
+			err = ValidateAddItxRegistrantHostResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "add-itx-registrant-host", err)
+			}
+			res := NewAddItxRegistrantHostITXZoomMeetingRegistrantOK(&body)
+			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body ResendItxMeetingInvitationsBadRequestResponseBody
+				body AddItxRegistrantHostBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			err = ValidateResendItxMeetingInvitationsBadRequestResponseBody(&body)
+			err = ValidateAddItxRegistrantHostBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			return nil, NewResendItxMeetingInvitationsBadRequest(&body)
+			return nil, NewAddItxRegistrantHostBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body ResendItxMeetingInvitationsForbiddenResponseBody
+				body AddItxRegistrantHostForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			err = ValidateResendItxMeetingInvitationsForbiddenResponseBody(&body)
+			err = ValidateAddItxRegistrantHostForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			return nil, NewResendItxMeetingInvitationsForbidden(&body)
+			return nil, NewAddItxRegistrantHostForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ResendItxMeetingInvitationsInternalServerErrorResponseBody
+				body AddItxRegistrantHostInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			err = ValidateResendItxMeetingInvitationsInternalServerErrorResponseBody(&body)
+			err = ValidateAddItxRegistrantHostInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			return nil, NewResendItxMeetingInvitationsInternalServerError(&body)
+			return nil, NewAddItxRegistrantHostInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body ResendItxMeetingInvitationsNotFoundResponseBody
+				body AddItxRegistrantHostNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			err = ValidateResendItxMeetingInvitationsNotFoundResponseBody(&body)
+			err = ValidateAddItxRegistrantHostNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			return nil, NewResendItxMeetingInvitationsNotFound(&body)
+			return nil, NewAddItxRegistrantHostNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body ResendItxMeetingInvitationsServiceUnavailableResponseBody
+				body AddItxRegistrantHostServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			err = ValidateResendItxMeetingInvitationsServiceUnavailableResponseBody(&body)
+			err = ValidateAddItxRegistrantHostServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			return nil, NewResendItxMeetingInvitationsServiceUnavailable(&body)
+			return nil, NewAddItxRegistrantHostServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body ResendItxMeetingInvitationsUnauthorizedResponseBody
+				body AddItxRegistrantHostUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			err = ValidateResendItxMeetingInvitationsUnauthorizedResponseBody(&body)
+			err = ValidateAddItxRegistrantHostUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "add-itx-registrant-host", err)
 			}
-			return nil, NewResendItxMeetingInvitationsUnauthorized(&body)
+			return nil, NewAddItxRegistrantHostUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "resend-itx-meeting-invitations", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "add-itx-registrant-host", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildRegisterItxCommitteeMembersRequest instantiates a HTTP request object
-// with method and path set to call the "Meeting Service" service
-// "register-itx-committee-members" endpoint
-func (c *Client) BuildRegisterItxCommitteeMembersRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildRemoveItxRegistrantHostRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "remove-itx-registrant-host" endpoint
+func (c *Client) BuildRemoveItxRegistrantHostRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		meetingID string
+		meetingID    string
+		registrantID string
 	)
 	{
-		p, ok := v.(*meetingservice.RegisterItxCommitteeMembersPayload)
+		p, ok := v.(*meetingservice.RemoveItxRegistrantHostPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "register-itx-committee-members", "*meetingservice.RegisterItxCommitteeMembersPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "remove-itx-registrant-host", "*meetingservice.RemoveItxRegistrantHostPayload", v)
 		}
 		meetingID = p.MeetingID
+		registrantID = p.RegistrantID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RegisterItxCommitteeMembersMeetingServicePath(meetingID)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveItxRegistrantHostMeetingServicePath(meetingID, registrantID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "register-itx-committee-members", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "remove-itx-registrant-host", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2527,13 +3227,13 @@ func (c *Client) BuildRegisterItxCommitteeMembersRequest(ctx context.Context, v
 	return req, nil
 }
 
-// EncodeRegisterItxCommitteeMembersRequest returns an encoder for requests
-// sent to the Meeting Service register-itx-committee-members server.
-func EncodeRegisterItxCommitteeMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRemoveItxRegistrantHostRequest returns an encoder for requests sent to
+// the Meeting Service remove-itx-registrant-host server.
+func EncodeRemoveItxRegistrantHostRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.RegisterItxCommitteeMembersPayload)
+		p, ok := v.(*meetingservice.RemoveItxRegistrantHostPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "register-itx-committee-members", "*meetingservice.RegisterItxCommitteeMembersPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "remove-itx-registrant-host", "*meetingservice.RemoveItxRegistrantHostPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2552,11 +3252,11 @@ func EncodeRegisterItxCommitteeMembersRequest(encoder func(*http.Request) goahtt
 	}
 }
 
-// DecodeRegisterItxCommitteeMembersResponse returns a decoder for responses
-// returned by the Meeting Service register-itx-committee-members endpoint.
+// DecodeRemoveItxRegistrantHostResponse returns a decoder for responses
+// returned by the Meeting Service remove-itx-registrant-host endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeRegisterItxCommitteeMembersResponse may return the following errors:
+// DecodeRemoveItxRegistrantHostResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -2564,7 +3264,7 @@ func EncodeRegisterItxCommitteeMembersRequest(encoder func(*http.Request) goahtt
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeRegisterItxCommitteeMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRemoveItxRegistrantHostResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2579,119 +3279,132 @@ func DecodeRegisterItxCommitteeMembersResponse(decoder func(*http.Response) goah
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
+		case http.StatusOK:
+			var (
+				body RemoveItxRegistrantHostResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "remove-itx-registrant-host", err)
+			}
+			err = ValidateRemoveItxRegistrantHostResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "remove-itx-registrant-host", err)
+			}
+			res := NewRemoveItxRegistrantHostITXZoomMeetingRegistrantOK(&body)
+			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body RegisterItxCommitteeMembersBadRequestResponseBody
+				body RemoveItxRegistrantHostBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			err = ValidateRegisterItxCommitteeMembersBadRequestResponseBody(&body)
+			err = ValidateRemoveItxRegistrantHostBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			return nil, NewRegisterItxCommitteeMembersBadRequest(&body)
+			return nil, NewRemoveItxRegistrantHostBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body RegisterItxCommitteeMembersForbiddenResponseBody
+				body RemoveItxRegistrantHostForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			err = ValidateRegisterItxCommitteeMembersForbiddenResponseBody(&body)
+			err = ValidateRemoveItxRegistrantHostForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			return nil, NewRegisterItxCommitteeMembersForbidden(&body)
+			return nil, NewRemoveItxRegistrantHostForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body RegisterItxCommitteeMembersInternalServerErrorResponseBody
+				body RemoveItxRegistrantHostInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			err = ValidateRegisterItxCommitteeMembersInternalServerErrorResponseBody(&body)
+			err = ValidateRemoveItxRegistrantHostInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			return nil, NewRegisterItxCommitteeMembersInternalServerError(&body)
+			return nil, NewRemoveItxRegistrantHostInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body RegisterItxCommitteeMembersNotFoundResponseBody
+				body RemoveItxRegistrantHostNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			err = ValidateRegisterItxCommitteeMembersNotFoundResponseBody(&body)
+			err = ValidateRemoveItxRegistrantHostNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			return nil, NewRegisterItxCommitteeMembersNotFound(&body)
+			return nil, NewRemoveItxRegistrantHostNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body RegisterItxCommitteeMembersServiceUnavailableResponseBody
+				body RemoveItxRegistrantHostServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			err = ValidateRegisterItxCommitteeMembersServiceUnavailableResponseBody(&body)
+			err = ValidateRemoveItxRegistrantHostServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			return nil, NewRegisterItxCommitteeMembersServiceUnavailable(&body)
+			return nil, NewRemoveItxRegistrantHostServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body RegisterItxCommitteeMembersUnauthorizedResponseBody
+				body RemoveItxRegistrantHostUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			err = ValidateRegisterItxCommitteeMembersUnauthorizedResponseBody(&body)
+			err = ValidateRemoveItxRegistrantHostUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "remove-itx-registrant-host", err)
 			}
-			return nil, NewRegisterItxCommitteeMembersUnauthorized(&body)
+			return nil, NewRemoveItxRegistrantHostUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "register-itx-committee-members", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "remove-itx-registrant-host", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateItxOccurrenceRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "update-itx-occurrence" endpoint
-func (c *Client) BuildUpdateItxOccurrenceRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildResendItxRegistrantInvitationRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "resend-itx-registrant-invitation" endpoint
+func (c *Client) BuildResendItxRegistrantInvitationRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		meetingID    string
-		occurrenceID string
+		registrantID string
 	)
 	{
-		p, ok := v.(*meetingservice.UpdateItxOccurrencePayload)
+		p, ok := v.(*meetingservice.ResendItxRegistrantInvitationPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-occurrence", "*meetingservice.UpdateItxOccurrencePayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "resend-itx-registrant-invitation", "*meetingservice.ResendItxRegistrantInvitationPayload", v)
 		}
 		meetingID = p.MeetingID
-		occurrenceID = p.OccurrenceID
+		registrantID = p.RegistrantID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxOccurrenceMeetingServicePath(meetingID, occurrenceID)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResendItxRegistrantInvitationMeetingServicePath(meetingID, registrantID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-occurrence", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "resend-itx-registrant-invitation", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2700,13 +3413,13 @@ func (c *Client) BuildUpdateItxOccurrenceRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeUpdateItxOccurrenceRequest returns an encoder for requests sent to the
-// Meeting Service update-itx-occurrence server.
-func EncodeUpdateItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeResendItxRegistrantInvitationRequest returns an encoder for requests
+// sent to the Meeting Service resend-itx-registrant-invitation server.
+func EncodeResendItxRegistrantInvitationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.UpdateItxOccurrencePayload)
+		p, ok := v.(*meetingservice.ResendItxRegistrantInvitationPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "update-itx-occurrence", "*meetingservice.UpdateItxOccurrencePayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "resend-itx-registrant-invitation", "*meetingservice.ResendItxRegistrantInvitationPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2721,18 +3434,15 @@ func EncodeUpdateItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encode
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewUpdateItxOccurrenceRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "update-itx-occurrence", err)
-		}
 		return nil
 	}
 }
 
-// DecodeUpdateItxOccurrenceResponse returns a decoder for responses returned
-// by the Meeting Service update-itx-occurrence endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeUpdateItxOccurrenceResponse may return the following errors:
+// DecodeResendItxRegistrantInvitationResponse returns a decoder for responses
+// returned by the Meeting Service resend-itx-registrant-invitation endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeResendItxRegistrantInvitationResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -2740,7 +3450,7 @@ func EncodeUpdateItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encode
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeUpdateItxOccurrenceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeResendItxRegistrantInvitationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2759,115 +3469,113 @@ func DecodeUpdateItxOccurrenceResponse(decoder func(*http.Response) goahttp.Deco
 			return nil, nil
 		case http.StatusBadRequest:
 			var (
-				body UpdateItxOccurrenceBadRequestResponseBody
+				body ResendItxRegistrantInvitationBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			err = ValidateUpdateItxOccurrenceBadRequestResponseBody(&body)
+			err = ValidateResendItxRegistrantInvitationBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			return nil, NewUpdateItxOccurrenceBadRequest(&body)
+			return nil, NewResendItxRegistrantInvitationBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body UpdateItxOccurrenceForbiddenResponseBody
+				body ResendItxRegistrantInvitationForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			err = ValidateUpdateItxOccurrenceForbiddenResponseBody(&body)
+			err = ValidateResendItxRegistrantInvitationForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			return nil, NewUpdateItxOccurrenceForbidden(&body)
+			return nil, NewResendItxRegistrantInvitationForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateItxOccurrenceInternalServerErrorResponseBody
+				body ResendItxRegistrantInvitationInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			err = ValidateUpdateItxOccurrenceInternalServerErrorResponseBody(&body)
+			err = ValidateResendItxRegistrantInvitationInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			return nil, NewUpdateItxOccurrenceInternalServerError(&body)
+			return nil, NewResendItxRegistrantInvitationInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body UpdateItxOccurrenceNotFoundResponseBody
+				body ResendItxRegistrantInvitationNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			err = ValidateUpdateItxOccurrenceNotFoundResponseBody(&body)
+			err = ValidateResendItxRegistrantInvitationNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			return nil, NewUpdateItxOccurrenceNotFound(&body)
+			return nil, NewResendItxRegistrantInvitationNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UpdateItxOccurrenceServiceUnavailableResponseBody
+				body ResendItxRegistrantInvitationServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			err = ValidateUpdateItxOccurrenceServiceUnavailableResponseBody(&body)
+			err = ValidateResendItxRegistrantInvitationServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			return nil, NewUpdateItxOccurrenceServiceUnavailable(&body)
+			return nil, NewResendItxRegistrantInvitationServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body UpdateItxOccurrenceUnauthorizedResponseBody
+				body ResendItxRegistrantInvitationUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			err = ValidateUpdateItxOccurrenceUnauthorizedResponseBody(&body)
+			err = ValidateResendItxRegistrantInvitationUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-registrant-invitation", err)
 			}
-			return nil, NewUpdateItxOccurrenceUnauthorized(&body)
+			return nil, NewResendItxRegistrantInvitationUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "update-itx-occurrence", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "resend-itx-registrant-invitation", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteItxOccurrenceRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "delete-itx-occurrence" endpoint
-func (c *Client) BuildDeleteItxOccurrenceRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildResendItxMeetingInvitationsRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "resend-itx-meeting-invitations" endpoint
+func (c *Client) BuildResendItxMeetingInvitationsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		meetingID    string
-		occurrenceID string
+		meetingID string
 	)
 	{
-		p, ok := v.(*meetingservice.DeleteItxOccurrencePayload)
+		p, ok := v.(*meetingservice.ResendItxMeetingInvitationsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "delete-itx-occurrence", "*meetingservice.DeleteItxOccurrencePayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "resend-itx-meeting-invitations", "*meetingservice.ResendItxMeetingInvitationsPayload", v)
 		}
 		meetingID = p.MeetingID
-		occurrenceID = p.OccurrenceID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteItxOccurrenceMeetingServicePath(meetingID, occurrenceID)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResendItxMeetingInvitationsMeetingServicePath(meetingID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "delete-itx-occurrence", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "resend-itx-meeting-invitations", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2876,13 +3584,13 @@ func (c *Client) BuildDeleteItxOccurrenceRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeDeleteItxOccurrenceRequest returns an encoder for requests sent to the
-// Meeting Service delete-itx-occurrence server.
-func EncodeDeleteItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeResendItxMeetingInvitationsRequest returns an encoder for requests
+// sent to the Meeting Service resend-itx-meeting-invitations server.
+func EncodeResendItxMeetingInvitationsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.DeleteItxOccurrencePayload)
+		p, ok := v.(*meetingservice.ResendItxMeetingInvitationsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "delete-itx-occurrence", "*meetingservice.DeleteItxOccurrencePayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "resend-itx-meeting-invitations", "*meetingservice.ResendItxMeetingInvitationsPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2897,14 +3605,19 @@ func EncodeDeleteItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encode
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
+		body := NewResendItxMeetingInvitationsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "resend-itx-meeting-invitations", err)
+		}
 		return nil
 	}
 }
 
-// DecodeDeleteItxOccurrenceResponse returns a decoder for responses returned
-// by the Meeting Service delete-itx-occurrence endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeDeleteItxOccurrenceResponse may return the following errors:
+// DecodeResendItxMeetingInvitationsResponse returns a decoder for responses
+// returned by the Meeting Service resend-itx-meeting-invitations endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeResendItxMeetingInvitationsResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -2912,7 +3625,7 @@ func EncodeDeleteItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encode
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeDeleteItxOccurrenceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeResendItxMeetingInvitationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2931,113 +3644,113 @@ func DecodeDeleteItxOccurrenceResponse(decoder func(*http.Response) goahttp.Deco
 			return nil, nil
 		case http.StatusBadRequest:
 			var (
-				body DeleteItxOccurrenceBadRequestResponseBody
+				body ResendItxMeetingInvitationsBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			err = ValidateDeleteItxOccurrenceBadRequestResponseBody(&body)
+			err = ValidateResendItxMeetingInvitationsBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			return nil, NewDeleteItxOccurrenceBadRequest(&body)
+			return nil, NewResendItxMeetingInvitationsBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body DeleteItxOccurrenceForbiddenResponseBody
+				body ResendItxMeetingInvitationsForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			err = ValidateDeleteItxOccurrenceForbiddenResponseBody(&body)
+			err = ValidateResendItxMeetingInvitationsForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			return nil, NewDeleteItxOccurrenceForbidden(&body)
+			return nil, NewResendItxMeetingInvitationsForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteItxOccurrenceInternalServerErrorResponseBody
+				body ResendItxMeetingInvitationsInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			err = ValidateDeleteItxOccurrenceInternalServerErrorResponseBody(&body)
+			err = ValidateResendItxMeetingInvitationsInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			return nil, NewDeleteItxOccurrenceInternalServerError(&body)
+			return nil, NewResendItxMeetingInvitationsInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteItxOccurrenceNotFoundResponseBody
+				body ResendItxMeetingInvitationsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			err = ValidateDeleteItxOccurrenceNotFoundResponseBody(&body)
+			err = ValidateResendItxMeetingInvitationsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			return nil, NewDeleteItxOccurrenceNotFound(&body)
+			return nil, NewResendItxMeetingInvitationsNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteItxOccurrenceServiceUnavailableResponseBody
+				body ResendItxMeetingInvitationsServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			err = ValidateDeleteItxOccurrenceServiceUnavailableResponseBody(&body)
+			err = ValidateResendItxMeetingInvitationsServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			return nil, NewDeleteItxOccurrenceServiceUnavailable(&body)
+			return nil, NewResendItxMeetingInvitationsServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body DeleteItxOccurrenceUnauthorizedResponseBody
+				body ResendItxMeetingInvitationsUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			err = ValidateDeleteItxOccurrenceUnauthorizedResponseBody(&body)
+			err = ValidateResendItxMeetingInvitationsUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "resend-itx-meeting-invitations", err)
 			}
-			return nil, NewDeleteItxOccurrenceUnauthorized(&body)
+			return nil, NewResendItxMeetingInvitationsUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "delete-itx-occurrence", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "resend-itx-meeting-invitations", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildSubmitItxMeetingResponseRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "submit-itx-meeting-response" endpoint
-func (c *Client) BuildSubmitItxMeetingResponseRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildRegisterItxCommitteeMembersRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "register-itx-committee-members" endpoint
+func (c *Client) BuildRegisterItxCommitteeMembersRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		meetingID string
 	)
 	{
-		p, ok := v.(*meetingservice.SubmitItxMeetingResponsePayload)
+		p, ok := v.(*meetingservice.RegisterItxCommitteeMembersPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "submit-itx-meeting-response", "*meetingservice.SubmitItxMeetingResponsePayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "register-itx-committee-members", "*meetingservice.RegisterItxCommitteeMembersPayload", v)
 		}
 		meetingID = p.MeetingID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SubmitItxMeetingResponseMeetingServicePath(meetingID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RegisterItxCommitteeMembersMeetingServicePath(meetingID)}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "submit-itx-meeting-response", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "register-itx-committee-members", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3046,13 +3759,13 @@ func (c *Client) BuildSubmitItxMeetingResponseRequest(ctx context.Context, v any
 	return req, nil
 }
 
-// EncodeSubmitItxMeetingResponseRequest returns an encoder for requests sent
-// to the Meeting Service submit-itx-meeting-response server.
-func EncodeSubmitItxMeetingResponseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRegisterItxCommitteeMembersRequest returns an encoder for requests
+// sent to the Meeting Service register-itx-committee-members server.
+func EncodeRegisterItxCommitteeMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.SubmitItxMeetingResponsePayload)
+		p, ok := v.(*meetingservice.RegisterItxCommitteeMembersPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "submit-itx-meeting-response", "*meetingservice.SubmitItxMeetingResponsePayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "register-itx-committee-members", "*meetingservice.RegisterItxCommitteeMembersPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3067,19 +3780,15 @@ func EncodeSubmitItxMeetingResponseRequest(encoder func(*http.Request) goahttp.E
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewSubmitItxMeetingResponseRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "submit-itx-meeting-response", err)
-		}
 		return nil
 	}
 }
 
-// DecodeSubmitItxMeetingResponseResponse returns a decoder for responses
-// returned by the Meeting Service submit-itx-meeting-response endpoint.
+// DecodeRegisterItxCommitteeMembersResponse returns a decoder for responses
+// returned by the Meeting Service register-itx-committee-members endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeSubmitItxMeetingResponseResponse may return the following errors:
+// DecodeRegisterItxCommitteeMembersResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -3087,7 +3796,7 @@ func EncodeSubmitItxMeetingResponseRequest(encoder func(*http.Request) goahttp.E
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeSubmitItxMeetingResponseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRegisterItxCommitteeMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3102,120 +3811,117 @@ func DecodeSubmitItxMeetingResponseResponse(decoder func(*http.Response) goahttp
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
-			var (
-				body SubmitItxMeetingResponseResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
-			}
-			err = ValidateSubmitItxMeetingResponseResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
-			}
-			res := NewSubmitItxMeetingResponseITXMeetingResponseResultCreated(&body)
-			return res, nil
+		case http.StatusNoContent:
+			return nil, nil
 		case http.StatusBadRequest:
 			var (
-				body SubmitItxMeetingResponseBadRequestResponseBody
+				body RegisterItxCommitteeMembersBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
 			}
-			err = ValidateSubmitItxMeetingResponseBadRequestResponseBody(&body)
+			err = ValidateRegisterItxCommitteeMembersBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
 			}
-			return nil, NewSubmitItxMeetingResponseBadRequest(&body)
+			return nil, NewRegisterItxCommitteeMembersBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body SubmitItxMeetingResponseForbiddenResponseBody
+				body RegisterItxCommitteeMembersForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
 			}
-			err = ValidateSubmitItxMeetingResponseForbiddenResponseBody(&body)
+			err = ValidateRegisterItxCommitteeMembersForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
 			}
-			return nil, NewSubmitItxMeetingResponseForbidden(&body)
+			return nil, NewRegisterItxCommitteeMembersForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body SubmitItxMeetingResponseInternalServerErrorResponseBody
+				body RegisterItxCommitteeMembersInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
 			}
-			err = ValidateSubmitItxMeetingResponseInternalServerErrorResponseBody(&body)
+			err = ValidateRegisterItxCommitteeMembersInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
 			}
-			return nil, NewSubmitItxMeetingResponseInternalServerError(&body)
+			return nil, NewRegisterItxCommitteeMembersInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body SubmitItxMeetingResponseNotFoundResponseBody
+				body RegisterItxCommitteeMembersNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
 			}
-			err = ValidateSubmitItxMeetingResponseNotFoundResponseBody(&body)
+			err = ValidateRegisterItxCommitteeMembersNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
 			}
-			return nil, NewSubmitItxMeetingResponseNotFound(&body)
+			return nil, NewRegisterItxCommitteeMembersNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body SubmitItxMeetingResponseServiceUnavailableResponseBody
+				body RegisterItxCommitteeMembersServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
 			}
-			err = ValidateSubmitItxMeetingResponseServiceUnavailableResponseBody(&body)
+			err = ValidateRegisterItxCommitteeMembersServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
 			}
-			return nil, NewSubmitItxMeetingResponseServiceUnavailable(&body)
+			return nil, NewRegisterItxCommitteeMembersServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body SubmitItxMeetingResponseUnauthorizedResponseBody
+				body RegisterItxCommitteeMembersUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "register-itx-committee-members", err)
 			}
-			err = ValidateSubmitItxMeetingResponseUnauthorizedResponseBody(&body)
+			err = ValidateRegisterItxCommitteeMembersUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "register-itx-committee-members", err)
 			}
-			return nil, NewSubmitItxMeetingResponseUnauthorized(&body)
+			return nil, NewRegisterItxCommitteeMembersUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "submit-itx-meeting-response", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "register-itx-committee-members", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCreateItxPastMeetingRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "create-itx-past-meeting" endpoint
-func (c *Client) BuildCreateItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateItxPastMeetingMeetingServicePath()}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// BuildListItxMeetingOccurrencesRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "list-itx-meeting-occurrences" endpoint
+func (c *Client) BuildListItxMeetingOccurrencesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		meetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.ListItxMeetingOccurrencesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "list-itx-meeting-occurrences", "*meetingservice.ListItxMeetingOccurrencesPayload", v)
+		}
+		meetingID = p.MeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListItxMeetingOccurrencesMeetingServicePath(meetingID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "create-itx-past-meeting", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "list-itx-meeting-occurrences", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3224,13 +3930,13 @@ func (c *Client) BuildCreateItxPastMeetingRequest(ctx context.Context, v any) (*
 	return req, nil
 }
 
-// EncodeCreateItxPastMeetingRequest returns an encoder for requests sent to
-// the Meeting Service create-itx-past-meeting server.
-func EncodeCreateItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListItxMeetingOccurrencesRequest returns an encoder for requests sent
+// to the Meeting Service list-itx-meeting-occurrences server.
+func EncodeListItxMeetingOccurrencesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.CreateItxPastMeetingPayload)
+		p, ok := v.(*meetingservice.ListItxMeetingOccurrencesPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "create-itx-past-meeting", "*meetingservice.CreateItxPastMeetingPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "list-itx-meeting-occurrences", "*meetingservice.ListItxMeetingOccurrencesPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3244,28 +3950,33 @@ func EncodeCreateItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encod
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
-		req.URL.RawQuery = values.Encode()
-		body := NewCreateItxPastMeetingRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "create-itx-past-meeting", err)
+		if p.Tz != nil {
+			values.Add("tz", *p.Tz)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
 		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeCreateItxPastMeetingResponse returns a decoder for responses returned
-// by the Meeting Service create-itx-past-meeting endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeCreateItxPastMeetingResponse may return the following errors:
+// DecodeListItxMeetingOccurrencesResponse returns a decoder for responses
+// returned by the Meeting Service list-itx-meeting-occurrences endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeListItxMeetingOccurrencesResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeCreateItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListItxMeetingOccurrencesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3280,144 +3991,132 @@ func DecodeCreateItxPastMeetingResponse(decoder func(*http.Response) goahttp.Dec
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body CreateItxPastMeetingResponseBody
+				body ListItxMeetingOccurrencesResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxPastMeetingResponseBody(&body)
+			err = ValidateListItxMeetingOccurrencesResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			res := NewCreateItxPastMeetingITXPastZoomMeetingCreated(&body)
+			res := NewListItxMeetingOccurrencesITXOccurrenceListResponseOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body CreateItxPastMeetingBadRequestResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
-			}
-			err = ValidateCreateItxPastMeetingBadRequestResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
-			}
-			return nil, NewCreateItxPastMeetingBadRequest(&body)
-		case http.StatusConflict:
-			var (
-				body CreateItxPastMeetingConflictResponseBody
+				body ListItxMeetingOccurrencesBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxPastMeetingConflictResponseBody(&body)
+			err = ValidateListItxMeetingOccurrencesBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxPastMeetingConflict(&body)
+			return nil, NewListItxMeetingOccurrencesBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body CreateItxPastMeetingForbiddenResponseBody
+				body ListItxMeetingOccurrencesForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxPastMeetingForbiddenResponseBody(&body)
+			err = ValidateListItxMeetingOccurrencesForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxPastMeetingForbidden(&body)
+			return nil, NewListItxMeetingOccurrencesForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body CreateItxPastMeetingInternalServerErrorResponseBody
+				body ListItxMeetingOccurrencesInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxPastMeetingInternalServerErrorResponseBody(&body)
+			err = ValidateListItxMeetingOccurrencesInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxPastMeetingInternalServerError(&body)
+			return nil, NewListItxMeetingOccurrencesInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body CreateItxPastMeetingNotFoundResponseBody
+				body ListItxMeetingOccurrencesNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxPastMeetingNotFoundResponseBody(&body)
+			err = ValidateListItxMeetingOccurrencesNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxPastMeetingNotFound(&body)
+			return nil, NewListItxMeetingOccurrencesNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body CreateItxPastMeetingServiceUnavailableResponseBody
+				body ListItxMeetingOccurrencesServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxPastMeetingServiceUnavailableResponseBody(&body)
+			err = ValidateListItxMeetingOccurrencesServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxPastMeetingServiceUnavailable(&body)
+			return nil, NewListItxMeetingOccurrencesServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body CreateItxPastMeetingUnauthorizedResponseBody
+				body ListItxMeetingOccurrencesUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			err = ValidateCreateItxPastMeetingUnauthorizedResponseBody(&body)
+			err = ValidateListItxMeetingOccurrencesUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "list-itx-meeting-occurrences", err)
 			}
-			return nil, NewCreateItxPastMeetingUnauthorized(&body)
+			return nil, NewListItxMeetingOccurrencesUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "create-itx-past-meeting", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "list-itx-meeting-occurrences", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetItxPastMeetingRequest instantiates a HTTP request object with method
-// and path set to call the "Meeting Service" service "get-itx-past-meeting"
-// endpoint
-func (c *Client) BuildGetItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildUpdateItxOccurrenceRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "update-itx-occurrence" endpoint
+func (c *Client) BuildUpdateItxOccurrenceRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		pastMeetingID string
+		meetingID    string
+		occurrenceID string
 	)
 	{
-		p, ok := v.(*meetingservice.GetItxPastMeetingPayload)
+		p, ok := v.(*meetingservice.UpdateItxOccurrencePayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting", "*meetingservice.GetItxPastMeetingPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-occurrence", "*meetingservice.UpdateItxOccurrencePayload", v)
 		}
-		pastMeetingID = p.PastMeetingID
+		meetingID = p.MeetingID
+		occurrenceID = p.OccurrenceID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetItxPastMeetingMeetingServicePath(pastMeetingID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxOccurrenceMeetingServicePath(meetingID, occurrenceID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-itx-past-meeting", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-occurrence", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3426,13 +4125,13 @@ func (c *Client) BuildGetItxPastMeetingRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeGetItxPastMeetingRequest returns an encoder for requests sent to the
-// Meeting Service get-itx-past-meeting server.
-func EncodeGetItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUpdateItxOccurrenceRequest returns an encoder for requests sent to the
+// Meeting Service update-itx-occurrence server.
+func EncodeUpdateItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.GetItxPastMeetingPayload)
+		p, ok := v.(*meetingservice.UpdateItxOccurrencePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting", "*meetingservice.GetItxPastMeetingPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "update-itx-occurrence", "*meetingservice.UpdateItxOccurrencePayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3447,14 +4146,18 @@ func EncodeGetItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder)
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
+		body := NewUpdateItxOccurrenceRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "update-itx-occurrence", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetItxPastMeetingResponse returns a decoder for responses returned by
-// the Meeting Service get-itx-past-meeting endpoint. restoreBody controls
+// DecodeUpdateItxOccurrenceResponse returns a decoder for responses returned
+// by the Meeting Service update-itx-occurrence endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeGetItxPastMeetingResponse may return the following errors:
+// DecodeUpdateItxOccurrenceResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -3462,7 +4165,7 @@ func EncodeGetItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder)
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeGetItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUpdateItxOccurrenceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3477,130 +4180,1384 @@ func DecodeGetItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decode
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
-			var (
-				body GetItxPastMeetingResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
-			}
-			err = ValidateGetItxPastMeetingResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
-			}
-			res := NewGetItxPastMeetingITXPastZoomMeetingOK(&body)
-			return res, nil
+		case http.StatusNoContent:
+			return nil, nil
 		case http.StatusBadRequest:
 			var (
-				body GetItxPastMeetingBadRequestResponseBody
+				body UpdateItxOccurrenceBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
 			}
-			err = ValidateGetItxPastMeetingBadRequestResponseBody(&body)
+			err = ValidateUpdateItxOccurrenceBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
 			}
-			return nil, NewGetItxPastMeetingBadRequest(&body)
+			return nil, NewUpdateItxOccurrenceBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body GetItxPastMeetingForbiddenResponseBody
+				body UpdateItxOccurrenceForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
 			}
-			err = ValidateGetItxPastMeetingForbiddenResponseBody(&body)
+			err = ValidateUpdateItxOccurrenceForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
 			}
-			return nil, NewGetItxPastMeetingForbidden(&body)
+			return nil, NewUpdateItxOccurrenceForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetItxPastMeetingInternalServerErrorResponseBody
+				body UpdateItxOccurrenceInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
 			}
-			err = ValidateGetItxPastMeetingInternalServerErrorResponseBody(&body)
+			err = ValidateUpdateItxOccurrenceInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+			}
+			return nil, NewUpdateItxOccurrenceInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateItxOccurrenceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+			}
+			err = ValidateUpdateItxOccurrenceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+			}
+			return nil, NewUpdateItxOccurrenceNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateItxOccurrenceServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+			}
+			err = ValidateUpdateItxOccurrenceServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+			}
+			return nil, NewUpdateItxOccurrenceServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateItxOccurrenceUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-occurrence", err)
+			}
+			err = ValidateUpdateItxOccurrenceUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-occurrence", err)
+			}
+			return nil, NewUpdateItxOccurrenceUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "update-itx-occurrence", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteItxOccurrenceRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "delete-itx-occurrence" endpoint
+func (c *Client) BuildDeleteItxOccurrenceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		meetingID    string
+		occurrenceID string
+	)
+	{
+		p, ok := v.(*meetingservice.DeleteItxOccurrencePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "delete-itx-occurrence", "*meetingservice.DeleteItxOccurrencePayload", v)
+		}
+		meetingID = p.MeetingID
+		occurrenceID = p.OccurrenceID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteItxOccurrenceMeetingServicePath(meetingID, occurrenceID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "delete-itx-occurrence", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteItxOccurrenceRequest returns an encoder for requests sent to the
+// Meeting Service delete-itx-occurrence server.
+func EncodeDeleteItxOccurrenceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.DeleteItxOccurrencePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "delete-itx-occurrence", "*meetingservice.DeleteItxOccurrencePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDeleteItxOccurrenceResponse returns a decoder for responses returned
+// by the Meeting Service delete-itx-occurrence endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeDeleteItxOccurrenceResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteItxOccurrenceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteItxOccurrenceBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			err = ValidateDeleteItxOccurrenceBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			return nil, NewDeleteItxOccurrenceBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteItxOccurrenceForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			err = ValidateDeleteItxOccurrenceForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			return nil, NewDeleteItxOccurrenceForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteItxOccurrenceInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			err = ValidateDeleteItxOccurrenceInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			return nil, NewDeleteItxOccurrenceInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteItxOccurrenceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			err = ValidateDeleteItxOccurrenceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			return nil, NewDeleteItxOccurrenceNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteItxOccurrenceServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			err = ValidateDeleteItxOccurrenceServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			return nil, NewDeleteItxOccurrenceServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteItxOccurrenceUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			err = ValidateDeleteItxOccurrenceUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-occurrence", err)
+			}
+			return nil, NewDeleteItxOccurrenceUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "delete-itx-occurrence", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSubmitItxMeetingResponseRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "submit-itx-meeting-response" endpoint
+func (c *Client) BuildSubmitItxMeetingResponseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		meetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.SubmitItxMeetingResponsePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "submit-itx-meeting-response", "*meetingservice.SubmitItxMeetingResponsePayload", v)
+		}
+		meetingID = p.MeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SubmitItxMeetingResponseMeetingServicePath(meetingID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "submit-itx-meeting-response", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSubmitItxMeetingResponseRequest returns an encoder for requests sent
+// to the Meeting Service submit-itx-meeting-response server.
+func EncodeSubmitItxMeetingResponseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.SubmitItxMeetingResponsePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "submit-itx-meeting-response", "*meetingservice.SubmitItxMeetingResponsePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewSubmitItxMeetingResponseRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "submit-itx-meeting-response", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSubmitItxMeetingResponseResponse returns a decoder for responses
+// returned by the Meeting Service submit-itx-meeting-response endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeSubmitItxMeetingResponseResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSubmitItxMeetingResponseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body SubmitItxMeetingResponseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			err = ValidateSubmitItxMeetingResponseResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			res := NewSubmitItxMeetingResponseITXMeetingResponseResultCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SubmitItxMeetingResponseBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			err = ValidateSubmitItxMeetingResponseBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			return nil, NewSubmitItxMeetingResponseBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body SubmitItxMeetingResponseForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			err = ValidateSubmitItxMeetingResponseForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			return nil, NewSubmitItxMeetingResponseForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SubmitItxMeetingResponseInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			err = ValidateSubmitItxMeetingResponseInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			return nil, NewSubmitItxMeetingResponseInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body SubmitItxMeetingResponseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			err = ValidateSubmitItxMeetingResponseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			return nil, NewSubmitItxMeetingResponseNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body SubmitItxMeetingResponseServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			err = ValidateSubmitItxMeetingResponseServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			return nil, NewSubmitItxMeetingResponseServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SubmitItxMeetingResponseUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			err = ValidateSubmitItxMeetingResponseUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "submit-itx-meeting-response", err)
+			}
+			return nil, NewSubmitItxMeetingResponseUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "submit-itx-meeting-response", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateItxPastMeetingRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "create-itx-past-meeting" endpoint
+func (c *Client) BuildCreateItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateItxPastMeetingMeetingServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "create-itx-past-meeting", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateItxPastMeetingRequest returns an encoder for requests sent to
+// the Meeting Service create-itx-past-meeting server.
+func EncodeCreateItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.CreateItxPastMeetingPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "create-itx-past-meeting", "*meetingservice.CreateItxPastMeetingPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewCreateItxPastMeetingRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "create-itx-past-meeting", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateItxPastMeetingResponse returns a decoder for responses returned
+// by the Meeting Service create-itx-past-meeting endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeCreateItxPastMeetingResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateItxPastMeetingResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			res := NewCreateItxPastMeetingITXPastZoomMeetingCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateItxPastMeetingBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			return nil, NewCreateItxPastMeetingBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CreateItxPastMeetingConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			return nil, NewCreateItxPastMeetingConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateItxPastMeetingForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			return nil, NewCreateItxPastMeetingForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateItxPastMeetingInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			return nil, NewCreateItxPastMeetingInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateItxPastMeetingNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			return nil, NewCreateItxPastMeetingNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateItxPastMeetingServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			return nil, NewCreateItxPastMeetingServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateItxPastMeetingUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			err = ValidateCreateItxPastMeetingUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "create-itx-past-meeting", err)
+			}
+			return nil, NewCreateItxPastMeetingUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "create-itx-past-meeting", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetItxPastMeetingRequest instantiates a HTTP request object with method
+// and path set to call the "Meeting Service" service "get-itx-past-meeting"
+// endpoint
+func (c *Client) BuildGetItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		pastMeetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.GetItxPastMeetingPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting", "*meetingservice.GetItxPastMeetingPayload", v)
+		}
+		pastMeetingID = p.PastMeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetItxPastMeetingMeetingServicePath(pastMeetingID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-itx-past-meeting", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetItxPastMeetingRequest returns an encoder for requests sent to the
+// Meeting Service get-itx-past-meeting server.
+func EncodeGetItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.GetItxPastMeetingPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting", "*meetingservice.GetItxPastMeetingPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetItxPastMeetingResponse returns a decoder for responses returned by
+// the Meeting Service get-itx-past-meeting endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetItxPastMeetingResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetItxPastMeetingResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			err = ValidateGetItxPastMeetingResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			res := NewGetItxPastMeetingITXPastZoomMeetingOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetItxPastMeetingBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			err = ValidateGetItxPastMeetingBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			return nil, NewGetItxPastMeetingBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetItxPastMeetingForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			err = ValidateGetItxPastMeetingForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			return nil, NewGetItxPastMeetingForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetItxPastMeetingInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			err = ValidateGetItxPastMeetingInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			return nil, NewGetItxPastMeetingInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetItxPastMeetingNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			err = ValidateGetItxPastMeetingNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			return nil, NewGetItxPastMeetingNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetItxPastMeetingServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			err = ValidateGetItxPastMeetingServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			return nil, NewGetItxPastMeetingServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetItxPastMeetingUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			err = ValidateGetItxPastMeetingUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+			}
+			return nil, NewGetItxPastMeetingUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-itx-past-meeting", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteItxPastMeetingRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "delete-itx-past-meeting" endpoint
+func (c *Client) BuildDeleteItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		pastMeetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.DeleteItxPastMeetingPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "delete-itx-past-meeting", "*meetingservice.DeleteItxPastMeetingPayload", v)
+		}
+		pastMeetingID = p.PastMeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteItxPastMeetingMeetingServicePath(pastMeetingID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "delete-itx-past-meeting", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteItxPastMeetingRequest returns an encoder for requests sent to
+// the Meeting Service delete-itx-past-meeting server.
+func EncodeDeleteItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.DeleteItxPastMeetingPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "delete-itx-past-meeting", "*meetingservice.DeleteItxPastMeetingPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDeleteItxPastMeetingResponse returns a decoder for responses returned
+// by the Meeting Service delete-itx-past-meeting endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeDeleteItxPastMeetingResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteItxPastMeetingBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			err = ValidateDeleteItxPastMeetingBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			return nil, NewDeleteItxPastMeetingBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteItxPastMeetingForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			err = ValidateDeleteItxPastMeetingForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			return nil, NewDeleteItxPastMeetingForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteItxPastMeetingInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			err = ValidateDeleteItxPastMeetingInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			return nil, NewDeleteItxPastMeetingInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteItxPastMeetingNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			err = ValidateDeleteItxPastMeetingNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			return nil, NewDeleteItxPastMeetingNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteItxPastMeetingServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			err = ValidateDeleteItxPastMeetingServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			return nil, NewDeleteItxPastMeetingServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteItxPastMeetingUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			err = ValidateDeleteItxPastMeetingUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+			}
+			return nil, NewDeleteItxPastMeetingUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "delete-itx-past-meeting", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateItxPastMeetingRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "update-itx-past-meeting" endpoint
+func (c *Client) BuildUpdateItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		pastMeetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.UpdateItxPastMeetingPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting", "*meetingservice.UpdateItxPastMeetingPayload", v)
+		}
+		pastMeetingID = p.PastMeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxPastMeetingMeetingServicePath(pastMeetingID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-past-meeting", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateItxPastMeetingRequest returns an encoder for requests sent to
+// the Meeting Service update-itx-past-meeting server.
+func EncodeUpdateItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.UpdateItxPastMeetingPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting", "*meetingservice.UpdateItxPastMeetingPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewUpdateItxPastMeetingRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "update-itx-past-meeting", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateItxPastMeetingResponse returns a decoder for responses returned
+// by the Meeting Service update-itx-past-meeting endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateItxPastMeetingResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateItxPastMeetingBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
 			}
-			return nil, NewGetItxPastMeetingInternalServerError(&body)
+			err = ValidateUpdateItxPastMeetingBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+			}
+			return nil, NewUpdateItxPastMeetingBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateItxPastMeetingForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+			}
+			err = ValidateUpdateItxPastMeetingForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+			}
+			return nil, NewUpdateItxPastMeetingForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateItxPastMeetingInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+			}
+			err = ValidateUpdateItxPastMeetingInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+			}
+			return nil, NewUpdateItxPastMeetingInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetItxPastMeetingNotFoundResponseBody
+				body UpdateItxPastMeetingNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
 			}
-			err = ValidateGetItxPastMeetingNotFoundResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
 			}
-			return nil, NewGetItxPastMeetingNotFound(&body)
+			return nil, NewUpdateItxPastMeetingNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetItxPastMeetingServiceUnavailableResponseBody
+				body UpdateItxPastMeetingServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
 			}
-			err = ValidateGetItxPastMeetingServiceUnavailableResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
 			}
-			return nil, NewGetItxPastMeetingServiceUnavailable(&body)
+			return nil, NewUpdateItxPastMeetingServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body GetItxPastMeetingUnauthorizedResponseBody
+				body UpdateItxPastMeetingUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+			}
+			err = ValidateUpdateItxPastMeetingUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+			}
+			return nil, NewUpdateItxPastMeetingUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "update-itx-past-meeting", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetItxPastMeetingSummaryRequest instantiates a HTTP request object with
+// method and path set to call the "Meeting Service" service
+// "get-itx-past-meeting-summary" endpoint
+func (c *Client) BuildGetItxPastMeetingSummaryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		pastMeetingID string
+		summaryUID    string
+	)
+	{
+		p, ok := v.(*meetingservice.GetItxPastMeetingSummaryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting-summary", "*meetingservice.GetItxPastMeetingSummaryPayload", v)
+		}
+		pastMeetingID = p.PastMeetingID
+		summaryUID = p.SummaryUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetItxPastMeetingSummaryMeetingServicePath(pastMeetingID, summaryUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-itx-past-meeting-summary", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetItxPastMeetingSummaryRequest returns an encoder for requests sent
+// to the Meeting Service get-itx-past-meeting-summary server.
+func EncodeGetItxPastMeetingSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.GetItxPastMeetingSummaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting-summary", "*meetingservice.GetItxPastMeetingSummaryPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetItxPastMeetingSummaryResponse returns a decoder for responses
+// returned by the Meeting Service get-itx-past-meeting-summary endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetItxPastMeetingSummaryResponse may return the following errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetItxPastMeetingSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetItxPastMeetingSummaryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			err = ValidateGetItxPastMeetingSummaryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			res := NewGetItxPastMeetingSummaryPastMeetingSummaryOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetItxPastMeetingSummaryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			err = ValidateGetItxPastMeetingSummaryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			return nil, NewGetItxPastMeetingSummaryBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetItxPastMeetingSummaryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			err = ValidateGetItxPastMeetingSummaryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			return nil, NewGetItxPastMeetingSummaryForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetItxPastMeetingSummaryInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			err = ValidateGetItxPastMeetingSummaryInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			return nil, NewGetItxPastMeetingSummaryInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetItxPastMeetingSummaryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			err = ValidateGetItxPastMeetingSummaryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			return nil, NewGetItxPastMeetingSummaryNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetItxPastMeetingSummaryServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			err = ValidateGetItxPastMeetingSummaryServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+			}
+			return nil, NewGetItxPastMeetingSummaryServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetItxPastMeetingSummaryUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingUnauthorizedResponseBody(&body)
+			err = ValidateGetItxPastMeetingSummaryUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
 			}
-			return nil, NewGetItxPastMeetingUnauthorized(&body)
+			return nil, NewGetItxPastMeetingSummaryUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-itx-past-meeting", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-itx-past-meeting-summary", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteItxPastMeetingRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "delete-itx-past-meeting" endpoint
-func (c *Client) BuildDeleteItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetItxPastMeetingSummaryDiffRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "get-itx-past-meeting-summary-diff" endpoint
+func (c *Client) BuildGetItxPastMeetingSummaryDiffRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		pastMeetingID string
+		summaryUID    string
 	)
 	{
-		p, ok := v.(*meetingservice.DeleteItxPastMeetingPayload)
+		p, ok := v.(*meetingservice.GetItxPastMeetingSummaryDiffPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "delete-itx-past-meeting", "*meetingservice.DeleteItxPastMeetingPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting-summary-diff", "*meetingservice.GetItxPastMeetingSummaryDiffPayload", v)
 		}
 		pastMeetingID = p.PastMeetingID
+		summaryUID = p.SummaryUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteItxPastMeetingMeetingServicePath(pastMeetingID)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetItxPastMeetingSummaryDiffMeetingServicePath(pastMeetingID, summaryUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "delete-itx-past-meeting", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-itx-past-meeting-summary-diff", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3609,13 +5566,13 @@ func (c *Client) BuildDeleteItxPastMeetingRequest(ctx context.Context, v any) (*
 	return req, nil
 }
 
-// EncodeDeleteItxPastMeetingRequest returns an encoder for requests sent to
-// the Meeting Service delete-itx-past-meeting server.
-func EncodeDeleteItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetItxPastMeetingSummaryDiffRequest returns an encoder for requests
+// sent to the Meeting Service get-itx-past-meeting-summary-diff server.
+func EncodeGetItxPastMeetingSummaryDiffRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.DeleteItxPastMeetingPayload)
+		p, ok := v.(*meetingservice.GetItxPastMeetingSummaryDiffPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "delete-itx-past-meeting", "*meetingservice.DeleteItxPastMeetingPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting-summary-diff", "*meetingservice.GetItxPastMeetingSummaryDiffPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3634,10 +5591,11 @@ func EncodeDeleteItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encod
 	}
 }
 
-// DecodeDeleteItxPastMeetingResponse returns a decoder for responses returned
-// by the Meeting Service delete-itx-past-meeting endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeDeleteItxPastMeetingResponse may return the following errors:
+// DecodeGetItxPastMeetingSummaryDiffResponse returns a decoder for responses
+// returned by the Meeting Service get-itx-past-meeting-summary-diff endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetItxPastMeetingSummaryDiffResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -3645,7 +5603,7 @@ func EncodeDeleteItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encod
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeDeleteItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetItxPastMeetingSummaryDiffResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3660,117 +5618,132 @@ func DecodeDeleteItxPastMeetingResponse(decoder func(*http.Response) goahttp.Dec
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
+		case http.StatusOK:
+			var (
+				body GetItxPastMeetingSummaryDiffResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
+			}
+			err = ValidateGetItxPastMeetingSummaryDiffResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
+			}
+			res := NewGetItxPastMeetingSummaryDiffPastMeetingSummaryDiffOK(&body)
+			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body DeleteItxPastMeetingBadRequestResponseBody
+				body GetItxPastMeetingSummaryDiffBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			err = ValidateDeleteItxPastMeetingBadRequestResponseBody(&body)
+			err = ValidateGetItxPastMeetingSummaryDiffBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			return nil, NewDeleteItxPastMeetingBadRequest(&body)
+			return nil, NewGetItxPastMeetingSummaryDiffBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body DeleteItxPastMeetingForbiddenResponseBody
+				body GetItxPastMeetingSummaryDiffForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			err = ValidateDeleteItxPastMeetingForbiddenResponseBody(&body)
+			err = ValidateGetItxPastMeetingSummaryDiffForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			return nil, NewDeleteItxPastMeetingForbidden(&body)
+			return nil, NewGetItxPastMeetingSummaryDiffForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteItxPastMeetingInternalServerErrorResponseBody
+				body GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			err = ValidateDeleteItxPastMeetingInternalServerErrorResponseBody(&body)
+			err = ValidateGetItxPastMeetingSummaryDiffInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			return nil, NewDeleteItxPastMeetingInternalServerError(&body)
+			return nil, NewGetItxPastMeetingSummaryDiffInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteItxPastMeetingNotFoundResponseBody
+				body GetItxPastMeetingSummaryDiffNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			err = ValidateDeleteItxPastMeetingNotFoundResponseBody(&body)
+			err = ValidateGetItxPastMeetingSummaryDiffNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			return nil, NewDeleteItxPastMeetingNotFound(&body)
+			return nil, NewGetItxPastMeetingSummaryDiffNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteItxPastMeetingServiceUnavailableResponseBody
+				body GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			err = ValidateDeleteItxPastMeetingServiceUnavailableResponseBody(&body)
+			err = ValidateGetItxPastMeetingSummaryDiffServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			return nil, NewDeleteItxPastMeetingServiceUnavailable(&body)
+			return nil, NewGetItxPastMeetingSummaryDiffServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body DeleteItxPastMeetingUnauthorizedResponseBody
+				body GetItxPastMeetingSummaryDiffUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			err = ValidateDeleteItxPastMeetingUnauthorizedResponseBody(&body)
+			err = ValidateGetItxPastMeetingSummaryDiffUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "delete-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary-diff", err)
 			}
-			return nil, NewDeleteItxPastMeetingUnauthorized(&body)
+			return nil, NewGetItxPastMeetingSummaryDiffUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "delete-itx-past-meeting", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-itx-past-meeting-summary-diff", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateItxPastMeetingRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "update-itx-past-meeting" endpoint
-func (c *Client) BuildUpdateItxPastMeetingRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildUpdateItxPastMeetingSummaryRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "update-itx-past-meeting-summary" endpoint
+func (c *Client) BuildUpdateItxPastMeetingSummaryRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		pastMeetingID string
+		summaryUID    string
 	)
 	{
-		p, ok := v.(*meetingservice.UpdateItxPastMeetingPayload)
+		p, ok := v.(*meetingservice.UpdateItxPastMeetingSummaryPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting", "*meetingservice.UpdateItxPastMeetingPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting-summary", "*meetingservice.UpdateItxPastMeetingSummaryPayload", v)
 		}
 		pastMeetingID = p.PastMeetingID
+		summaryUID = p.SummaryUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxPastMeetingMeetingServicePath(pastMeetingID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxPastMeetingSummaryMeetingServicePath(pastMeetingID, summaryUID)}
 	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-past-meeting", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-past-meeting-summary", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3779,13 +5752,13 @@ func (c *Client) BuildUpdateItxPastMeetingRequest(ctx context.Context, v any) (*
 	return req, nil
 }
 
-// EncodeUpdateItxPastMeetingRequest returns an encoder for requests sent to
-// the Meeting Service update-itx-past-meeting server.
-func EncodeUpdateItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUpdateItxPastMeetingSummaryRequest returns an encoder for requests
+// sent to the Meeting Service update-itx-past-meeting-summary server.
+func EncodeUpdateItxPastMeetingSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.UpdateItxPastMeetingPayload)
+		p, ok := v.(*meetingservice.UpdateItxPastMeetingSummaryPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting", "*meetingservice.UpdateItxPastMeetingPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting-summary", "*meetingservice.UpdateItxPastMeetingSummaryPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3800,18 +5773,19 @@ func EncodeUpdateItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encod
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewUpdateItxPastMeetingRequestBody(p)
+		body := NewUpdateItxPastMeetingSummaryRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "update-itx-past-meeting", err)
+			return goahttp.ErrEncodingError("Meeting Service", "update-itx-past-meeting-summary", err)
 		}
 		return nil
 	}
 }
 
-// DecodeUpdateItxPastMeetingResponse returns a decoder for responses returned
-// by the Meeting Service update-itx-past-meeting endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeUpdateItxPastMeetingResponse may return the following errors:
+// DecodeUpdateItxPastMeetingSummaryResponse returns a decoder for responses
+// returned by the Meeting Service update-itx-past-meeting-summary endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeUpdateItxPastMeetingSummaryResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
@@ -3819,7 +5793,7 @@ func EncodeUpdateItxPastMeetingRequest(encoder func(*http.Request) goahttp.Encod
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeUpdateItxPastMeetingResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUpdateItxPastMeetingSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3834,119 +5808,132 @@ func DecodeUpdateItxPastMeetingResponse(decoder func(*http.Response) goahttp.Dec
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
+		case http.StatusOK:
+			var (
+				body UpdateItxPastMeetingSummaryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+			}
+			err = ValidateUpdateItxPastMeetingSummaryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+			}
+			res := NewUpdateItxPastMeetingSummaryPastMeetingSummaryOK(&body)
+			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body UpdateItxPastMeetingBadRequestResponseBody
+				body UpdateItxPastMeetingSummaryBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingBadRequestResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingSummaryBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingBadRequest(&body)
+			return nil, NewUpdateItxPastMeetingSummaryBadRequest(&body)
 		case http.StatusForbidden:
 			var (
-				body UpdateItxPastMeetingForbiddenResponseBody
+				body UpdateItxPastMeetingSummaryForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingForbiddenResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingSummaryForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingForbidden(&body)
+			return nil, NewUpdateItxPastMeetingSummaryForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateItxPastMeetingInternalServerErrorResponseBody
+				body UpdateItxPastMeetingSummaryInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingInternalServerErrorResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingInternalServerError(&body)
+			return nil, NewUpdateItxPastMeetingSummaryInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body UpdateItxPastMeetingNotFoundResponseBody
+				body UpdateItxPastMeetingSummaryNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingNotFoundResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingSummaryNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingNotFound(&body)
+			return nil, NewUpdateItxPastMeetingSummaryNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UpdateItxPastMeetingServiceUnavailableResponseBody
+				body UpdateItxPastMeetingSummaryServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingServiceUnavailableResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingServiceUnavailable(&body)
+			return nil, NewUpdateItxPastMeetingSummaryServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body UpdateItxPastMeetingUnauthorizedResponseBody
+				body UpdateItxPastMeetingSummaryUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingUnauthorizedResponseBody(&body)
+			err = ValidateUpdateItxPastMeetingSummaryUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingUnauthorized(&body)
+			return nil, NewUpdateItxPastMeetingSummaryUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "update-itx-past-meeting", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "update-itx-past-meeting-summary", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetItxPastMeetingSummaryRequest instantiates a HTTP request object with
-// method and path set to call the "Meeting Service" service
-// "get-itx-past-meeting-summary" endpoint
-func (c *Client) BuildGetItxPastMeetingSummaryRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildApproveItxPastMeetingSummaryRequest instantiates a HTTP request object
+// with method and path set to call the "Meeting Service" service
+// "approve-itx-past-meeting-summary" endpoint
+func (c *Client) BuildApproveItxPastMeetingSummaryRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		pastMeetingID string
 		summaryUID    string
 	)
 	{
-		p, ok := v.(*meetingservice.GetItxPastMeetingSummaryPayload)
+		p, ok := v.(*meetingservice.ApproveItxPastMeetingSummaryPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting-summary", "*meetingservice.GetItxPastMeetingSummaryPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "approve-itx-past-meeting-summary", "*meetingservice.ApproveItxPastMeetingSummaryPayload", v)
 		}
 		pastMeetingID = p.PastMeetingID
 		summaryUID = p.SummaryUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetItxPastMeetingSummaryMeetingServicePath(pastMeetingID, summaryUID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ApproveItxPastMeetingSummaryMeetingServicePath(pastMeetingID, summaryUID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "get-itx-past-meeting-summary", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "approve-itx-past-meeting-summary", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3955,13 +5942,13 @@ func (c *Client) BuildGetItxPastMeetingSummaryRequest(ctx context.Context, v any
 	return req, nil
 }
 
-// EncodeGetItxPastMeetingSummaryRequest returns an encoder for requests sent
-// to the Meeting Service get-itx-past-meeting-summary server.
-func EncodeGetItxPastMeetingSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeApproveItxPastMeetingSummaryRequest returns an encoder for requests
+// sent to the Meeting Service approve-itx-past-meeting-summary server.
+func EncodeApproveItxPastMeetingSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.GetItxPastMeetingSummaryPayload)
+		p, ok := v.(*meetingservice.ApproveItxPastMeetingSummaryPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "get-itx-past-meeting-summary", "*meetingservice.GetItxPastMeetingSummaryPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "approve-itx-past-meeting-summary", "*meetingservice.ApproveItxPastMeetingSummaryPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3980,19 +5967,20 @@ func EncodeGetItxPastMeetingSummaryRequest(encoder func(*http.Request) goahttp.E
 	}
 }
 
-// DecodeGetItxPastMeetingSummaryResponse returns a decoder for responses
-// returned by the Meeting Service get-itx-past-meeting-summary endpoint.
+// DecodeApproveItxPastMeetingSummaryResponse returns a decoder for responses
+// returned by the Meeting Service approve-itx-past-meeting-summary endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeGetItxPastMeetingSummaryResponse may return the following errors:
+// DecodeApproveItxPastMeetingSummaryResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeGetItxPastMeetingSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeApproveItxPastMeetingSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -4009,130 +5997,144 @@ func DecodeGetItxPastMeetingSummaryResponse(decoder func(*http.Response) goahttp
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetItxPastMeetingSummaryResponseBody
+				body ApproveItxPastMeetingSummaryResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingSummaryResponseBody(&body)
+			err = ValidateApproveItxPastMeetingSummaryResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			res := NewGetItxPastMeetingSummaryPastMeetingSummaryOK(&body)
+			res := NewApproveItxPastMeetingSummaryPastMeetingSummaryOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body GetItxPastMeetingSummaryBadRequestResponseBody
+				body ApproveItxPastMeetingSummaryBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingSummaryBadRequestResponseBody(&body)
+			err = ValidateApproveItxPastMeetingSummaryBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			return nil, NewGetItxPastMeetingSummaryBadRequest(&body)
+			return nil, NewApproveItxPastMeetingSummaryBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body ApproveItxPastMeetingSummaryConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
+			}
+			err = ValidateApproveItxPastMeetingSummaryConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
+			}
+			return nil, NewApproveItxPastMeetingSummaryConflict(&body)
 		case http.StatusForbidden:
 			var (
-				body GetItxPastMeetingSummaryForbiddenResponseBody
+				body ApproveItxPastMeetingSummaryForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingSummaryForbiddenResponseBody(&body)
+			err = ValidateApproveItxPastMeetingSummaryForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			return nil, NewGetItxPastMeetingSummaryForbidden(&body)
+			return nil, NewApproveItxPastMeetingSummaryForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetItxPastMeetingSummaryInternalServerErrorResponseBody
+				body ApproveItxPastMeetingSummaryInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingSummaryInternalServerErrorResponseBody(&body)
+			err = ValidateApproveItxPastMeetingSummaryInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			return nil, NewGetItxPastMeetingSummaryInternalServerError(&body)
+			return nil, NewApproveItxPastMeetingSummaryInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetItxPastMeetingSummaryNotFoundResponseBody
+				body ApproveItxPastMeetingSummaryNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingSummaryNotFoundResponseBody(&body)
+			err = ValidateApproveItxPastMeetingSummaryNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			return nil, NewGetItxPastMeetingSummaryNotFound(&body)
+			return nil, NewApproveItxPastMeetingSummaryNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetItxPastMeetingSummaryServiceUnavailableResponseBody
+				body ApproveItxPastMeetingSummaryServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingSummaryServiceUnavailableResponseBody(&body)
+			err = ValidateApproveItxPastMeetingSummaryServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			return nil, NewGetItxPastMeetingSummaryServiceUnavailable(&body)
+			return nil, NewApproveItxPastMeetingSummaryServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body GetItxPastMeetingSummaryUnauthorizedResponseBody
+				body ApproveItxPastMeetingSummaryUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			err = ValidateGetItxPastMeetingSummaryUnauthorizedResponseBody(&body)
+			err = ValidateApproveItxPastMeetingSummaryUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "get-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "approve-itx-past-meeting-summary", err)
 			}
-			return nil, NewGetItxPastMeetingSummaryUnauthorized(&body)
+			return nil, NewApproveItxPastMeetingSummaryUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "get-itx-past-meeting-summary", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "approve-itx-past-meeting-summary", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateItxPastMeetingSummaryRequest instantiates a HTTP request object
+// BuildRejectItxPastMeetingSummaryRequest instantiates a HTTP request object
 // with method and path set to call the "Meeting Service" service
-// "update-itx-past-meeting-summary" endpoint
-func (c *Client) BuildUpdateItxPastMeetingSummaryRequest(ctx context.Context, v any) (*http.Request, error) {
+// "reject-itx-past-meeting-summary" endpoint
+func (c *Client) BuildRejectItxPastMeetingSummaryRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		pastMeetingID string
 		summaryUID    string
 	)
 	{
-		p, ok := v.(*meetingservice.UpdateItxPastMeetingSummaryPayload)
+		p, ok := v.(*meetingservice.RejectItxPastMeetingSummaryPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting-summary", "*meetingservice.UpdateItxPastMeetingSummaryPayload", v)
+			return nil, goahttp.ErrInvalidType("Meeting Service", "reject-itx-past-meeting-summary", "*meetingservice.RejectItxPastMeetingSummaryPayload", v)
 		}
 		pastMeetingID = p.PastMeetingID
 		summaryUID = p.SummaryUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItxPastMeetingSummaryMeetingServicePath(pastMeetingID, summaryUID)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RejectItxPastMeetingSummaryMeetingServicePath(pastMeetingID, summaryUID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("Meeting Service", "update-itx-past-meeting-summary", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "reject-itx-past-meeting-summary", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -4141,13 +6143,13 @@ func (c *Client) BuildUpdateItxPastMeetingSummaryRequest(ctx context.Context, v
 	return req, nil
 }
 
-// EncodeUpdateItxPastMeetingSummaryRequest returns an encoder for requests
-// sent to the Meeting Service update-itx-past-meeting-summary server.
-func EncodeUpdateItxPastMeetingSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRejectItxPastMeetingSummaryRequest returns an encoder for requests
+// sent to the Meeting Service reject-itx-past-meeting-summary server.
+func EncodeRejectItxPastMeetingSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*meetingservice.UpdateItxPastMeetingSummaryPayload)
+		p, ok := v.(*meetingservice.RejectItxPastMeetingSummaryPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("Meeting Service", "update-itx-past-meeting-summary", "*meetingservice.UpdateItxPastMeetingSummaryPayload", v)
+			return goahttp.ErrInvalidType("Meeting Service", "reject-itx-past-meeting-summary", "*meetingservice.RejectItxPastMeetingSummaryPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -4162,27 +6164,24 @@ func EncodeUpdateItxPastMeetingSummaryRequest(encoder func(*http.Request) goahtt
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewUpdateItxPastMeetingSummaryRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("Meeting Service", "update-itx-past-meeting-summary", err)
-		}
 		return nil
 	}
 }
 
-// DecodeUpdateItxPastMeetingSummaryResponse returns a decoder for responses
-// returned by the Meeting Service update-itx-past-meeting-summary endpoint.
+// DecodeRejectItxPastMeetingSummaryResponse returns a decoder for responses
+// returned by the Meeting Service reject-itx-past-meeting-summary endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeUpdateItxPastMeetingSummaryResponse may return the following errors:
+// DecodeRejectItxPastMeetingSummaryResponse may return the following errors:
 //   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *meetingservice.ConflictError): http.StatusConflict
 //   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *meetingservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeUpdateItxPastMeetingSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRejectItxPastMeetingSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -4199,106 +6198,120 @@ func DecodeUpdateItxPastMeetingSummaryResponse(decoder func(*http.Response) goah
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body UpdateItxPastMeetingSummaryResponseBody
+				body RejectItxPastMeetingSummaryResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingSummaryResponseBody(&body)
+			err = ValidateRejectItxPastMeetingSummaryResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			res := NewUpdateItxPastMeetingSummaryPastMeetingSummaryOK(&body)
+			res := NewRejectItxPastMeetingSummaryPastMeetingSummaryOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body UpdateItxPastMeetingSummaryBadRequestResponseBody
+				body RejectItxPastMeetingSummaryBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingSummaryBadRequestResponseBody(&body)
+			err = ValidateRejectItxPastMeetingSummaryBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingSummaryBadRequest(&body)
+			return nil, NewRejectItxPastMeetingSummaryBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body RejectItxPastMeetingSummaryConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
+			}
+			err = ValidateRejectItxPastMeetingSummaryConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
+			}
+			return nil, NewRejectItxPastMeetingSummaryConflict(&body)
 		case http.StatusForbidden:
 			var (
-				body UpdateItxPastMeetingSummaryForbiddenResponseBody
+				body RejectItxPastMeetingSummaryForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingSummaryForbiddenResponseBody(&body)
+			err = ValidateRejectItxPastMeetingSummaryForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingSummaryForbidden(&body)
+			return nil, NewRejectItxPastMeetingSummaryForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateItxPastMeetingSummaryInternalServerErrorResponseBody
+				body RejectItxPastMeetingSummaryInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(&body)
+			err = ValidateRejectItxPastMeetingSummaryInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingSummaryInternalServerError(&body)
+			return nil, NewRejectItxPastMeetingSummaryInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body UpdateItxPastMeetingSummaryNotFoundResponseBody
+				body RejectItxPastMeetingSummaryNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingSummaryNotFoundResponseBody(&body)
+			err = ValidateRejectItxPastMeetingSummaryNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingSummaryNotFound(&body)
+			return nil, NewRejectItxPastMeetingSummaryNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UpdateItxPastMeetingSummaryServiceUnavailableResponseBody
+				body RejectItxPastMeetingSummaryServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(&body)
+			err = ValidateRejectItxPastMeetingSummaryServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingSummaryServiceUnavailable(&body)
+			return nil, NewRejectItxPastMeetingSummaryServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body UpdateItxPastMeetingSummaryUnauthorizedResponseBody
+				body RejectItxPastMeetingSummaryUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrDecodingError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			err = ValidateUpdateItxPastMeetingSummaryUnauthorizedResponseBody(&body)
+			err = ValidateRejectItxPastMeetingSummaryUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("Meeting Service", "update-itx-past-meeting-summary", err)
+				return nil, goahttp.ErrValidationError("Meeting Service", "reject-itx-past-meeting-summary", err)
 			}
-			return nil, NewUpdateItxPastMeetingSummaryUnauthorized(&body)
+			return nil, NewRejectItxPastMeetingSummaryUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("Meeting Service", "update-itx-past-meeting-summary", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "reject-itx-past-meeting-summary", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -4683,6 +6696,181 @@ func DecodeUpdateItxPastMeetingParticipantResponse(decoder func(*http.Response)
 	}
 }
 
+// BuildBulkUpdateItxPastMeetingParticipantsRequest instantiates a HTTP request
+// object with method and path set to call the "Meeting Service" service
+// "bulk-update-itx-past-meeting-participants" endpoint
+func (c *Client) BuildBulkUpdateItxPastMeetingParticipantsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		pastMeetingID string
+	)
+	{
+		p, ok := v.(*meetingservice.BulkUpdateItxPastMeetingParticipantsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Meeting Service", "bulk-update-itx-past-meeting-participants", "*meetingservice.BulkUpdateItxPastMeetingParticipantsPayload", v)
+		}
+		pastMeetingID = p.PastMeetingID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkUpdateItxPastMeetingParticipantsMeetingServicePath(pastMeetingID)}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Meeting Service", "bulk-update-itx-past-meeting-participants", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBulkUpdateItxPastMeetingParticipantsRequest returns an encoder for
+// requests sent to the Meeting Service
+// bulk-update-itx-past-meeting-participants server.
+func EncodeBulkUpdateItxPastMeetingParticipantsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*meetingservice.BulkUpdateItxPastMeetingParticipantsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Meeting Service", "bulk-update-itx-past-meeting-participants", "*meetingservice.BulkUpdateItxPastMeetingParticipantsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewBulkUpdateItxPastMeetingParticipantsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBulkUpdateItxPastMeetingParticipantsResponse returns a decoder for
+// responses returned by the Meeting Service
+// bulk-update-itx-past-meeting-participants endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeBulkUpdateItxPastMeetingParticipantsResponse may return the following
+// errors:
+//   - "BadRequest" (type *meetingservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *meetingservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *meetingservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *meetingservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *meetingservice.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeBulkUpdateItxPastMeetingParticipantsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BulkUpdateItxPastMeetingParticipantsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			err = ValidateBulkUpdateItxPastMeetingParticipantsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			res := NewBulkUpdateItxPastMeetingParticipantsITXPastMeetingParticipantBulkUpdateResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			err = ValidateBulkUpdateItxPastMeetingParticipantsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			return nil, NewBulkUpdateItxPastMeetingParticipantsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			err = ValidateBulkUpdateItxPastMeetingParticipantsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			return nil, NewBulkUpdateItxPastMeetingParticipantsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			err = ValidateBulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			return nil, NewBulkUpdateItxPastMeetingParticipantsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			err = ValidateBulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			return nil, NewBulkUpdateItxPastMeetingParticipantsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			err = ValidateBulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Meeting Service", "bulk-update-itx-past-meeting-participants", err)
+			}
+			return nil, NewBulkUpdateItxPastMeetingParticipantsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Meeting Service", "bulk-update-itx-past-meeting-participants", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildDeleteItxPastMeetingParticipantRequest instantiates a HTTP request
 // object with method and path set to call the "Meeting Service" service
 // "delete-itx-past-meeting-participant" endpoint
@@ -7060,6 +9248,92 @@ func DecodeGetItxPastMeetingAttachmentDownloadResponse(decoder func(*http.Respon
 	}
 }
 
+// unmarshalServiceEventProcessingConfigResponseBodyToMeetingserviceServiceEventProcessingConfig
+// builds a value of type *meetingservice.ServiceEventProcessingConfig from a
+// value of type *ServiceEventProcessingConfigResponseBody.
+func unmarshalServiceEventProcessingConfigResponseBodyToMeetingserviceServiceEventProcessingConfig(v *ServiceEventProcessingConfigResponseBody) *meetingservice.ServiceEventProcessingConfig {
+	res := &meetingservice.ServiceEventProcessingConfig{
+		Enabled:          *v.Enabled,
+		ConsumerName:     *v.ConsumerName,
+		StreamName:       *v.StreamName,
+		MaxDeliver:       *v.MaxDeliver,
+		AckWait:          *v.AckWait,
+		MaxAckPending:    *v.MaxAckPending,
+		V1MappingsBucket: *v.V1MappingsBucket,
+		BreakerThreshold: *v.BreakerThreshold,
+		BreakerCooldown:  *v.BreakerCooldown,
+	}
+	res.FilterSubjects = make([]string, len(v.FilterSubjects))
+	for i, val := range v.FilterSubjects {
+		res.FilterSubjects[i] = val
+	}
+
+	return res
+}
+
+// unmarshalServiceInvitesConfigResponseBodyToMeetingserviceServiceInvitesConfig
+// builds a value of type *meetingservice.ServiceInvitesConfig from a value of
+// type *ServiceInvitesConfigResponseBody.
+func unmarshalServiceInvitesConfigResponseBodyToMeetingserviceServiceInvitesConfig(v *ServiceInvitesConfigResponseBody) *meetingservice.ServiceInvitesConfig {
+	res := &meetingservice.ServiceInvitesConfig{
+		Enabled:          *v.Enabled,
+		SelfServeBaseURL: *v.SelfServeBaseURL,
+	}
+
+	return res
+}
+
+// unmarshalServiceITXResilienceConfigResponseBodyToMeetingserviceServiceITXResilienceConfig
+// builds a value of type *meetingservice.ServiceITXResilienceConfig from a
+// value of type *ServiceITXResilienceConfigResponseBody.
+func unmarshalServiceITXResilienceConfigResponseBodyToMeetingserviceServiceITXResilienceConfig(v *ServiceITXResilienceConfigResponseBody) *meetingservice.ServiceITXResilienceConfig {
+	res := &meetingservice.ServiceITXResilienceConfig{
+		MaxRetries:       *v.MaxRetries,
+		RetryBaseDelay:   *v.RetryBaseDelay,
+		RetryMaxDelay:    *v.RetryMaxDelay,
+		AttemptTimeout:   *v.AttemptTimeout,
+		BreakerThreshold: *v.BreakerThreshold,
+		BreakerCooldown:  *v.BreakerCooldown,
+	}
+	res.EndpointAttemptTimeouts = make(map[string]string, len(v.EndpointAttemptTimeouts))
+	for key, val := range v.EndpointAttemptTimeouts {
+		tk := key
+		tv := val
+		res.EndpointAttemptTimeouts[tk] = tv
+	}
+
+	return res
+}
+
+// unmarshalServiceAttachmentsConfigResponseBodyToMeetingserviceServiceAttachmentsConfig
+// builds a value of type *meetingservice.ServiceAttachmentsConfig from a value
+// of type *ServiceAttachmentsConfigResponseBody.
+func unmarshalServiceAttachmentsConfigResponseBodyToMeetingserviceServiceAttachmentsConfig(v *ServiceAttachmentsConfigResponseBody) *meetingservice.ServiceAttachmentsConfig {
+	res := &meetingservice.ServiceAttachmentsConfig{
+		MaxFileSize: *v.MaxFileSize,
+	}
+	res.AllowedContentTypes = make([]string, len(v.AllowedContentTypes))
+	for i, val := range v.AllowedContentTypes {
+		res.AllowedContentTypes[i] = val
+	}
+
+	return res
+}
+
+// unmarshalDependencyHealthResponseBodyToMeetingserviceDependencyHealth builds
+// a value of type *meetingservice.DependencyHealth from a value of type
+// *DependencyHealthResponseBody.
+func unmarshalDependencyHealthResponseBodyToMeetingserviceDependencyHealth(v *DependencyHealthResponseBody) *meetingservice.DependencyHealth {
+	res := &meetingservice.DependencyHealth{
+		Name:      *v.Name,
+		Status:    *v.Status,
+		LatencyMs: *v.LatencyMs,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
 // marshalMeetingserviceCommitteeToCommitteeRequestBody builds a value of type
 // *CommitteeRequestBody from a value of type *meetingservice.Committee.
 func marshalMeetingserviceCommitteeToCommitteeRequestBody(v *meetingservice.Committee) *CommitteeRequestBody {
@@ -7094,6 +9368,7 @@ func marshalMeetingserviceRecurrenceToRecurrenceRequestBody(v *meetingservice.Re
 		MonthlyWeekDay: v.MonthlyWeekDay,
 		EndTimes:       v.EndTimes,
 		EndDateTime:    v.EndDateTime,
+		Rrule:          v.Rrule,
 	}
 
 	return res
@@ -7133,6 +9408,7 @@ func marshalRecurrenceRequestBodyToMeetingserviceRecurrence(v *RecurrenceRequest
 		MonthlyWeekDay: v.MonthlyWeekDay,
 		EndTimes:       v.EndTimes,
 		EndDateTime:    v.EndDateTime,
+		Rrule:          v.Rrule,
 	}
 
 	return res
@@ -7172,6 +9448,7 @@ func unmarshalRecurrenceResponseBodyToMeetingserviceRecurrence(v *RecurrenceResp
 		MonthlyWeekDay: v.MonthlyWeekDay,
 		EndTimes:       v.EndTimes,
 		EndDateTime:    v.EndDateTime,
+		Rrule:          v.Rrule,
 	}
 
 	return res
@@ -7243,6 +9520,22 @@ func unmarshalITXUserResponseBodyToMeetingserviceITXUser(v *ITXUserResponseBody)
 	return res
 }
 
+// unmarshalITXLocalOccurrenceResponseBodyToMeetingserviceITXLocalOccurrence
+// builds a value of type *meetingservice.ITXLocalOccurrence from a value of
+// type *ITXLocalOccurrenceResponseBody.
+func unmarshalITXLocalOccurrenceResponseBodyToMeetingserviceITXLocalOccurrence(v *ITXLocalOccurrenceResponseBody) *meetingservice.ITXLocalOccurrence {
+	res := &meetingservice.ITXLocalOccurrence{
+		OccurrenceID:   *v.OccurrenceID,
+		StartTime:      *v.StartTime,
+		LocalStartTime: *v.LocalStartTime,
+		LocalEndTime:   *v.LocalEndTime,
+		Duration:       *v.Duration,
+		Status:         *v.Status,
+	}
+
+	return res
+}
+
 // unmarshalPastMeetingSummaryZoomConfigResponseBodyToMeetingservicePastMeetingSummaryZoomConfig
 // builds a value of type *meetingservice.PastMeetingSummaryZoomConfig from a
 // value of type *PastMeetingSummaryZoomConfigResponseBody.
@@ -7274,6 +9567,20 @@ func unmarshalSummaryDataResponseBodyToMeetingserviceSummaryData(v *SummaryDataR
 	return res
 }
 
+// unmarshalSummaryDiffLineResponseBodyToMeetingserviceSummaryDiffLine builds a
+// value of type *meetingservice.SummaryDiffLine from a value of type
+// *SummaryDiffLineResponseBody.
+func unmarshalSummaryDiffLineResponseBodyToMeetingserviceSummaryDiffLine(v *SummaryDiffLineResponseBody) *meetingservice.SummaryDiffLine {
+	res := &meetingservice.SummaryDiffLine{
+		Operation:    *v.Operation,
+		Text:         *v.Text,
+		OriginalLine: v.OriginalLine,
+		EditedLine:   v.EditedLine,
+	}
+
+	return res
+}
+
 // marshalMeetingserviceParticipantSessionToParticipantSessionRequestBody
 // builds a value of type *ParticipantSessionRequestBody from a value of type
 // *meetingservice.ParticipantSession.
@@ -7324,3 +9631,109 @@ func unmarshalParticipantSessionResponseBodyToMeetingserviceParticipantSession(v
 
 	return res
 }
+
+// marshalMeetingserviceITXPastMeetingParticipantUpdateToITXPastMeetingParticipantUpdateRequestBody
+// builds a value of type *ITXPastMeetingParticipantUpdateRequestBody from a
+// value of type *meetingservice.ITXPastMeetingParticipantUpdate.
+func marshalMeetingserviceITXPastMeetingParticipantUpdateToITXPastMeetingParticipantUpdateRequestBody(v *meetingservice.ITXPastMeetingParticipantUpdate) *ITXPastMeetingParticipantUpdateRequestBody {
+	res := &ITXPastMeetingParticipantUpdateRequestBody{
+		ParticipantID:         v.ParticipantID,
+		InviteeID:             v.InviteeID,
+		AttendeeID:            v.AttendeeID,
+		IsInvited:             v.IsInvited,
+		IsAttended:            v.IsAttended,
+		Email:                 v.Email,
+		Username:              v.Username,
+		LfUserID:              v.LfUserID,
+		FirstName:             v.FirstName,
+		LastName:              v.LastName,
+		OrgName:               v.OrgName,
+		JobTitle:              v.JobTitle,
+		CommitteeRole:         v.CommitteeRole,
+		CommitteeVotingStatus: v.CommitteeVotingStatus,
+		IsVerified:            v.IsVerified,
+	}
+
+	return res
+}
+
+// marshalITXPastMeetingParticipantUpdateRequestBodyToMeetingserviceITXPastMeetingParticipantUpdate
+// builds a value of type *meetingservice.ITXPastMeetingParticipantUpdate from
+// a value of type *ITXPastMeetingParticipantUpdateRequestBody.
+func marshalITXPastMeetingParticipantUpdateRequestBodyToMeetingserviceITXPastMeetingParticipantUpdate(v *ITXPastMeetingParticipantUpdateRequestBody) *meetingservice.ITXPastMeetingParticipantUpdate {
+	res := &meetingservice.ITXPastMeetingParticipantUpdate{
+		ParticipantID:         v.ParticipantID,
+		InviteeID:             v.InviteeID,
+		AttendeeID:            v.AttendeeID,
+		IsInvited:             v.IsInvited,
+		IsAttended:            v.IsAttended,
+		Email:                 v.Email,
+		Username:              v.Username,
+		LfUserID:              v.LfUserID,
+		FirstName:             v.FirstName,
+		LastName:              v.LastName,
+		OrgName:               v.OrgName,
+		JobTitle:              v.JobTitle,
+		CommitteeRole:         v.CommitteeRole,
+		CommitteeVotingStatus: v.CommitteeVotingStatus,
+		IsVerified:            v.IsVerified,
+	}
+
+	return res
+}
+
+// unmarshalITXPastMeetingParticipantResponseBodyToMeetingserviceITXPastMeetingParticipant
+// builds a value of type *meetingservice.ITXPastMeetingParticipant from a
+// value of type *ITXPastMeetingParticipantResponseBody.
+func unmarshalITXPastMeetingParticipantResponseBodyToMeetingserviceITXPastMeetingParticipant(v *ITXPastMeetingParticipantResponseBody) *meetingservice.ITXPastMeetingParticipant {
+	res := &meetingservice.ITXPastMeetingParticipant{
+		ID:                    v.ID,
+		InviteeID:             v.InviteeID,
+		AttendeeID:            v.AttendeeID,
+		PastMeetingID:         v.PastMeetingID,
+		MeetingID:             v.MeetingID,
+		Email:                 v.Email,
+		FirstName:             v.FirstName,
+		LastName:              v.LastName,
+		Username:              v.Username,
+		LfUserID:              v.LfUserID,
+		OrgName:               v.OrgName,
+		JobTitle:              v.JobTitle,
+		OrgIsMember:           v.OrgIsMember,
+		OrgIsProjectMember:    v.OrgIsProjectMember,
+		CommitteeID:           v.CommitteeID,
+		CommitteeRole:         v.CommitteeRole,
+		IsCommitteeMember:     v.IsCommitteeMember,
+		CommitteeVotingStatus: v.CommitteeVotingStatus,
+		AvatarURL:             v.AvatarURL,
+		IsInvited:             v.IsInvited,
+		IsAttended:            v.IsAttended,
+		IsVerified:            v.IsVerified,
+		IsUnknown:             v.IsUnknown,
+		IsAiReconciled:        v.IsAiReconciled,
+		IsAutoMatched:         v.IsAutoMatched,
+		ZoomUserName:          v.ZoomUserName,
+		MappedInviteeName:     v.MappedInviteeName,
+		AverageAttendance:     v.AverageAttendance,
+		CreatedAt:             v.CreatedAt,
+		ModifiedAt:            v.ModifiedAt,
+	}
+	if v.Sessions != nil {
+		res.Sessions = make([]*meetingservice.ParticipantSession, len(v.Sessions))
+		for i, val := range v.Sessions {
+			if val == nil {
+				res.Sessions[i] = nil
+				continue
+			}
+			res.Sessions[i] = unmarshalParticipantSessionResponseBodyToMeetingserviceParticipantSession(val)
+		}
+	}
+	if v.CreatedBy != nil {
+		res.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(v.CreatedBy)
+	}
+	if v.ModifiedBy != nil {
+		res.ModifiedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(v.ModifiedBy)
+	}
+
+	return res
+}
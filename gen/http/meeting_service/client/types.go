@@ -56,6 +56,15 @@ type CreateItxMeetingRequestBody struct {
 	Recurrence *RecurrenceRequestBody `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
 }
 
+// CloneItxMeetingRequestBody is the type of the "Meeting Service" service
+// "clone-itx-meeting" endpoint HTTP request body.
+type CloneItxMeetingRequestBody struct {
+	// The start time of the meeting in RFC3339 format
+	StartTime string `form:"start_time" json:"start_time" xml:"start_time"`
+	// Title of the new meeting; defaults to the source meeting title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+}
+
 // UpdateItxMeetingRequestBody is the type of the "Meeting Service" service
 // "update-itx-meeting" endpoint HTTP request body.
 type UpdateItxMeetingRequestBody struct {
@@ -101,6 +110,21 @@ type UpdateItxMeetingRequestBody struct {
 	UpdateNote *string `form:"update_note,omitempty" json:"update_note,omitempty" xml:"update_note,omitempty"`
 }
 
+// PreviewItxMeetingOccurrencesRequestBody is the type of the "Meeting Service"
+// service "preview-itx-meeting-occurrences" endpoint HTTP request body.
+type PreviewItxMeetingOccurrencesRequestBody struct {
+	// The start time of the meeting in RFC3339 format
+	StartTime string `form:"start_time" json:"start_time" xml:"start_time"`
+	// The timezone of the meeting (e.g. 'America/New_York')
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// The duration of the meeting in minutes
+	Duration int `form:"duration" json:"duration" xml:"duration"`
+	// The recurrence of the meeting
+	Recurrence *RecurrenceRequestBody `form:"recurrence" json:"recurrence" xml:"recurrence"`
+	// Maximum number of occurrences to return
+	Count int `form:"count" json:"count" xml:"count"`
+}
+
 // CreateItxRegistrantRequestBody is the type of the "Meeting Service" service
 // "create-itx-registrant" endpoint HTTP request body.
 type CreateItxRegistrantRequestBody struct {
@@ -392,6 +416,14 @@ type UpdateItxPastMeetingParticipantRequestBody struct {
 	IsVerified *bool `form:"is_verified,omitempty" json:"is_verified,omitempty" xml:"is_verified,omitempty"`
 }
 
+// BulkUpdateItxPastMeetingParticipantsRequestBody is the type of the "Meeting
+// Service" service "bulk-update-itx-past-meeting-participants" endpoint HTTP
+// request body.
+type BulkUpdateItxPastMeetingParticipantsRequestBody struct {
+	// Participant corrections
+	Participants []*ITXPastMeetingParticipantUpdateRequestBody `form:"participants" json:"participants" xml:"participants"`
+}
+
 // CreateItxMeetingAttachmentRequestBody is the type of the "Meeting Service"
 // service "create-itx-meeting-attachment" endpoint HTTP request body.
 type CreateItxMeetingAttachmentRequestBody struct {
@@ -486,6 +518,49 @@ type CreateItxPastMeetingAttachmentPresignRequestBody struct {
 	FileType string `form:"file_type" json:"file_type" xml:"file_type"`
 }
 
+// GetServiceConfigResponseBody is the type of the "Meeting Service" service
+// "get-service-config" endpoint HTTP response body.
+type GetServiceConfigResponseBody struct {
+	// Service build version
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Normalized LFX environment
+	LfxEnvironment *string `form:"lfx_environment,omitempty" json:"lfx_environment,omitempty" xml:"lfx_environment,omitempty"`
+	// ITX API base URL
+	ItxBaseURL *string `form:"itx_base_url,omitempty" json:"itx_base_url,omitempty" xml:"itx_base_url,omitempty"`
+	// Auth0 domain used for ITX M2M tokens
+	ItxAuth0Domain *string `form:"itx_auth0_domain,omitempty" json:"itx_auth0_domain,omitempty" xml:"itx_auth0_domain,omitempty"`
+	// Audience of the ITX M2M tokens
+	ItxAudience *string `form:"itx_audience,omitempty" json:"itx_audience,omitempty" xml:"itx_audience,omitempty"`
+	// v1 user-service (API gateway) base URL
+	UserServiceBaseURL *string `form:"user_service_base_url,omitempty" json:"user_service_base_url,omitempty" xml:"user_service_base_url,omitempty"`
+	// Whether v1/v2 ID mapping through NATS is enabled
+	IDMappingEnabled *bool `form:"id_mapping_enabled,omitempty" json:"id_mapping_enabled,omitempty" xml:"id_mapping_enabled,omitempty"`
+	// Event processing configuration
+	EventProcessing *ServiceEventProcessingConfigResponseBody `form:"event_processing,omitempty" json:"event_processing,omitempty" xml:"event_processing,omitempty"`
+	// LFID invite feature configuration
+	Invites *ServiceInvitesConfigResponseBody `form:"invites,omitempty" json:"invites,omitempty" xml:"invites,omitempty"`
+	// ITX client timeout, retry and circuit breaker configuration
+	ItxResilience *ServiceITXResilienceConfigResponseBody `form:"itx_resilience,omitempty" json:"itx_resilience,omitempty" xml:"itx_resilience,omitempty"`
+	// Attachment upload limits
+	Attachments *ServiceAttachmentsConfigResponseBody `form:"attachments,omitempty" json:"attachments,omitempty" xml:"attachments,omitempty"`
+	// How long ITX join links are cached, as a Go duration (0s when caching is
+	// disabled)
+	JoinLinkCacheTTL *string `form:"join_link_cache_ttl,omitempty" json:"join_link_cache_ttl,omitempty" xml:"join_link_cache_ttl,omitempty"`
+	// Global log level (LOG_LEVEL)
+	LogLevel *string `form:"log_level,omitempty" json:"log_level,omitempty" xml:"log_level,omitempty"`
+	// Effective per-subsystem log level overrides
+	SubsystemLogLevels map[string]string `form:"subsystem_log_levels,omitempty" json:"subsystem_log_levels,omitempty" xml:"subsystem_log_levels,omitempty"`
+}
+
+// GetServiceHealthResponseBody is the type of the "Meeting Service" service
+// "get-service-health" endpoint HTTP response body.
+type GetServiceHealthResponseBody struct {
+	// ok when every dependency check passed, degraded otherwise
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Result of each dependency check
+	Checks []*DependencyHealthResponseBody `form:"checks,omitempty" json:"checks,omitempty" xml:"checks,omitempty"`
+}
+
 // CreateItxMeetingResponseBody is the type of the "Meeting Service" service
 // "create-itx-meeting" endpoint HTTP response body.
 type CreateItxMeetingResponseBody struct {
@@ -660,6 +735,93 @@ type GetItxMeetingResponseBody struct {
 	RegistrantCount *int `form:"registrant_count,omitempty" json:"registrant_count,omitempty" xml:"registrant_count,omitempty"`
 }
 
+// CloneItxMeetingResponseBody is the type of the "Meeting Service" service
+// "clone-itx-meeting" endpoint HTTP response body.
+type CloneItxMeetingResponseBody struct {
+	// The UID of the LF project
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// The title of the meeting
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// The start time of the meeting in RFC3339 format
+	StartTime *string `form:"start_time,omitempty" json:"start_time,omitempty" xml:"start_time,omitempty"`
+	// The duration of the meeting in minutes
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// The timezone of the meeting (e.g. 'America/New_York')
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// The visibility of the meeting's existence to other users
+	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
+	// The description of the meeting
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The restrictedness of joining the meeting (i.e. is the meeting restricted to
+	// only invited users or anyone?)
+	Restricted *bool `form:"restricted,omitempty" json:"restricted,omitempty" xml:"restricted,omitempty"`
+	// The committees associated with the meeting
+	Committees []*CommitteeResponseBody `form:"committees,omitempty" json:"committees,omitempty" xml:"committees,omitempty"`
+	// The type of meeting
+	MeetingType *string `form:"meeting_type,omitempty" json:"meeting_type,omitempty" xml:"meeting_type,omitempty"`
+	// The number of minutes that users are allowed to join the meeting early
+	EarlyJoinTimeMinutes *int `form:"early_join_time_minutes,omitempty" json:"early_join_time_minutes,omitempty" xml:"early_join_time_minutes,omitempty"`
+	// Whether recording is enabled for the meeting
+	RecordingEnabled *bool `form:"recording_enabled,omitempty" json:"recording_enabled,omitempty" xml:"recording_enabled,omitempty"`
+	// Whether transcription is enabled for the meeting
+	TranscriptEnabled *bool `form:"transcript_enabled,omitempty" json:"transcript_enabled,omitempty" xml:"transcript_enabled,omitempty"`
+	// Whether automatic youtube uploading is enabled for the meeting
+	YoutubeUploadEnabled *bool `form:"youtube_upload_enabled,omitempty" json:"youtube_upload_enabled,omitempty" xml:"youtube_upload_enabled,omitempty"`
+	// Whether Zoom AI Companion summary is enabled for the meeting
+	AiSummaryEnabled *bool `form:"ai_summary_enabled,omitempty" json:"ai_summary_enabled,omitempty" xml:"ai_summary_enabled,omitempty"`
+	// Whether AI summary requires approval before being shared
+	RequireAiSummaryApproval *bool `form:"require_ai_summary_approval,omitempty" json:"require_ai_summary_approval,omitempty" xml:"require_ai_summary_approval,omitempty"`
+	// The visibility of artifacts to users
+	ArtifactVisibility *string `form:"artifact_visibility,omitempty" json:"artifact_visibility,omitempty" xml:"artifact_visibility,omitempty"`
+	// The recurrence of the meeting
+	Recurrence *RecurrenceResponseBody `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+	// Whether automatic email reminders are enabled for the meeting
+	AutoEmailReminderEnabled *bool `form:"auto_email_reminder_enabled,omitempty" json:"auto_email_reminder_enabled,omitempty" xml:"auto_email_reminder_enabled,omitempty"`
+	// Time in minutes before the meeting to send the automatic email reminder
+	AutoEmailReminderTime *int `form:"auto_email_reminder_time,omitempty" json:"auto_email_reminder_time,omitempty" xml:"auto_email_reminder_time,omitempty"`
+	// Status of the last bulk registrant import job
+	LastBulkRegistrantJobStatus *string `form:"last_bulk_registrant_job_status,omitempty" json:"last_bulk_registrant_job_status,omitempty" xml:"last_bulk_registrant_job_status,omitempty"`
+	// Number of records with warnings in the last bulk registrant import job
+	LastBulkRegistrantsJobWarningCount *int `form:"last_bulk_registrants_job_warning_count,omitempty" json:"last_bulk_registrants_job_warning_count,omitempty" xml:"last_bulk_registrants_job_warning_count,omitempty"`
+	// Number of email delivery errors for the meeting
+	EmailDeliveryErrorCount *int `form:"email_delivery_error_count,omitempty" json:"email_delivery_error_count,omitempty" xml:"email_delivery_error_count,omitempty"`
+	// Whether invite responses (RSVP) are enabled for the meeting
+	IsInviteResponsesEnabled *bool `form:"is_invite_responses_enabled,omitempty" json:"is_invite_responses_enabled,omitempty" xml:"is_invite_responses_enabled,omitempty"`
+	// Number of 'yes' RSVP responses for the meeting
+	ResponseCountYes *int `form:"response_count_yes,omitempty" json:"response_count_yes,omitempty" xml:"response_count_yes,omitempty"`
+	// Number of 'maybe' RSVP responses for the meeting
+	ResponseCountMaybe *int `form:"response_count_maybe,omitempty" json:"response_count_maybe,omitempty" xml:"response_count_maybe,omitempty"`
+	// Number of 'no' RSVP responses for the meeting
+	ResponseCountNo *int `form:"response_count_no,omitempty" json:"response_count_no,omitempty" xml:"response_count_no,omitempty"`
+	// Status of the last mailing list members sync job
+	LastMailingListMembersSyncJobStatus *string `form:"last_mailing_list_members_sync_job_status,omitempty" json:"last_mailing_list_members_sync_job_status,omitempty" xml:"last_mailing_list_members_sync_job_status,omitempty"`
+	// Number of failed records in the last mailing list members sync job
+	LastMailingListMembersSyncJobFailedCount *int `form:"last_mailing_list_members_sync_job_failed_count,omitempty" json:"last_mailing_list_members_sync_job_failed_count,omitempty" xml:"last_mailing_list_members_sync_job_failed_count,omitempty"`
+	// Number of records with warnings in the last mailing list members sync job
+	LastMailingListMembersSyncJobWarningCount *int `form:"last_mailing_list_members_sync_job_warning_count,omitempty" json:"last_mailing_list_members_sync_job_warning_count,omitempty" xml:"last_mailing_list_members_sync_job_warning_count,omitempty"`
+	// RFC3339 start time of the next upcoming occurrence. Empty when no future
+	// occurrence exists.
+	NextOccurrenceStartTime *string `form:"next_occurrence_start_time,omitempty" json:"next_occurrence_start_time,omitempty" xml:"next_occurrence_start_time,omitempty"`
+	// Zoom meeting ID from ITX
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// 6-digit host key
+	HostKey *string `form:"host_key,omitempty" json:"host_key,omitempty" xml:"host_key,omitempty"`
+	// Zoom meeting passcode
+	Passcode *string `form:"passcode,omitempty" json:"passcode,omitempty" xml:"passcode,omitempty"`
+	// UUID password for join page
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Public meeting join URL
+	PublicLink *string `form:"public_link,omitempty" json:"public_link,omitempty" xml:"public_link,omitempty"`
+	// Creation timestamp (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last modification timestamp (RFC3339)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Meeting occurrences (for recurring)
+	Occurrences []*ITXOccurrenceResponseBody `form:"occurrences,omitempty" json:"occurrences,omitempty" xml:"occurrences,omitempty"`
+	// Number of registrants
+	RegistrantCount *int `form:"registrant_count,omitempty" json:"registrant_count,omitempty" xml:"registrant_count,omitempty"`
+}
+
 // GetItxMeetingCountResponseBody is the type of the "Meeting Service" service
 // "get-itx-meeting-count" endpoint HTTP response body.
 type GetItxMeetingCountResponseBody struct {
@@ -667,6 +829,14 @@ type GetItxMeetingCountResponseBody struct {
 	MeetingCount *int `form:"meeting_count,omitempty" json:"meeting_count,omitempty" xml:"meeting_count,omitempty"`
 }
 
+// PreviewItxMeetingOccurrencesResponseBody is the type of the "Meeting
+// Service" service "preview-itx-meeting-occurrences" endpoint HTTP response
+// body.
+type PreviewItxMeetingOccurrencesResponseBody struct {
+	// Computed occurrences
+	Occurrences []*ITXOccurrenceResponseBody `form:"occurrences,omitempty" json:"occurrences,omitempty" xml:"occurrences,omitempty"`
+}
+
 // CreateItxRegistrantResponseBody is the type of the "Meeting Service" service
 // "create-itx-registrant" endpoint HTTP response body.
 type CreateItxRegistrantResponseBody struct {
@@ -770,6 +940,119 @@ type GetItxRegistrantResponseBody struct {
 type GetItxJoinLinkResponseBody struct {
 	// Zoom meeting join URL
 	Link *string `form:"link,omitempty" json:"link,omitempty" xml:"link,omitempty"`
+	// Occurrence the link was requested for (Unix timestamp)
+	OccurrenceID *string `form:"occurrence_id,omitempty" json:"occurrence_id,omitempty" xml:"occurrence_id,omitempty"`
+	// Start time of the occurrence in RFC3339 format
+	OccurrenceStartTime *string `form:"occurrence_start_time,omitempty" json:"occurrence_start_time,omitempty" xml:"occurrence_start_time,omitempty"`
+	// Duration of the occurrence in minutes
+	OccurrenceDuration *int `form:"occurrence_duration,omitempty" json:"occurrence_duration,omitempty" xml:"occurrence_duration,omitempty"`
+}
+
+// AddItxRegistrantHostResponseBody is the type of the "Meeting Service"
+// service "add-itx-registrant-host" endpoint HTTP response body.
+type AddItxRegistrantHostResponseBody struct {
+	// Registrant UID (read-only)
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Registrant type: direct or committee (read-only)
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Committee UID (for committee registrants)
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Registrant email
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LF username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// First name (required with email)
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name (required with email)
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Organization
+	Org *string `form:"org,omitempty" json:"org,omitempty" xml:"org,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Profile picture URL
+	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
+	// Access to host key for the meeting
+	Host *bool `form:"host,omitempty" json:"host,omitempty" xml:"host,omitempty"`
+	// Specific occurrence ID (blank = all occurrences)
+	Occurrence *string `form:"occurrence,omitempty" json:"occurrence,omitempty" xml:"occurrence,omitempty"`
+	// Number of meetings attended (read-only)
+	AttendedOccurrenceCount *int `form:"attended_occurrence_count,omitempty" json:"attended_occurrence_count,omitempty" xml:"attended_occurrence_count,omitempty"`
+	// Total meetings registered (read-only)
+	TotalOccurrenceCount *int `form:"total_occurrence_count,omitempty" json:"total_occurrence_count,omitempty" xml:"total_occurrence_count,omitempty"`
+	// Last invite timestamp RFC3339 (read-only)
+	LastInviteReceivedTime *string `form:"last_invite_received_time,omitempty" json:"last_invite_received_time,omitempty" xml:"last_invite_received_time,omitempty"`
+	// Last email message ID (read-only)
+	LastInviteReceivedMessageID *string `form:"last_invite_received_message_id,omitempty" json:"last_invite_received_message_id,omitempty" xml:"last_invite_received_message_id,omitempty"`
+	// delivered or failed (read-only)
+	LastInviteDeliveryStatus *string `form:"last_invite_delivery_status,omitempty" json:"last_invite_delivery_status,omitempty" xml:"last_invite_delivery_status,omitempty"`
+	// Delivery status details (read-only)
+	LastInviteDeliveryDescription *string `form:"last_invite_delivery_description,omitempty" json:"last_invite_delivery_description,omitempty" xml:"last_invite_delivery_description,omitempty"`
+	// Creation timestamp RFC3339 (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Creator user info (read-only)
+	CreatedBy *ITXUserResponseBody `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Last modified timestamp RFC3339 (read-only)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Last updater user info (read-only)
+	UpdatedBy *ITXUserResponseBody `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// RemoveItxRegistrantHostResponseBody is the type of the "Meeting Service"
+// service "remove-itx-registrant-host" endpoint HTTP response body.
+type RemoveItxRegistrantHostResponseBody struct {
+	// Registrant UID (read-only)
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Registrant type: direct or committee (read-only)
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Committee UID (for committee registrants)
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Registrant email
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LF username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// First name (required with email)
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name (required with email)
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Organization
+	Org *string `form:"org,omitempty" json:"org,omitempty" xml:"org,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Profile picture URL
+	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
+	// Access to host key for the meeting
+	Host *bool `form:"host,omitempty" json:"host,omitempty" xml:"host,omitempty"`
+	// Specific occurrence ID (blank = all occurrences)
+	Occurrence *string `form:"occurrence,omitempty" json:"occurrence,omitempty" xml:"occurrence,omitempty"`
+	// Number of meetings attended (read-only)
+	AttendedOccurrenceCount *int `form:"attended_occurrence_count,omitempty" json:"attended_occurrence_count,omitempty" xml:"attended_occurrence_count,omitempty"`
+	// Total meetings registered (read-only)
+	TotalOccurrenceCount *int `form:"total_occurrence_count,omitempty" json:"total_occurrence_count,omitempty" xml:"total_occurrence_count,omitempty"`
+	// Last invite timestamp RFC3339 (read-only)
+	LastInviteReceivedTime *string `form:"last_invite_received_time,omitempty" json:"last_invite_received_time,omitempty" xml:"last_invite_received_time,omitempty"`
+	// Last email message ID (read-only)
+	LastInviteReceivedMessageID *string `form:"last_invite_received_message_id,omitempty" json:"last_invite_received_message_id,omitempty" xml:"last_invite_received_message_id,omitempty"`
+	// delivered or failed (read-only)
+	LastInviteDeliveryStatus *string `form:"last_invite_delivery_status,omitempty" json:"last_invite_delivery_status,omitempty" xml:"last_invite_delivery_status,omitempty"`
+	// Delivery status details (read-only)
+	LastInviteDeliveryDescription *string `form:"last_invite_delivery_description,omitempty" json:"last_invite_delivery_description,omitempty" xml:"last_invite_delivery_description,omitempty"`
+	// Creation timestamp RFC3339 (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Creator user info (read-only)
+	CreatedBy *ITXUserResponseBody `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Last modified timestamp RFC3339 (read-only)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Last updater user info (read-only)
+	UpdatedBy *ITXUserResponseBody `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// ListItxMeetingOccurrencesResponseBody is the type of the "Meeting Service"
+// service "list-itx-meeting-occurrences" endpoint HTTP response body.
+type ListItxMeetingOccurrencesResponseBody struct {
+	// IANA timezone of the local times
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Occurrences
+	Occurrences []*ITXLocalOccurrenceResponseBody `form:"occurrences,omitempty" json:"occurrences,omitempty" xml:"occurrences,omitempty"`
 }
 
 // SubmitItxMeetingResponseResponseBody is the type of the "Meeting Service"
@@ -908,6 +1191,29 @@ type GetItxPastMeetingSummaryResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// GetItxPastMeetingSummaryDiffResponseBody is the type of the "Meeting
+// Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP response
+// body.
+type GetItxPastMeetingSummaryDiffResponseBody struct {
+	// The unique identifier of the summary
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// The past meeting identifier (meeting_id-occurrence_id)
+	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
+	// Whether the summary has edited content that differs from the AI-generated
+	// content
+	HasEdits *bool `form:"has_edits,omitempty" json:"has_edits,omitempty" xml:"has_edits,omitempty"`
+	// Number of lines added in the edited content
+	Additions *int `form:"additions,omitempty" json:"additions,omitempty" xml:"additions,omitempty"`
+	// Number of lines removed from the AI-generated content
+	Deletions *int `form:"deletions,omitempty" json:"deletions,omitempty" xml:"deletions,omitempty"`
+	// Whether the changed region was too large to diff line by line, in which case
+	// its AI-generated lines are reported as deleted and its edited lines as
+	// inserted
+	Approximate *bool `form:"approximate,omitempty" json:"approximate,omitempty" xml:"approximate,omitempty"`
+	// Diff lines in document order
+	Lines []*SummaryDiffLineResponseBody `form:"lines,omitempty" json:"lines,omitempty" xml:"lines,omitempty"`
+}
+
 // UpdateItxPastMeetingSummaryResponseBody is the type of the "Meeting Service"
 // service "update-itx-past-meeting-summary" endpoint HTTP response body.
 type UpdateItxPastMeetingSummaryResponseBody struct {
@@ -937,48 +1243,107 @@ type UpdateItxPastMeetingSummaryResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
-// CreateItxPastMeetingParticipantResponseBody is the type of the "Meeting
-// Service" service "create-itx-past-meeting-participant" endpoint HTTP
-// response body.
-type CreateItxPastMeetingParticipantResponseBody struct {
-	// Participant identifier (invitee_id or attendee_id or both)
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Invitee record UUID (if is_invited=true)
-	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
-	// Attendee record UUID (if is_attended=true)
-	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
-	// Past meeting ID (meeting_id-occurrence_id)
+// ApproveItxPastMeetingSummaryResponseBody is the type of the "Meeting
+// Service" service "approve-itx-past-meeting-summary" endpoint HTTP response
+// body.
+type ApproveItxPastMeetingSummaryResponseBody struct {
+	// The unique identifier of the summary
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// The past meeting identifier (meeting_id-occurrence_id)
 	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
-	// Meeting ID
+	// The meeting identifier
 	MeetingID *string `form:"meeting_id,omitempty" json:"meeting_id,omitempty" xml:"meeting_id,omitempty"`
-	// Primary email address
-	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
-	// First name
-	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
-	// Last name
-	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
-	// LF SSO username
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// LF user ID (Salesforce ID)
-	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
-	// Organization name
-	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
-	// Job title
-	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
-	// Whether org has LF membership
-	OrgIsMember *bool `form:"org_is_member,omitempty" json:"org_is_member,omitempty" xml:"org_is_member,omitempty"`
-	// Whether org has project membership
-	OrgIsProjectMember *bool `form:"org_is_project_member,omitempty" json:"org_is_project_member,omitempty" xml:"org_is_project_member,omitempty"`
-	// Associated committee UUID
-	CommitteeID *string `form:"committee_id,omitempty" json:"committee_id,omitempty" xml:"committee_id,omitempty"`
-	// Role within committee
-	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
-	// Whether participant is a committee member
-	IsCommitteeMember *bool `form:"is_committee_member,omitempty" json:"is_committee_member,omitempty" xml:"is_committee_member,omitempty"`
-	// Voting status in committee
-	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
-	// URL to profile picture
-	AvatarURL *string `form:"avatar_url,omitempty" json:"avatar_url,omitempty" xml:"avatar_url,omitempty"`
+	// Meeting platform
+	Platform *string `form:"platform,omitempty" json:"platform,omitempty" xml:"platform,omitempty"`
+	// Password for accessing the summary (if required)
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Zoom-specific configuration
+	ZoomConfig *PastMeetingSummaryZoomConfigResponseBody `form:"zoom_config,omitempty" json:"zoom_config,omitempty" xml:"zoom_config,omitempty"`
+	// The actual summary content
+	SummaryData *SummaryDataResponseBody `form:"summary_data,omitempty" json:"summary_data,omitempty" xml:"summary_data,omitempty"`
+	// Whether the summary requires approval
+	RequiresApproval *bool `form:"requires_approval,omitempty" json:"requires_approval,omitempty" xml:"requires_approval,omitempty"`
+	// Whether the summary has been approved
+	Approved *bool `form:"approved,omitempty" json:"approved,omitempty" xml:"approved,omitempty"`
+	// Whether summary email has been sent
+	EmailSent *bool `form:"email_sent,omitempty" json:"email_sent,omitempty" xml:"email_sent,omitempty"`
+	// Creation timestamp (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Update timestamp (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryResponseBody is the type of the "Meeting Service"
+// service "reject-itx-past-meeting-summary" endpoint HTTP response body.
+type RejectItxPastMeetingSummaryResponseBody struct {
+	// The unique identifier of the summary
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// The past meeting identifier (meeting_id-occurrence_id)
+	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
+	// The meeting identifier
+	MeetingID *string `form:"meeting_id,omitempty" json:"meeting_id,omitempty" xml:"meeting_id,omitempty"`
+	// Meeting platform
+	Platform *string `form:"platform,omitempty" json:"platform,omitempty" xml:"platform,omitempty"`
+	// Password for accessing the summary (if required)
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Zoom-specific configuration
+	ZoomConfig *PastMeetingSummaryZoomConfigResponseBody `form:"zoom_config,omitempty" json:"zoom_config,omitempty" xml:"zoom_config,omitempty"`
+	// The actual summary content
+	SummaryData *SummaryDataResponseBody `form:"summary_data,omitempty" json:"summary_data,omitempty" xml:"summary_data,omitempty"`
+	// Whether the summary requires approval
+	RequiresApproval *bool `form:"requires_approval,omitempty" json:"requires_approval,omitempty" xml:"requires_approval,omitempty"`
+	// Whether the summary has been approved
+	Approved *bool `form:"approved,omitempty" json:"approved,omitempty" xml:"approved,omitempty"`
+	// Whether summary email has been sent
+	EmailSent *bool `form:"email_sent,omitempty" json:"email_sent,omitempty" xml:"email_sent,omitempty"`
+	// Creation timestamp (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Update timestamp (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// CreateItxPastMeetingParticipantResponseBody is the type of the "Meeting
+// Service" service "create-itx-past-meeting-participant" endpoint HTTP
+// response body.
+type CreateItxPastMeetingParticipantResponseBody struct {
+	// Participant identifier (invitee_id or attendee_id or both)
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Invitee record UUID (if is_invited=true)
+	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
+	// Attendee record UUID (if is_attended=true)
+	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
+	// Past meeting ID (meeting_id-occurrence_id)
+	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
+	// Meeting ID
+	MeetingID *string `form:"meeting_id,omitempty" json:"meeting_id,omitempty" xml:"meeting_id,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// LF SSO username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// LF user ID (Salesforce ID)
+	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
+	// Organization name
+	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Whether org has LF membership
+	OrgIsMember *bool `form:"org_is_member,omitempty" json:"org_is_member,omitempty" xml:"org_is_member,omitempty"`
+	// Whether org has project membership
+	OrgIsProjectMember *bool `form:"org_is_project_member,omitempty" json:"org_is_project_member,omitempty" xml:"org_is_project_member,omitempty"`
+	// Associated committee UUID
+	CommitteeID *string `form:"committee_id,omitempty" json:"committee_id,omitempty" xml:"committee_id,omitempty"`
+	// Role within committee
+	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
+	// Whether participant is a committee member
+	IsCommitteeMember *bool `form:"is_committee_member,omitempty" json:"is_committee_member,omitempty" xml:"is_committee_member,omitempty"`
+	// Voting status in committee
+	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
+	// URL to profile picture
+	AvatarURL *string `form:"avatar_url,omitempty" json:"avatar_url,omitempty" xml:"avatar_url,omitempty"`
 	// Whether the participant was invited/registered to this past meeting
 	IsInvited *bool `form:"is_invited,omitempty" json:"is_invited,omitempty" xml:"is_invited,omitempty"`
 	// Whether the participant attended this past meeting
@@ -1085,6 +1450,14 @@ type UpdateItxPastMeetingParticipantResponseBody struct {
 	ModifiedBy *ITXUserResponseBody `form:"modified_by,omitempty" json:"modified_by,omitempty" xml:"modified_by,omitempty"`
 }
 
+// BulkUpdateItxPastMeetingParticipantsResponseBody is the type of the "Meeting
+// Service" service "bulk-update-itx-past-meeting-participants" endpoint HTTP
+// response body.
+type BulkUpdateItxPastMeetingParticipantsResponseBody struct {
+	// Updated participants
+	Participants []*ITXPastMeetingParticipantResponseBody `form:"participants,omitempty" json:"participants,omitempty" xml:"participants,omitempty"`
+}
+
 // CreateItxMeetingAttachmentResponseBody is the type of the "Meeting Service"
 // service "create-itx-meeting-attachment" endpoint HTTP response body.
 type CreateItxMeetingAttachmentResponseBody struct {
@@ -1370,6 +1743,86 @@ type ReadyzServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// GetServiceConfigBadRequestResponseBody is the type of the "Meeting Service"
+// service "get-service-config" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetServiceConfigBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetServiceConfigForbiddenResponseBody is the type of the "Meeting Service"
+// service "get-service-config" endpoint HTTP response body for the "Forbidden"
+// error.
+type GetServiceConfigForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetServiceConfigServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "get-service-config" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetServiceConfigServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetServiceConfigUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "get-service-config" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetServiceConfigUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetServiceHealthBadRequestResponseBody is the type of the "Meeting Service"
+// service "get-service-health" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetServiceHealthBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetServiceHealthForbiddenResponseBody is the type of the "Meeting Service"
+// service "get-service-health" endpoint HTTP response body for the "Forbidden"
+// error.
+type GetServiceHealthForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetServiceHealthServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "get-service-health" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetServiceHealthServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetServiceHealthUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "get-service-health" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetServiceHealthUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // CreateItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
 // service "create-itx-meeting" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -1550,6 +2003,76 @@ type DeleteItxMeetingUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// CloneItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "BadRequest"
+// error.
+type CloneItxMeetingBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneItxMeetingConflictResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "Conflict"
+// error.
+type CloneItxMeetingConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneItxMeetingForbiddenResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "Forbidden"
+// error.
+type CloneItxMeetingForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneItxMeetingInternalServerErrorResponseBody is the type of the "Meeting
+// Service" service "clone-itx-meeting" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CloneItxMeetingInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneItxMeetingNotFoundResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "NotFound"
+// error.
+type CloneItxMeetingNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneItxMeetingServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "clone-itx-meeting" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CloneItxMeetingServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneItxMeetingUnauthorizedResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the
+// "Unauthorized" error.
+type CloneItxMeetingUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // UpdateItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
 // service "update-itx-meeting" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -1680,6 +2203,36 @@ type GetItxMeetingCountUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// PreviewItxMeetingOccurrencesBadRequestResponseBody is the type of the
+// "Meeting Service" service "preview-itx-meeting-occurrences" endpoint HTTP
+// response body for the "BadRequest" error.
+type PreviewItxMeetingOccurrencesBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PreviewItxMeetingOccurrencesInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "preview-itx-meeting-occurrences" endpoint
+// HTTP response body for the "InternalServerError" error.
+type PreviewItxMeetingOccurrencesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PreviewItxMeetingOccurrencesUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "preview-itx-meeting-occurrences" endpoint HTTP
+// response body for the "Unauthorized" error.
+type PreviewItxMeetingOccurrencesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // CreateItxRegistrantBadRequestResponseBody is the type of the "Meeting
 // Service" service "create-itx-registrant" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -1940,6 +2493,16 @@ type GetItxJoinLinkBadRequestResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// GetItxJoinLinkConflictResponseBody is the type of the "Meeting Service"
+// service "get-itx-join-link" endpoint HTTP response body for the "Conflict"
+// error.
+type GetItxJoinLinkConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // GetItxJoinLinkForbiddenResponseBody is the type of the "Meeting Service"
 // service "get-itx-join-link" endpoint HTTP response body for the "Forbidden"
 // error.
@@ -1990,68 +2553,258 @@ type GetItxJoinLinkUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetItxRegistrantIcsBadRequestResponseBody is the type of the "Meeting
-// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
-// the "BadRequest" error.
-type GetItxRegistrantIcsBadRequestResponseBody struct {
+// LaunchItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the
+// "BadRequest" error.
+type LaunchItxMeetingBadRequestResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetItxRegistrantIcsForbiddenResponseBody is the type of the "Meeting
-// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
-// the "Forbidden" error.
-type GetItxRegistrantIcsForbiddenResponseBody struct {
+// LaunchItxMeetingConflictResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the "Conflict"
+// error.
+type LaunchItxMeetingConflictResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetItxRegistrantIcsInternalServerErrorResponseBody is the type of the
-// "Meeting Service" service "get-itx-registrant-ics" endpoint HTTP response
-// body for the "InternalServerError" error.
-type GetItxRegistrantIcsInternalServerErrorResponseBody struct {
+// LaunchItxMeetingForbiddenResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the "Forbidden"
+// error.
+type LaunchItxMeetingForbiddenResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetItxRegistrantIcsNotFoundResponseBody is the type of the "Meeting Service"
-// service "get-itx-registrant-ics" endpoint HTTP response body for the
-// "NotFound" error.
-type GetItxRegistrantIcsNotFoundResponseBody struct {
+// LaunchItxMeetingInternalServerErrorResponseBody is the type of the "Meeting
+// Service" service "launch-itx-meeting" endpoint HTTP response body for the
+// "InternalServerError" error.
+type LaunchItxMeetingInternalServerErrorResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetItxRegistrantIcsServiceUnavailableResponseBody is the type of the
-// "Meeting Service" service "get-itx-registrant-ics" endpoint HTTP response
-// body for the "ServiceUnavailable" error.
-type GetItxRegistrantIcsServiceUnavailableResponseBody struct {
+// LaunchItxMeetingNotFoundResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the "NotFound"
+// error.
+type LaunchItxMeetingNotFoundResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetItxRegistrantIcsUnauthorizedResponseBody is the type of the "Meeting
-// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
-// the "Unauthorized" error.
-type GetItxRegistrantIcsUnauthorizedResponseBody struct {
+// LaunchItxMeetingServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "launch-itx-meeting" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type LaunchItxMeetingServiceUnavailableResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// ResendItxRegistrantInvitationBadRequestResponseBody is the type of the
-// "Meeting Service" service "resend-itx-registrant-invitation" endpoint HTTP
+// LaunchItxMeetingUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "launch-itx-meeting" endpoint HTTP response body for the
+// "Unauthorized" error.
+type LaunchItxMeetingUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxRegistrantIcsBadRequestResponseBody is the type of the "Meeting
+// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
+// the "BadRequest" error.
+type GetItxRegistrantIcsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxRegistrantIcsForbiddenResponseBody is the type of the "Meeting
+// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
+// the "Forbidden" error.
+type GetItxRegistrantIcsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxRegistrantIcsInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "get-itx-registrant-ics" endpoint HTTP response
+// body for the "InternalServerError" error.
+type GetItxRegistrantIcsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxRegistrantIcsNotFoundResponseBody is the type of the "Meeting Service"
+// service "get-itx-registrant-ics" endpoint HTTP response body for the
+// "NotFound" error.
+type GetItxRegistrantIcsNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxRegistrantIcsServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "get-itx-registrant-ics" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type GetItxRegistrantIcsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxRegistrantIcsUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
+// the "Unauthorized" error.
+type GetItxRegistrantIcsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItxRegistrantHostBadRequestResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "BadRequest" error.
+type AddItxRegistrantHostBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItxRegistrantHostForbiddenResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "Forbidden" error.
+type AddItxRegistrantHostForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItxRegistrantHostInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "add-itx-registrant-host" endpoint HTTP response
+// body for the "InternalServerError" error.
+type AddItxRegistrantHostInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItxRegistrantHostNotFoundResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "NotFound" error.
+type AddItxRegistrantHostNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItxRegistrantHostServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "add-itx-registrant-host" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type AddItxRegistrantHostServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItxRegistrantHostUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "Unauthorized" error.
+type AddItxRegistrantHostUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItxRegistrantHostBadRequestResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "BadRequest" error.
+type RemoveItxRegistrantHostBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItxRegistrantHostForbiddenResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "Forbidden" error.
+type RemoveItxRegistrantHostForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItxRegistrantHostInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "remove-itx-registrant-host" endpoint HTTP
+// response body for the "InternalServerError" error.
+type RemoveItxRegistrantHostInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItxRegistrantHostNotFoundResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "NotFound" error.
+type RemoveItxRegistrantHostNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItxRegistrantHostServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "remove-itx-registrant-host" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type RemoveItxRegistrantHostServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItxRegistrantHostUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "Unauthorized" error.
+type RemoveItxRegistrantHostUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ResendItxRegistrantInvitationBadRequestResponseBody is the type of the
+// "Meeting Service" service "resend-itx-registrant-invitation" endpoint HTTP
 // response body for the "BadRequest" error.
 type ResendItxRegistrantInvitationBadRequestResponseBody struct {
 	// HTTP status code
@@ -2230,6 +2983,66 @@ type RegisterItxCommitteeMembersUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ListItxMeetingOccurrencesBadRequestResponseBody is the type of the "Meeting
+// Service" service "list-itx-meeting-occurrences" endpoint HTTP response body
+// for the "BadRequest" error.
+type ListItxMeetingOccurrencesBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListItxMeetingOccurrencesForbiddenResponseBody is the type of the "Meeting
+// Service" service "list-itx-meeting-occurrences" endpoint HTTP response body
+// for the "Forbidden" error.
+type ListItxMeetingOccurrencesForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListItxMeetingOccurrencesInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "list-itx-meeting-occurrences" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListItxMeetingOccurrencesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListItxMeetingOccurrencesNotFoundResponseBody is the type of the "Meeting
+// Service" service "list-itx-meeting-occurrences" endpoint HTTP response body
+// for the "NotFound" error.
+type ListItxMeetingOccurrencesNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListItxMeetingOccurrencesServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "list-itx-meeting-occurrences" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListItxMeetingOccurrencesServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListItxMeetingOccurrencesUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "list-itx-meeting-occurrences" endpoint HTTP
+// response body for the "Unauthorized" error.
+type ListItxMeetingOccurrencesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // UpdateItxOccurrenceBadRequestResponseBody is the type of the "Meeting
 // Service" service "update-itx-occurrence" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -2720,6 +3533,66 @@ type GetItxPastMeetingSummaryUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// GetItxPastMeetingSummaryDiffBadRequestResponseBody is the type of the
+// "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP
+// response body for the "BadRequest" error.
+type GetItxPastMeetingSummaryDiffBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxPastMeetingSummaryDiffForbiddenResponseBody is the type of the
+// "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP
+// response body for the "Forbidden" error.
+type GetItxPastMeetingSummaryDiffForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint
+// HTTP response body for the "InternalServerError" error.
+type GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxPastMeetingSummaryDiffNotFoundResponseBody is the type of the "Meeting
+// Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP response
+// body for the "NotFound" error.
+type GetItxPastMeetingSummaryDiffNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody is the type of
+// the "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint
+// HTTP response body for the "ServiceUnavailable" error.
+type GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItxPastMeetingSummaryDiffUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP
+// response body for the "Unauthorized" error.
+type GetItxPastMeetingSummaryDiffUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // UpdateItxPastMeetingSummaryBadRequestResponseBody is the type of the
 // "Meeting Service" service "update-itx-past-meeting-summary" endpoint HTTP
 // response body for the "BadRequest" error.
@@ -2780,40 +3653,180 @@ type UpdateItxPastMeetingSummaryUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// CreateItxPastMeetingParticipantBadRequestResponseBody is the type of the
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "BadRequest" error.
-type CreateItxPastMeetingParticipantBadRequestResponseBody struct {
+// ApproveItxPastMeetingSummaryBadRequestResponseBody is the type of the
+// "Meeting Service" service "approve-itx-past-meeting-summary" endpoint HTTP
+// response body for the "BadRequest" error.
+type ApproveItxPastMeetingSummaryBadRequestResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// CreateItxPastMeetingParticipantForbiddenResponseBody is the type of the
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "Forbidden" error.
-type CreateItxPastMeetingParticipantForbiddenResponseBody struct {
+// ApproveItxPastMeetingSummaryConflictResponseBody is the type of the "Meeting
+// Service" service "approve-itx-past-meeting-summary" endpoint HTTP response
+// body for the "Conflict" error.
+type ApproveItxPastMeetingSummaryConflictResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// CreateItxPastMeetingParticipantInternalServerErrorResponseBody is the type
-// of the "Meeting Service" service "create-itx-past-meeting-participant"
-// endpoint HTTP response body for the "InternalServerError" error.
-type CreateItxPastMeetingParticipantInternalServerErrorResponseBody struct {
+// ApproveItxPastMeetingSummaryForbiddenResponseBody is the type of the
+// "Meeting Service" service "approve-itx-past-meeting-summary" endpoint HTTP
+// response body for the "Forbidden" error.
+type ApproveItxPastMeetingSummaryForbiddenResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// CreateItxPastMeetingParticipantNotFoundResponseBody is the type of the
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "NotFound" error.
-type CreateItxPastMeetingParticipantNotFoundResponseBody struct {
+// ApproveItxPastMeetingSummaryInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "approve-itx-past-meeting-summary" endpoint
+// HTTP response body for the "InternalServerError" error.
+type ApproveItxPastMeetingSummaryInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ApproveItxPastMeetingSummaryNotFoundResponseBody is the type of the "Meeting
+// Service" service "approve-itx-past-meeting-summary" endpoint HTTP response
+// body for the "NotFound" error.
+type ApproveItxPastMeetingSummaryNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ApproveItxPastMeetingSummaryServiceUnavailableResponseBody is the type of
+// the "Meeting Service" service "approve-itx-past-meeting-summary" endpoint
+// HTTP response body for the "ServiceUnavailable" error.
+type ApproveItxPastMeetingSummaryServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ApproveItxPastMeetingSummaryUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "approve-itx-past-meeting-summary" endpoint HTTP
+// response body for the "Unauthorized" error.
+type ApproveItxPastMeetingSummaryUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryBadRequestResponseBody is the type of the
+// "Meeting Service" service "reject-itx-past-meeting-summary" endpoint HTTP
+// response body for the "BadRequest" error.
+type RejectItxPastMeetingSummaryBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryConflictResponseBody is the type of the "Meeting
+// Service" service "reject-itx-past-meeting-summary" endpoint HTTP response
+// body for the "Conflict" error.
+type RejectItxPastMeetingSummaryConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryForbiddenResponseBody is the type of the "Meeting
+// Service" service "reject-itx-past-meeting-summary" endpoint HTTP response
+// body for the "Forbidden" error.
+type RejectItxPastMeetingSummaryForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "reject-itx-past-meeting-summary" endpoint
+// HTTP response body for the "InternalServerError" error.
+type RejectItxPastMeetingSummaryInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryNotFoundResponseBody is the type of the "Meeting
+// Service" service "reject-itx-past-meeting-summary" endpoint HTTP response
+// body for the "NotFound" error.
+type RejectItxPastMeetingSummaryNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "reject-itx-past-meeting-summary" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type RejectItxPastMeetingSummaryServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RejectItxPastMeetingSummaryUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "reject-itx-past-meeting-summary" endpoint HTTP
+// response body for the "Unauthorized" error.
+type RejectItxPastMeetingSummaryUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateItxPastMeetingParticipantBadRequestResponseBody is the type of the
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "BadRequest" error.
+type CreateItxPastMeetingParticipantBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateItxPastMeetingParticipantForbiddenResponseBody is the type of the
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "Forbidden" error.
+type CreateItxPastMeetingParticipantForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateItxPastMeetingParticipantInternalServerErrorResponseBody is the type
+// of the "Meeting Service" service "create-itx-past-meeting-participant"
+// endpoint HTTP response body for the "InternalServerError" error.
+type CreateItxPastMeetingParticipantInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateItxPastMeetingParticipantNotFoundResponseBody is the type of the
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "NotFound" error.
+type CreateItxPastMeetingParticipantNotFoundResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
@@ -2900,6 +3913,58 @@ type UpdateItxPastMeetingParticipantUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody is the type of
+// the "Meeting Service" service "bulk-update-itx-past-meeting-participants"
+// endpoint HTTP response body for the "BadRequest" error.
+type BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody is the type of the
+// "Meeting Service" service "bulk-update-itx-past-meeting-participants"
+// endpoint HTTP response body for the "Forbidden" error.
+type BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody is the
+// type of the "Meeting Service" service
+// "bulk-update-itx-past-meeting-participants" endpoint HTTP response body for
+// the "InternalServerError" error.
+type BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody is the
+// type of the "Meeting Service" service
+// "bulk-update-itx-past-meeting-participants" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody is the type of
+// the "Meeting Service" service "bulk-update-itx-past-meeting-participants"
+// endpoint HTTP response body for the "Unauthorized" error.
+type BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // DeleteItxPastMeetingParticipantBadRequestResponseBody is the type of the
 // "Meeting Service" service "delete-itx-past-meeting-participant" endpoint
 // HTTP response body for the "BadRequest" error.
@@ -3684,6 +4749,83 @@ type GetItxPastMeetingAttachmentDownloadUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ServiceEventProcessingConfigResponseBody is used to define fields on
+// response body types.
+type ServiceEventProcessingConfigResponseBody struct {
+	// Whether the v1-objects KV consumer is started
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// JetStream consumer name
+	ConsumerName *string `form:"consumer_name,omitempty" json:"consumer_name,omitempty" xml:"consumer_name,omitempty"`
+	// JetStream stream name
+	StreamName *string `form:"stream_name,omitempty" json:"stream_name,omitempty" xml:"stream_name,omitempty"`
+	// KV subjects the consumer is filtered to
+	FilterSubjects []string `form:"filter_subjects,omitempty" json:"filter_subjects,omitempty" xml:"filter_subjects,omitempty"`
+	// Maximum delivery attempts per message
+	MaxDeliver *int `form:"max_deliver,omitempty" json:"max_deliver,omitempty" xml:"max_deliver,omitempty"`
+	// Ack wait timeout as a Go duration
+	AckWait *string `form:"ack_wait,omitempty" json:"ack_wait,omitempty" xml:"ack_wait,omitempty"`
+	// Maximum unacknowledged messages
+	MaxAckPending *int `form:"max_ack_pending,omitempty" json:"max_ack_pending,omitempty" xml:"max_ack_pending,omitempty"`
+	// KV bucket used for v1 mappings
+	V1MappingsBucket *string `form:"v1_mappings_bucket,omitempty" json:"v1_mappings_bucket,omitempty" xml:"v1_mappings_bucket,omitempty"`
+	// Consecutive failures that open an object type's circuit breaker (0 disables)
+	BreakerThreshold *int `form:"breaker_threshold,omitempty" json:"breaker_threshold,omitempty" xml:"breaker_threshold,omitempty"`
+	// Circuit breaker cooldown as a Go duration
+	BreakerCooldown *string `form:"breaker_cooldown,omitempty" json:"breaker_cooldown,omitempty" xml:"breaker_cooldown,omitempty"`
+}
+
+// ServiceInvitesConfigResponseBody is used to define fields on response body
+// types.
+type ServiceInvitesConfigResponseBody struct {
+	// Whether outbound LFID invites are enabled
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// LFX self-serve base URL used in invite links (empty when invalid)
+	SelfServeBaseURL *string `form:"self_serve_base_url,omitempty" json:"self_serve_base_url,omitempty" xml:"self_serve_base_url,omitempty"`
+}
+
+// ServiceITXResilienceConfigResponseBody is used to define fields on response
+// body types.
+type ServiceITXResilienceConfigResponseBody struct {
+	// Retries of an idempotent request after a transient failure (0 disables
+	// retries)
+	MaxRetries *int `form:"max_retries,omitempty" json:"max_retries,omitempty" xml:"max_retries,omitempty"`
+	// Base retry backoff as a Go duration
+	RetryBaseDelay *string `form:"retry_base_delay,omitempty" json:"retry_base_delay,omitempty" xml:"retry_base_delay,omitempty"`
+	// Maximum retry backoff as a Go duration
+	RetryMaxDelay *string `form:"retry_max_delay,omitempty" json:"retry_max_delay,omitempty" xml:"retry_max_delay,omitempty"`
+	// Timeout of a single attempt of an idempotent request as a Go duration (0s
+	// means no per-attempt bound)
+	AttemptTimeout *string `form:"attempt_timeout,omitempty" json:"attempt_timeout,omitempty" xml:"attempt_timeout,omitempty"`
+	// attempt_timeout overrides by endpoint group (ics, attachments) as Go
+	// durations
+	EndpointAttemptTimeouts map[string]string `form:"endpoint_attempt_timeouts,omitempty" json:"endpoint_attempt_timeouts,omitempty" xml:"endpoint_attempt_timeouts,omitempty"`
+	// Consecutive failures that open the ITX circuit breaker (0 disables)
+	BreakerThreshold *int `form:"breaker_threshold,omitempty" json:"breaker_threshold,omitempty" xml:"breaker_threshold,omitempty"`
+	// Circuit breaker cooldown as a Go duration
+	BreakerCooldown *string `form:"breaker_cooldown,omitempty" json:"breaker_cooldown,omitempty" xml:"breaker_cooldown,omitempty"`
+}
+
+// ServiceAttachmentsConfigResponseBody is used to define fields on response
+// body types.
+type ServiceAttachmentsConfigResponseBody struct {
+	// Maximum attachment size in bytes (0 means no limit)
+	MaxFileSize *int64 `form:"max_file_size,omitempty" json:"max_file_size,omitempty" xml:"max_file_size,omitempty"`
+	// Accepted attachment content types (empty means any)
+	AllowedContentTypes []string `form:"allowed_content_types,omitempty" json:"allowed_content_types,omitempty" xml:"allowed_content_types,omitempty"`
+}
+
+// DependencyHealthResponseBody is used to define fields on response body types.
+type DependencyHealthResponseBody struct {
+	// Dependency name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Check outcome
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// How long the check took, in milliseconds
+	LatencyMs *int64 `form:"latency_ms,omitempty" json:"latency_ms,omitempty" xml:"latency_ms,omitempty"`
+	// Why the check failed (only when status is error)
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
 // CommitteeRequestBody is used to define fields on request body types.
 type CommitteeRequestBody struct {
 	// Committee UID
@@ -3708,8 +4850,14 @@ type RecurrenceRequestBody struct {
 	MonthlyWeekDay *int `form:"monthly_week_day,omitempty" json:"monthly_week_day,omitempty" xml:"monthly_week_day,omitempty"`
 	// Number of occurrences
 	EndTimes *int `form:"end_times,omitempty" json:"end_times,omitempty" xml:"end_times,omitempty"`
-	// End date/time in RFC3339
+	// End date/time in RFC3339. Occurrence previews reject end dates more than 10
+	// years after the start time
 	EndDateTime *string `form:"end_date_time,omitempty" json:"end_date_time,omitempty" xml:"end_date_time,omitempty"`
+	// RFC 5545 RRULE equivalent of the recurrence. Set on responses unless the
+	// recurrence ITX returns has an invalid type or end_date_time. On requests it
+	// may be sent instead of type and the other fields above, for rules Zoom can
+	// represent
+	Rrule *string `form:"rrule,omitempty" json:"rrule,omitempty" xml:"rrule,omitempty"`
 }
 
 // CommitteeResponseBody is used to define fields on response body types.
@@ -3736,8 +4884,14 @@ type RecurrenceResponseBody struct {
 	MonthlyWeekDay *int `form:"monthly_week_day,omitempty" json:"monthly_week_day,omitempty" xml:"monthly_week_day,omitempty"`
 	// Number of occurrences
 	EndTimes *int `form:"end_times,omitempty" json:"end_times,omitempty" xml:"end_times,omitempty"`
-	// End date/time in RFC3339
+	// End date/time in RFC3339. Occurrence previews reject end dates more than 10
+	// years after the start time
 	EndDateTime *string `form:"end_date_time,omitempty" json:"end_date_time,omitempty" xml:"end_date_time,omitempty"`
+	// RFC 5545 RRULE equivalent of the recurrence. Set on responses unless the
+	// recurrence ITX returns has an invalid type or end_date_time. On requests it
+	// may be sent instead of type and the other fields above, for rules Zoom can
+	// represent
+	Rrule *string `form:"rrule,omitempty" json:"rrule,omitempty" xml:"rrule,omitempty"`
 }
 
 // ITXOccurrenceResponseBody is used to define fields on response body types.
@@ -3778,6 +4932,23 @@ type ITXUserResponseBody struct {
 	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
 }
 
+// ITXLocalOccurrenceResponseBody is used to define fields on response body
+// types.
+type ITXLocalOccurrenceResponseBody struct {
+	// Unix timestamp
+	OccurrenceID *string `form:"occurrence_id,omitempty" json:"occurrence_id,omitempty" xml:"occurrence_id,omitempty"`
+	// RFC3339 start time in UTC
+	StartTime *string `form:"start_time,omitempty" json:"start_time,omitempty" xml:"start_time,omitempty"`
+	// RFC3339 start time in the list's timezone
+	LocalStartTime *string `form:"local_start_time,omitempty" json:"local_start_time,omitempty" xml:"local_start_time,omitempty"`
+	// RFC3339 end time in the list's timezone
+	LocalEndTime *string `form:"local_end_time,omitempty" json:"local_end_time,omitempty" xml:"local_end_time,omitempty"`
+	// Duration in minutes
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// available or cancel
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+}
+
 // PastMeetingSummaryZoomConfigResponseBody is used to define fields on
 // response body types.
 type PastMeetingSummaryZoomConfigResponseBody struct {
@@ -3803,6 +4974,18 @@ type SummaryDataResponseBody struct {
 	EditedContent *string `form:"edited_content,omitempty" json:"edited_content,omitempty" xml:"edited_content,omitempty"`
 }
 
+// SummaryDiffLineResponseBody is used to define fields on response body types.
+type SummaryDiffLineResponseBody struct {
+	// How the line changed from the AI-generated content to the edited content
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty" xml:"operation,omitempty"`
+	// The line text
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+	// 1-based line number in the AI-generated content (omitted for inserted lines)
+	OriginalLine *int `form:"original_line,omitempty" json:"original_line,omitempty" xml:"original_line,omitempty"`
+	// 1-based line number in the edited content (omitted for deleted lines)
+	EditedLine *int `form:"edited_line,omitempty" json:"edited_line,omitempty" xml:"edited_line,omitempty"`
+}
+
 // ParticipantSessionRequestBody is used to define fields on request body types.
 type ParticipantSessionRequestBody struct {
 	// Zoom participant UUID
@@ -3828,72 +5011,190 @@ type ParticipantSessionResponseBody struct {
 	LeaveReason *string `form:"leave_reason,omitempty" json:"leave_reason,omitempty" xml:"leave_reason,omitempty"`
 }
 
-// NewCreateItxMeetingRequestBody builds the HTTP request body from the payload
-// of the "create-itx-meeting" endpoint of the "Meeting Service" service.
-func NewCreateItxMeetingRequestBody(p *meetingservice.CreateItxMeetingPayload) *CreateItxMeetingRequestBody {
-	body := &CreateItxMeetingRequestBody{
-		ProjectUID:               p.ProjectUID,
-		Title:                    p.Title,
-		StartTime:                p.StartTime,
-		Duration:                 p.Duration,
-		Timezone:                 p.Timezone,
-		Visibility:               p.Visibility,
-		Description:              p.Description,
-		Restricted:               p.Restricted,
-		MeetingType:              p.MeetingType,
-		EarlyJoinTimeMinutes:     p.EarlyJoinTimeMinutes,
-		RecordingEnabled:         p.RecordingEnabled,
-		TranscriptEnabled:        p.TranscriptEnabled,
-		YoutubeUploadEnabled:     p.YoutubeUploadEnabled,
-		AiSummaryEnabled:         p.AiSummaryEnabled,
-		RequireAiSummaryApproval: p.RequireAiSummaryApproval,
-		ArtifactVisibility:       p.ArtifactVisibility,
-	}
-	if p.Committees != nil {
-		body.Committees = make([]*CommitteeRequestBody, len(p.Committees))
-		for i, val := range p.Committees {
-			if val == nil {
-				body.Committees[i] = nil
-				continue
-			}
-			body.Committees[i] = marshalMeetingserviceCommitteeToCommitteeRequestBody(val)
-		}
-	}
-	if p.Recurrence != nil {
-		body.Recurrence = marshalMeetingserviceRecurrenceToRecurrenceRequestBody(p.Recurrence)
-	}
-	return body
+// ITXPastMeetingParticipantUpdateRequestBody is used to define fields on
+// request body types.
+type ITXPastMeetingParticipantUpdateRequestBody struct {
+	// Participant ID (invitee_id or attendee_id)
+	ParticipantID string `form:"participant_id" json:"participant_id" xml:"participant_id"`
+	// Optional invitee ID to use directly (avoids ID mapping lookup)
+	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
+	// Optional attendee ID to use directly (avoids ID mapping lookup)
+	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
+	// Whether the participant is invited (if false, invitee record will be deleted)
+	IsInvited *bool `form:"is_invited,omitempty" json:"is_invited,omitempty" xml:"is_invited,omitempty"`
+	// Whether the participant attended (if false, attendee record will be deleted)
+	IsAttended *bool `form:"is_attended,omitempty" json:"is_attended,omitempty" xml:"is_attended,omitempty"`
+	// Email address (used for creation)
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LF SSO username (used for creation)
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// LF user ID (used for creation)
+	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
+	// First name (required for invitee updates)
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name (required for invitee updates)
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Organization name
+	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Role within committee
+	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
+	// Voting status in committee
+	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
+	// Whether the attendee has been verified (attendee only)
+	IsVerified *bool `form:"is_verified,omitempty" json:"is_verified,omitempty" xml:"is_verified,omitempty"`
 }
 
-// NewUpdateItxMeetingRequestBody builds the HTTP request body from the payload
-// of the "update-itx-meeting" endpoint of the "Meeting Service" service.
-func NewUpdateItxMeetingRequestBody(p *meetingservice.UpdateItxMeetingPayload) *UpdateItxMeetingRequestBody {
-	body := &UpdateItxMeetingRequestBody{
-		ProjectUID:               p.ProjectUID,
-		Title:                    p.Title,
-		StartTime:                p.StartTime,
-		Duration:                 p.Duration,
-		Timezone:                 p.Timezone,
-		Visibility:               p.Visibility,
-		Description:              p.Description,
-		Restricted:               p.Restricted,
-		MeetingType:              p.MeetingType,
-		EarlyJoinTimeMinutes:     p.EarlyJoinTimeMinutes,
-		RecordingEnabled:         p.RecordingEnabled,
-		TranscriptEnabled:        p.TranscriptEnabled,
-		YoutubeUploadEnabled:     p.YoutubeUploadEnabled,
-		AiSummaryEnabled:         p.AiSummaryEnabled,
-		RequireAiSummaryApproval: p.RequireAiSummaryApproval,
-		ArtifactVisibility:       p.ArtifactVisibility,
-		UpdateNote:               p.UpdateNote,
-	}
-	if p.Committees != nil {
-		body.Committees = make([]*CommitteeRequestBody, len(p.Committees))
-		for i, val := range p.Committees {
-			if val == nil {
-				body.Committees[i] = nil
-				continue
-			}
+// ITXPastMeetingParticipantResponseBody is used to define fields on response
+// body types.
+type ITXPastMeetingParticipantResponseBody struct {
+	// Participant identifier (invitee_id or attendee_id or both)
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Invitee record UUID (if is_invited=true)
+	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
+	// Attendee record UUID (if is_attended=true)
+	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
+	// Past meeting ID (meeting_id-occurrence_id)
+	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
+	// Meeting ID
+	MeetingID *string `form:"meeting_id,omitempty" json:"meeting_id,omitempty" xml:"meeting_id,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// LF SSO username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// LF user ID (Salesforce ID)
+	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
+	// Organization name
+	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Whether org has LF membership
+	OrgIsMember *bool `form:"org_is_member,omitempty" json:"org_is_member,omitempty" xml:"org_is_member,omitempty"`
+	// Whether org has project membership
+	OrgIsProjectMember *bool `form:"org_is_project_member,omitempty" json:"org_is_project_member,omitempty" xml:"org_is_project_member,omitempty"`
+	// Associated committee UUID
+	CommitteeID *string `form:"committee_id,omitempty" json:"committee_id,omitempty" xml:"committee_id,omitempty"`
+	// Role within committee
+	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
+	// Whether participant is a committee member
+	IsCommitteeMember *bool `form:"is_committee_member,omitempty" json:"is_committee_member,omitempty" xml:"is_committee_member,omitempty"`
+	// Voting status in committee
+	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
+	// URL to profile picture
+	AvatarURL *string `form:"avatar_url,omitempty" json:"avatar_url,omitempty" xml:"avatar_url,omitempty"`
+	// Whether the participant was invited/registered to this past meeting
+	IsInvited *bool `form:"is_invited,omitempty" json:"is_invited,omitempty" xml:"is_invited,omitempty"`
+	// Whether the participant attended this past meeting
+	IsAttended *bool `form:"is_attended,omitempty" json:"is_attended,omitempty" xml:"is_attended,omitempty"`
+	// Whether the attendee has been verified (attendees only)
+	IsVerified *bool `form:"is_verified,omitempty" json:"is_verified,omitempty" xml:"is_verified,omitempty"`
+	// Whether attendee is marked as unknown (attendees only)
+	IsUnknown *bool `form:"is_unknown,omitempty" json:"is_unknown,omitempty" xml:"is_unknown,omitempty"`
+	// Whether the attendee record was updated via AI reconciliation (attendees
+	// only)
+	IsAiReconciled *bool `form:"is_ai_reconciled,omitempty" json:"is_ai_reconciled,omitempty" xml:"is_ai_reconciled,omitempty"`
+	// Whether the attendee name was auto-matched to a registrant's email
+	// (attendees only)
+	IsAutoMatched *bool `form:"is_auto_matched,omitempty" json:"is_auto_matched,omitempty" xml:"is_auto_matched,omitempty"`
+	// Zoom display name of the attendee (attendees only)
+	ZoomUserName *string `form:"zoom_user_name,omitempty" json:"zoom_user_name,omitempty" xml:"zoom_user_name,omitempty"`
+	// Full name of the invitee the attendee was matched to (attendees only)
+	MappedInviteeName *string `form:"mapped_invitee_name,omitempty" json:"mapped_invitee_name,omitempty" xml:"mapped_invitee_name,omitempty"`
+	// Array of session objects with join/leave times (attendees only)
+	Sessions []*ParticipantSessionResponseBody `form:"sessions,omitempty" json:"sessions,omitempty" xml:"sessions,omitempty"`
+	// Average attendance percentage (attendees only, calculated)
+	AverageAttendance *int `form:"average_attendance,omitempty" json:"average_attendance,omitempty" xml:"average_attendance,omitempty"`
+	// Creation timestamp (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Creator user info
+	CreatedBy *ITXUserResponseBody `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Last modified timestamp (RFC3339)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Last modifier user info
+	ModifiedBy *ITXUserResponseBody `form:"modified_by,omitempty" json:"modified_by,omitempty" xml:"modified_by,omitempty"`
+}
+
+// NewCreateItxMeetingRequestBody builds the HTTP request body from the payload
+// of the "create-itx-meeting" endpoint of the "Meeting Service" service.
+func NewCreateItxMeetingRequestBody(p *meetingservice.CreateItxMeetingPayload) *CreateItxMeetingRequestBody {
+	body := &CreateItxMeetingRequestBody{
+		ProjectUID:               p.ProjectUID,
+		Title:                    p.Title,
+		StartTime:                p.StartTime,
+		Duration:                 p.Duration,
+		Timezone:                 p.Timezone,
+		Visibility:               p.Visibility,
+		Description:              p.Description,
+		Restricted:               p.Restricted,
+		MeetingType:              p.MeetingType,
+		EarlyJoinTimeMinutes:     p.EarlyJoinTimeMinutes,
+		RecordingEnabled:         p.RecordingEnabled,
+		TranscriptEnabled:        p.TranscriptEnabled,
+		YoutubeUploadEnabled:     p.YoutubeUploadEnabled,
+		AiSummaryEnabled:         p.AiSummaryEnabled,
+		RequireAiSummaryApproval: p.RequireAiSummaryApproval,
+		ArtifactVisibility:       p.ArtifactVisibility,
+	}
+	if p.Committees != nil {
+		body.Committees = make([]*CommitteeRequestBody, len(p.Committees))
+		for i, val := range p.Committees {
+			if val == nil {
+				body.Committees[i] = nil
+				continue
+			}
+			body.Committees[i] = marshalMeetingserviceCommitteeToCommitteeRequestBody(val)
+		}
+	}
+	if p.Recurrence != nil {
+		body.Recurrence = marshalMeetingserviceRecurrenceToRecurrenceRequestBody(p.Recurrence)
+	}
+	return body
+}
+
+// NewCloneItxMeetingRequestBody builds the HTTP request body from the payload
+// of the "clone-itx-meeting" endpoint of the "Meeting Service" service.
+func NewCloneItxMeetingRequestBody(p *meetingservice.CloneItxMeetingPayload) *CloneItxMeetingRequestBody {
+	body := &CloneItxMeetingRequestBody{
+		StartTime: p.StartTime,
+		Title:     p.Title,
+	}
+	return body
+}
+
+// NewUpdateItxMeetingRequestBody builds the HTTP request body from the payload
+// of the "update-itx-meeting" endpoint of the "Meeting Service" service.
+func NewUpdateItxMeetingRequestBody(p *meetingservice.UpdateItxMeetingPayload) *UpdateItxMeetingRequestBody {
+	body := &UpdateItxMeetingRequestBody{
+		ProjectUID:               p.ProjectUID,
+		Title:                    p.Title,
+		StartTime:                p.StartTime,
+		Duration:                 p.Duration,
+		Timezone:                 p.Timezone,
+		Visibility:               p.Visibility,
+		Description:              p.Description,
+		Restricted:               p.Restricted,
+		MeetingType:              p.MeetingType,
+		EarlyJoinTimeMinutes:     p.EarlyJoinTimeMinutes,
+		RecordingEnabled:         p.RecordingEnabled,
+		TranscriptEnabled:        p.TranscriptEnabled,
+		YoutubeUploadEnabled:     p.YoutubeUploadEnabled,
+		AiSummaryEnabled:         p.AiSummaryEnabled,
+		RequireAiSummaryApproval: p.RequireAiSummaryApproval,
+		ArtifactVisibility:       p.ArtifactVisibility,
+		UpdateNote:               p.UpdateNote,
+	}
+	if p.Committees != nil {
+		body.Committees = make([]*CommitteeRequestBody, len(p.Committees))
+		for i, val := range p.Committees {
+			if val == nil {
+				body.Committees[i] = nil
+				continue
+			}
 			body.Committees[i] = marshalMeetingserviceCommitteeToCommitteeRequestBody(val)
 		}
 	}
@@ -3903,6 +5204,28 @@ func NewUpdateItxMeetingRequestBody(p *meetingservice.UpdateItxMeetingPayload) *
 	return body
 }
 
+// NewPreviewItxMeetingOccurrencesRequestBody builds the HTTP request body from
+// the payload of the "preview-itx-meeting-occurrences" endpoint of the
+// "Meeting Service" service.
+func NewPreviewItxMeetingOccurrencesRequestBody(p *meetingservice.PreviewItxMeetingOccurrencesPayload) *PreviewItxMeetingOccurrencesRequestBody {
+	body := &PreviewItxMeetingOccurrencesRequestBody{
+		StartTime: p.StartTime,
+		Timezone:  p.Timezone,
+		Duration:  p.Duration,
+		Count:     p.Count,
+	}
+	if p.Recurrence != nil {
+		body.Recurrence = marshalMeetingserviceRecurrenceToRecurrenceRequestBody(p.Recurrence)
+	}
+	{
+		var zero int
+		if body.Count == zero {
+			body.Count = 10
+		}
+	}
+	return body
+}
+
 // NewCreateItxRegistrantRequestBody builds the HTTP request body from the
 // payload of the "create-itx-registrant" endpoint of the "Meeting Service"
 // service.
@@ -4152,6 +5475,26 @@ func NewUpdateItxPastMeetingParticipantRequestBody(p *meetingservice.UpdateItxPa
 	return body
 }
 
+// NewBulkUpdateItxPastMeetingParticipantsRequestBody builds the HTTP request
+// body from the payload of the "bulk-update-itx-past-meeting-participants"
+// endpoint of the "Meeting Service" service.
+func NewBulkUpdateItxPastMeetingParticipantsRequestBody(p *meetingservice.BulkUpdateItxPastMeetingParticipantsPayload) *BulkUpdateItxPastMeetingParticipantsRequestBody {
+	body := &BulkUpdateItxPastMeetingParticipantsRequestBody{}
+	if p.Participants != nil {
+		body.Participants = make([]*ITXPastMeetingParticipantUpdateRequestBody, len(p.Participants))
+		for i, val := range p.Participants {
+			if val == nil {
+				body.Participants[i] = nil
+				continue
+			}
+			body.Participants[i] = marshalMeetingserviceITXPastMeetingParticipantUpdateToITXPastMeetingParticipantUpdateRequestBody(val)
+		}
+	} else {
+		body.Participants = []*ITXPastMeetingParticipantUpdateRequestBody{}
+	}
+	return body
+}
+
 // NewCreateItxMeetingAttachmentRequestBody builds the HTTP request body from
 // the payload of the "create-itx-meeting-attachment" endpoint of the "Meeting
 // Service" service.
@@ -4247,6 +5590,140 @@ func NewReadyzServiceUnavailable(body *ReadyzServiceUnavailableResponseBody) *me
 	return v
 }
 
+// NewGetServiceConfigServiceConfigOK builds a "Meeting Service" service
+// "get-service-config" endpoint result from a HTTP "OK" response.
+func NewGetServiceConfigServiceConfigOK(body *GetServiceConfigResponseBody) *meetingservice.ServiceConfig {
+	v := &meetingservice.ServiceConfig{
+		Version:            *body.Version,
+		LfxEnvironment:     *body.LfxEnvironment,
+		ItxBaseURL:         *body.ItxBaseURL,
+		ItxAuth0Domain:     *body.ItxAuth0Domain,
+		ItxAudience:        *body.ItxAudience,
+		UserServiceBaseURL: *body.UserServiceBaseURL,
+		IDMappingEnabled:   *body.IDMappingEnabled,
+		JoinLinkCacheTTL:   *body.JoinLinkCacheTTL,
+		LogLevel:           *body.LogLevel,
+	}
+	v.EventProcessing = unmarshalServiceEventProcessingConfigResponseBodyToMeetingserviceServiceEventProcessingConfig(body.EventProcessing)
+	v.Invites = unmarshalServiceInvitesConfigResponseBodyToMeetingserviceServiceInvitesConfig(body.Invites)
+	v.ItxResilience = unmarshalServiceITXResilienceConfigResponseBodyToMeetingserviceServiceITXResilienceConfig(body.ItxResilience)
+	v.Attachments = unmarshalServiceAttachmentsConfigResponseBodyToMeetingserviceServiceAttachmentsConfig(body.Attachments)
+	v.SubsystemLogLevels = make(map[string]string, len(body.SubsystemLogLevels))
+	for key, val := range body.SubsystemLogLevels {
+		tk := key
+		tv := val
+		v.SubsystemLogLevels[tk] = tv
+	}
+
+	return v
+}
+
+// NewGetServiceConfigBadRequest builds a Meeting Service service
+// get-service-config endpoint BadRequest error.
+func NewGetServiceConfigBadRequest(body *GetServiceConfigBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetServiceConfigForbidden builds a Meeting Service service
+// get-service-config endpoint Forbidden error.
+func NewGetServiceConfigForbidden(body *GetServiceConfigForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetServiceConfigServiceUnavailable builds a Meeting Service service
+// get-service-config endpoint ServiceUnavailable error.
+func NewGetServiceConfigServiceUnavailable(body *GetServiceConfigServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetServiceConfigUnauthorized builds a Meeting Service service
+// get-service-config endpoint Unauthorized error.
+func NewGetServiceConfigUnauthorized(body *GetServiceConfigUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetServiceHealthServiceHealthOK builds a "Meeting Service" service
+// "get-service-health" endpoint result from a HTTP "OK" response.
+func NewGetServiceHealthServiceHealthOK(body *GetServiceHealthResponseBody) *meetingservice.ServiceHealth {
+	v := &meetingservice.ServiceHealth{
+		Status: *body.Status,
+	}
+	v.Checks = make([]*meetingservice.DependencyHealth, len(body.Checks))
+	for i, val := range body.Checks {
+		if val == nil {
+			v.Checks[i] = nil
+			continue
+		}
+		v.Checks[i] = unmarshalDependencyHealthResponseBodyToMeetingserviceDependencyHealth(val)
+	}
+
+	return v
+}
+
+// NewGetServiceHealthBadRequest builds a Meeting Service service
+// get-service-health endpoint BadRequest error.
+func NewGetServiceHealthBadRequest(body *GetServiceHealthBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetServiceHealthForbidden builds a Meeting Service service
+// get-service-health endpoint Forbidden error.
+func NewGetServiceHealthForbidden(body *GetServiceHealthForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetServiceHealthServiceUnavailable builds a Meeting Service service
+// get-service-health endpoint ServiceUnavailable error.
+func NewGetServiceHealthServiceUnavailable(body *GetServiceHealthServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetServiceHealthUnauthorized builds a Meeting Service service
+// get-service-health endpoint Unauthorized error.
+func NewGetServiceHealthUnauthorized(body *GetServiceHealthUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewCreateItxMeetingITXZoomMeetingResponseCreated builds a "Meeting Service"
 // service "create-itx-meeting" endpoint result from a HTTP "Created" response.
 func NewCreateItxMeetingITXZoomMeetingResponseCreated(body *CreateItxMeetingResponseBody) *meetingservice.ITXZoomMeetingResponse {
@@ -4583,23 +6060,169 @@ func NewDeleteItxMeetingUnauthorized(body *DeleteItxMeetingUnauthorizedResponseB
 	return v
 }
 
-// NewUpdateItxMeetingBadRequest builds a Meeting Service service
-// update-itx-meeting endpoint BadRequest error.
-func NewUpdateItxMeetingBadRequest(body *UpdateItxMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
-	v := &meetingservice.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewUpdateItxMeetingConflict builds a Meeting Service service
-// update-itx-meeting endpoint Conflict error.
-func NewUpdateItxMeetingConflict(body *UpdateItxMeetingConflictResponseBody) *meetingservice.ConflictError {
-	v := &meetingservice.ConflictError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// NewCloneItxMeetingITXZoomMeetingResponseCreated builds a "Meeting Service"
+// service "clone-itx-meeting" endpoint result from a HTTP "Created" response.
+func NewCloneItxMeetingITXZoomMeetingResponseCreated(body *CloneItxMeetingResponseBody) *meetingservice.ITXZoomMeetingResponse {
+	v := &meetingservice.ITXZoomMeetingResponse{
+		ProjectUID:                               body.ProjectUID,
+		Title:                                    body.Title,
+		StartTime:                                body.StartTime,
+		Duration:                                 body.Duration,
+		Timezone:                                 body.Timezone,
+		Visibility:                               body.Visibility,
+		Description:                              body.Description,
+		Restricted:                               body.Restricted,
+		MeetingType:                              body.MeetingType,
+		EarlyJoinTimeMinutes:                     body.EarlyJoinTimeMinutes,
+		RecordingEnabled:                         body.RecordingEnabled,
+		TranscriptEnabled:                        body.TranscriptEnabled,
+		YoutubeUploadEnabled:                     body.YoutubeUploadEnabled,
+		AiSummaryEnabled:                         body.AiSummaryEnabled,
+		RequireAiSummaryApproval:                 body.RequireAiSummaryApproval,
+		ArtifactVisibility:                       body.ArtifactVisibility,
+		AutoEmailReminderEnabled:                 body.AutoEmailReminderEnabled,
+		AutoEmailReminderTime:                    body.AutoEmailReminderTime,
+		LastBulkRegistrantJobStatus:              body.LastBulkRegistrantJobStatus,
+		LastBulkRegistrantsJobWarningCount:       body.LastBulkRegistrantsJobWarningCount,
+		EmailDeliveryErrorCount:                  body.EmailDeliveryErrorCount,
+		IsInviteResponsesEnabled:                 body.IsInviteResponsesEnabled,
+		ResponseCountYes:                         body.ResponseCountYes,
+		ResponseCountMaybe:                       body.ResponseCountMaybe,
+		ResponseCountNo:                          body.ResponseCountNo,
+		LastMailingListMembersSyncJobStatus:      body.LastMailingListMembersSyncJobStatus,
+		LastMailingListMembersSyncJobFailedCount: body.LastMailingListMembersSyncJobFailedCount,
+		LastMailingListMembersSyncJobWarningCount: body.LastMailingListMembersSyncJobWarningCount,
+		NextOccurrenceStartTime:                   body.NextOccurrenceStartTime,
+		ID:                                        body.ID,
+		HostKey:                                   body.HostKey,
+		Passcode:                                  body.Passcode,
+		Password:                                  body.Password,
+		PublicLink:                                body.PublicLink,
+		CreatedAt:                                 body.CreatedAt,
+		ModifiedAt:                                body.ModifiedAt,
+		RegistrantCount:                           body.RegistrantCount,
+	}
+	if body.Committees != nil {
+		v.Committees = make([]*meetingservice.Committee, len(body.Committees))
+		for i, val := range body.Committees {
+			if val == nil {
+				v.Committees[i] = nil
+				continue
+			}
+			v.Committees[i] = unmarshalCommitteeResponseBodyToMeetingserviceCommittee(val)
+		}
+	}
+	if body.Recurrence != nil {
+		v.Recurrence = unmarshalRecurrenceResponseBodyToMeetingserviceRecurrence(body.Recurrence)
+	}
+	if body.Occurrences != nil {
+		v.Occurrences = make([]*meetingservice.ITXOccurrence, len(body.Occurrences))
+		for i, val := range body.Occurrences {
+			if val == nil {
+				v.Occurrences[i] = nil
+				continue
+			}
+			v.Occurrences[i] = unmarshalITXOccurrenceResponseBodyToMeetingserviceITXOccurrence(val)
+		}
+	}
+
+	return v
+}
+
+// NewCloneItxMeetingBadRequest builds a Meeting Service service
+// clone-itx-meeting endpoint BadRequest error.
+func NewCloneItxMeetingBadRequest(body *CloneItxMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneItxMeetingConflict builds a Meeting Service service
+// clone-itx-meeting endpoint Conflict error.
+func NewCloneItxMeetingConflict(body *CloneItxMeetingConflictResponseBody) *meetingservice.ConflictError {
+	v := &meetingservice.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneItxMeetingForbidden builds a Meeting Service service
+// clone-itx-meeting endpoint Forbidden error.
+func NewCloneItxMeetingForbidden(body *CloneItxMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneItxMeetingInternalServerError builds a Meeting Service service
+// clone-itx-meeting endpoint InternalServerError error.
+func NewCloneItxMeetingInternalServerError(body *CloneItxMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneItxMeetingNotFound builds a Meeting Service service
+// clone-itx-meeting endpoint NotFound error.
+func NewCloneItxMeetingNotFound(body *CloneItxMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneItxMeetingServiceUnavailable builds a Meeting Service service
+// clone-itx-meeting endpoint ServiceUnavailable error.
+func NewCloneItxMeetingServiceUnavailable(body *CloneItxMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneItxMeetingUnauthorized builds a Meeting Service service
+// clone-itx-meeting endpoint Unauthorized error.
+func NewCloneItxMeetingUnauthorized(body *CloneItxMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxMeetingBadRequest builds a Meeting Service service
+// update-itx-meeting endpoint BadRequest error.
+func NewUpdateItxMeetingBadRequest(body *UpdateItxMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxMeetingConflict builds a Meeting Service service
+// update-itx-meeting endpoint Conflict error.
+func NewUpdateItxMeetingConflict(body *UpdateItxMeetingConflictResponseBody) *meetingservice.ConflictError {
+	v := &meetingservice.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
 
 	return v
@@ -4736,6 +6359,56 @@ func NewGetItxMeetingCountUnauthorized(body *GetItxMeetingCountUnauthorizedRespo
 	return v
 }
 
+// NewPreviewItxMeetingOccurrencesITXOccurrencePreviewResponseOK builds a
+// "Meeting Service" service "preview-itx-meeting-occurrences" endpoint result
+// from a HTTP "OK" response.
+func NewPreviewItxMeetingOccurrencesITXOccurrencePreviewResponseOK(body *PreviewItxMeetingOccurrencesResponseBody) *meetingservice.ITXOccurrencePreviewResponse {
+	v := &meetingservice.ITXOccurrencePreviewResponse{}
+	v.Occurrences = make([]*meetingservice.ITXOccurrence, len(body.Occurrences))
+	for i, val := range body.Occurrences {
+		if val == nil {
+			v.Occurrences[i] = nil
+			continue
+		}
+		v.Occurrences[i] = unmarshalITXOccurrenceResponseBodyToMeetingserviceITXOccurrence(val)
+	}
+
+	return v
+}
+
+// NewPreviewItxMeetingOccurrencesBadRequest builds a Meeting Service service
+// preview-itx-meeting-occurrences endpoint BadRequest error.
+func NewPreviewItxMeetingOccurrencesBadRequest(body *PreviewItxMeetingOccurrencesBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPreviewItxMeetingOccurrencesInternalServerError builds a Meeting Service
+// service preview-itx-meeting-occurrences endpoint InternalServerError error.
+func NewPreviewItxMeetingOccurrencesInternalServerError(body *PreviewItxMeetingOccurrencesInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPreviewItxMeetingOccurrencesUnauthorized builds a Meeting Service service
+// preview-itx-meeting-occurrences endpoint Unauthorized error.
+func NewPreviewItxMeetingOccurrencesUnauthorized(body *PreviewItxMeetingOccurrencesUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewCreateItxRegistrantITXZoomMeetingRegistrantCreated builds a "Meeting
 // Service" service "create-itx-registrant" endpoint result from a HTTP
 // "Created" response.
@@ -5086,7 +6759,10 @@ func NewDeleteItxRegistrantUnauthorized(body *DeleteItxRegistrantUnauthorizedRes
 // "get-itx-join-link" endpoint result from a HTTP "OK" response.
 func NewGetItxJoinLinkITXZoomMeetingJoinLinkOK(body *GetItxJoinLinkResponseBody) *meetingservice.ITXZoomMeetingJoinLink {
 	v := &meetingservice.ITXZoomMeetingJoinLink{
-		Link: *body.Link,
+		Link:                *body.Link,
+		OccurrenceID:        body.OccurrenceID,
+		OccurrenceStartTime: body.OccurrenceStartTime,
+		OccurrenceDuration:  body.OccurrenceDuration,
 	}
 
 	return v
@@ -5103,6 +6779,17 @@ func NewGetItxJoinLinkBadRequest(body *GetItxJoinLinkBadRequestResponseBody) *me
 	return v
 }
 
+// NewGetItxJoinLinkConflict builds a Meeting Service service get-itx-join-link
+// endpoint Conflict error.
+func NewGetItxJoinLinkConflict(body *GetItxJoinLinkConflictResponseBody) *meetingservice.ConflictError {
+	v := &meetingservice.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewGetItxJoinLinkForbidden builds a Meeting Service service
 // get-itx-join-link endpoint Forbidden error.
 func NewGetItxJoinLinkForbidden(body *GetItxJoinLinkForbiddenResponseBody) *meetingservice.ForbiddenError {
@@ -5158,9 +6845,18 @@ func NewGetItxJoinLinkUnauthorized(body *GetItxJoinLinkUnauthorizedResponseBody)
 	return v
 }
 
-// NewGetItxRegistrantIcsBadRequest builds a Meeting Service service
-// get-itx-registrant-ics endpoint BadRequest error.
-func NewGetItxRegistrantIcsBadRequest(body *GetItxRegistrantIcsBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewLaunchItxMeetingResultFound builds a "Meeting Service" service
+// "launch-itx-meeting" endpoint result from a HTTP "Found" response.
+func NewLaunchItxMeetingResultFound(location string) *meetingservice.LaunchItxMeetingResult {
+	v := &meetingservice.LaunchItxMeetingResult{}
+	v.Location = location
+
+	return v
+}
+
+// NewLaunchItxMeetingBadRequest builds a Meeting Service service
+// launch-itx-meeting endpoint BadRequest error.
+func NewLaunchItxMeetingBadRequest(body *LaunchItxMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5169,9 +6865,20 @@ func NewGetItxRegistrantIcsBadRequest(body *GetItxRegistrantIcsBadRequestRespons
 	return v
 }
 
-// NewGetItxRegistrantIcsForbidden builds a Meeting Service service
-// get-itx-registrant-ics endpoint Forbidden error.
-func NewGetItxRegistrantIcsForbidden(body *GetItxRegistrantIcsForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewLaunchItxMeetingConflict builds a Meeting Service service
+// launch-itx-meeting endpoint Conflict error.
+func NewLaunchItxMeetingConflict(body *LaunchItxMeetingConflictResponseBody) *meetingservice.ConflictError {
+	v := &meetingservice.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewLaunchItxMeetingForbidden builds a Meeting Service service
+// launch-itx-meeting endpoint Forbidden error.
+func NewLaunchItxMeetingForbidden(body *LaunchItxMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5180,9 +6887,9 @@ func NewGetItxRegistrantIcsForbidden(body *GetItxRegistrantIcsForbiddenResponseB
 	return v
 }
 
-// NewGetItxRegistrantIcsInternalServerError builds a Meeting Service service
-// get-itx-registrant-ics endpoint InternalServerError error.
-func NewGetItxRegistrantIcsInternalServerError(body *GetItxRegistrantIcsInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewLaunchItxMeetingInternalServerError builds a Meeting Service service
+// launch-itx-meeting endpoint InternalServerError error.
+func NewLaunchItxMeetingInternalServerError(body *LaunchItxMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5191,9 +6898,9 @@ func NewGetItxRegistrantIcsInternalServerError(body *GetItxRegistrantIcsInternal
 	return v
 }
 
-// NewGetItxRegistrantIcsNotFound builds a Meeting Service service
-// get-itx-registrant-ics endpoint NotFound error.
-func NewGetItxRegistrantIcsNotFound(body *GetItxRegistrantIcsNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewLaunchItxMeetingNotFound builds a Meeting Service service
+// launch-itx-meeting endpoint NotFound error.
+func NewLaunchItxMeetingNotFound(body *LaunchItxMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5202,9 +6909,9 @@ func NewGetItxRegistrantIcsNotFound(body *GetItxRegistrantIcsNotFoundResponseBod
 	return v
 }
 
-// NewGetItxRegistrantIcsServiceUnavailable builds a Meeting Service service
-// get-itx-registrant-ics endpoint ServiceUnavailable error.
-func NewGetItxRegistrantIcsServiceUnavailable(body *GetItxRegistrantIcsServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewLaunchItxMeetingServiceUnavailable builds a Meeting Service service
+// launch-itx-meeting endpoint ServiceUnavailable error.
+func NewLaunchItxMeetingServiceUnavailable(body *LaunchItxMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5213,9 +6920,9 @@ func NewGetItxRegistrantIcsServiceUnavailable(body *GetItxRegistrantIcsServiceUn
 	return v
 }
 
-// NewGetItxRegistrantIcsUnauthorized builds a Meeting Service service
-// get-itx-registrant-ics endpoint Unauthorized error.
-func NewGetItxRegistrantIcsUnauthorized(body *GetItxRegistrantIcsUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewLaunchItxMeetingUnauthorized builds a Meeting Service service
+// launch-itx-meeting endpoint Unauthorized error.
+func NewLaunchItxMeetingUnauthorized(body *LaunchItxMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5224,9 +6931,9 @@ func NewGetItxRegistrantIcsUnauthorized(body *GetItxRegistrantIcsUnauthorizedRes
 	return v
 }
 
-// NewResendItxRegistrantInvitationBadRequest builds a Meeting Service service
-// resend-itx-registrant-invitation endpoint BadRequest error.
-func NewResendItxRegistrantInvitationBadRequest(body *ResendItxRegistrantInvitationBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewGetItxRegistrantIcsBadRequest builds a Meeting Service service
+// get-itx-registrant-ics endpoint BadRequest error.
+func NewGetItxRegistrantIcsBadRequest(body *GetItxRegistrantIcsBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5235,9 +6942,9 @@ func NewResendItxRegistrantInvitationBadRequest(body *ResendItxRegistrantInvitat
 	return v
 }
 
-// NewResendItxRegistrantInvitationForbidden builds a Meeting Service service
-// resend-itx-registrant-invitation endpoint Forbidden error.
-func NewResendItxRegistrantInvitationForbidden(body *ResendItxRegistrantInvitationForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewGetItxRegistrantIcsForbidden builds a Meeting Service service
+// get-itx-registrant-ics endpoint Forbidden error.
+func NewGetItxRegistrantIcsForbidden(body *GetItxRegistrantIcsForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5246,9 +6953,9 @@ func NewResendItxRegistrantInvitationForbidden(body *ResendItxRegistrantInvitati
 	return v
 }
 
-// NewResendItxRegistrantInvitationInternalServerError builds a Meeting Service
-// service resend-itx-registrant-invitation endpoint InternalServerError error.
-func NewResendItxRegistrantInvitationInternalServerError(body *ResendItxRegistrantInvitationInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewGetItxRegistrantIcsInternalServerError builds a Meeting Service service
+// get-itx-registrant-ics endpoint InternalServerError error.
+func NewGetItxRegistrantIcsInternalServerError(body *GetItxRegistrantIcsInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5257,9 +6964,9 @@ func NewResendItxRegistrantInvitationInternalServerError(body *ResendItxRegistra
 	return v
 }
 
-// NewResendItxRegistrantInvitationNotFound builds a Meeting Service service
-// resend-itx-registrant-invitation endpoint NotFound error.
-func NewResendItxRegistrantInvitationNotFound(body *ResendItxRegistrantInvitationNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewGetItxRegistrantIcsNotFound builds a Meeting Service service
+// get-itx-registrant-ics endpoint NotFound error.
+func NewGetItxRegistrantIcsNotFound(body *GetItxRegistrantIcsNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5268,9 +6975,9 @@ func NewResendItxRegistrantInvitationNotFound(body *ResendItxRegistrantInvitatio
 	return v
 }
 
-// NewResendItxRegistrantInvitationServiceUnavailable builds a Meeting Service
-// service resend-itx-registrant-invitation endpoint ServiceUnavailable error.
-func NewResendItxRegistrantInvitationServiceUnavailable(body *ResendItxRegistrantInvitationServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewGetItxRegistrantIcsServiceUnavailable builds a Meeting Service service
+// get-itx-registrant-ics endpoint ServiceUnavailable error.
+func NewGetItxRegistrantIcsServiceUnavailable(body *GetItxRegistrantIcsServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5279,9 +6986,9 @@ func NewResendItxRegistrantInvitationServiceUnavailable(body *ResendItxRegistran
 	return v
 }
 
-// NewResendItxRegistrantInvitationUnauthorized builds a Meeting Service
-// service resend-itx-registrant-invitation endpoint Unauthorized error.
-func NewResendItxRegistrantInvitationUnauthorized(body *ResendItxRegistrantInvitationUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewGetItxRegistrantIcsUnauthorized builds a Meeting Service service
+// get-itx-registrant-ics endpoint Unauthorized error.
+func NewGetItxRegistrantIcsUnauthorized(body *GetItxRegistrantIcsUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5290,20 +6997,55 @@ func NewResendItxRegistrantInvitationUnauthorized(body *ResendItxRegistrantInvit
 	return v
 }
 
-// NewResendItxMeetingInvitationsBadRequest builds a Meeting Service service
-// resend-itx-meeting-invitations endpoint BadRequest error.
-func NewResendItxMeetingInvitationsBadRequest(body *ResendItxMeetingInvitationsBadRequestResponseBody) *meetingservice.BadRequestError {
-	v := &meetingservice.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewResendItxMeetingInvitationsForbidden builds a Meeting Service service
-// resend-itx-meeting-invitations endpoint Forbidden error.
-func NewResendItxMeetingInvitationsForbidden(body *ResendItxMeetingInvitationsForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewAddItxRegistrantHostITXZoomMeetingRegistrantOK builds a "Meeting Service"
+// service "add-itx-registrant-host" endpoint result from a HTTP "OK" response.
+func NewAddItxRegistrantHostITXZoomMeetingRegistrantOK(body *AddItxRegistrantHostResponseBody) *meetingservice.ITXZoomMeetingRegistrant {
+	v := &meetingservice.ITXZoomMeetingRegistrant{
+		UID:                           body.UID,
+		Type:                          body.Type,
+		CommitteeUID:                  body.CommitteeUID,
+		Email:                         body.Email,
+		Username:                      body.Username,
+		FirstName:                     body.FirstName,
+		LastName:                      body.LastName,
+		Org:                           body.Org,
+		JobTitle:                      body.JobTitle,
+		ProfilePicture:                body.ProfilePicture,
+		Host:                          body.Host,
+		Occurrence:                    body.Occurrence,
+		AttendedOccurrenceCount:       body.AttendedOccurrenceCount,
+		TotalOccurrenceCount:          body.TotalOccurrenceCount,
+		LastInviteReceivedTime:        body.LastInviteReceivedTime,
+		LastInviteReceivedMessageID:   body.LastInviteReceivedMessageID,
+		LastInviteDeliveryStatus:      body.LastInviteDeliveryStatus,
+		LastInviteDeliveryDescription: body.LastInviteDeliveryDescription,
+		CreatedAt:                     body.CreatedAt,
+		ModifiedAt:                    body.ModifiedAt,
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+	}
+	if body.UpdatedBy != nil {
+		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	}
+
+	return v
+}
+
+// NewAddItxRegistrantHostBadRequest builds a Meeting Service service
+// add-itx-registrant-host endpoint BadRequest error.
+func NewAddItxRegistrantHostBadRequest(body *AddItxRegistrantHostBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAddItxRegistrantHostForbidden builds a Meeting Service service
+// add-itx-registrant-host endpoint Forbidden error.
+func NewAddItxRegistrantHostForbidden(body *AddItxRegistrantHostForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5312,9 +7054,9 @@ func NewResendItxMeetingInvitationsForbidden(body *ResendItxMeetingInvitationsFo
 	return v
 }
 
-// NewResendItxMeetingInvitationsInternalServerError builds a Meeting Service
-// service resend-itx-meeting-invitations endpoint InternalServerError error.
-func NewResendItxMeetingInvitationsInternalServerError(body *ResendItxMeetingInvitationsInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewAddItxRegistrantHostInternalServerError builds a Meeting Service service
+// add-itx-registrant-host endpoint InternalServerError error.
+func NewAddItxRegistrantHostInternalServerError(body *AddItxRegistrantHostInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5323,9 +7065,9 @@ func NewResendItxMeetingInvitationsInternalServerError(body *ResendItxMeetingInv
 	return v
 }
 
-// NewResendItxMeetingInvitationsNotFound builds a Meeting Service service
-// resend-itx-meeting-invitations endpoint NotFound error.
-func NewResendItxMeetingInvitationsNotFound(body *ResendItxMeetingInvitationsNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewAddItxRegistrantHostNotFound builds a Meeting Service service
+// add-itx-registrant-host endpoint NotFound error.
+func NewAddItxRegistrantHostNotFound(body *AddItxRegistrantHostNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5334,9 +7076,9 @@ func NewResendItxMeetingInvitationsNotFound(body *ResendItxMeetingInvitationsNot
 	return v
 }
 
-// NewResendItxMeetingInvitationsServiceUnavailable builds a Meeting Service
-// service resend-itx-meeting-invitations endpoint ServiceUnavailable error.
-func NewResendItxMeetingInvitationsServiceUnavailable(body *ResendItxMeetingInvitationsServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewAddItxRegistrantHostServiceUnavailable builds a Meeting Service service
+// add-itx-registrant-host endpoint ServiceUnavailable error.
+func NewAddItxRegistrantHostServiceUnavailable(body *AddItxRegistrantHostServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5345,9 +7087,9 @@ func NewResendItxMeetingInvitationsServiceUnavailable(body *ResendItxMeetingInvi
 	return v
 }
 
-// NewResendItxMeetingInvitationsUnauthorized builds a Meeting Service service
-// resend-itx-meeting-invitations endpoint Unauthorized error.
-func NewResendItxMeetingInvitationsUnauthorized(body *ResendItxMeetingInvitationsUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewAddItxRegistrantHostUnauthorized builds a Meeting Service service
+// add-itx-registrant-host endpoint Unauthorized error.
+func NewAddItxRegistrantHostUnauthorized(body *AddItxRegistrantHostUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5356,9 +7098,45 @@ func NewResendItxMeetingInvitationsUnauthorized(body *ResendItxMeetingInvitation
 	return v
 }
 
-// NewRegisterItxCommitteeMembersBadRequest builds a Meeting Service service
-// register-itx-committee-members endpoint BadRequest error.
-func NewRegisterItxCommitteeMembersBadRequest(body *RegisterItxCommitteeMembersBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewRemoveItxRegistrantHostITXZoomMeetingRegistrantOK builds a "Meeting
+// Service" service "remove-itx-registrant-host" endpoint result from a HTTP
+// "OK" response.
+func NewRemoveItxRegistrantHostITXZoomMeetingRegistrantOK(body *RemoveItxRegistrantHostResponseBody) *meetingservice.ITXZoomMeetingRegistrant {
+	v := &meetingservice.ITXZoomMeetingRegistrant{
+		UID:                           body.UID,
+		Type:                          body.Type,
+		CommitteeUID:                  body.CommitteeUID,
+		Email:                         body.Email,
+		Username:                      body.Username,
+		FirstName:                     body.FirstName,
+		LastName:                      body.LastName,
+		Org:                           body.Org,
+		JobTitle:                      body.JobTitle,
+		ProfilePicture:                body.ProfilePicture,
+		Host:                          body.Host,
+		Occurrence:                    body.Occurrence,
+		AttendedOccurrenceCount:       body.AttendedOccurrenceCount,
+		TotalOccurrenceCount:          body.TotalOccurrenceCount,
+		LastInviteReceivedTime:        body.LastInviteReceivedTime,
+		LastInviteReceivedMessageID:   body.LastInviteReceivedMessageID,
+		LastInviteDeliveryStatus:      body.LastInviteDeliveryStatus,
+		LastInviteDeliveryDescription: body.LastInviteDeliveryDescription,
+		CreatedAt:                     body.CreatedAt,
+		ModifiedAt:                    body.ModifiedAt,
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+	}
+	if body.UpdatedBy != nil {
+		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	}
+
+	return v
+}
+
+// NewRemoveItxRegistrantHostBadRequest builds a Meeting Service service
+// remove-itx-registrant-host endpoint BadRequest error.
+func NewRemoveItxRegistrantHostBadRequest(body *RemoveItxRegistrantHostBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5367,9 +7145,9 @@ func NewRegisterItxCommitteeMembersBadRequest(body *RegisterItxCommitteeMembersB
 	return v
 }
 
-// NewRegisterItxCommitteeMembersForbidden builds a Meeting Service service
-// register-itx-committee-members endpoint Forbidden error.
-func NewRegisterItxCommitteeMembersForbidden(body *RegisterItxCommitteeMembersForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewRemoveItxRegistrantHostForbidden builds a Meeting Service service
+// remove-itx-registrant-host endpoint Forbidden error.
+func NewRemoveItxRegistrantHostForbidden(body *RemoveItxRegistrantHostForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5378,9 +7156,9 @@ func NewRegisterItxCommitteeMembersForbidden(body *RegisterItxCommitteeMembersFo
 	return v
 }
 
-// NewRegisterItxCommitteeMembersInternalServerError builds a Meeting Service
-// service register-itx-committee-members endpoint InternalServerError error.
-func NewRegisterItxCommitteeMembersInternalServerError(body *RegisterItxCommitteeMembersInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewRemoveItxRegistrantHostInternalServerError builds a Meeting Service
+// service remove-itx-registrant-host endpoint InternalServerError error.
+func NewRemoveItxRegistrantHostInternalServerError(body *RemoveItxRegistrantHostInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5389,9 +7167,9 @@ func NewRegisterItxCommitteeMembersInternalServerError(body *RegisterItxCommitte
 	return v
 }
 
-// NewRegisterItxCommitteeMembersNotFound builds a Meeting Service service
-// register-itx-committee-members endpoint NotFound error.
-func NewRegisterItxCommitteeMembersNotFound(body *RegisterItxCommitteeMembersNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewRemoveItxRegistrantHostNotFound builds a Meeting Service service
+// remove-itx-registrant-host endpoint NotFound error.
+func NewRemoveItxRegistrantHostNotFound(body *RemoveItxRegistrantHostNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5400,9 +7178,9 @@ func NewRegisterItxCommitteeMembersNotFound(body *RegisterItxCommitteeMembersNot
 	return v
 }
 
-// NewRegisterItxCommitteeMembersServiceUnavailable builds a Meeting Service
-// service register-itx-committee-members endpoint ServiceUnavailable error.
-func NewRegisterItxCommitteeMembersServiceUnavailable(body *RegisterItxCommitteeMembersServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewRemoveItxRegistrantHostServiceUnavailable builds a Meeting Service
+// service remove-itx-registrant-host endpoint ServiceUnavailable error.
+func NewRemoveItxRegistrantHostServiceUnavailable(body *RemoveItxRegistrantHostServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5411,9 +7189,9 @@ func NewRegisterItxCommitteeMembersServiceUnavailable(body *RegisterItxCommittee
 	return v
 }
 
-// NewRegisterItxCommitteeMembersUnauthorized builds a Meeting Service service
-// register-itx-committee-members endpoint Unauthorized error.
-func NewRegisterItxCommitteeMembersUnauthorized(body *RegisterItxCommitteeMembersUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewRemoveItxRegistrantHostUnauthorized builds a Meeting Service service
+// remove-itx-registrant-host endpoint Unauthorized error.
+func NewRemoveItxRegistrantHostUnauthorized(body *RemoveItxRegistrantHostUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5422,9 +7200,9 @@ func NewRegisterItxCommitteeMembersUnauthorized(body *RegisterItxCommitteeMember
 	return v
 }
 
-// NewUpdateItxOccurrenceBadRequest builds a Meeting Service service
-// update-itx-occurrence endpoint BadRequest error.
-func NewUpdateItxOccurrenceBadRequest(body *UpdateItxOccurrenceBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewResendItxRegistrantInvitationBadRequest builds a Meeting Service service
+// resend-itx-registrant-invitation endpoint BadRequest error.
+func NewResendItxRegistrantInvitationBadRequest(body *ResendItxRegistrantInvitationBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5433,9 +7211,9 @@ func NewUpdateItxOccurrenceBadRequest(body *UpdateItxOccurrenceBadRequestRespons
 	return v
 }
 
-// NewUpdateItxOccurrenceForbidden builds a Meeting Service service
-// update-itx-occurrence endpoint Forbidden error.
-func NewUpdateItxOccurrenceForbidden(body *UpdateItxOccurrenceForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewResendItxRegistrantInvitationForbidden builds a Meeting Service service
+// resend-itx-registrant-invitation endpoint Forbidden error.
+func NewResendItxRegistrantInvitationForbidden(body *ResendItxRegistrantInvitationForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5444,9 +7222,9 @@ func NewUpdateItxOccurrenceForbidden(body *UpdateItxOccurrenceForbiddenResponseB
 	return v
 }
 
-// NewUpdateItxOccurrenceInternalServerError builds a Meeting Service service
-// update-itx-occurrence endpoint InternalServerError error.
-func NewUpdateItxOccurrenceInternalServerError(body *UpdateItxOccurrenceInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewResendItxRegistrantInvitationInternalServerError builds a Meeting Service
+// service resend-itx-registrant-invitation endpoint InternalServerError error.
+func NewResendItxRegistrantInvitationInternalServerError(body *ResendItxRegistrantInvitationInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5455,9 +7233,9 @@ func NewUpdateItxOccurrenceInternalServerError(body *UpdateItxOccurrenceInternal
 	return v
 }
 
-// NewUpdateItxOccurrenceNotFound builds a Meeting Service service
-// update-itx-occurrence endpoint NotFound error.
-func NewUpdateItxOccurrenceNotFound(body *UpdateItxOccurrenceNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewResendItxRegistrantInvitationNotFound builds a Meeting Service service
+// resend-itx-registrant-invitation endpoint NotFound error.
+func NewResendItxRegistrantInvitationNotFound(body *ResendItxRegistrantInvitationNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5466,9 +7244,9 @@ func NewUpdateItxOccurrenceNotFound(body *UpdateItxOccurrenceNotFoundResponseBod
 	return v
 }
 
-// NewUpdateItxOccurrenceServiceUnavailable builds a Meeting Service service
-// update-itx-occurrence endpoint ServiceUnavailable error.
-func NewUpdateItxOccurrenceServiceUnavailable(body *UpdateItxOccurrenceServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewResendItxRegistrantInvitationServiceUnavailable builds a Meeting Service
+// service resend-itx-registrant-invitation endpoint ServiceUnavailable error.
+func NewResendItxRegistrantInvitationServiceUnavailable(body *ResendItxRegistrantInvitationServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5477,9 +7255,9 @@ func NewUpdateItxOccurrenceServiceUnavailable(body *UpdateItxOccurrenceServiceUn
 	return v
 }
 
-// NewUpdateItxOccurrenceUnauthorized builds a Meeting Service service
-// update-itx-occurrence endpoint Unauthorized error.
-func NewUpdateItxOccurrenceUnauthorized(body *UpdateItxOccurrenceUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewResendItxRegistrantInvitationUnauthorized builds a Meeting Service
+// service resend-itx-registrant-invitation endpoint Unauthorized error.
+func NewResendItxRegistrantInvitationUnauthorized(body *ResendItxRegistrantInvitationUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5488,9 +7266,9 @@ func NewUpdateItxOccurrenceUnauthorized(body *UpdateItxOccurrenceUnauthorizedRes
 	return v
 }
 
-// NewDeleteItxOccurrenceBadRequest builds a Meeting Service service
-// delete-itx-occurrence endpoint BadRequest error.
-func NewDeleteItxOccurrenceBadRequest(body *DeleteItxOccurrenceBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewResendItxMeetingInvitationsBadRequest builds a Meeting Service service
+// resend-itx-meeting-invitations endpoint BadRequest error.
+func NewResendItxMeetingInvitationsBadRequest(body *ResendItxMeetingInvitationsBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5499,9 +7277,9 @@ func NewDeleteItxOccurrenceBadRequest(body *DeleteItxOccurrenceBadRequestRespons
 	return v
 }
 
-// NewDeleteItxOccurrenceForbidden builds a Meeting Service service
-// delete-itx-occurrence endpoint Forbidden error.
-func NewDeleteItxOccurrenceForbidden(body *DeleteItxOccurrenceForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewResendItxMeetingInvitationsForbidden builds a Meeting Service service
+// resend-itx-meeting-invitations endpoint Forbidden error.
+func NewResendItxMeetingInvitationsForbidden(body *ResendItxMeetingInvitationsForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5510,9 +7288,9 @@ func NewDeleteItxOccurrenceForbidden(body *DeleteItxOccurrenceForbiddenResponseB
 	return v
 }
 
-// NewDeleteItxOccurrenceInternalServerError builds a Meeting Service service
-// delete-itx-occurrence endpoint InternalServerError error.
-func NewDeleteItxOccurrenceInternalServerError(body *DeleteItxOccurrenceInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewResendItxMeetingInvitationsInternalServerError builds a Meeting Service
+// service resend-itx-meeting-invitations endpoint InternalServerError error.
+func NewResendItxMeetingInvitationsInternalServerError(body *ResendItxMeetingInvitationsInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5521,9 +7299,9 @@ func NewDeleteItxOccurrenceInternalServerError(body *DeleteItxOccurrenceInternal
 	return v
 }
 
-// NewDeleteItxOccurrenceNotFound builds a Meeting Service service
-// delete-itx-occurrence endpoint NotFound error.
-func NewDeleteItxOccurrenceNotFound(body *DeleteItxOccurrenceNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewResendItxMeetingInvitationsNotFound builds a Meeting Service service
+// resend-itx-meeting-invitations endpoint NotFound error.
+func NewResendItxMeetingInvitationsNotFound(body *ResendItxMeetingInvitationsNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5532,9 +7310,9 @@ func NewDeleteItxOccurrenceNotFound(body *DeleteItxOccurrenceNotFoundResponseBod
 	return v
 }
 
-// NewDeleteItxOccurrenceServiceUnavailable builds a Meeting Service service
-// delete-itx-occurrence endpoint ServiceUnavailable error.
-func NewDeleteItxOccurrenceServiceUnavailable(body *DeleteItxOccurrenceServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewResendItxMeetingInvitationsServiceUnavailable builds a Meeting Service
+// service resend-itx-meeting-invitations endpoint ServiceUnavailable error.
+func NewResendItxMeetingInvitationsServiceUnavailable(body *ResendItxMeetingInvitationsServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5543,9 +7321,9 @@ func NewDeleteItxOccurrenceServiceUnavailable(body *DeleteItxOccurrenceServiceUn
 	return v
 }
 
-// NewDeleteItxOccurrenceUnauthorized builds a Meeting Service service
-// delete-itx-occurrence endpoint Unauthorized error.
-func NewDeleteItxOccurrenceUnauthorized(body *DeleteItxOccurrenceUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewResendItxMeetingInvitationsUnauthorized builds a Meeting Service service
+// resend-itx-meeting-invitations endpoint Unauthorized error.
+func NewResendItxMeetingInvitationsUnauthorized(body *ResendItxMeetingInvitationsUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5554,29 +7332,9 @@ func NewDeleteItxOccurrenceUnauthorized(body *DeleteItxOccurrenceUnauthorizedRes
 	return v
 }
 
-// NewSubmitItxMeetingResponseITXMeetingResponseResultCreated builds a "Meeting
-// Service" service "submit-itx-meeting-response" endpoint result from a HTTP
-// "Created" response.
-func NewSubmitItxMeetingResponseITXMeetingResponseResultCreated(body *SubmitItxMeetingResponseResponseBody) *meetingservice.ITXMeetingResponseResult {
-	v := &meetingservice.ITXMeetingResponseResult{
-		ID:           *body.ID,
-		MeetingID:    *body.MeetingID,
-		RegistrantID: *body.RegistrantID,
-		Username:     body.Username,
-		Email:        body.Email,
-		Response:     *body.Response,
-		Scope:        *body.Scope,
-		OccurrenceID: body.OccurrenceID,
-		CreatedAt:    body.CreatedAt,
-		UpdatedAt:    body.UpdatedAt,
-	}
-
-	return v
-}
-
-// NewSubmitItxMeetingResponseBadRequest builds a Meeting Service service
-// submit-itx-meeting-response endpoint BadRequest error.
-func NewSubmitItxMeetingResponseBadRequest(body *SubmitItxMeetingResponseBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewRegisterItxCommitteeMembersBadRequest builds a Meeting Service service
+// register-itx-committee-members endpoint BadRequest error.
+func NewRegisterItxCommitteeMembersBadRequest(body *RegisterItxCommitteeMembersBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5585,9 +7343,9 @@ func NewSubmitItxMeetingResponseBadRequest(body *SubmitItxMeetingResponseBadRequ
 	return v
 }
 
-// NewSubmitItxMeetingResponseForbidden builds a Meeting Service service
-// submit-itx-meeting-response endpoint Forbidden error.
-func NewSubmitItxMeetingResponseForbidden(body *SubmitItxMeetingResponseForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewRegisterItxCommitteeMembersForbidden builds a Meeting Service service
+// register-itx-committee-members endpoint Forbidden error.
+func NewRegisterItxCommitteeMembersForbidden(body *RegisterItxCommitteeMembersForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5596,9 +7354,9 @@ func NewSubmitItxMeetingResponseForbidden(body *SubmitItxMeetingResponseForbidde
 	return v
 }
 
-// NewSubmitItxMeetingResponseInternalServerError builds a Meeting Service
-// service submit-itx-meeting-response endpoint InternalServerError error.
-func NewSubmitItxMeetingResponseInternalServerError(body *SubmitItxMeetingResponseInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewRegisterItxCommitteeMembersInternalServerError builds a Meeting Service
+// service register-itx-committee-members endpoint InternalServerError error.
+func NewRegisterItxCommitteeMembersInternalServerError(body *RegisterItxCommitteeMembersInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5607,9 +7365,9 @@ func NewSubmitItxMeetingResponseInternalServerError(body *SubmitItxMeetingRespon
 	return v
 }
 
-// NewSubmitItxMeetingResponseNotFound builds a Meeting Service service
-// submit-itx-meeting-response endpoint NotFound error.
-func NewSubmitItxMeetingResponseNotFound(body *SubmitItxMeetingResponseNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewRegisterItxCommitteeMembersNotFound builds a Meeting Service service
+// register-itx-committee-members endpoint NotFound error.
+func NewRegisterItxCommitteeMembersNotFound(body *RegisterItxCommitteeMembersNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5618,9 +7376,9 @@ func NewSubmitItxMeetingResponseNotFound(body *SubmitItxMeetingResponseNotFoundR
 	return v
 }
 
-// NewSubmitItxMeetingResponseServiceUnavailable builds a Meeting Service
-// service submit-itx-meeting-response endpoint ServiceUnavailable error.
-func NewSubmitItxMeetingResponseServiceUnavailable(body *SubmitItxMeetingResponseServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewRegisterItxCommitteeMembersServiceUnavailable builds a Meeting Service
+// service register-itx-committee-members endpoint ServiceUnavailable error.
+func NewRegisterItxCommitteeMembersServiceUnavailable(body *RegisterItxCommitteeMembersServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5629,9 +7387,9 @@ func NewSubmitItxMeetingResponseServiceUnavailable(body *SubmitItxMeetingRespons
 	return v
 }
 
-// NewSubmitItxMeetingResponseUnauthorized builds a Meeting Service service
-// submit-itx-meeting-response endpoint Unauthorized error.
-func NewSubmitItxMeetingResponseUnauthorized(body *SubmitItxMeetingResponseUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewRegisterItxCommitteeMembersUnauthorized builds a Meeting Service service
+// register-itx-committee-members endpoint Unauthorized error.
+func NewRegisterItxCommitteeMembersUnauthorized(body *RegisterItxCommitteeMembersUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5640,46 +7398,28 @@ func NewSubmitItxMeetingResponseUnauthorized(body *SubmitItxMeetingResponseUnaut
 	return v
 }
 
-// NewCreateItxPastMeetingITXPastZoomMeetingCreated builds a "Meeting Service"
-// service "create-itx-past-meeting" endpoint result from a HTTP "Created"
-// response.
-func NewCreateItxPastMeetingITXPastZoomMeetingCreated(body *CreateItxPastMeetingResponseBody) *meetingservice.ITXPastZoomMeeting {
-	v := &meetingservice.ITXPastZoomMeeting{
-		ID:                 body.ID,
-		MeetingID:          body.MeetingID,
-		OccurrenceID:       body.OccurrenceID,
-		ProjectUID:         body.ProjectUID,
-		Title:              body.Title,
-		Description:        body.Description,
-		StartTime:          body.StartTime,
-		Duration:           body.Duration,
-		Timezone:           body.Timezone,
-		Visibility:         body.Visibility,
-		Restricted:         body.Restricted,
-		MeetingType:        body.MeetingType,
-		RecordingEnabled:   body.RecordingEnabled,
-		ArtifactVisibility: body.ArtifactVisibility,
-		TranscriptEnabled:  body.TranscriptEnabled,
-		IsManuallyCreated:  body.IsManuallyCreated,
-		MeetingPassword:    body.MeetingPassword,
+// NewListItxMeetingOccurrencesITXOccurrenceListResponseOK builds a "Meeting
+// Service" service "list-itx-meeting-occurrences" endpoint result from a HTTP
+// "OK" response.
+func NewListItxMeetingOccurrencesITXOccurrenceListResponseOK(body *ListItxMeetingOccurrencesResponseBody) *meetingservice.ITXOccurrenceListResponse {
+	v := &meetingservice.ITXOccurrenceListResponse{
+		Timezone: *body.Timezone,
 	}
-	if body.Committees != nil {
-		v.Committees = make([]*meetingservice.Committee, len(body.Committees))
-		for i, val := range body.Committees {
-			if val == nil {
-				v.Committees[i] = nil
-				continue
-			}
-			v.Committees[i] = unmarshalCommitteeResponseBodyToMeetingserviceCommittee(val)
+	v.Occurrences = make([]*meetingservice.ITXLocalOccurrence, len(body.Occurrences))
+	for i, val := range body.Occurrences {
+		if val == nil {
+			v.Occurrences[i] = nil
+			continue
 		}
+		v.Occurrences[i] = unmarshalITXLocalOccurrenceResponseBodyToMeetingserviceITXLocalOccurrence(val)
 	}
 
 	return v
 }
 
-// NewCreateItxPastMeetingBadRequest builds a Meeting Service service
-// create-itx-past-meeting endpoint BadRequest error.
-func NewCreateItxPastMeetingBadRequest(body *CreateItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewListItxMeetingOccurrencesBadRequest builds a Meeting Service service
+// list-itx-meeting-occurrences endpoint BadRequest error.
+func NewListItxMeetingOccurrencesBadRequest(body *ListItxMeetingOccurrencesBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5688,20 +7428,9 @@ func NewCreateItxPastMeetingBadRequest(body *CreateItxPastMeetingBadRequestRespo
 	return v
 }
 
-// NewCreateItxPastMeetingConflict builds a Meeting Service service
-// create-itx-past-meeting endpoint Conflict error.
-func NewCreateItxPastMeetingConflict(body *CreateItxPastMeetingConflictResponseBody) *meetingservice.ConflictError {
-	v := &meetingservice.ConflictError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewCreateItxPastMeetingForbidden builds a Meeting Service service
-// create-itx-past-meeting endpoint Forbidden error.
-func NewCreateItxPastMeetingForbidden(body *CreateItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewListItxMeetingOccurrencesForbidden builds a Meeting Service service
+// list-itx-meeting-occurrences endpoint Forbidden error.
+func NewListItxMeetingOccurrencesForbidden(body *ListItxMeetingOccurrencesForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5710,9 +7439,9 @@ func NewCreateItxPastMeetingForbidden(body *CreateItxPastMeetingForbiddenRespons
 	return v
 }
 
-// NewCreateItxPastMeetingInternalServerError builds a Meeting Service service
-// create-itx-past-meeting endpoint InternalServerError error.
-func NewCreateItxPastMeetingInternalServerError(body *CreateItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewListItxMeetingOccurrencesInternalServerError builds a Meeting Service
+// service list-itx-meeting-occurrences endpoint InternalServerError error.
+func NewListItxMeetingOccurrencesInternalServerError(body *ListItxMeetingOccurrencesInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5721,9 +7450,9 @@ func NewCreateItxPastMeetingInternalServerError(body *CreateItxPastMeetingIntern
 	return v
 }
 
-// NewCreateItxPastMeetingNotFound builds a Meeting Service service
-// create-itx-past-meeting endpoint NotFound error.
-func NewCreateItxPastMeetingNotFound(body *CreateItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewListItxMeetingOccurrencesNotFound builds a Meeting Service service
+// list-itx-meeting-occurrences endpoint NotFound error.
+func NewListItxMeetingOccurrencesNotFound(body *ListItxMeetingOccurrencesNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5732,9 +7461,9 @@ func NewCreateItxPastMeetingNotFound(body *CreateItxPastMeetingNotFoundResponseB
 	return v
 }
 
-// NewCreateItxPastMeetingServiceUnavailable builds a Meeting Service service
-// create-itx-past-meeting endpoint ServiceUnavailable error.
-func NewCreateItxPastMeetingServiceUnavailable(body *CreateItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewListItxMeetingOccurrencesServiceUnavailable builds a Meeting Service
+// service list-itx-meeting-occurrences endpoint ServiceUnavailable error.
+func NewListItxMeetingOccurrencesServiceUnavailable(body *ListItxMeetingOccurrencesServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5743,9 +7472,9 @@ func NewCreateItxPastMeetingServiceUnavailable(body *CreateItxPastMeetingService
 	return v
 }
 
-// NewCreateItxPastMeetingUnauthorized builds a Meeting Service service
-// create-itx-past-meeting endpoint Unauthorized error.
-func NewCreateItxPastMeetingUnauthorized(body *CreateItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewListItxMeetingOccurrencesUnauthorized builds a Meeting Service service
+// list-itx-meeting-occurrences endpoint Unauthorized error.
+func NewListItxMeetingOccurrencesUnauthorized(body *ListItxMeetingOccurrencesUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5754,45 +7483,9 @@ func NewCreateItxPastMeetingUnauthorized(body *CreateItxPastMeetingUnauthorizedR
 	return v
 }
 
-// NewGetItxPastMeetingITXPastZoomMeetingOK builds a "Meeting Service" service
-// "get-itx-past-meeting" endpoint result from a HTTP "OK" response.
-func NewGetItxPastMeetingITXPastZoomMeetingOK(body *GetItxPastMeetingResponseBody) *meetingservice.ITXPastZoomMeeting {
-	v := &meetingservice.ITXPastZoomMeeting{
-		ID:                 body.ID,
-		MeetingID:          body.MeetingID,
-		OccurrenceID:       body.OccurrenceID,
-		ProjectUID:         body.ProjectUID,
-		Title:              body.Title,
-		Description:        body.Description,
-		StartTime:          body.StartTime,
-		Duration:           body.Duration,
-		Timezone:           body.Timezone,
-		Visibility:         body.Visibility,
-		Restricted:         body.Restricted,
-		MeetingType:        body.MeetingType,
-		RecordingEnabled:   body.RecordingEnabled,
-		ArtifactVisibility: body.ArtifactVisibility,
-		TranscriptEnabled:  body.TranscriptEnabled,
-		IsManuallyCreated:  body.IsManuallyCreated,
-		MeetingPassword:    body.MeetingPassword,
-	}
-	if body.Committees != nil {
-		v.Committees = make([]*meetingservice.Committee, len(body.Committees))
-		for i, val := range body.Committees {
-			if val == nil {
-				v.Committees[i] = nil
-				continue
-			}
-			v.Committees[i] = unmarshalCommitteeResponseBodyToMeetingserviceCommittee(val)
-		}
-	}
-
-	return v
-}
-
-// NewGetItxPastMeetingBadRequest builds a Meeting Service service
-// get-itx-past-meeting endpoint BadRequest error.
-func NewGetItxPastMeetingBadRequest(body *GetItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewUpdateItxOccurrenceBadRequest builds a Meeting Service service
+// update-itx-occurrence endpoint BadRequest error.
+func NewUpdateItxOccurrenceBadRequest(body *UpdateItxOccurrenceBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5801,9 +7494,9 @@ func NewGetItxPastMeetingBadRequest(body *GetItxPastMeetingBadRequestResponseBod
 	return v
 }
 
-// NewGetItxPastMeetingForbidden builds a Meeting Service service
-// get-itx-past-meeting endpoint Forbidden error.
-func NewGetItxPastMeetingForbidden(body *GetItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewUpdateItxOccurrenceForbidden builds a Meeting Service service
+// update-itx-occurrence endpoint Forbidden error.
+func NewUpdateItxOccurrenceForbidden(body *UpdateItxOccurrenceForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5812,9 +7505,9 @@ func NewGetItxPastMeetingForbidden(body *GetItxPastMeetingForbiddenResponseBody)
 	return v
 }
 
-// NewGetItxPastMeetingInternalServerError builds a Meeting Service service
-// get-itx-past-meeting endpoint InternalServerError error.
-func NewGetItxPastMeetingInternalServerError(body *GetItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewUpdateItxOccurrenceInternalServerError builds a Meeting Service service
+// update-itx-occurrence endpoint InternalServerError error.
+func NewUpdateItxOccurrenceInternalServerError(body *UpdateItxOccurrenceInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5823,9 +7516,9 @@ func NewGetItxPastMeetingInternalServerError(body *GetItxPastMeetingInternalServ
 	return v
 }
 
-// NewGetItxPastMeetingNotFound builds a Meeting Service service
-// get-itx-past-meeting endpoint NotFound error.
-func NewGetItxPastMeetingNotFound(body *GetItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewUpdateItxOccurrenceNotFound builds a Meeting Service service
+// update-itx-occurrence endpoint NotFound error.
+func NewUpdateItxOccurrenceNotFound(body *UpdateItxOccurrenceNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5834,9 +7527,9 @@ func NewGetItxPastMeetingNotFound(body *GetItxPastMeetingNotFoundResponseBody) *
 	return v
 }
 
-// NewGetItxPastMeetingServiceUnavailable builds a Meeting Service service
-// get-itx-past-meeting endpoint ServiceUnavailable error.
-func NewGetItxPastMeetingServiceUnavailable(body *GetItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewUpdateItxOccurrenceServiceUnavailable builds a Meeting Service service
+// update-itx-occurrence endpoint ServiceUnavailable error.
+func NewUpdateItxOccurrenceServiceUnavailable(body *UpdateItxOccurrenceServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5845,9 +7538,9 @@ func NewGetItxPastMeetingServiceUnavailable(body *GetItxPastMeetingServiceUnavai
 	return v
 }
 
-// NewGetItxPastMeetingUnauthorized builds a Meeting Service service
-// get-itx-past-meeting endpoint Unauthorized error.
-func NewGetItxPastMeetingUnauthorized(body *GetItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewUpdateItxOccurrenceUnauthorized builds a Meeting Service service
+// update-itx-occurrence endpoint Unauthorized error.
+func NewUpdateItxOccurrenceUnauthorized(body *UpdateItxOccurrenceUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5856,9 +7549,9 @@ func NewGetItxPastMeetingUnauthorized(body *GetItxPastMeetingUnauthorizedRespons
 	return v
 }
 
-// NewDeleteItxPastMeetingBadRequest builds a Meeting Service service
-// delete-itx-past-meeting endpoint BadRequest error.
-func NewDeleteItxPastMeetingBadRequest(body *DeleteItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewDeleteItxOccurrenceBadRequest builds a Meeting Service service
+// delete-itx-occurrence endpoint BadRequest error.
+func NewDeleteItxOccurrenceBadRequest(body *DeleteItxOccurrenceBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5867,9 +7560,9 @@ func NewDeleteItxPastMeetingBadRequest(body *DeleteItxPastMeetingBadRequestRespo
 	return v
 }
 
-// NewDeleteItxPastMeetingForbidden builds a Meeting Service service
-// delete-itx-past-meeting endpoint Forbidden error.
-func NewDeleteItxPastMeetingForbidden(body *DeleteItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewDeleteItxOccurrenceForbidden builds a Meeting Service service
+// delete-itx-occurrence endpoint Forbidden error.
+func NewDeleteItxOccurrenceForbidden(body *DeleteItxOccurrenceForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5878,9 +7571,9 @@ func NewDeleteItxPastMeetingForbidden(body *DeleteItxPastMeetingForbiddenRespons
 	return v
 }
 
-// NewDeleteItxPastMeetingInternalServerError builds a Meeting Service service
-// delete-itx-past-meeting endpoint InternalServerError error.
-func NewDeleteItxPastMeetingInternalServerError(body *DeleteItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewDeleteItxOccurrenceInternalServerError builds a Meeting Service service
+// delete-itx-occurrence endpoint InternalServerError error.
+func NewDeleteItxOccurrenceInternalServerError(body *DeleteItxOccurrenceInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5889,9 +7582,9 @@ func NewDeleteItxPastMeetingInternalServerError(body *DeleteItxPastMeetingIntern
 	return v
 }
 
-// NewDeleteItxPastMeetingNotFound builds a Meeting Service service
-// delete-itx-past-meeting endpoint NotFound error.
-func NewDeleteItxPastMeetingNotFound(body *DeleteItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewDeleteItxOccurrenceNotFound builds a Meeting Service service
+// delete-itx-occurrence endpoint NotFound error.
+func NewDeleteItxOccurrenceNotFound(body *DeleteItxOccurrenceNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5900,9 +7593,9 @@ func NewDeleteItxPastMeetingNotFound(body *DeleteItxPastMeetingNotFoundResponseB
 	return v
 }
 
-// NewDeleteItxPastMeetingServiceUnavailable builds a Meeting Service service
-// delete-itx-past-meeting endpoint ServiceUnavailable error.
-func NewDeleteItxPastMeetingServiceUnavailable(body *DeleteItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewDeleteItxOccurrenceServiceUnavailable builds a Meeting Service service
+// delete-itx-occurrence endpoint ServiceUnavailable error.
+func NewDeleteItxOccurrenceServiceUnavailable(body *DeleteItxOccurrenceServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5911,9 +7604,9 @@ func NewDeleteItxPastMeetingServiceUnavailable(body *DeleteItxPastMeetingService
 	return v
 }
 
-// NewDeleteItxPastMeetingUnauthorized builds a Meeting Service service
-// delete-itx-past-meeting endpoint Unauthorized error.
-func NewDeleteItxPastMeetingUnauthorized(body *DeleteItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewDeleteItxOccurrenceUnauthorized builds a Meeting Service service
+// delete-itx-occurrence endpoint Unauthorized error.
+func NewDeleteItxOccurrenceUnauthorized(body *DeleteItxOccurrenceUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5922,9 +7615,29 @@ func NewDeleteItxPastMeetingUnauthorized(body *DeleteItxPastMeetingUnauthorizedR
 	return v
 }
 
-// NewUpdateItxPastMeetingBadRequest builds a Meeting Service service
-// update-itx-past-meeting endpoint BadRequest error.
-func NewUpdateItxPastMeetingBadRequest(body *UpdateItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewSubmitItxMeetingResponseITXMeetingResponseResultCreated builds a "Meeting
+// Service" service "submit-itx-meeting-response" endpoint result from a HTTP
+// "Created" response.
+func NewSubmitItxMeetingResponseITXMeetingResponseResultCreated(body *SubmitItxMeetingResponseResponseBody) *meetingservice.ITXMeetingResponseResult {
+	v := &meetingservice.ITXMeetingResponseResult{
+		ID:           *body.ID,
+		MeetingID:    *body.MeetingID,
+		RegistrantID: *body.RegistrantID,
+		Username:     body.Username,
+		Email:        body.Email,
+		Response:     *body.Response,
+		Scope:        *body.Scope,
+		OccurrenceID: body.OccurrenceID,
+		CreatedAt:    body.CreatedAt,
+		UpdatedAt:    body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewSubmitItxMeetingResponseBadRequest builds a Meeting Service service
+// submit-itx-meeting-response endpoint BadRequest error.
+func NewSubmitItxMeetingResponseBadRequest(body *SubmitItxMeetingResponseBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5933,9 +7646,9 @@ func NewUpdateItxPastMeetingBadRequest(body *UpdateItxPastMeetingBadRequestRespo
 	return v
 }
 
-// NewUpdateItxPastMeetingForbidden builds a Meeting Service service
-// update-itx-past-meeting endpoint Forbidden error.
-func NewUpdateItxPastMeetingForbidden(body *UpdateItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewSubmitItxMeetingResponseForbidden builds a Meeting Service service
+// submit-itx-meeting-response endpoint Forbidden error.
+func NewSubmitItxMeetingResponseForbidden(body *SubmitItxMeetingResponseForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5944,9 +7657,9 @@ func NewUpdateItxPastMeetingForbidden(body *UpdateItxPastMeetingForbiddenRespons
 	return v
 }
 
-// NewUpdateItxPastMeetingInternalServerError builds a Meeting Service service
-// update-itx-past-meeting endpoint InternalServerError error.
-func NewUpdateItxPastMeetingInternalServerError(body *UpdateItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewSubmitItxMeetingResponseInternalServerError builds a Meeting Service
+// service submit-itx-meeting-response endpoint InternalServerError error.
+func NewSubmitItxMeetingResponseInternalServerError(body *SubmitItxMeetingResponseInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5955,9 +7668,9 @@ func NewUpdateItxPastMeetingInternalServerError(body *UpdateItxPastMeetingIntern
 	return v
 }
 
-// NewUpdateItxPastMeetingNotFound builds a Meeting Service service
-// update-itx-past-meeting endpoint NotFound error.
-func NewUpdateItxPastMeetingNotFound(body *UpdateItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewSubmitItxMeetingResponseNotFound builds a Meeting Service service
+// submit-itx-meeting-response endpoint NotFound error.
+func NewSubmitItxMeetingResponseNotFound(body *SubmitItxMeetingResponseNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5966,9 +7679,9 @@ func NewUpdateItxPastMeetingNotFound(body *UpdateItxPastMeetingNotFoundResponseB
 	return v
 }
 
-// NewUpdateItxPastMeetingServiceUnavailable builds a Meeting Service service
-// update-itx-past-meeting endpoint ServiceUnavailable error.
-func NewUpdateItxPastMeetingServiceUnavailable(body *UpdateItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewSubmitItxMeetingResponseServiceUnavailable builds a Meeting Service
+// service submit-itx-meeting-response endpoint ServiceUnavailable error.
+func NewSubmitItxMeetingResponseServiceUnavailable(body *SubmitItxMeetingResponseServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5977,9 +7690,9 @@ func NewUpdateItxPastMeetingServiceUnavailable(body *UpdateItxPastMeetingService
 	return v
 }
 
-// NewUpdateItxPastMeetingUnauthorized builds a Meeting Service service
-// update-itx-past-meeting endpoint Unauthorized error.
-func NewUpdateItxPastMeetingUnauthorized(body *UpdateItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewSubmitItxMeetingResponseUnauthorized builds a Meeting Service service
+// submit-itx-meeting-response endpoint Unauthorized error.
+func NewSubmitItxMeetingResponseUnauthorized(body *SubmitItxMeetingResponseUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -5988,33 +7701,46 @@ func NewUpdateItxPastMeetingUnauthorized(body *UpdateItxPastMeetingUnauthorizedR
 	return v
 }
 
-// NewGetItxPastMeetingSummaryPastMeetingSummaryOK builds a "Meeting Service"
-// service "get-itx-past-meeting-summary" endpoint result from a HTTP "OK"
+// NewCreateItxPastMeetingITXPastZoomMeetingCreated builds a "Meeting Service"
+// service "create-itx-past-meeting" endpoint result from a HTTP "Created"
 // response.
-func NewGetItxPastMeetingSummaryPastMeetingSummaryOK(body *GetItxPastMeetingSummaryResponseBody) *meetingservice.PastMeetingSummary {
-	v := &meetingservice.PastMeetingSummary{
-		UID:              *body.UID,
-		PastMeetingID:    *body.PastMeetingID,
-		MeetingID:        *body.MeetingID,
-		Platform:         *body.Platform,
-		Password:         body.Password,
-		RequiresApproval: *body.RequiresApproval,
-		Approved:         *body.Approved,
-		EmailSent:        *body.EmailSent,
-		CreatedAt:        *body.CreatedAt,
-		UpdatedAt:        *body.UpdatedAt,
+func NewCreateItxPastMeetingITXPastZoomMeetingCreated(body *CreateItxPastMeetingResponseBody) *meetingservice.ITXPastZoomMeeting {
+	v := &meetingservice.ITXPastZoomMeeting{
+		ID:                 body.ID,
+		MeetingID:          body.MeetingID,
+		OccurrenceID:       body.OccurrenceID,
+		ProjectUID:         body.ProjectUID,
+		Title:              body.Title,
+		Description:        body.Description,
+		StartTime:          body.StartTime,
+		Duration:           body.Duration,
+		Timezone:           body.Timezone,
+		Visibility:         body.Visibility,
+		Restricted:         body.Restricted,
+		MeetingType:        body.MeetingType,
+		RecordingEnabled:   body.RecordingEnabled,
+		ArtifactVisibility: body.ArtifactVisibility,
+		TranscriptEnabled:  body.TranscriptEnabled,
+		IsManuallyCreated:  body.IsManuallyCreated,
+		MeetingPassword:    body.MeetingPassword,
 	}
-	if body.ZoomConfig != nil {
-		v.ZoomConfig = unmarshalPastMeetingSummaryZoomConfigResponseBodyToMeetingservicePastMeetingSummaryZoomConfig(body.ZoomConfig)
+	if body.Committees != nil {
+		v.Committees = make([]*meetingservice.Committee, len(body.Committees))
+		for i, val := range body.Committees {
+			if val == nil {
+				v.Committees[i] = nil
+				continue
+			}
+			v.Committees[i] = unmarshalCommitteeResponseBodyToMeetingserviceCommittee(val)
+		}
 	}
-	v.SummaryData = unmarshalSummaryDataResponseBodyToMeetingserviceSummaryData(body.SummaryData)
 
 	return v
 }
 
-// NewGetItxPastMeetingSummaryBadRequest builds a Meeting Service service
-// get-itx-past-meeting-summary endpoint BadRequest error.
-func NewGetItxPastMeetingSummaryBadRequest(body *GetItxPastMeetingSummaryBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewCreateItxPastMeetingBadRequest builds a Meeting Service service
+// create-itx-past-meeting endpoint BadRequest error.
+func NewCreateItxPastMeetingBadRequest(body *CreateItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6023,20 +7749,31 @@ func NewGetItxPastMeetingSummaryBadRequest(body *GetItxPastMeetingSummaryBadRequ
 	return v
 }
 
-// NewGetItxPastMeetingSummaryForbidden builds a Meeting Service service
-// get-itx-past-meeting-summary endpoint Forbidden error.
-func NewGetItxPastMeetingSummaryForbidden(body *GetItxPastMeetingSummaryForbiddenResponseBody) *meetingservice.ForbiddenError {
-	v := &meetingservice.ForbiddenError{
-		Code:    *body.Code,
+// NewCreateItxPastMeetingConflict builds a Meeting Service service
+// create-itx-past-meeting endpoint Conflict error.
+func NewCreateItxPastMeetingConflict(body *CreateItxPastMeetingConflictResponseBody) *meetingservice.ConflictError {
+	v := &meetingservice.ConflictError{
+		Code:    *body.Code,
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetItxPastMeetingSummaryInternalServerError builds a Meeting Service
-// service get-itx-past-meeting-summary endpoint InternalServerError error.
-func NewGetItxPastMeetingSummaryInternalServerError(body *GetItxPastMeetingSummaryInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewCreateItxPastMeetingForbidden builds a Meeting Service service
+// create-itx-past-meeting endpoint Forbidden error.
+func NewCreateItxPastMeetingForbidden(body *CreateItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingInternalServerError builds a Meeting Service service
+// create-itx-past-meeting endpoint InternalServerError error.
+func NewCreateItxPastMeetingInternalServerError(body *CreateItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6045,9 +7782,9 @@ func NewGetItxPastMeetingSummaryInternalServerError(body *GetItxPastMeetingSumma
 	return v
 }
 
-// NewGetItxPastMeetingSummaryNotFound builds a Meeting Service service
-// get-itx-past-meeting-summary endpoint NotFound error.
-func NewGetItxPastMeetingSummaryNotFound(body *GetItxPastMeetingSummaryNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewCreateItxPastMeetingNotFound builds a Meeting Service service
+// create-itx-past-meeting endpoint NotFound error.
+func NewCreateItxPastMeetingNotFound(body *CreateItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6056,9 +7793,9 @@ func NewGetItxPastMeetingSummaryNotFound(body *GetItxPastMeetingSummaryNotFoundR
 	return v
 }
 
-// NewGetItxPastMeetingSummaryServiceUnavailable builds a Meeting Service
-// service get-itx-past-meeting-summary endpoint ServiceUnavailable error.
-func NewGetItxPastMeetingSummaryServiceUnavailable(body *GetItxPastMeetingSummaryServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewCreateItxPastMeetingServiceUnavailable builds a Meeting Service service
+// create-itx-past-meeting endpoint ServiceUnavailable error.
+func NewCreateItxPastMeetingServiceUnavailable(body *CreateItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6067,9 +7804,9 @@ func NewGetItxPastMeetingSummaryServiceUnavailable(body *GetItxPastMeetingSummar
 	return v
 }
 
-// NewGetItxPastMeetingSummaryUnauthorized builds a Meeting Service service
-// get-itx-past-meeting-summary endpoint Unauthorized error.
-func NewGetItxPastMeetingSummaryUnauthorized(body *GetItxPastMeetingSummaryUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewCreateItxPastMeetingUnauthorized builds a Meeting Service service
+// create-itx-past-meeting endpoint Unauthorized error.
+func NewCreateItxPastMeetingUnauthorized(body *CreateItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6078,33 +7815,45 @@ func NewGetItxPastMeetingSummaryUnauthorized(body *GetItxPastMeetingSummaryUnaut
 	return v
 }
 
-// NewUpdateItxPastMeetingSummaryPastMeetingSummaryOK builds a "Meeting
-// Service" service "update-itx-past-meeting-summary" endpoint result from a
-// HTTP "OK" response.
-func NewUpdateItxPastMeetingSummaryPastMeetingSummaryOK(body *UpdateItxPastMeetingSummaryResponseBody) *meetingservice.PastMeetingSummary {
-	v := &meetingservice.PastMeetingSummary{
-		UID:              *body.UID,
-		PastMeetingID:    *body.PastMeetingID,
-		MeetingID:        *body.MeetingID,
-		Platform:         *body.Platform,
-		Password:         body.Password,
-		RequiresApproval: *body.RequiresApproval,
-		Approved:         *body.Approved,
-		EmailSent:        *body.EmailSent,
-		CreatedAt:        *body.CreatedAt,
-		UpdatedAt:        *body.UpdatedAt,
+// NewGetItxPastMeetingITXPastZoomMeetingOK builds a "Meeting Service" service
+// "get-itx-past-meeting" endpoint result from a HTTP "OK" response.
+func NewGetItxPastMeetingITXPastZoomMeetingOK(body *GetItxPastMeetingResponseBody) *meetingservice.ITXPastZoomMeeting {
+	v := &meetingservice.ITXPastZoomMeeting{
+		ID:                 body.ID,
+		MeetingID:          body.MeetingID,
+		OccurrenceID:       body.OccurrenceID,
+		ProjectUID:         body.ProjectUID,
+		Title:              body.Title,
+		Description:        body.Description,
+		StartTime:          body.StartTime,
+		Duration:           body.Duration,
+		Timezone:           body.Timezone,
+		Visibility:         body.Visibility,
+		Restricted:         body.Restricted,
+		MeetingType:        body.MeetingType,
+		RecordingEnabled:   body.RecordingEnabled,
+		ArtifactVisibility: body.ArtifactVisibility,
+		TranscriptEnabled:  body.TranscriptEnabled,
+		IsManuallyCreated:  body.IsManuallyCreated,
+		MeetingPassword:    body.MeetingPassword,
 	}
-	if body.ZoomConfig != nil {
-		v.ZoomConfig = unmarshalPastMeetingSummaryZoomConfigResponseBodyToMeetingservicePastMeetingSummaryZoomConfig(body.ZoomConfig)
+	if body.Committees != nil {
+		v.Committees = make([]*meetingservice.Committee, len(body.Committees))
+		for i, val := range body.Committees {
+			if val == nil {
+				v.Committees[i] = nil
+				continue
+			}
+			v.Committees[i] = unmarshalCommitteeResponseBodyToMeetingserviceCommittee(val)
+		}
 	}
-	v.SummaryData = unmarshalSummaryDataResponseBodyToMeetingserviceSummaryData(body.SummaryData)
 
 	return v
 }
 
-// NewUpdateItxPastMeetingSummaryBadRequest builds a Meeting Service service
-// update-itx-past-meeting-summary endpoint BadRequest error.
-func NewUpdateItxPastMeetingSummaryBadRequest(body *UpdateItxPastMeetingSummaryBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewGetItxPastMeetingBadRequest builds a Meeting Service service
+// get-itx-past-meeting endpoint BadRequest error.
+func NewGetItxPastMeetingBadRequest(body *GetItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6113,9 +7862,9 @@ func NewUpdateItxPastMeetingSummaryBadRequest(body *UpdateItxPastMeetingSummaryB
 	return v
 }
 
-// NewUpdateItxPastMeetingSummaryForbidden builds a Meeting Service service
-// update-itx-past-meeting-summary endpoint Forbidden error.
-func NewUpdateItxPastMeetingSummaryForbidden(body *UpdateItxPastMeetingSummaryForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewGetItxPastMeetingForbidden builds a Meeting Service service
+// get-itx-past-meeting endpoint Forbidden error.
+func NewGetItxPastMeetingForbidden(body *GetItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6124,9 +7873,9 @@ func NewUpdateItxPastMeetingSummaryForbidden(body *UpdateItxPastMeetingSummaryFo
 	return v
 }
 
-// NewUpdateItxPastMeetingSummaryInternalServerError builds a Meeting Service
-// service update-itx-past-meeting-summary endpoint InternalServerError error.
-func NewUpdateItxPastMeetingSummaryInternalServerError(body *UpdateItxPastMeetingSummaryInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewGetItxPastMeetingInternalServerError builds a Meeting Service service
+// get-itx-past-meeting endpoint InternalServerError error.
+func NewGetItxPastMeetingInternalServerError(body *GetItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6135,9 +7884,9 @@ func NewUpdateItxPastMeetingSummaryInternalServerError(body *UpdateItxPastMeetin
 	return v
 }
 
-// NewUpdateItxPastMeetingSummaryNotFound builds a Meeting Service service
-// update-itx-past-meeting-summary endpoint NotFound error.
-func NewUpdateItxPastMeetingSummaryNotFound(body *UpdateItxPastMeetingSummaryNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewGetItxPastMeetingNotFound builds a Meeting Service service
+// get-itx-past-meeting endpoint NotFound error.
+func NewGetItxPastMeetingNotFound(body *GetItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6146,9 +7895,9 @@ func NewUpdateItxPastMeetingSummaryNotFound(body *UpdateItxPastMeetingSummaryNot
 	return v
 }
 
-// NewUpdateItxPastMeetingSummaryServiceUnavailable builds a Meeting Service
-// service update-itx-past-meeting-summary endpoint ServiceUnavailable error.
-func NewUpdateItxPastMeetingSummaryServiceUnavailable(body *UpdateItxPastMeetingSummaryServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewGetItxPastMeetingServiceUnavailable builds a Meeting Service service
+// get-itx-past-meeting endpoint ServiceUnavailable error.
+func NewGetItxPastMeetingServiceUnavailable(body *GetItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6157,9 +7906,9 @@ func NewUpdateItxPastMeetingSummaryServiceUnavailable(body *UpdateItxPastMeeting
 	return v
 }
 
-// NewUpdateItxPastMeetingSummaryUnauthorized builds a Meeting Service service
-// update-itx-past-meeting-summary endpoint Unauthorized error.
-func NewUpdateItxPastMeetingSummaryUnauthorized(body *UpdateItxPastMeetingSummaryUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewGetItxPastMeetingUnauthorized builds a Meeting Service service
+// get-itx-past-meeting endpoint Unauthorized error.
+func NewGetItxPastMeetingUnauthorized(body *GetItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6168,66 +7917,43 @@ func NewUpdateItxPastMeetingSummaryUnauthorized(body *UpdateItxPastMeetingSummar
 	return v
 }
 
-// NewCreateItxPastMeetingParticipantITXPastMeetingParticipantCreated builds a
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// result from a HTTP "Created" response.
-func NewCreateItxPastMeetingParticipantITXPastMeetingParticipantCreated(body *CreateItxPastMeetingParticipantResponseBody) *meetingservice.ITXPastMeetingParticipant {
-	v := &meetingservice.ITXPastMeetingParticipant{
-		ID:                    body.ID,
-		InviteeID:             body.InviteeID,
-		AttendeeID:            body.AttendeeID,
-		PastMeetingID:         body.PastMeetingID,
-		MeetingID:             body.MeetingID,
-		Email:                 body.Email,
-		FirstName:             body.FirstName,
-		LastName:              body.LastName,
-		Username:              body.Username,
-		LfUserID:              body.LfUserID,
-		OrgName:               body.OrgName,
-		JobTitle:              body.JobTitle,
-		OrgIsMember:           body.OrgIsMember,
-		OrgIsProjectMember:    body.OrgIsProjectMember,
-		CommitteeID:           body.CommitteeID,
-		CommitteeRole:         body.CommitteeRole,
-		IsCommitteeMember:     body.IsCommitteeMember,
-		CommitteeVotingStatus: body.CommitteeVotingStatus,
-		AvatarURL:             body.AvatarURL,
-		IsInvited:             body.IsInvited,
-		IsAttended:            body.IsAttended,
-		IsVerified:            body.IsVerified,
-		IsUnknown:             body.IsUnknown,
-		IsAiReconciled:        body.IsAiReconciled,
-		IsAutoMatched:         body.IsAutoMatched,
-		ZoomUserName:          body.ZoomUserName,
-		MappedInviteeName:     body.MappedInviteeName,
-		AverageAttendance:     body.AverageAttendance,
-		CreatedAt:             body.CreatedAt,
-		ModifiedAt:            body.ModifiedAt,
-	}
-	if body.Sessions != nil {
-		v.Sessions = make([]*meetingservice.ParticipantSession, len(body.Sessions))
-		for i, val := range body.Sessions {
-			if val == nil {
-				v.Sessions[i] = nil
-				continue
-			}
-			v.Sessions[i] = unmarshalParticipantSessionResponseBodyToMeetingserviceParticipantSession(val)
-		}
+// NewDeleteItxPastMeetingBadRequest builds a Meeting Service service
+// delete-itx-past-meeting endpoint BadRequest error.
+func NewDeleteItxPastMeetingBadRequest(body *DeleteItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.CreatedBy != nil {
-		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+
+	return v
+}
+
+// NewDeleteItxPastMeetingForbidden builds a Meeting Service service
+// delete-itx-past-meeting endpoint Forbidden error.
+func NewDeleteItxPastMeetingForbidden(body *DeleteItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.ModifiedBy != nil {
-		v.ModifiedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.ModifiedBy)
+
+	return v
+}
+
+// NewDeleteItxPastMeetingInternalServerError builds a Meeting Service service
+// delete-itx-past-meeting endpoint InternalServerError error.
+func NewDeleteItxPastMeetingInternalServerError(body *DeleteItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewCreateItxPastMeetingParticipantBadRequest builds a Meeting Service
-// service create-itx-past-meeting-participant endpoint BadRequest error.
-func NewCreateItxPastMeetingParticipantBadRequest(body *CreateItxPastMeetingParticipantBadRequestResponseBody) *meetingservice.BadRequestError {
-	v := &meetingservice.BadRequestError{
+// NewDeleteItxPastMeetingNotFound builds a Meeting Service service
+// delete-itx-past-meeting endpoint NotFound error.
+func NewDeleteItxPastMeetingNotFound(body *DeleteItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6235,10 +7961,10 @@ func NewCreateItxPastMeetingParticipantBadRequest(body *CreateItxPastMeetingPart
 	return v
 }
 
-// NewCreateItxPastMeetingParticipantForbidden builds a Meeting Service service
-// create-itx-past-meeting-participant endpoint Forbidden error.
-func NewCreateItxPastMeetingParticipantForbidden(body *CreateItxPastMeetingParticipantForbiddenResponseBody) *meetingservice.ForbiddenError {
-	v := &meetingservice.ForbiddenError{
+// NewDeleteItxPastMeetingServiceUnavailable builds a Meeting Service service
+// delete-itx-past-meeting endpoint ServiceUnavailable error.
+func NewDeleteItxPastMeetingServiceUnavailable(body *DeleteItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6246,11 +7972,10 @@ func NewCreateItxPastMeetingParticipantForbidden(body *CreateItxPastMeetingParti
 	return v
 }
 
-// NewCreateItxPastMeetingParticipantInternalServerError builds a Meeting
-// Service service create-itx-past-meeting-participant endpoint
-// InternalServerError error.
-func NewCreateItxPastMeetingParticipantInternalServerError(body *CreateItxPastMeetingParticipantInternalServerErrorResponseBody) *meetingservice.InternalServerError {
-	v := &meetingservice.InternalServerError{
+// NewDeleteItxPastMeetingUnauthorized builds a Meeting Service service
+// delete-itx-past-meeting endpoint Unauthorized error.
+func NewDeleteItxPastMeetingUnauthorized(body *DeleteItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6258,10 +7983,10 @@ func NewCreateItxPastMeetingParticipantInternalServerError(body *CreateItxPastMe
 	return v
 }
 
-// NewCreateItxPastMeetingParticipantNotFound builds a Meeting Service service
-// create-itx-past-meeting-participant endpoint NotFound error.
-func NewCreateItxPastMeetingParticipantNotFound(body *CreateItxPastMeetingParticipantNotFoundResponseBody) *meetingservice.NotFoundError {
-	v := &meetingservice.NotFoundError{
+// NewUpdateItxPastMeetingBadRequest builds a Meeting Service service
+// update-itx-past-meeting endpoint BadRequest error.
+func NewUpdateItxPastMeetingBadRequest(body *UpdateItxPastMeetingBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6269,11 +7994,10 @@ func NewCreateItxPastMeetingParticipantNotFound(body *CreateItxPastMeetingPartic
 	return v
 }
 
-// NewCreateItxPastMeetingParticipantServiceUnavailable builds a Meeting
-// Service service create-itx-past-meeting-participant endpoint
-// ServiceUnavailable error.
-func NewCreateItxPastMeetingParticipantServiceUnavailable(body *CreateItxPastMeetingParticipantServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
-	v := &meetingservice.ServiceUnavailableError{
+// NewUpdateItxPastMeetingForbidden builds a Meeting Service service
+// update-itx-past-meeting endpoint Forbidden error.
+func NewUpdateItxPastMeetingForbidden(body *UpdateItxPastMeetingForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6281,10 +8005,10 @@ func NewCreateItxPastMeetingParticipantServiceUnavailable(body *CreateItxPastMee
 	return v
 }
 
-// NewCreateItxPastMeetingParticipantUnauthorized builds a Meeting Service
-// service create-itx-past-meeting-participant endpoint Unauthorized error.
-func NewCreateItxPastMeetingParticipantUnauthorized(body *CreateItxPastMeetingParticipantUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
-	v := &meetingservice.UnauthorizedError{
+// NewUpdateItxPastMeetingInternalServerError builds a Meeting Service service
+// update-itx-past-meeting endpoint InternalServerError error.
+func NewUpdateItxPastMeetingInternalServerError(body *UpdateItxPastMeetingInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6292,65 +8016,66 @@ func NewCreateItxPastMeetingParticipantUnauthorized(body *CreateItxPastMeetingPa
 	return v
 }
 
-// NewUpdateItxPastMeetingParticipantITXPastMeetingParticipantOK builds a
-// "Meeting Service" service "update-itx-past-meeting-participant" endpoint
-// result from a HTTP "OK" response.
-func NewUpdateItxPastMeetingParticipantITXPastMeetingParticipantOK(body *UpdateItxPastMeetingParticipantResponseBody) *meetingservice.ITXPastMeetingParticipant {
-	v := &meetingservice.ITXPastMeetingParticipant{
-		ID:                    body.ID,
-		InviteeID:             body.InviteeID,
-		AttendeeID:            body.AttendeeID,
-		PastMeetingID:         body.PastMeetingID,
-		MeetingID:             body.MeetingID,
-		Email:                 body.Email,
-		FirstName:             body.FirstName,
-		LastName:              body.LastName,
-		Username:              body.Username,
-		LfUserID:              body.LfUserID,
-		OrgName:               body.OrgName,
-		JobTitle:              body.JobTitle,
-		OrgIsMember:           body.OrgIsMember,
-		OrgIsProjectMember:    body.OrgIsProjectMember,
-		CommitteeID:           body.CommitteeID,
-		CommitteeRole:         body.CommitteeRole,
-		IsCommitteeMember:     body.IsCommitteeMember,
-		CommitteeVotingStatus: body.CommitteeVotingStatus,
-		AvatarURL:             body.AvatarURL,
-		IsInvited:             body.IsInvited,
-		IsAttended:            body.IsAttended,
-		IsVerified:            body.IsVerified,
-		IsUnknown:             body.IsUnknown,
-		IsAiReconciled:        body.IsAiReconciled,
-		IsAutoMatched:         body.IsAutoMatched,
-		ZoomUserName:          body.ZoomUserName,
-		MappedInviteeName:     body.MappedInviteeName,
-		AverageAttendance:     body.AverageAttendance,
-		CreatedAt:             body.CreatedAt,
-		ModifiedAt:            body.ModifiedAt,
+// NewUpdateItxPastMeetingNotFound builds a Meeting Service service
+// update-itx-past-meeting endpoint NotFound error.
+func NewUpdateItxPastMeetingNotFound(body *UpdateItxPastMeetingNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.Sessions != nil {
-		v.Sessions = make([]*meetingservice.ParticipantSession, len(body.Sessions))
-		for i, val := range body.Sessions {
-			if val == nil {
-				v.Sessions[i] = nil
-				continue
-			}
-			v.Sessions[i] = unmarshalParticipantSessionResponseBodyToMeetingserviceParticipantSession(val)
-		}
+
+	return v
+}
+
+// NewUpdateItxPastMeetingServiceUnavailable builds a Meeting Service service
+// update-itx-past-meeting endpoint ServiceUnavailable error.
+func NewUpdateItxPastMeetingServiceUnavailable(body *UpdateItxPastMeetingServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.CreatedBy != nil {
-		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+
+	return v
+}
+
+// NewUpdateItxPastMeetingUnauthorized builds a Meeting Service service
+// update-itx-past-meeting endpoint Unauthorized error.
+func NewUpdateItxPastMeetingUnauthorized(body *UpdateItxPastMeetingUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.ModifiedBy != nil {
-		v.ModifiedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.ModifiedBy)
+
+	return v
+}
+
+// NewGetItxPastMeetingSummaryPastMeetingSummaryOK builds a "Meeting Service"
+// service "get-itx-past-meeting-summary" endpoint result from a HTTP "OK"
+// response.
+func NewGetItxPastMeetingSummaryPastMeetingSummaryOK(body *GetItxPastMeetingSummaryResponseBody) *meetingservice.PastMeetingSummary {
+	v := &meetingservice.PastMeetingSummary{
+		UID:              *body.UID,
+		PastMeetingID:    *body.PastMeetingID,
+		MeetingID:        *body.MeetingID,
+		Platform:         *body.Platform,
+		Password:         body.Password,
+		RequiresApproval: *body.RequiresApproval,
+		Approved:         *body.Approved,
+		EmailSent:        *body.EmailSent,
+		CreatedAt:        *body.CreatedAt,
+		UpdatedAt:        *body.UpdatedAt,
+	}
+	if body.ZoomConfig != nil {
+		v.ZoomConfig = unmarshalPastMeetingSummaryZoomConfigResponseBodyToMeetingservicePastMeetingSummaryZoomConfig(body.ZoomConfig)
 	}
+	v.SummaryData = unmarshalSummaryDataResponseBodyToMeetingserviceSummaryData(body.SummaryData)
 
 	return v
 }
 
-// NewUpdateItxPastMeetingParticipantBadRequest builds a Meeting Service
-// service update-itx-past-meeting-participant endpoint BadRequest error.
-func NewUpdateItxPastMeetingParticipantBadRequest(body *UpdateItxPastMeetingParticipantBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewGetItxPastMeetingSummaryBadRequest builds a Meeting Service service
+// get-itx-past-meeting-summary endpoint BadRequest error.
+func NewGetItxPastMeetingSummaryBadRequest(body *GetItxPastMeetingSummaryBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6359,9 +8084,9 @@ func NewUpdateItxPastMeetingParticipantBadRequest(body *UpdateItxPastMeetingPart
 	return v
 }
 
-// NewUpdateItxPastMeetingParticipantForbidden builds a Meeting Service service
-// update-itx-past-meeting-participant endpoint Forbidden error.
-func NewUpdateItxPastMeetingParticipantForbidden(body *UpdateItxPastMeetingParticipantForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewGetItxPastMeetingSummaryForbidden builds a Meeting Service service
+// get-itx-past-meeting-summary endpoint Forbidden error.
+func NewGetItxPastMeetingSummaryForbidden(body *GetItxPastMeetingSummaryForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6370,10 +8095,9 @@ func NewUpdateItxPastMeetingParticipantForbidden(body *UpdateItxPastMeetingParti
 	return v
 }
 
-// NewUpdateItxPastMeetingParticipantInternalServerError builds a Meeting
-// Service service update-itx-past-meeting-participant endpoint
-// InternalServerError error.
-func NewUpdateItxPastMeetingParticipantInternalServerError(body *UpdateItxPastMeetingParticipantInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewGetItxPastMeetingSummaryInternalServerError builds a Meeting Service
+// service get-itx-past-meeting-summary endpoint InternalServerError error.
+func NewGetItxPastMeetingSummaryInternalServerError(body *GetItxPastMeetingSummaryInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6382,9 +8106,9 @@ func NewUpdateItxPastMeetingParticipantInternalServerError(body *UpdateItxPastMe
 	return v
 }
 
-// NewUpdateItxPastMeetingParticipantNotFound builds a Meeting Service service
-// update-itx-past-meeting-participant endpoint NotFound error.
-func NewUpdateItxPastMeetingParticipantNotFound(body *UpdateItxPastMeetingParticipantNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewGetItxPastMeetingSummaryNotFound builds a Meeting Service service
+// get-itx-past-meeting-summary endpoint NotFound error.
+func NewGetItxPastMeetingSummaryNotFound(body *GetItxPastMeetingSummaryNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6393,10 +8117,9 @@ func NewUpdateItxPastMeetingParticipantNotFound(body *UpdateItxPastMeetingPartic
 	return v
 }
 
-// NewUpdateItxPastMeetingParticipantServiceUnavailable builds a Meeting
-// Service service update-itx-past-meeting-participant endpoint
-// ServiceUnavailable error.
-func NewUpdateItxPastMeetingParticipantServiceUnavailable(body *UpdateItxPastMeetingParticipantServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewGetItxPastMeetingSummaryServiceUnavailable builds a Meeting Service
+// service get-itx-past-meeting-summary endpoint ServiceUnavailable error.
+func NewGetItxPastMeetingSummaryServiceUnavailable(body *GetItxPastMeetingSummaryServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6405,9 +8128,9 @@ func NewUpdateItxPastMeetingParticipantServiceUnavailable(body *UpdateItxPastMee
 	return v
 }
 
-// NewUpdateItxPastMeetingParticipantUnauthorized builds a Meeting Service
-// service update-itx-past-meeting-participant endpoint Unauthorized error.
-func NewUpdateItxPastMeetingParticipantUnauthorized(body *UpdateItxPastMeetingParticipantUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewGetItxPastMeetingSummaryUnauthorized builds a Meeting Service service
+// get-itx-past-meeting-summary endpoint Unauthorized error.
+func NewGetItxPastMeetingSummaryUnauthorized(body *GetItxPastMeetingSummaryUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6416,9 +8139,33 @@ func NewUpdateItxPastMeetingParticipantUnauthorized(body *UpdateItxPastMeetingPa
 	return v
 }
 
-// NewDeleteItxPastMeetingParticipantBadRequest builds a Meeting Service
-// service delete-itx-past-meeting-participant endpoint BadRequest error.
-func NewDeleteItxPastMeetingParticipantBadRequest(body *DeleteItxPastMeetingParticipantBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewGetItxPastMeetingSummaryDiffPastMeetingSummaryDiffOK builds a "Meeting
+// Service" service "get-itx-past-meeting-summary-diff" endpoint result from a
+// HTTP "OK" response.
+func NewGetItxPastMeetingSummaryDiffPastMeetingSummaryDiffOK(body *GetItxPastMeetingSummaryDiffResponseBody) *meetingservice.PastMeetingSummaryDiff {
+	v := &meetingservice.PastMeetingSummaryDiff{
+		UID:           *body.UID,
+		PastMeetingID: *body.PastMeetingID,
+		HasEdits:      *body.HasEdits,
+		Additions:     *body.Additions,
+		Deletions:     *body.Deletions,
+		Approximate:   *body.Approximate,
+	}
+	v.Lines = make([]*meetingservice.SummaryDiffLine, len(body.Lines))
+	for i, val := range body.Lines {
+		if val == nil {
+			v.Lines[i] = nil
+			continue
+		}
+		v.Lines[i] = unmarshalSummaryDiffLineResponseBodyToMeetingserviceSummaryDiffLine(val)
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingSummaryDiffBadRequest builds a Meeting Service service
+// get-itx-past-meeting-summary-diff endpoint BadRequest error.
+func NewGetItxPastMeetingSummaryDiffBadRequest(body *GetItxPastMeetingSummaryDiffBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6427,9 +8174,9 @@ func NewDeleteItxPastMeetingParticipantBadRequest(body *DeleteItxPastMeetingPart
 	return v
 }
 
-// NewDeleteItxPastMeetingParticipantForbidden builds a Meeting Service service
-// delete-itx-past-meeting-participant endpoint Forbidden error.
-func NewDeleteItxPastMeetingParticipantForbidden(body *DeleteItxPastMeetingParticipantForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewGetItxPastMeetingSummaryDiffForbidden builds a Meeting Service service
+// get-itx-past-meeting-summary-diff endpoint Forbidden error.
+func NewGetItxPastMeetingSummaryDiffForbidden(body *GetItxPastMeetingSummaryDiffForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6438,10 +8185,9 @@ func NewDeleteItxPastMeetingParticipantForbidden(body *DeleteItxPastMeetingParti
 	return v
 }
 
-// NewDeleteItxPastMeetingParticipantInternalServerError builds a Meeting
-// Service service delete-itx-past-meeting-participant endpoint
-// InternalServerError error.
-func NewDeleteItxPastMeetingParticipantInternalServerError(body *DeleteItxPastMeetingParticipantInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewGetItxPastMeetingSummaryDiffInternalServerError builds a Meeting Service
+// service get-itx-past-meeting-summary-diff endpoint InternalServerError error.
+func NewGetItxPastMeetingSummaryDiffInternalServerError(body *GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6450,9 +8196,9 @@ func NewDeleteItxPastMeetingParticipantInternalServerError(body *DeleteItxPastMe
 	return v
 }
 
-// NewDeleteItxPastMeetingParticipantNotFound builds a Meeting Service service
-// delete-itx-past-meeting-participant endpoint NotFound error.
-func NewDeleteItxPastMeetingParticipantNotFound(body *DeleteItxPastMeetingParticipantNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewGetItxPastMeetingSummaryDiffNotFound builds a Meeting Service service
+// get-itx-past-meeting-summary-diff endpoint NotFound error.
+func NewGetItxPastMeetingSummaryDiffNotFound(body *GetItxPastMeetingSummaryDiffNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6461,10 +8207,9 @@ func NewDeleteItxPastMeetingParticipantNotFound(body *DeleteItxPastMeetingPartic
 	return v
 }
 
-// NewDeleteItxPastMeetingParticipantServiceUnavailable builds a Meeting
-// Service service delete-itx-past-meeting-participant endpoint
-// ServiceUnavailable error.
-func NewDeleteItxPastMeetingParticipantServiceUnavailable(body *DeleteItxPastMeetingParticipantServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewGetItxPastMeetingSummaryDiffServiceUnavailable builds a Meeting Service
+// service get-itx-past-meeting-summary-diff endpoint ServiceUnavailable error.
+func NewGetItxPastMeetingSummaryDiffServiceUnavailable(body *GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6473,9 +8218,9 @@ func NewDeleteItxPastMeetingParticipantServiceUnavailable(body *DeleteItxPastMee
 	return v
 }
 
-// NewDeleteItxPastMeetingParticipantUnauthorized builds a Meeting Service
-// service delete-itx-past-meeting-participant endpoint Unauthorized error.
-func NewDeleteItxPastMeetingParticipantUnauthorized(body *DeleteItxPastMeetingParticipantUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewGetItxPastMeetingSummaryDiffUnauthorized builds a Meeting Service service
+// get-itx-past-meeting-summary-diff endpoint Unauthorized error.
+func NewGetItxPastMeetingSummaryDiffUnauthorized(body *GetItxPastMeetingSummaryDiffUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6484,45 +8229,33 @@ func NewDeleteItxPastMeetingParticipantUnauthorized(body *DeleteItxPastMeetingPa
 	return v
 }
 
-// NewCreateItxMeetingAttachmentITXMeetingAttachmentCreated builds a "Meeting
-// Service" service "create-itx-meeting-attachment" endpoint result from a HTTP
-// "Created" response.
-func NewCreateItxMeetingAttachmentITXMeetingAttachmentCreated(body *CreateItxMeetingAttachmentResponseBody) *meetingservice.ITXMeetingAttachment {
-	v := &meetingservice.ITXMeetingAttachment{
+// NewUpdateItxPastMeetingSummaryPastMeetingSummaryOK builds a "Meeting
+// Service" service "update-itx-past-meeting-summary" endpoint result from a
+// HTTP "OK" response.
+func NewUpdateItxPastMeetingSummaryPastMeetingSummaryOK(body *UpdateItxPastMeetingSummaryResponseBody) *meetingservice.PastMeetingSummary {
+	v := &meetingservice.PastMeetingSummary{
 		UID:              *body.UID,
+		PastMeetingID:    *body.PastMeetingID,
 		MeetingID:        *body.MeetingID,
-		Type:             *body.Type,
-		Source:           body.Source,
-		Category:         *body.Category,
-		Link:             body.Link,
-		Name:             *body.Name,
-		Description:      body.Description,
-		FileName:         body.FileName,
-		FileSize:         body.FileSize,
-		FileURL:          body.FileURL,
-		FileUploaded:     body.FileUploaded,
-		FileUploadStatus: body.FileUploadStatus,
-		FileContentType:  body.FileContentType,
-		CreatedAt:        body.CreatedAt,
-		UpdatedAt:        body.UpdatedAt,
-		FileUploadedAt:   body.FileUploadedAt,
-	}
-	if body.CreatedBy != nil {
-		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
-	}
-	if body.UpdatedBy != nil {
-		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+		Platform:         *body.Platform,
+		Password:         body.Password,
+		RequiresApproval: *body.RequiresApproval,
+		Approved:         *body.Approved,
+		EmailSent:        *body.EmailSent,
+		CreatedAt:        *body.CreatedAt,
+		UpdatedAt:        *body.UpdatedAt,
 	}
-	if body.FileUploadedBy != nil {
-		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+	if body.ZoomConfig != nil {
+		v.ZoomConfig = unmarshalPastMeetingSummaryZoomConfigResponseBodyToMeetingservicePastMeetingSummaryZoomConfig(body.ZoomConfig)
 	}
+	v.SummaryData = unmarshalSummaryDataResponseBodyToMeetingserviceSummaryData(body.SummaryData)
 
 	return v
 }
 
-// NewCreateItxMeetingAttachmentBadRequest builds a Meeting Service service
-// create-itx-meeting-attachment endpoint BadRequest error.
-func NewCreateItxMeetingAttachmentBadRequest(body *CreateItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewUpdateItxPastMeetingSummaryBadRequest builds a Meeting Service service
+// update-itx-past-meeting-summary endpoint BadRequest error.
+func NewUpdateItxPastMeetingSummaryBadRequest(body *UpdateItxPastMeetingSummaryBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6531,9 +8264,9 @@ func NewCreateItxMeetingAttachmentBadRequest(body *CreateItxMeetingAttachmentBad
 	return v
 }
 
-// NewCreateItxMeetingAttachmentForbidden builds a Meeting Service service
-// create-itx-meeting-attachment endpoint Forbidden error.
-func NewCreateItxMeetingAttachmentForbidden(body *CreateItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewUpdateItxPastMeetingSummaryForbidden builds a Meeting Service service
+// update-itx-past-meeting-summary endpoint Forbidden error.
+func NewUpdateItxPastMeetingSummaryForbidden(body *UpdateItxPastMeetingSummaryForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6542,9 +8275,9 @@ func NewCreateItxMeetingAttachmentForbidden(body *CreateItxMeetingAttachmentForb
 	return v
 }
 
-// NewCreateItxMeetingAttachmentInternalServerError builds a Meeting Service
-// service create-itx-meeting-attachment endpoint InternalServerError error.
-func NewCreateItxMeetingAttachmentInternalServerError(body *CreateItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewUpdateItxPastMeetingSummaryInternalServerError builds a Meeting Service
+// service update-itx-past-meeting-summary endpoint InternalServerError error.
+func NewUpdateItxPastMeetingSummaryInternalServerError(body *UpdateItxPastMeetingSummaryInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6553,9 +8286,9 @@ func NewCreateItxMeetingAttachmentInternalServerError(body *CreateItxMeetingAtta
 	return v
 }
 
-// NewCreateItxMeetingAttachmentNotFound builds a Meeting Service service
-// create-itx-meeting-attachment endpoint NotFound error.
-func NewCreateItxMeetingAttachmentNotFound(body *CreateItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewUpdateItxPastMeetingSummaryNotFound builds a Meeting Service service
+// update-itx-past-meeting-summary endpoint NotFound error.
+func NewUpdateItxPastMeetingSummaryNotFound(body *UpdateItxPastMeetingSummaryNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6564,9 +8297,9 @@ func NewCreateItxMeetingAttachmentNotFound(body *CreateItxMeetingAttachmentNotFo
 	return v
 }
 
-// NewCreateItxMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service create-itx-meeting-attachment endpoint ServiceUnavailable error.
-func NewCreateItxMeetingAttachmentServiceUnavailable(body *CreateItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewUpdateItxPastMeetingSummaryServiceUnavailable builds a Meeting Service
+// service update-itx-past-meeting-summary endpoint ServiceUnavailable error.
+func NewUpdateItxPastMeetingSummaryServiceUnavailable(body *UpdateItxPastMeetingSummaryServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6575,9 +8308,9 @@ func NewCreateItxMeetingAttachmentServiceUnavailable(body *CreateItxMeetingAttac
 	return v
 }
 
-// NewCreateItxMeetingAttachmentUnauthorized builds a Meeting Service service
-// create-itx-meeting-attachment endpoint Unauthorized error.
-func NewCreateItxMeetingAttachmentUnauthorized(body *CreateItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewUpdateItxPastMeetingSummaryUnauthorized builds a Meeting Service service
+// update-itx-past-meeting-summary endpoint Unauthorized error.
+func NewUpdateItxPastMeetingSummaryUnauthorized(body *UpdateItxPastMeetingSummaryUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6586,45 +8319,33 @@ func NewCreateItxMeetingAttachmentUnauthorized(body *CreateItxMeetingAttachmentU
 	return v
 }
 
-// NewGetItxMeetingAttachmentITXMeetingAttachmentOK builds a "Meeting Service"
-// service "get-itx-meeting-attachment" endpoint result from a HTTP "OK"
-// response.
-func NewGetItxMeetingAttachmentITXMeetingAttachmentOK(body *GetItxMeetingAttachmentResponseBody) *meetingservice.ITXMeetingAttachment {
-	v := &meetingservice.ITXMeetingAttachment{
+// NewApproveItxPastMeetingSummaryPastMeetingSummaryOK builds a "Meeting
+// Service" service "approve-itx-past-meeting-summary" endpoint result from a
+// HTTP "OK" response.
+func NewApproveItxPastMeetingSummaryPastMeetingSummaryOK(body *ApproveItxPastMeetingSummaryResponseBody) *meetingservice.PastMeetingSummary {
+	v := &meetingservice.PastMeetingSummary{
 		UID:              *body.UID,
+		PastMeetingID:    *body.PastMeetingID,
 		MeetingID:        *body.MeetingID,
-		Type:             *body.Type,
-		Source:           body.Source,
-		Category:         *body.Category,
-		Link:             body.Link,
-		Name:             *body.Name,
-		Description:      body.Description,
-		FileName:         body.FileName,
-		FileSize:         body.FileSize,
-		FileURL:          body.FileURL,
-		FileUploaded:     body.FileUploaded,
-		FileUploadStatus: body.FileUploadStatus,
-		FileContentType:  body.FileContentType,
-		CreatedAt:        body.CreatedAt,
-		UpdatedAt:        body.UpdatedAt,
-		FileUploadedAt:   body.FileUploadedAt,
-	}
-	if body.CreatedBy != nil {
-		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
-	}
-	if body.UpdatedBy != nil {
-		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+		Platform:         *body.Platform,
+		Password:         body.Password,
+		RequiresApproval: *body.RequiresApproval,
+		Approved:         *body.Approved,
+		EmailSent:        *body.EmailSent,
+		CreatedAt:        *body.CreatedAt,
+		UpdatedAt:        *body.UpdatedAt,
 	}
-	if body.FileUploadedBy != nil {
-		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+	if body.ZoomConfig != nil {
+		v.ZoomConfig = unmarshalPastMeetingSummaryZoomConfigResponseBodyToMeetingservicePastMeetingSummaryZoomConfig(body.ZoomConfig)
 	}
+	v.SummaryData = unmarshalSummaryDataResponseBodyToMeetingserviceSummaryData(body.SummaryData)
 
 	return v
 }
 
-// NewGetItxMeetingAttachmentBadRequest builds a Meeting Service service
-// get-itx-meeting-attachment endpoint BadRequest error.
-func NewGetItxMeetingAttachmentBadRequest(body *GetItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewApproveItxPastMeetingSummaryBadRequest builds a Meeting Service service
+// approve-itx-past-meeting-summary endpoint BadRequest error.
+func NewApproveItxPastMeetingSummaryBadRequest(body *ApproveItxPastMeetingSummaryBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6633,32 +8354,10 @@ func NewGetItxMeetingAttachmentBadRequest(body *GetItxMeetingAttachmentBadReques
 	return v
 }
 
-// NewGetItxMeetingAttachmentForbidden builds a Meeting Service service
-// get-itx-meeting-attachment endpoint Forbidden error.
-func NewGetItxMeetingAttachmentForbidden(body *GetItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
-	v := &meetingservice.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentInternalServerError builds a Meeting Service
-// service get-itx-meeting-attachment endpoint InternalServerError error.
-func NewGetItxMeetingAttachmentInternalServerError(body *GetItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
-	v := &meetingservice.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentNotFound builds a Meeting Service service
-// get-itx-meeting-attachment endpoint NotFound error.
-func NewGetItxMeetingAttachmentNotFound(body *GetItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
-	v := &meetingservice.NotFoundError{
+// NewApproveItxPastMeetingSummaryConflict builds a Meeting Service service
+// approve-itx-past-meeting-summary endpoint Conflict error.
+func NewApproveItxPastMeetingSummaryConflict(body *ApproveItxPastMeetingSummaryConflictResponseBody) *meetingservice.ConflictError {
+	v := &meetingservice.ConflictError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6666,10 +8365,10 @@ func NewGetItxMeetingAttachmentNotFound(body *GetItxMeetingAttachmentNotFoundRes
 	return v
 }
 
-// NewGetItxMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service get-itx-meeting-attachment endpoint ServiceUnavailable error.
-func NewGetItxMeetingAttachmentServiceUnavailable(body *GetItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
-	v := &meetingservice.ServiceUnavailableError{
+// NewApproveItxPastMeetingSummaryForbidden builds a Meeting Service service
+// approve-itx-past-meeting-summary endpoint Forbidden error.
+func NewApproveItxPastMeetingSummaryForbidden(body *ApproveItxPastMeetingSummaryForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6677,10 +8376,10 @@ func NewGetItxMeetingAttachmentServiceUnavailable(body *GetItxMeetingAttachmentS
 	return v
 }
 
-// NewGetItxMeetingAttachmentUnauthorized builds a Meeting Service service
-// get-itx-meeting-attachment endpoint Unauthorized error.
-func NewGetItxMeetingAttachmentUnauthorized(body *GetItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
-	v := &meetingservice.UnauthorizedError{
+// NewApproveItxPastMeetingSummaryInternalServerError builds a Meeting Service
+// service approve-itx-past-meeting-summary endpoint InternalServerError error.
+func NewApproveItxPastMeetingSummaryInternalServerError(body *ApproveItxPastMeetingSummaryInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6688,10 +8387,10 @@ func NewGetItxMeetingAttachmentUnauthorized(body *GetItxMeetingAttachmentUnautho
 	return v
 }
 
-// NewUpdateItxMeetingAttachmentBadRequest builds a Meeting Service service
-// update-itx-meeting-attachment endpoint BadRequest error.
-func NewUpdateItxMeetingAttachmentBadRequest(body *UpdateItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
-	v := &meetingservice.BadRequestError{
+// NewApproveItxPastMeetingSummaryNotFound builds a Meeting Service service
+// approve-itx-past-meeting-summary endpoint NotFound error.
+func NewApproveItxPastMeetingSummaryNotFound(body *ApproveItxPastMeetingSummaryNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6699,10 +8398,10 @@ func NewUpdateItxMeetingAttachmentBadRequest(body *UpdateItxMeetingAttachmentBad
 	return v
 }
 
-// NewUpdateItxMeetingAttachmentForbidden builds a Meeting Service service
-// update-itx-meeting-attachment endpoint Forbidden error.
-func NewUpdateItxMeetingAttachmentForbidden(body *UpdateItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
-	v := &meetingservice.ForbiddenError{
+// NewApproveItxPastMeetingSummaryServiceUnavailable builds a Meeting Service
+// service approve-itx-past-meeting-summary endpoint ServiceUnavailable error.
+func NewApproveItxPastMeetingSummaryServiceUnavailable(body *ApproveItxPastMeetingSummaryServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6710,10 +8409,10 @@ func NewUpdateItxMeetingAttachmentForbidden(body *UpdateItxMeetingAttachmentForb
 	return v
 }
 
-// NewUpdateItxMeetingAttachmentInternalServerError builds a Meeting Service
-// service update-itx-meeting-attachment endpoint InternalServerError error.
-func NewUpdateItxMeetingAttachmentInternalServerError(body *UpdateItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
-	v := &meetingservice.InternalServerError{
+// NewApproveItxPastMeetingSummaryUnauthorized builds a Meeting Service service
+// approve-itx-past-meeting-summary endpoint Unauthorized error.
+func NewApproveItxPastMeetingSummaryUnauthorized(body *ApproveItxPastMeetingSummaryUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6721,32 +8420,34 @@ func NewUpdateItxMeetingAttachmentInternalServerError(body *UpdateItxMeetingAtta
 	return v
 }
 
-// NewUpdateItxMeetingAttachmentNotFound builds a Meeting Service service
-// update-itx-meeting-attachment endpoint NotFound error.
-func NewUpdateItxMeetingAttachmentNotFound(body *UpdateItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
-	v := &meetingservice.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// NewRejectItxPastMeetingSummaryPastMeetingSummaryOK builds a "Meeting
+// Service" service "reject-itx-past-meeting-summary" endpoint result from a
+// HTTP "OK" response.
+func NewRejectItxPastMeetingSummaryPastMeetingSummaryOK(body *RejectItxPastMeetingSummaryResponseBody) *meetingservice.PastMeetingSummary {
+	v := &meetingservice.PastMeetingSummary{
+		UID:              *body.UID,
+		PastMeetingID:    *body.PastMeetingID,
+		MeetingID:        *body.MeetingID,
+		Platform:         *body.Platform,
+		Password:         body.Password,
+		RequiresApproval: *body.RequiresApproval,
+		Approved:         *body.Approved,
+		EmailSent:        *body.EmailSent,
+		CreatedAt:        *body.CreatedAt,
+		UpdatedAt:        *body.UpdatedAt,
 	}
-
-	return v
-}
-
-// NewUpdateItxMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service update-itx-meeting-attachment endpoint ServiceUnavailable error.
-func NewUpdateItxMeetingAttachmentServiceUnavailable(body *UpdateItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
-	v := &meetingservice.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+	if body.ZoomConfig != nil {
+		v.ZoomConfig = unmarshalPastMeetingSummaryZoomConfigResponseBodyToMeetingservicePastMeetingSummaryZoomConfig(body.ZoomConfig)
 	}
+	v.SummaryData = unmarshalSummaryDataResponseBodyToMeetingserviceSummaryData(body.SummaryData)
 
 	return v
 }
 
-// NewUpdateItxMeetingAttachmentUnauthorized builds a Meeting Service service
-// update-itx-meeting-attachment endpoint Unauthorized error.
-func NewUpdateItxMeetingAttachmentUnauthorized(body *UpdateItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
-	v := &meetingservice.UnauthorizedError{
+// NewRejectItxPastMeetingSummaryBadRequest builds a Meeting Service service
+// reject-itx-past-meeting-summary endpoint BadRequest error.
+func NewRejectItxPastMeetingSummaryBadRequest(body *RejectItxPastMeetingSummaryBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6754,10 +8455,10 @@ func NewUpdateItxMeetingAttachmentUnauthorized(body *UpdateItxMeetingAttachmentU
 	return v
 }
 
-// NewDeleteItxMeetingAttachmentBadRequest builds a Meeting Service service
-// delete-itx-meeting-attachment endpoint BadRequest error.
-func NewDeleteItxMeetingAttachmentBadRequest(body *DeleteItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
-	v := &meetingservice.BadRequestError{
+// NewRejectItxPastMeetingSummaryConflict builds a Meeting Service service
+// reject-itx-past-meeting-summary endpoint Conflict error.
+func NewRejectItxPastMeetingSummaryConflict(body *RejectItxPastMeetingSummaryConflictResponseBody) *meetingservice.ConflictError {
+	v := &meetingservice.ConflictError{
 		Code:    *body.Code,
 		Message: *body.Message,
 	}
@@ -6765,9 +8466,9 @@ func NewDeleteItxMeetingAttachmentBadRequest(body *DeleteItxMeetingAttachmentBad
 	return v
 }
 
-// NewDeleteItxMeetingAttachmentForbidden builds a Meeting Service service
-// delete-itx-meeting-attachment endpoint Forbidden error.
-func NewDeleteItxMeetingAttachmentForbidden(body *DeleteItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewRejectItxPastMeetingSummaryForbidden builds a Meeting Service service
+// reject-itx-past-meeting-summary endpoint Forbidden error.
+func NewRejectItxPastMeetingSummaryForbidden(body *RejectItxPastMeetingSummaryForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6776,9 +8477,9 @@ func NewDeleteItxMeetingAttachmentForbidden(body *DeleteItxMeetingAttachmentForb
 	return v
 }
 
-// NewDeleteItxMeetingAttachmentInternalServerError builds a Meeting Service
-// service delete-itx-meeting-attachment endpoint InternalServerError error.
-func NewDeleteItxMeetingAttachmentInternalServerError(body *DeleteItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewRejectItxPastMeetingSummaryInternalServerError builds a Meeting Service
+// service reject-itx-past-meeting-summary endpoint InternalServerError error.
+func NewRejectItxPastMeetingSummaryInternalServerError(body *RejectItxPastMeetingSummaryInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6787,9 +8488,9 @@ func NewDeleteItxMeetingAttachmentInternalServerError(body *DeleteItxMeetingAtta
 	return v
 }
 
-// NewDeleteItxMeetingAttachmentNotFound builds a Meeting Service service
-// delete-itx-meeting-attachment endpoint NotFound error.
-func NewDeleteItxMeetingAttachmentNotFound(body *DeleteItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewRejectItxPastMeetingSummaryNotFound builds a Meeting Service service
+// reject-itx-past-meeting-summary endpoint NotFound error.
+func NewRejectItxPastMeetingSummaryNotFound(body *RejectItxPastMeetingSummaryNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6798,9 +8499,9 @@ func NewDeleteItxMeetingAttachmentNotFound(body *DeleteItxMeetingAttachmentNotFo
 	return v
 }
 
-// NewDeleteItxMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service delete-itx-meeting-attachment endpoint ServiceUnavailable error.
-func NewDeleteItxMeetingAttachmentServiceUnavailable(body *DeleteItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewRejectItxPastMeetingSummaryServiceUnavailable builds a Meeting Service
+// service reject-itx-past-meeting-summary endpoint ServiceUnavailable error.
+func NewRejectItxPastMeetingSummaryServiceUnavailable(body *RejectItxPastMeetingSummaryServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6809,9 +8510,9 @@ func NewDeleteItxMeetingAttachmentServiceUnavailable(body *DeleteItxMeetingAttac
 	return v
 }
 
-// NewDeleteItxMeetingAttachmentUnauthorized builds a Meeting Service service
-// delete-itx-meeting-attachment endpoint Unauthorized error.
-func NewDeleteItxMeetingAttachmentUnauthorized(body *DeleteItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewRejectItxPastMeetingSummaryUnauthorized builds a Meeting Service service
+// reject-itx-past-meeting-summary endpoint Unauthorized error.
+func NewRejectItxPastMeetingSummaryUnauthorized(body *RejectItxPastMeetingSummaryUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6820,38 +8521,65 @@ func NewDeleteItxMeetingAttachmentUnauthorized(body *DeleteItxMeetingAttachmentU
 	return v
 }
 
-// NewCreateItxMeetingAttachmentPresignITXMeetingAttachmentPresignResponseCreated
-// builds a "Meeting Service" service "create-itx-meeting-attachment-presign"
-// endpoint result from a HTTP "Created" response.
-func NewCreateItxMeetingAttachmentPresignITXMeetingAttachmentPresignResponseCreated(body *CreateItxMeetingAttachmentPresignResponseBody) *meetingservice.ITXMeetingAttachmentPresignResponse {
-	v := &meetingservice.ITXMeetingAttachmentPresignResponse{
-		UID:              *body.UID,
-		MeetingID:        *body.MeetingID,
-		Type:             body.Type,
-		Category:         body.Category,
-		Name:             body.Name,
-		Description:      body.Description,
-		FileName:         body.FileName,
-		FileSize:         body.FileSize,
-		FileURL:          *body.FileURL,
-		FileUploadStatus: body.FileUploadStatus,
-		FileContentType:  body.FileContentType,
-		CreatedAt:        body.CreatedAt,
-		UpdatedAt:        body.UpdatedAt,
+// NewCreateItxPastMeetingParticipantITXPastMeetingParticipantCreated builds a
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// result from a HTTP "Created" response.
+func NewCreateItxPastMeetingParticipantITXPastMeetingParticipantCreated(body *CreateItxPastMeetingParticipantResponseBody) *meetingservice.ITXPastMeetingParticipant {
+	v := &meetingservice.ITXPastMeetingParticipant{
+		ID:                    body.ID,
+		InviteeID:             body.InviteeID,
+		AttendeeID:            body.AttendeeID,
+		PastMeetingID:         body.PastMeetingID,
+		MeetingID:             body.MeetingID,
+		Email:                 body.Email,
+		FirstName:             body.FirstName,
+		LastName:              body.LastName,
+		Username:              body.Username,
+		LfUserID:              body.LfUserID,
+		OrgName:               body.OrgName,
+		JobTitle:              body.JobTitle,
+		OrgIsMember:           body.OrgIsMember,
+		OrgIsProjectMember:    body.OrgIsProjectMember,
+		CommitteeID:           body.CommitteeID,
+		CommitteeRole:         body.CommitteeRole,
+		IsCommitteeMember:     body.IsCommitteeMember,
+		CommitteeVotingStatus: body.CommitteeVotingStatus,
+		AvatarURL:             body.AvatarURL,
+		IsInvited:             body.IsInvited,
+		IsAttended:            body.IsAttended,
+		IsVerified:            body.IsVerified,
+		IsUnknown:             body.IsUnknown,
+		IsAiReconciled:        body.IsAiReconciled,
+		IsAutoMatched:         body.IsAutoMatched,
+		ZoomUserName:          body.ZoomUserName,
+		MappedInviteeName:     body.MappedInviteeName,
+		AverageAttendance:     body.AverageAttendance,
+		CreatedAt:             body.CreatedAt,
+		ModifiedAt:            body.ModifiedAt,
+	}
+	if body.Sessions != nil {
+		v.Sessions = make([]*meetingservice.ParticipantSession, len(body.Sessions))
+		for i, val := range body.Sessions {
+			if val == nil {
+				v.Sessions[i] = nil
+				continue
+			}
+			v.Sessions[i] = unmarshalParticipantSessionResponseBodyToMeetingserviceParticipantSession(val)
+		}
 	}
 	if body.CreatedBy != nil {
 		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
 	}
-	if body.UpdatedBy != nil {
-		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	if body.ModifiedBy != nil {
+		v.ModifiedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.ModifiedBy)
 	}
 
 	return v
 }
 
-// NewCreateItxMeetingAttachmentPresignBadRequest builds a Meeting Service
-// service create-itx-meeting-attachment-presign endpoint BadRequest error.
-func NewCreateItxMeetingAttachmentPresignBadRequest(body *CreateItxMeetingAttachmentPresignBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewCreateItxPastMeetingParticipantBadRequest builds a Meeting Service
+// service create-itx-past-meeting-participant endpoint BadRequest error.
+func NewCreateItxPastMeetingParticipantBadRequest(body *CreateItxPastMeetingParticipantBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6860,9 +8588,9 @@ func NewCreateItxMeetingAttachmentPresignBadRequest(body *CreateItxMeetingAttach
 	return v
 }
 
-// NewCreateItxMeetingAttachmentPresignForbidden builds a Meeting Service
-// service create-itx-meeting-attachment-presign endpoint Forbidden error.
-func NewCreateItxMeetingAttachmentPresignForbidden(body *CreateItxMeetingAttachmentPresignForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewCreateItxPastMeetingParticipantForbidden builds a Meeting Service service
+// create-itx-past-meeting-participant endpoint Forbidden error.
+func NewCreateItxPastMeetingParticipantForbidden(body *CreateItxPastMeetingParticipantForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6871,10 +8599,10 @@ func NewCreateItxMeetingAttachmentPresignForbidden(body *CreateItxMeetingAttachm
 	return v
 }
 
-// NewCreateItxMeetingAttachmentPresignInternalServerError builds a Meeting
-// Service service create-itx-meeting-attachment-presign endpoint
+// NewCreateItxPastMeetingParticipantInternalServerError builds a Meeting
+// Service service create-itx-past-meeting-participant endpoint
 // InternalServerError error.
-func NewCreateItxMeetingAttachmentPresignInternalServerError(body *CreateItxMeetingAttachmentPresignInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+func NewCreateItxPastMeetingParticipantInternalServerError(body *CreateItxPastMeetingParticipantInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6883,9 +8611,9 @@ func NewCreateItxMeetingAttachmentPresignInternalServerError(body *CreateItxMeet
 	return v
 }
 
-// NewCreateItxMeetingAttachmentPresignNotFound builds a Meeting Service
-// service create-itx-meeting-attachment-presign endpoint NotFound error.
-func NewCreateItxMeetingAttachmentPresignNotFound(body *CreateItxMeetingAttachmentPresignNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewCreateItxPastMeetingParticipantNotFound builds a Meeting Service service
+// create-itx-past-meeting-participant endpoint NotFound error.
+func NewCreateItxPastMeetingParticipantNotFound(body *CreateItxPastMeetingParticipantNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6894,10 +8622,10 @@ func NewCreateItxMeetingAttachmentPresignNotFound(body *CreateItxMeetingAttachme
 	return v
 }
 
-// NewCreateItxMeetingAttachmentPresignServiceUnavailable builds a Meeting
-// Service service create-itx-meeting-attachment-presign endpoint
+// NewCreateItxPastMeetingParticipantServiceUnavailable builds a Meeting
+// Service service create-itx-past-meeting-participant endpoint
 // ServiceUnavailable error.
-func NewCreateItxMeetingAttachmentPresignServiceUnavailable(body *CreateItxMeetingAttachmentPresignServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+func NewCreateItxPastMeetingParticipantServiceUnavailable(body *CreateItxPastMeetingParticipantServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6906,9 +8634,9 @@ func NewCreateItxMeetingAttachmentPresignServiceUnavailable(body *CreateItxMeeti
 	return v
 }
 
-// NewCreateItxMeetingAttachmentPresignUnauthorized builds a Meeting Service
-// service create-itx-meeting-attachment-presign endpoint Unauthorized error.
-func NewCreateItxMeetingAttachmentPresignUnauthorized(body *CreateItxMeetingAttachmentPresignUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewCreateItxPastMeetingParticipantUnauthorized builds a Meeting Service
+// service create-itx-past-meeting-participant endpoint Unauthorized error.
+func NewCreateItxPastMeetingParticipantUnauthorized(body *CreateItxPastMeetingParticipantUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -6917,125 +8645,65 @@ func NewCreateItxMeetingAttachmentPresignUnauthorized(body *CreateItxMeetingAtta
 	return v
 }
 
-// NewGetItxMeetingAttachmentDownloadITXAttachmentDownloadResponseOK builds a
-// "Meeting Service" service "get-itx-meeting-attachment-download" endpoint
+// NewUpdateItxPastMeetingParticipantITXPastMeetingParticipantOK builds a
+// "Meeting Service" service "update-itx-past-meeting-participant" endpoint
 // result from a HTTP "OK" response.
-func NewGetItxMeetingAttachmentDownloadITXAttachmentDownloadResponseOK(body *GetItxMeetingAttachmentDownloadResponseBody) *meetingservice.ITXAttachmentDownloadResponse {
-	v := &meetingservice.ITXAttachmentDownloadResponse{
-		DownloadURL: *body.DownloadURL,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentDownloadBadRequest builds a Meeting Service
-// service get-itx-meeting-attachment-download endpoint BadRequest error.
-func NewGetItxMeetingAttachmentDownloadBadRequest(body *GetItxMeetingAttachmentDownloadBadRequestResponseBody) *meetingservice.BadRequestError {
-	v := &meetingservice.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentDownloadForbidden builds a Meeting Service service
-// get-itx-meeting-attachment-download endpoint Forbidden error.
-func NewGetItxMeetingAttachmentDownloadForbidden(body *GetItxMeetingAttachmentDownloadForbiddenResponseBody) *meetingservice.ForbiddenError {
-	v := &meetingservice.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentDownloadInternalServerError builds a Meeting
-// Service service get-itx-meeting-attachment-download endpoint
-// InternalServerError error.
-func NewGetItxMeetingAttachmentDownloadInternalServerError(body *GetItxMeetingAttachmentDownloadInternalServerErrorResponseBody) *meetingservice.InternalServerError {
-	v := &meetingservice.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentDownloadNotFound builds a Meeting Service service
-// get-itx-meeting-attachment-download endpoint NotFound error.
-func NewGetItxMeetingAttachmentDownloadNotFound(body *GetItxMeetingAttachmentDownloadNotFoundResponseBody) *meetingservice.NotFoundError {
-	v := &meetingservice.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentDownloadServiceUnavailable builds a Meeting
-// Service service get-itx-meeting-attachment-download endpoint
-// ServiceUnavailable error.
-func NewGetItxMeetingAttachmentDownloadServiceUnavailable(body *GetItxMeetingAttachmentDownloadServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
-	v := &meetingservice.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxMeetingAttachmentDownloadUnauthorized builds a Meeting Service
-// service get-itx-meeting-attachment-download endpoint Unauthorized error.
-func NewGetItxMeetingAttachmentDownloadUnauthorized(body *GetItxMeetingAttachmentDownloadUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
-	v := &meetingservice.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+func NewUpdateItxPastMeetingParticipantITXPastMeetingParticipantOK(body *UpdateItxPastMeetingParticipantResponseBody) *meetingservice.ITXPastMeetingParticipant {
+	v := &meetingservice.ITXPastMeetingParticipant{
+		ID:                    body.ID,
+		InviteeID:             body.InviteeID,
+		AttendeeID:            body.AttendeeID,
+		PastMeetingID:         body.PastMeetingID,
+		MeetingID:             body.MeetingID,
+		Email:                 body.Email,
+		FirstName:             body.FirstName,
+		LastName:              body.LastName,
+		Username:              body.Username,
+		LfUserID:              body.LfUserID,
+		OrgName:               body.OrgName,
+		JobTitle:              body.JobTitle,
+		OrgIsMember:           body.OrgIsMember,
+		OrgIsProjectMember:    body.OrgIsProjectMember,
+		CommitteeID:           body.CommitteeID,
+		CommitteeRole:         body.CommitteeRole,
+		IsCommitteeMember:     body.IsCommitteeMember,
+		CommitteeVotingStatus: body.CommitteeVotingStatus,
+		AvatarURL:             body.AvatarURL,
+		IsInvited:             body.IsInvited,
+		IsAttended:            body.IsAttended,
+		IsVerified:            body.IsVerified,
+		IsUnknown:             body.IsUnknown,
+		IsAiReconciled:        body.IsAiReconciled,
+		IsAutoMatched:         body.IsAutoMatched,
+		ZoomUserName:          body.ZoomUserName,
+		MappedInviteeName:     body.MappedInviteeName,
+		AverageAttendance:     body.AverageAttendance,
+		CreatedAt:             body.CreatedAt,
+		ModifiedAt:            body.ModifiedAt,
 	}
-
-	return v
-}
-
-// NewCreateItxPastMeetingAttachmentITXPastMeetingAttachmentCreated builds a
-// "Meeting Service" service "create-itx-past-meeting-attachment" endpoint
-// result from a HTTP "Created" response.
-func NewCreateItxPastMeetingAttachmentITXPastMeetingAttachmentCreated(body *CreateItxPastMeetingAttachmentResponseBody) *meetingservice.ITXPastMeetingAttachment {
-	v := &meetingservice.ITXPastMeetingAttachment{
-		UID:                    *body.UID,
-		MeetingAndOccurrenceID: *body.MeetingAndOccurrenceID,
-		MeetingID:              *body.MeetingID,
-		Type:                   *body.Type,
-		Source:                 body.Source,
-		Category:               *body.Category,
-		Link:                   body.Link,
-		Name:                   *body.Name,
-		Description:            body.Description,
-		FileName:               body.FileName,
-		FileSize:               body.FileSize,
-		FileURL:                body.FileURL,
-		FileUploaded:           body.FileUploaded,
-		FileUploadStatus:       body.FileUploadStatus,
-		FileContentType:        body.FileContentType,
-		CreatedAt:              body.CreatedAt,
-		UpdatedAt:              body.UpdatedAt,
-		FileUploadedAt:         body.FileUploadedAt,
+	if body.Sessions != nil {
+		v.Sessions = make([]*meetingservice.ParticipantSession, len(body.Sessions))
+		for i, val := range body.Sessions {
+			if val == nil {
+				v.Sessions[i] = nil
+				continue
+			}
+			v.Sessions[i] = unmarshalParticipantSessionResponseBodyToMeetingserviceParticipantSession(val)
+		}
 	}
 	if body.CreatedBy != nil {
 		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
 	}
-	if body.UpdatedBy != nil {
-		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
-	}
-	if body.FileUploadedBy != nil {
-		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+	if body.ModifiedBy != nil {
+		v.ModifiedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.ModifiedBy)
 	}
 
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentBadRequest builds a Meeting Service service
-// create-itx-past-meeting-attachment endpoint BadRequest error.
-func NewCreateItxPastMeetingAttachmentBadRequest(body *CreateItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewUpdateItxPastMeetingParticipantBadRequest builds a Meeting Service
+// service update-itx-past-meeting-participant endpoint BadRequest error.
+func NewUpdateItxPastMeetingParticipantBadRequest(body *UpdateItxPastMeetingParticipantBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7044,9 +8712,9 @@ func NewCreateItxPastMeetingAttachmentBadRequest(body *CreateItxPastMeetingAttac
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentForbidden builds a Meeting Service service
-// create-itx-past-meeting-attachment endpoint Forbidden error.
-func NewCreateItxPastMeetingAttachmentForbidden(body *CreateItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewUpdateItxPastMeetingParticipantForbidden builds a Meeting Service service
+// update-itx-past-meeting-participant endpoint Forbidden error.
+func NewUpdateItxPastMeetingParticipantForbidden(body *UpdateItxPastMeetingParticipantForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7055,10 +8723,10 @@ func NewCreateItxPastMeetingAttachmentForbidden(body *CreateItxPastMeetingAttach
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentInternalServerError builds a Meeting
-// Service service create-itx-past-meeting-attachment endpoint
+// NewUpdateItxPastMeetingParticipantInternalServerError builds a Meeting
+// Service service update-itx-past-meeting-participant endpoint
 // InternalServerError error.
-func NewCreateItxPastMeetingAttachmentInternalServerError(body *CreateItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+func NewUpdateItxPastMeetingParticipantInternalServerError(body *UpdateItxPastMeetingParticipantInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7067,9 +8735,9 @@ func NewCreateItxPastMeetingAttachmentInternalServerError(body *CreateItxPastMee
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentNotFound builds a Meeting Service service
-// create-itx-past-meeting-attachment endpoint NotFound error.
-func NewCreateItxPastMeetingAttachmentNotFound(body *CreateItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewUpdateItxPastMeetingParticipantNotFound builds a Meeting Service service
+// update-itx-past-meeting-participant endpoint NotFound error.
+func NewUpdateItxPastMeetingParticipantNotFound(body *UpdateItxPastMeetingParticipantNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7078,9 +8746,10 @@ func NewCreateItxPastMeetingAttachmentNotFound(body *CreateItxPastMeetingAttachm
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service create-itx-past-meeting-attachment endpoint ServiceUnavailable error.
-func NewCreateItxPastMeetingAttachmentServiceUnavailable(body *CreateItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewUpdateItxPastMeetingParticipantServiceUnavailable builds a Meeting
+// Service service update-itx-past-meeting-participant endpoint
+// ServiceUnavailable error.
+func NewUpdateItxPastMeetingParticipantServiceUnavailable(body *UpdateItxPastMeetingParticipantServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7089,9 +8758,9 @@ func NewCreateItxPastMeetingAttachmentServiceUnavailable(body *CreateItxPastMeet
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentUnauthorized builds a Meeting Service
-// service create-itx-past-meeting-attachment endpoint Unauthorized error.
-func NewCreateItxPastMeetingAttachmentUnauthorized(body *CreateItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewUpdateItxPastMeetingParticipantUnauthorized builds a Meeting Service
+// service update-itx-past-meeting-participant endpoint Unauthorized error.
+func NewUpdateItxPastMeetingParticipantUnauthorized(body *UpdateItxPastMeetingParticipantUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7100,46 +8769,27 @@ func NewCreateItxPastMeetingAttachmentUnauthorized(body *CreateItxPastMeetingAtt
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentITXPastMeetingAttachmentOK builds a "Meeting
-// Service" service "get-itx-past-meeting-attachment" endpoint result from a
-// HTTP "OK" response.
-func NewGetItxPastMeetingAttachmentITXPastMeetingAttachmentOK(body *GetItxPastMeetingAttachmentResponseBody) *meetingservice.ITXPastMeetingAttachment {
-	v := &meetingservice.ITXPastMeetingAttachment{
-		UID:                    *body.UID,
-		MeetingAndOccurrenceID: *body.MeetingAndOccurrenceID,
-		MeetingID:              *body.MeetingID,
-		Type:                   *body.Type,
-		Source:                 body.Source,
-		Category:               *body.Category,
-		Link:                   body.Link,
-		Name:                   *body.Name,
-		Description:            body.Description,
-		FileName:               body.FileName,
-		FileSize:               body.FileSize,
-		FileURL:                body.FileURL,
-		FileUploaded:           body.FileUploaded,
-		FileUploadStatus:       body.FileUploadStatus,
-		FileContentType:        body.FileContentType,
-		CreatedAt:              body.CreatedAt,
-		UpdatedAt:              body.UpdatedAt,
-		FileUploadedAt:         body.FileUploadedAt,
-	}
-	if body.CreatedBy != nil {
-		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
-	}
-	if body.UpdatedBy != nil {
-		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
-	}
-	if body.FileUploadedBy != nil {
-		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+// NewBulkUpdateItxPastMeetingParticipantsITXPastMeetingParticipantBulkUpdateResponseOK
+// builds a "Meeting Service" service
+// "bulk-update-itx-past-meeting-participants" endpoint result from a HTTP "OK"
+// response.
+func NewBulkUpdateItxPastMeetingParticipantsITXPastMeetingParticipantBulkUpdateResponseOK(body *BulkUpdateItxPastMeetingParticipantsResponseBody) *meetingservice.ITXPastMeetingParticipantBulkUpdateResponse {
+	v := &meetingservice.ITXPastMeetingParticipantBulkUpdateResponse{}
+	v.Participants = make([]*meetingservice.ITXPastMeetingParticipant, len(body.Participants))
+	for i, val := range body.Participants {
+		if val == nil {
+			v.Participants[i] = nil
+			continue
+		}
+		v.Participants[i] = unmarshalITXPastMeetingParticipantResponseBodyToMeetingserviceITXPastMeetingParticipant(val)
 	}
 
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentBadRequest builds a Meeting Service service
-// get-itx-past-meeting-attachment endpoint BadRequest error.
-func NewGetItxPastMeetingAttachmentBadRequest(body *GetItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewBulkUpdateItxPastMeetingParticipantsBadRequest builds a Meeting Service
+// service bulk-update-itx-past-meeting-participants endpoint BadRequest error.
+func NewBulkUpdateItxPastMeetingParticipantsBadRequest(body *BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7148,9 +8798,9 @@ func NewGetItxPastMeetingAttachmentBadRequest(body *GetItxPastMeetingAttachmentB
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentForbidden builds a Meeting Service service
-// get-itx-past-meeting-attachment endpoint Forbidden error.
-func NewGetItxPastMeetingAttachmentForbidden(body *GetItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewBulkUpdateItxPastMeetingParticipantsForbidden builds a Meeting Service
+// service bulk-update-itx-past-meeting-participants endpoint Forbidden error.
+func NewBulkUpdateItxPastMeetingParticipantsForbidden(body *BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7159,9 +8809,10 @@ func NewGetItxPastMeetingAttachmentForbidden(body *GetItxPastMeetingAttachmentFo
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentInternalServerError builds a Meeting Service
-// service get-itx-past-meeting-attachment endpoint InternalServerError error.
-func NewGetItxPastMeetingAttachmentInternalServerError(body *GetItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewBulkUpdateItxPastMeetingParticipantsInternalServerError builds a Meeting
+// Service service bulk-update-itx-past-meeting-participants endpoint
+// InternalServerError error.
+func NewBulkUpdateItxPastMeetingParticipantsInternalServerError(body *BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7170,20 +8821,10 @@ func NewGetItxPastMeetingAttachmentInternalServerError(body *GetItxPastMeetingAt
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentNotFound builds a Meeting Service service
-// get-itx-past-meeting-attachment endpoint NotFound error.
-func NewGetItxPastMeetingAttachmentNotFound(body *GetItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
-	v := &meetingservice.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
-	}
-
-	return v
-}
-
-// NewGetItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service get-itx-past-meeting-attachment endpoint ServiceUnavailable error.
-func NewGetItxPastMeetingAttachmentServiceUnavailable(body *GetItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewBulkUpdateItxPastMeetingParticipantsServiceUnavailable builds a Meeting
+// Service service bulk-update-itx-past-meeting-participants endpoint
+// ServiceUnavailable error.
+func NewBulkUpdateItxPastMeetingParticipantsServiceUnavailable(body *BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7192,9 +8833,10 @@ func NewGetItxPastMeetingAttachmentServiceUnavailable(body *GetItxPastMeetingAtt
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentUnauthorized builds a Meeting Service service
-// get-itx-past-meeting-attachment endpoint Unauthorized error.
-func NewGetItxPastMeetingAttachmentUnauthorized(body *GetItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewBulkUpdateItxPastMeetingParticipantsUnauthorized builds a Meeting Service
+// service bulk-update-itx-past-meeting-participants endpoint Unauthorized
+// error.
+func NewBulkUpdateItxPastMeetingParticipantsUnauthorized(body *BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7203,9 +8845,9 @@ func NewGetItxPastMeetingAttachmentUnauthorized(body *GetItxPastMeetingAttachmen
 	return v
 }
 
-// NewUpdateItxPastMeetingAttachmentBadRequest builds a Meeting Service service
-// update-itx-past-meeting-attachment endpoint BadRequest error.
-func NewUpdateItxPastMeetingAttachmentBadRequest(body *UpdateItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewDeleteItxPastMeetingParticipantBadRequest builds a Meeting Service
+// service delete-itx-past-meeting-participant endpoint BadRequest error.
+func NewDeleteItxPastMeetingParticipantBadRequest(body *DeleteItxPastMeetingParticipantBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7214,9 +8856,9 @@ func NewUpdateItxPastMeetingAttachmentBadRequest(body *UpdateItxPastMeetingAttac
 	return v
 }
 
-// NewUpdateItxPastMeetingAttachmentForbidden builds a Meeting Service service
-// update-itx-past-meeting-attachment endpoint Forbidden error.
-func NewUpdateItxPastMeetingAttachmentForbidden(body *UpdateItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewDeleteItxPastMeetingParticipantForbidden builds a Meeting Service service
+// delete-itx-past-meeting-participant endpoint Forbidden error.
+func NewDeleteItxPastMeetingParticipantForbidden(body *DeleteItxPastMeetingParticipantForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7225,10 +8867,10 @@ func NewUpdateItxPastMeetingAttachmentForbidden(body *UpdateItxPastMeetingAttach
 	return v
 }
 
-// NewUpdateItxPastMeetingAttachmentInternalServerError builds a Meeting
-// Service service update-itx-past-meeting-attachment endpoint
+// NewDeleteItxPastMeetingParticipantInternalServerError builds a Meeting
+// Service service delete-itx-past-meeting-participant endpoint
 // InternalServerError error.
-func NewUpdateItxPastMeetingAttachmentInternalServerError(body *UpdateItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+func NewDeleteItxPastMeetingParticipantInternalServerError(body *DeleteItxPastMeetingParticipantInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7237,9 +8879,9 @@ func NewUpdateItxPastMeetingAttachmentInternalServerError(body *UpdateItxPastMee
 	return v
 }
 
-// NewUpdateItxPastMeetingAttachmentNotFound builds a Meeting Service service
-// update-itx-past-meeting-attachment endpoint NotFound error.
-func NewUpdateItxPastMeetingAttachmentNotFound(body *UpdateItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewDeleteItxPastMeetingParticipantNotFound builds a Meeting Service service
+// delete-itx-past-meeting-participant endpoint NotFound error.
+func NewDeleteItxPastMeetingParticipantNotFound(body *DeleteItxPastMeetingParticipantNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7248,9 +8890,10 @@ func NewUpdateItxPastMeetingAttachmentNotFound(body *UpdateItxPastMeetingAttachm
 	return v
 }
 
-// NewUpdateItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service update-itx-past-meeting-attachment endpoint ServiceUnavailable error.
-func NewUpdateItxPastMeetingAttachmentServiceUnavailable(body *UpdateItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewDeleteItxPastMeetingParticipantServiceUnavailable builds a Meeting
+// Service service delete-itx-past-meeting-participant endpoint
+// ServiceUnavailable error.
+func NewDeleteItxPastMeetingParticipantServiceUnavailable(body *DeleteItxPastMeetingParticipantServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7259,9 +8902,9 @@ func NewUpdateItxPastMeetingAttachmentServiceUnavailable(body *UpdateItxPastMeet
 	return v
 }
 
-// NewUpdateItxPastMeetingAttachmentUnauthorized builds a Meeting Service
-// service update-itx-past-meeting-attachment endpoint Unauthorized error.
-func NewUpdateItxPastMeetingAttachmentUnauthorized(body *UpdateItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewDeleteItxPastMeetingParticipantUnauthorized builds a Meeting Service
+// service delete-itx-past-meeting-participant endpoint Unauthorized error.
+func NewDeleteItxPastMeetingParticipantUnauthorized(body *DeleteItxPastMeetingParticipantUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7270,9 +8913,45 @@ func NewUpdateItxPastMeetingAttachmentUnauthorized(body *UpdateItxPastMeetingAtt
 	return v
 }
 
-// NewDeleteItxPastMeetingAttachmentBadRequest builds a Meeting Service service
-// delete-itx-past-meeting-attachment endpoint BadRequest error.
-func NewDeleteItxPastMeetingAttachmentBadRequest(body *DeleteItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewCreateItxMeetingAttachmentITXMeetingAttachmentCreated builds a "Meeting
+// Service" service "create-itx-meeting-attachment" endpoint result from a HTTP
+// "Created" response.
+func NewCreateItxMeetingAttachmentITXMeetingAttachmentCreated(body *CreateItxMeetingAttachmentResponseBody) *meetingservice.ITXMeetingAttachment {
+	v := &meetingservice.ITXMeetingAttachment{
+		UID:              *body.UID,
+		MeetingID:        *body.MeetingID,
+		Type:             *body.Type,
+		Source:           body.Source,
+		Category:         *body.Category,
+		Link:             body.Link,
+		Name:             *body.Name,
+		Description:      body.Description,
+		FileName:         body.FileName,
+		FileSize:         body.FileSize,
+		FileURL:          body.FileURL,
+		FileUploaded:     body.FileUploaded,
+		FileUploadStatus: body.FileUploadStatus,
+		FileContentType:  body.FileContentType,
+		CreatedAt:        body.CreatedAt,
+		UpdatedAt:        body.UpdatedAt,
+		FileUploadedAt:   body.FileUploadedAt,
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+	}
+	if body.UpdatedBy != nil {
+		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	}
+	if body.FileUploadedBy != nil {
+		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentBadRequest builds a Meeting Service service
+// create-itx-meeting-attachment endpoint BadRequest error.
+func NewCreateItxMeetingAttachmentBadRequest(body *CreateItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7281,9 +8960,9 @@ func NewDeleteItxPastMeetingAttachmentBadRequest(body *DeleteItxPastMeetingAttac
 	return v
 }
 
-// NewDeleteItxPastMeetingAttachmentForbidden builds a Meeting Service service
-// delete-itx-past-meeting-attachment endpoint Forbidden error.
-func NewDeleteItxPastMeetingAttachmentForbidden(body *DeleteItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewCreateItxMeetingAttachmentForbidden builds a Meeting Service service
+// create-itx-meeting-attachment endpoint Forbidden error.
+func NewCreateItxMeetingAttachmentForbidden(body *CreateItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7292,10 +8971,9 @@ func NewDeleteItxPastMeetingAttachmentForbidden(body *DeleteItxPastMeetingAttach
 	return v
 }
 
-// NewDeleteItxPastMeetingAttachmentInternalServerError builds a Meeting
-// Service service delete-itx-past-meeting-attachment endpoint
-// InternalServerError error.
-func NewDeleteItxPastMeetingAttachmentInternalServerError(body *DeleteItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewCreateItxMeetingAttachmentInternalServerError builds a Meeting Service
+// service create-itx-meeting-attachment endpoint InternalServerError error.
+func NewCreateItxMeetingAttachmentInternalServerError(body *CreateItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7304,9 +8982,9 @@ func NewDeleteItxPastMeetingAttachmentInternalServerError(body *DeleteItxPastMee
 	return v
 }
 
-// NewDeleteItxPastMeetingAttachmentNotFound builds a Meeting Service service
-// delete-itx-past-meeting-attachment endpoint NotFound error.
-func NewDeleteItxPastMeetingAttachmentNotFound(body *DeleteItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewCreateItxMeetingAttachmentNotFound builds a Meeting Service service
+// create-itx-meeting-attachment endpoint NotFound error.
+func NewCreateItxMeetingAttachmentNotFound(body *CreateItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7315,9 +8993,9 @@ func NewDeleteItxPastMeetingAttachmentNotFound(body *DeleteItxPastMeetingAttachm
 	return v
 }
 
-// NewDeleteItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
-// service delete-itx-past-meeting-attachment endpoint ServiceUnavailable error.
-func NewDeleteItxPastMeetingAttachmentServiceUnavailable(body *DeleteItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewCreateItxMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service create-itx-meeting-attachment endpoint ServiceUnavailable error.
+func NewCreateItxMeetingAttachmentServiceUnavailable(body *CreateItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7326,9 +9004,9 @@ func NewDeleteItxPastMeetingAttachmentServiceUnavailable(body *DeleteItxPastMeet
 	return v
 }
 
-// NewDeleteItxPastMeetingAttachmentUnauthorized builds a Meeting Service
-// service delete-itx-past-meeting-attachment endpoint Unauthorized error.
-func NewDeleteItxPastMeetingAttachmentUnauthorized(body *DeleteItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewCreateItxMeetingAttachmentUnauthorized builds a Meeting Service service
+// create-itx-meeting-attachment endpoint Unauthorized error.
+func NewCreateItxMeetingAttachmentUnauthorized(body *CreateItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7337,26 +9015,28 @@ func NewDeleteItxPastMeetingAttachmentUnauthorized(body *DeleteItxPastMeetingAtt
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentPresignITXPastMeetingAttachmentPresignResponseCreated
-// builds a "Meeting Service" service
-// "create-itx-past-meeting-attachment-presign" endpoint result from a HTTP
-// "Created" response.
-func NewCreateItxPastMeetingAttachmentPresignITXPastMeetingAttachmentPresignResponseCreated(body *CreateItxPastMeetingAttachmentPresignResponseBody) *meetingservice.ITXPastMeetingAttachmentPresignResponse {
-	v := &meetingservice.ITXPastMeetingAttachmentPresignResponse{
-		UID:                    *body.UID,
-		MeetingAndOccurrenceID: *body.MeetingAndOccurrenceID,
-		MeetingID:              body.MeetingID,
-		Type:                   body.Type,
-		Category:               body.Category,
-		Name:                   body.Name,
-		Description:            body.Description,
-		FileName:               body.FileName,
-		FileSize:               body.FileSize,
-		FileURL:                *body.FileURL,
-		FileUploadStatus:       body.FileUploadStatus,
-		FileContentType:        body.FileContentType,
-		CreatedAt:              body.CreatedAt,
-		UpdatedAt:              body.UpdatedAt,
+// NewGetItxMeetingAttachmentITXMeetingAttachmentOK builds a "Meeting Service"
+// service "get-itx-meeting-attachment" endpoint result from a HTTP "OK"
+// response.
+func NewGetItxMeetingAttachmentITXMeetingAttachmentOK(body *GetItxMeetingAttachmentResponseBody) *meetingservice.ITXMeetingAttachment {
+	v := &meetingservice.ITXMeetingAttachment{
+		UID:              *body.UID,
+		MeetingID:        *body.MeetingID,
+		Type:             *body.Type,
+		Source:           body.Source,
+		Category:         *body.Category,
+		Link:             body.Link,
+		Name:             *body.Name,
+		Description:      body.Description,
+		FileName:         body.FileName,
+		FileSize:         body.FileSize,
+		FileURL:          body.FileURL,
+		FileUploaded:     body.FileUploaded,
+		FileUploadStatus: body.FileUploadStatus,
+		FileContentType:  body.FileContentType,
+		CreatedAt:        body.CreatedAt,
+		UpdatedAt:        body.UpdatedAt,
+		FileUploadedAt:   body.FileUploadedAt,
 	}
 	if body.CreatedBy != nil {
 		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
@@ -7364,13 +9044,16 @@ func NewCreateItxPastMeetingAttachmentPresignITXPastMeetingAttachmentPresignResp
 	if body.UpdatedBy != nil {
 		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
 	}
+	if body.FileUploadedBy != nil {
+		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+	}
 
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentPresignBadRequest builds a Meeting Service
-// service create-itx-past-meeting-attachment-presign endpoint BadRequest error.
-func NewCreateItxPastMeetingAttachmentPresignBadRequest(body *CreateItxPastMeetingAttachmentPresignBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewGetItxMeetingAttachmentBadRequest builds a Meeting Service service
+// get-itx-meeting-attachment endpoint BadRequest error.
+func NewGetItxMeetingAttachmentBadRequest(body *GetItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7379,9 +9062,9 @@ func NewCreateItxPastMeetingAttachmentPresignBadRequest(body *CreateItxPastMeeti
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentPresignForbidden builds a Meeting Service
-// service create-itx-past-meeting-attachment-presign endpoint Forbidden error.
-func NewCreateItxPastMeetingAttachmentPresignForbidden(body *CreateItxPastMeetingAttachmentPresignForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewGetItxMeetingAttachmentForbidden builds a Meeting Service service
+// get-itx-meeting-attachment endpoint Forbidden error.
+func NewGetItxMeetingAttachmentForbidden(body *GetItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7390,10 +9073,9 @@ func NewCreateItxPastMeetingAttachmentPresignForbidden(body *CreateItxPastMeetin
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentPresignInternalServerError builds a Meeting
-// Service service create-itx-past-meeting-attachment-presign endpoint
-// InternalServerError error.
-func NewCreateItxPastMeetingAttachmentPresignInternalServerError(body *CreateItxPastMeetingAttachmentPresignInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewGetItxMeetingAttachmentInternalServerError builds a Meeting Service
+// service get-itx-meeting-attachment endpoint InternalServerError error.
+func NewGetItxMeetingAttachmentInternalServerError(body *GetItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7402,9 +9084,9 @@ func NewCreateItxPastMeetingAttachmentPresignInternalServerError(body *CreateItx
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentPresignNotFound builds a Meeting Service
-// service create-itx-past-meeting-attachment-presign endpoint NotFound error.
-func NewCreateItxPastMeetingAttachmentPresignNotFound(body *CreateItxPastMeetingAttachmentPresignNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewGetItxMeetingAttachmentNotFound builds a Meeting Service service
+// get-itx-meeting-attachment endpoint NotFound error.
+func NewGetItxMeetingAttachmentNotFound(body *GetItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7413,10 +9095,9 @@ func NewCreateItxPastMeetingAttachmentPresignNotFound(body *CreateItxPastMeeting
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentPresignServiceUnavailable builds a Meeting
-// Service service create-itx-past-meeting-attachment-presign endpoint
-// ServiceUnavailable error.
-func NewCreateItxPastMeetingAttachmentPresignServiceUnavailable(body *CreateItxPastMeetingAttachmentPresignServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewGetItxMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service get-itx-meeting-attachment endpoint ServiceUnavailable error.
+func NewGetItxMeetingAttachmentServiceUnavailable(body *GetItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7425,10 +9106,9 @@ func NewCreateItxPastMeetingAttachmentPresignServiceUnavailable(body *CreateItxP
 	return v
 }
 
-// NewCreateItxPastMeetingAttachmentPresignUnauthorized builds a Meeting
-// Service service create-itx-past-meeting-attachment-presign endpoint
-// Unauthorized error.
-func NewCreateItxPastMeetingAttachmentPresignUnauthorized(body *CreateItxPastMeetingAttachmentPresignUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewGetItxMeetingAttachmentUnauthorized builds a Meeting Service service
+// get-itx-meeting-attachment endpoint Unauthorized error.
+func NewGetItxMeetingAttachmentUnauthorized(body *GetItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7437,20 +9117,9 @@ func NewCreateItxPastMeetingAttachmentPresignUnauthorized(body *CreateItxPastMee
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentDownloadITXAttachmentDownloadResponseOK builds
-// a "Meeting Service" service "get-itx-past-meeting-attachment-download"
-// endpoint result from a HTTP "OK" response.
-func NewGetItxPastMeetingAttachmentDownloadITXAttachmentDownloadResponseOK(body *GetItxPastMeetingAttachmentDownloadResponseBody) *meetingservice.ITXAttachmentDownloadResponse {
-	v := &meetingservice.ITXAttachmentDownloadResponse{
-		DownloadURL: *body.DownloadURL,
-	}
-
-	return v
-}
-
-// NewGetItxPastMeetingAttachmentDownloadBadRequest builds a Meeting Service
-// service get-itx-past-meeting-attachment-download endpoint BadRequest error.
-func NewGetItxPastMeetingAttachmentDownloadBadRequest(body *GetItxPastMeetingAttachmentDownloadBadRequestResponseBody) *meetingservice.BadRequestError {
+// NewUpdateItxMeetingAttachmentBadRequest builds a Meeting Service service
+// update-itx-meeting-attachment endpoint BadRequest error.
+func NewUpdateItxMeetingAttachmentBadRequest(body *UpdateItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
 	v := &meetingservice.BadRequestError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7459,9 +9128,9 @@ func NewGetItxPastMeetingAttachmentDownloadBadRequest(body *GetItxPastMeetingAtt
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentDownloadForbidden builds a Meeting Service
-// service get-itx-past-meeting-attachment-download endpoint Forbidden error.
-func NewGetItxPastMeetingAttachmentDownloadForbidden(body *GetItxPastMeetingAttachmentDownloadForbiddenResponseBody) *meetingservice.ForbiddenError {
+// NewUpdateItxMeetingAttachmentForbidden builds a Meeting Service service
+// update-itx-meeting-attachment endpoint Forbidden error.
+func NewUpdateItxMeetingAttachmentForbidden(body *UpdateItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
 	v := &meetingservice.ForbiddenError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7470,10 +9139,9 @@ func NewGetItxPastMeetingAttachmentDownloadForbidden(body *GetItxPastMeetingAtta
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentDownloadInternalServerError builds a Meeting
-// Service service get-itx-past-meeting-attachment-download endpoint
-// InternalServerError error.
-func NewGetItxPastMeetingAttachmentDownloadInternalServerError(body *GetItxPastMeetingAttachmentDownloadInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+// NewUpdateItxMeetingAttachmentInternalServerError builds a Meeting Service
+// service update-itx-meeting-attachment endpoint InternalServerError error.
+func NewUpdateItxMeetingAttachmentInternalServerError(body *UpdateItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
 	v := &meetingservice.InternalServerError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7482,9 +9150,9 @@ func NewGetItxPastMeetingAttachmentDownloadInternalServerError(body *GetItxPastM
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentDownloadNotFound builds a Meeting Service
-// service get-itx-past-meeting-attachment-download endpoint NotFound error.
-func NewGetItxPastMeetingAttachmentDownloadNotFound(body *GetItxPastMeetingAttachmentDownloadNotFoundResponseBody) *meetingservice.NotFoundError {
+// NewUpdateItxMeetingAttachmentNotFound builds a Meeting Service service
+// update-itx-meeting-attachment endpoint NotFound error.
+func NewUpdateItxMeetingAttachmentNotFound(body *UpdateItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
 	v := &meetingservice.NotFoundError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7493,10 +9161,9 @@ func NewGetItxPastMeetingAttachmentDownloadNotFound(body *GetItxPastMeetingAttac
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentDownloadServiceUnavailable builds a Meeting
-// Service service get-itx-past-meeting-attachment-download endpoint
-// ServiceUnavailable error.
-func NewGetItxPastMeetingAttachmentDownloadServiceUnavailable(body *GetItxPastMeetingAttachmentDownloadServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+// NewUpdateItxMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service update-itx-meeting-attachment endpoint ServiceUnavailable error.
+func NewUpdateItxMeetingAttachmentServiceUnavailable(body *UpdateItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
 	v := &meetingservice.ServiceUnavailableError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7505,9 +9172,9 @@ func NewGetItxPastMeetingAttachmentDownloadServiceUnavailable(body *GetItxPastMe
 	return v
 }
 
-// NewGetItxPastMeetingAttachmentDownloadUnauthorized builds a Meeting Service
-// service get-itx-past-meeting-attachment-download endpoint Unauthorized error.
-func NewGetItxPastMeetingAttachmentDownloadUnauthorized(body *GetItxPastMeetingAttachmentDownloadUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+// NewUpdateItxMeetingAttachmentUnauthorized builds a Meeting Service service
+// update-itx-meeting-attachment endpoint Unauthorized error.
+func NewUpdateItxMeetingAttachmentUnauthorized(body *UpdateItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
 	v := &meetingservice.UnauthorizedError{
 		Code:    *body.Code,
 		Message: *body.Message,
@@ -7516,23 +9183,970 @@ func NewGetItxPastMeetingAttachmentDownloadUnauthorized(body *GetItxPastMeetingA
 	return v
 }
 
-// ValidateCreateItxMeetingResponseBody runs the validations defined on
-// Create-Itx-MeetingResponseBody
-func ValidateCreateItxMeetingResponseBody(body *CreateItxMeetingResponseBody) (err error) {
-	if body.StartTime != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+// NewDeleteItxMeetingAttachmentBadRequest builds a Meeting Service service
+// delete-itx-meeting-attachment endpoint BadRequest error.
+func NewDeleteItxMeetingAttachmentBadRequest(body *DeleteItxMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.Duration != nil {
-		if *body.Duration < 0 {
-			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 0, true))
-		}
+
+	return v
+}
+
+// NewDeleteItxMeetingAttachmentForbidden builds a Meeting Service service
+// delete-itx-meeting-attachment endpoint Forbidden error.
+func NewDeleteItxMeetingAttachmentForbidden(body *DeleteItxMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.Duration != nil {
-		if *body.Duration > 600 {
-			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 600, false))
-		}
+
+	return v
+}
+
+// NewDeleteItxMeetingAttachmentInternalServerError builds a Meeting Service
+// service delete-itx-meeting-attachment endpoint InternalServerError error.
+func NewDeleteItxMeetingAttachmentInternalServerError(body *DeleteItxMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
 	}
-	if body.Visibility != nil {
+
+	return v
+}
+
+// NewDeleteItxMeetingAttachmentNotFound builds a Meeting Service service
+// delete-itx-meeting-attachment endpoint NotFound error.
+func NewDeleteItxMeetingAttachmentNotFound(body *DeleteItxMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service delete-itx-meeting-attachment endpoint ServiceUnavailable error.
+func NewDeleteItxMeetingAttachmentServiceUnavailable(body *DeleteItxMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxMeetingAttachmentUnauthorized builds a Meeting Service service
+// delete-itx-meeting-attachment endpoint Unauthorized error.
+func NewDeleteItxMeetingAttachmentUnauthorized(body *DeleteItxMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentPresignITXMeetingAttachmentPresignResponseCreated
+// builds a "Meeting Service" service "create-itx-meeting-attachment-presign"
+// endpoint result from a HTTP "Created" response.
+func NewCreateItxMeetingAttachmentPresignITXMeetingAttachmentPresignResponseCreated(body *CreateItxMeetingAttachmentPresignResponseBody) *meetingservice.ITXMeetingAttachmentPresignResponse {
+	v := &meetingservice.ITXMeetingAttachmentPresignResponse{
+		UID:              *body.UID,
+		MeetingID:        *body.MeetingID,
+		Type:             body.Type,
+		Category:         body.Category,
+		Name:             body.Name,
+		Description:      body.Description,
+		FileName:         body.FileName,
+		FileSize:         body.FileSize,
+		FileURL:          *body.FileURL,
+		FileUploadStatus: body.FileUploadStatus,
+		FileContentType:  body.FileContentType,
+		CreatedAt:        body.CreatedAt,
+		UpdatedAt:        body.UpdatedAt,
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+	}
+	if body.UpdatedBy != nil {
+		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentPresignBadRequest builds a Meeting Service
+// service create-itx-meeting-attachment-presign endpoint BadRequest error.
+func NewCreateItxMeetingAttachmentPresignBadRequest(body *CreateItxMeetingAttachmentPresignBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentPresignForbidden builds a Meeting Service
+// service create-itx-meeting-attachment-presign endpoint Forbidden error.
+func NewCreateItxMeetingAttachmentPresignForbidden(body *CreateItxMeetingAttachmentPresignForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentPresignInternalServerError builds a Meeting
+// Service service create-itx-meeting-attachment-presign endpoint
+// InternalServerError error.
+func NewCreateItxMeetingAttachmentPresignInternalServerError(body *CreateItxMeetingAttachmentPresignInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentPresignNotFound builds a Meeting Service
+// service create-itx-meeting-attachment-presign endpoint NotFound error.
+func NewCreateItxMeetingAttachmentPresignNotFound(body *CreateItxMeetingAttachmentPresignNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentPresignServiceUnavailable builds a Meeting
+// Service service create-itx-meeting-attachment-presign endpoint
+// ServiceUnavailable error.
+func NewCreateItxMeetingAttachmentPresignServiceUnavailable(body *CreateItxMeetingAttachmentPresignServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxMeetingAttachmentPresignUnauthorized builds a Meeting Service
+// service create-itx-meeting-attachment-presign endpoint Unauthorized error.
+func NewCreateItxMeetingAttachmentPresignUnauthorized(body *CreateItxMeetingAttachmentPresignUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxMeetingAttachmentDownloadITXAttachmentDownloadResponseOK builds a
+// "Meeting Service" service "get-itx-meeting-attachment-download" endpoint
+// result from a HTTP "OK" response.
+func NewGetItxMeetingAttachmentDownloadITXAttachmentDownloadResponseOK(body *GetItxMeetingAttachmentDownloadResponseBody) *meetingservice.ITXAttachmentDownloadResponse {
+	v := &meetingservice.ITXAttachmentDownloadResponse{
+		DownloadURL: *body.DownloadURL,
+	}
+
+	return v
+}
+
+// NewGetItxMeetingAttachmentDownloadBadRequest builds a Meeting Service
+// service get-itx-meeting-attachment-download endpoint BadRequest error.
+func NewGetItxMeetingAttachmentDownloadBadRequest(body *GetItxMeetingAttachmentDownloadBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxMeetingAttachmentDownloadForbidden builds a Meeting Service service
+// get-itx-meeting-attachment-download endpoint Forbidden error.
+func NewGetItxMeetingAttachmentDownloadForbidden(body *GetItxMeetingAttachmentDownloadForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxMeetingAttachmentDownloadInternalServerError builds a Meeting
+// Service service get-itx-meeting-attachment-download endpoint
+// InternalServerError error.
+func NewGetItxMeetingAttachmentDownloadInternalServerError(body *GetItxMeetingAttachmentDownloadInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxMeetingAttachmentDownloadNotFound builds a Meeting Service service
+// get-itx-meeting-attachment-download endpoint NotFound error.
+func NewGetItxMeetingAttachmentDownloadNotFound(body *GetItxMeetingAttachmentDownloadNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxMeetingAttachmentDownloadServiceUnavailable builds a Meeting
+// Service service get-itx-meeting-attachment-download endpoint
+// ServiceUnavailable error.
+func NewGetItxMeetingAttachmentDownloadServiceUnavailable(body *GetItxMeetingAttachmentDownloadServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxMeetingAttachmentDownloadUnauthorized builds a Meeting Service
+// service get-itx-meeting-attachment-download endpoint Unauthorized error.
+func NewGetItxMeetingAttachmentDownloadUnauthorized(body *GetItxMeetingAttachmentDownloadUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentITXPastMeetingAttachmentCreated builds a
+// "Meeting Service" service "create-itx-past-meeting-attachment" endpoint
+// result from a HTTP "Created" response.
+func NewCreateItxPastMeetingAttachmentITXPastMeetingAttachmentCreated(body *CreateItxPastMeetingAttachmentResponseBody) *meetingservice.ITXPastMeetingAttachment {
+	v := &meetingservice.ITXPastMeetingAttachment{
+		UID:                    *body.UID,
+		MeetingAndOccurrenceID: *body.MeetingAndOccurrenceID,
+		MeetingID:              *body.MeetingID,
+		Type:                   *body.Type,
+		Source:                 body.Source,
+		Category:               *body.Category,
+		Link:                   body.Link,
+		Name:                   *body.Name,
+		Description:            body.Description,
+		FileName:               body.FileName,
+		FileSize:               body.FileSize,
+		FileURL:                body.FileURL,
+		FileUploaded:           body.FileUploaded,
+		FileUploadStatus:       body.FileUploadStatus,
+		FileContentType:        body.FileContentType,
+		CreatedAt:              body.CreatedAt,
+		UpdatedAt:              body.UpdatedAt,
+		FileUploadedAt:         body.FileUploadedAt,
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+	}
+	if body.UpdatedBy != nil {
+		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	}
+	if body.FileUploadedBy != nil {
+		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentBadRequest builds a Meeting Service service
+// create-itx-past-meeting-attachment endpoint BadRequest error.
+func NewCreateItxPastMeetingAttachmentBadRequest(body *CreateItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentForbidden builds a Meeting Service service
+// create-itx-past-meeting-attachment endpoint Forbidden error.
+func NewCreateItxPastMeetingAttachmentForbidden(body *CreateItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentInternalServerError builds a Meeting
+// Service service create-itx-past-meeting-attachment endpoint
+// InternalServerError error.
+func NewCreateItxPastMeetingAttachmentInternalServerError(body *CreateItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentNotFound builds a Meeting Service service
+// create-itx-past-meeting-attachment endpoint NotFound error.
+func NewCreateItxPastMeetingAttachmentNotFound(body *CreateItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service create-itx-past-meeting-attachment endpoint ServiceUnavailable error.
+func NewCreateItxPastMeetingAttachmentServiceUnavailable(body *CreateItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentUnauthorized builds a Meeting Service
+// service create-itx-past-meeting-attachment endpoint Unauthorized error.
+func NewCreateItxPastMeetingAttachmentUnauthorized(body *CreateItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentITXPastMeetingAttachmentOK builds a "Meeting
+// Service" service "get-itx-past-meeting-attachment" endpoint result from a
+// HTTP "OK" response.
+func NewGetItxPastMeetingAttachmentITXPastMeetingAttachmentOK(body *GetItxPastMeetingAttachmentResponseBody) *meetingservice.ITXPastMeetingAttachment {
+	v := &meetingservice.ITXPastMeetingAttachment{
+		UID:                    *body.UID,
+		MeetingAndOccurrenceID: *body.MeetingAndOccurrenceID,
+		MeetingID:              *body.MeetingID,
+		Type:                   *body.Type,
+		Source:                 body.Source,
+		Category:               *body.Category,
+		Link:                   body.Link,
+		Name:                   *body.Name,
+		Description:            body.Description,
+		FileName:               body.FileName,
+		FileSize:               body.FileSize,
+		FileURL:                body.FileURL,
+		FileUploaded:           body.FileUploaded,
+		FileUploadStatus:       body.FileUploadStatus,
+		FileContentType:        body.FileContentType,
+		CreatedAt:              body.CreatedAt,
+		UpdatedAt:              body.UpdatedAt,
+		FileUploadedAt:         body.FileUploadedAt,
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+	}
+	if body.UpdatedBy != nil {
+		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	}
+	if body.FileUploadedBy != nil {
+		v.FileUploadedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.FileUploadedBy)
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentBadRequest builds a Meeting Service service
+// get-itx-past-meeting-attachment endpoint BadRequest error.
+func NewGetItxPastMeetingAttachmentBadRequest(body *GetItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentForbidden builds a Meeting Service service
+// get-itx-past-meeting-attachment endpoint Forbidden error.
+func NewGetItxPastMeetingAttachmentForbidden(body *GetItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentInternalServerError builds a Meeting Service
+// service get-itx-past-meeting-attachment endpoint InternalServerError error.
+func NewGetItxPastMeetingAttachmentInternalServerError(body *GetItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentNotFound builds a Meeting Service service
+// get-itx-past-meeting-attachment endpoint NotFound error.
+func NewGetItxPastMeetingAttachmentNotFound(body *GetItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service get-itx-past-meeting-attachment endpoint ServiceUnavailable error.
+func NewGetItxPastMeetingAttachmentServiceUnavailable(body *GetItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentUnauthorized builds a Meeting Service service
+// get-itx-past-meeting-attachment endpoint Unauthorized error.
+func NewGetItxPastMeetingAttachmentUnauthorized(body *GetItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxPastMeetingAttachmentBadRequest builds a Meeting Service service
+// update-itx-past-meeting-attachment endpoint BadRequest error.
+func NewUpdateItxPastMeetingAttachmentBadRequest(body *UpdateItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxPastMeetingAttachmentForbidden builds a Meeting Service service
+// update-itx-past-meeting-attachment endpoint Forbidden error.
+func NewUpdateItxPastMeetingAttachmentForbidden(body *UpdateItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxPastMeetingAttachmentInternalServerError builds a Meeting
+// Service service update-itx-past-meeting-attachment endpoint
+// InternalServerError error.
+func NewUpdateItxPastMeetingAttachmentInternalServerError(body *UpdateItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxPastMeetingAttachmentNotFound builds a Meeting Service service
+// update-itx-past-meeting-attachment endpoint NotFound error.
+func NewUpdateItxPastMeetingAttachmentNotFound(body *UpdateItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service update-itx-past-meeting-attachment endpoint ServiceUnavailable error.
+func NewUpdateItxPastMeetingAttachmentServiceUnavailable(body *UpdateItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItxPastMeetingAttachmentUnauthorized builds a Meeting Service
+// service update-itx-past-meeting-attachment endpoint Unauthorized error.
+func NewUpdateItxPastMeetingAttachmentUnauthorized(body *UpdateItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxPastMeetingAttachmentBadRequest builds a Meeting Service service
+// delete-itx-past-meeting-attachment endpoint BadRequest error.
+func NewDeleteItxPastMeetingAttachmentBadRequest(body *DeleteItxPastMeetingAttachmentBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxPastMeetingAttachmentForbidden builds a Meeting Service service
+// delete-itx-past-meeting-attachment endpoint Forbidden error.
+func NewDeleteItxPastMeetingAttachmentForbidden(body *DeleteItxPastMeetingAttachmentForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxPastMeetingAttachmentInternalServerError builds a Meeting
+// Service service delete-itx-past-meeting-attachment endpoint
+// InternalServerError error.
+func NewDeleteItxPastMeetingAttachmentInternalServerError(body *DeleteItxPastMeetingAttachmentInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxPastMeetingAttachmentNotFound builds a Meeting Service service
+// delete-itx-past-meeting-attachment endpoint NotFound error.
+func NewDeleteItxPastMeetingAttachmentNotFound(body *DeleteItxPastMeetingAttachmentNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxPastMeetingAttachmentServiceUnavailable builds a Meeting Service
+// service delete-itx-past-meeting-attachment endpoint ServiceUnavailable error.
+func NewDeleteItxPastMeetingAttachmentServiceUnavailable(body *DeleteItxPastMeetingAttachmentServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItxPastMeetingAttachmentUnauthorized builds a Meeting Service
+// service delete-itx-past-meeting-attachment endpoint Unauthorized error.
+func NewDeleteItxPastMeetingAttachmentUnauthorized(body *DeleteItxPastMeetingAttachmentUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentPresignITXPastMeetingAttachmentPresignResponseCreated
+// builds a "Meeting Service" service
+// "create-itx-past-meeting-attachment-presign" endpoint result from a HTTP
+// "Created" response.
+func NewCreateItxPastMeetingAttachmentPresignITXPastMeetingAttachmentPresignResponseCreated(body *CreateItxPastMeetingAttachmentPresignResponseBody) *meetingservice.ITXPastMeetingAttachmentPresignResponse {
+	v := &meetingservice.ITXPastMeetingAttachmentPresignResponse{
+		UID:                    *body.UID,
+		MeetingAndOccurrenceID: *body.MeetingAndOccurrenceID,
+		MeetingID:              body.MeetingID,
+		Type:                   body.Type,
+		Category:               body.Category,
+		Name:                   body.Name,
+		Description:            body.Description,
+		FileName:               body.FileName,
+		FileSize:               body.FileSize,
+		FileURL:                *body.FileURL,
+		FileUploadStatus:       body.FileUploadStatus,
+		FileContentType:        body.FileContentType,
+		CreatedAt:              body.CreatedAt,
+		UpdatedAt:              body.UpdatedAt,
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.CreatedBy)
+	}
+	if body.UpdatedBy != nil {
+		v.UpdatedBy = unmarshalITXUserResponseBodyToMeetingserviceITXUser(body.UpdatedBy)
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentPresignBadRequest builds a Meeting Service
+// service create-itx-past-meeting-attachment-presign endpoint BadRequest error.
+func NewCreateItxPastMeetingAttachmentPresignBadRequest(body *CreateItxPastMeetingAttachmentPresignBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentPresignForbidden builds a Meeting Service
+// service create-itx-past-meeting-attachment-presign endpoint Forbidden error.
+func NewCreateItxPastMeetingAttachmentPresignForbidden(body *CreateItxPastMeetingAttachmentPresignForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentPresignInternalServerError builds a Meeting
+// Service service create-itx-past-meeting-attachment-presign endpoint
+// InternalServerError error.
+func NewCreateItxPastMeetingAttachmentPresignInternalServerError(body *CreateItxPastMeetingAttachmentPresignInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentPresignNotFound builds a Meeting Service
+// service create-itx-past-meeting-attachment-presign endpoint NotFound error.
+func NewCreateItxPastMeetingAttachmentPresignNotFound(body *CreateItxPastMeetingAttachmentPresignNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentPresignServiceUnavailable builds a Meeting
+// Service service create-itx-past-meeting-attachment-presign endpoint
+// ServiceUnavailable error.
+func NewCreateItxPastMeetingAttachmentPresignServiceUnavailable(body *CreateItxPastMeetingAttachmentPresignServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateItxPastMeetingAttachmentPresignUnauthorized builds a Meeting
+// Service service create-itx-past-meeting-attachment-presign endpoint
+// Unauthorized error.
+func NewCreateItxPastMeetingAttachmentPresignUnauthorized(body *CreateItxPastMeetingAttachmentPresignUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentDownloadITXAttachmentDownloadResponseOK builds
+// a "Meeting Service" service "get-itx-past-meeting-attachment-download"
+// endpoint result from a HTTP "OK" response.
+func NewGetItxPastMeetingAttachmentDownloadITXAttachmentDownloadResponseOK(body *GetItxPastMeetingAttachmentDownloadResponseBody) *meetingservice.ITXAttachmentDownloadResponse {
+	v := &meetingservice.ITXAttachmentDownloadResponse{
+		DownloadURL: *body.DownloadURL,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentDownloadBadRequest builds a Meeting Service
+// service get-itx-past-meeting-attachment-download endpoint BadRequest error.
+func NewGetItxPastMeetingAttachmentDownloadBadRequest(body *GetItxPastMeetingAttachmentDownloadBadRequestResponseBody) *meetingservice.BadRequestError {
+	v := &meetingservice.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentDownloadForbidden builds a Meeting Service
+// service get-itx-past-meeting-attachment-download endpoint Forbidden error.
+func NewGetItxPastMeetingAttachmentDownloadForbidden(body *GetItxPastMeetingAttachmentDownloadForbiddenResponseBody) *meetingservice.ForbiddenError {
+	v := &meetingservice.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentDownloadInternalServerError builds a Meeting
+// Service service get-itx-past-meeting-attachment-download endpoint
+// InternalServerError error.
+func NewGetItxPastMeetingAttachmentDownloadInternalServerError(body *GetItxPastMeetingAttachmentDownloadInternalServerErrorResponseBody) *meetingservice.InternalServerError {
+	v := &meetingservice.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentDownloadNotFound builds a Meeting Service
+// service get-itx-past-meeting-attachment-download endpoint NotFound error.
+func NewGetItxPastMeetingAttachmentDownloadNotFound(body *GetItxPastMeetingAttachmentDownloadNotFoundResponseBody) *meetingservice.NotFoundError {
+	v := &meetingservice.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentDownloadServiceUnavailable builds a Meeting
+// Service service get-itx-past-meeting-attachment-download endpoint
+// ServiceUnavailable error.
+func NewGetItxPastMeetingAttachmentDownloadServiceUnavailable(body *GetItxPastMeetingAttachmentDownloadServiceUnavailableResponseBody) *meetingservice.ServiceUnavailableError {
+	v := &meetingservice.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetItxPastMeetingAttachmentDownloadUnauthorized builds a Meeting Service
+// service get-itx-past-meeting-attachment-download endpoint Unauthorized error.
+func NewGetItxPastMeetingAttachmentDownloadUnauthorized(body *GetItxPastMeetingAttachmentDownloadUnauthorizedResponseBody) *meetingservice.UnauthorizedError {
+	v := &meetingservice.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateGetServiceConfigResponseBody runs the validations defined on
+// Get-Service-ConfigResponseBody
+func ValidateGetServiceConfigResponseBody(body *GetServiceConfigResponseBody) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.LfxEnvironment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lfx_environment", "body"))
+	}
+	if body.ItxBaseURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("itx_base_url", "body"))
+	}
+	if body.ItxAuth0Domain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("itx_auth0_domain", "body"))
+	}
+	if body.ItxAudience == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("itx_audience", "body"))
+	}
+	if body.UserServiceBaseURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_service_base_url", "body"))
+	}
+	if body.IDMappingEnabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id_mapping_enabled", "body"))
+	}
+	if body.EventProcessing == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_processing", "body"))
+	}
+	if body.Invites == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invites", "body"))
+	}
+	if body.ItxResilience == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("itx_resilience", "body"))
+	}
+	if body.Attachments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attachments", "body"))
+	}
+	if body.JoinLinkCacheTTL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("join_link_cache_ttl", "body"))
+	}
+	if body.LogLevel == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("log_level", "body"))
+	}
+	if body.SubsystemLogLevels == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subsystem_log_levels", "body"))
+	}
+	if body.LfxEnvironment != nil {
+		if !(*body.LfxEnvironment == "dev" || *body.LfxEnvironment == "staging" || *body.LfxEnvironment == "prod") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.lfx_environment", *body.LfxEnvironment, []any{"dev", "staging", "prod"}))
+		}
+	}
+	if body.EventProcessing != nil {
+		if err2 := ValidateServiceEventProcessingConfigResponseBody(body.EventProcessing); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Invites != nil {
+		if err2 := ValidateServiceInvitesConfigResponseBody(body.Invites); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ItxResilience != nil {
+		if err2 := ValidateServiceITXResilienceConfigResponseBody(body.ItxResilience); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Attachments != nil {
+		if err2 := ValidateServiceAttachmentsConfigResponseBody(body.Attachments); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.LogLevel != nil {
+		if !(*body.LogLevel == "debug" || *body.LogLevel == "info" || *body.LogLevel == "warn" || *body.LogLevel == "error") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.log_level", *body.LogLevel, []any{"debug", "info", "warn", "error"}))
+		}
+	}
+	return
+}
+
+// ValidateGetServiceHealthResponseBody runs the validations defined on
+// Get-Service-HealthResponseBody
+func ValidateGetServiceHealthResponseBody(body *GetServiceHealthResponseBody) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Checks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checks", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "ok" || *body.Status == "degraded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"ok", "degraded"}))
+		}
+	}
+	for _, e := range body.Checks {
+		if e != nil {
+			if err2 := ValidateDependencyHealthResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateItxMeetingResponseBody runs the validations defined on
+// Create-Itx-MeetingResponseBody
+func ValidateCreateItxMeetingResponseBody(body *CreateItxMeetingResponseBody) (err error) {
+	if body.StartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+	}
+	if body.Duration != nil {
+		if *body.Duration < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 0, true))
+		}
+	}
+	if body.Duration != nil {
+		if *body.Duration > 600 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 600, false))
+		}
+	}
+	if body.Visibility != nil {
+		if !(*body.Visibility == "public" || *body.Visibility == "private") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", *body.Visibility, []any{"public", "private"}))
+		}
+	}
+	if body.Description != nil {
+		if utf8.RuneCountInString(*body.Description) > 2000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 2000, false))
+		}
+	}
+	for _, e := range body.Committees {
+		if e != nil {
+			if err2 := ValidateCommitteeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.MeetingType != nil {
+		if !(*body.MeetingType == "Board" || *body.MeetingType == "Maintainers" || *body.MeetingType == "Marketing" || *body.MeetingType == "Technical" || *body.MeetingType == "Legal" || *body.MeetingType == "Other" || *body.MeetingType == "None") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.meeting_type", *body.MeetingType, []any{"Board", "Maintainers", "Marketing", "Technical", "Legal", "Other", "None"}))
+		}
+	}
+	if body.EarlyJoinTimeMinutes != nil {
+		if *body.EarlyJoinTimeMinutes < 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.early_join_time_minutes", *body.EarlyJoinTimeMinutes, 10, true))
+		}
+	}
+	if body.EarlyJoinTimeMinutes != nil {
+		if *body.EarlyJoinTimeMinutes > 60 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.early_join_time_minutes", *body.EarlyJoinTimeMinutes, 60, false))
+		}
+	}
+	if body.ArtifactVisibility != nil {
+		if !(*body.ArtifactVisibility == "meeting_hosts" || *body.ArtifactVisibility == "meeting_participants" || *body.ArtifactVisibility == "public") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.artifact_visibility", *body.ArtifactVisibility, []any{"meeting_hosts", "meeting_participants", "public"}))
+		}
+	}
+	if body.Recurrence != nil {
+		if err2 := ValidateRecurrenceResponseBody(body.Recurrence); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.NextOccurrenceStartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_occurrence_start_time", *body.NextOccurrenceStartTime, goa.FormatDateTime))
+	}
+	if body.Password != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.password", *body.Password, goa.FormatUUID))
+	}
+	if body.PublicLink != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.public_link", *body.PublicLink, goa.FormatURI))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ModifiedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_at", *body.ModifiedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Occurrences {
+		if e != nil {
+			if err2 := ValidateITXOccurrenceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetItxMeetingResponseBody runs the validations defined on
+// Get-Itx-MeetingResponseBody
+func ValidateGetItxMeetingResponseBody(body *GetItxMeetingResponseBody) (err error) {
+	if body.StartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+	}
+	if body.Duration != nil {
+		if *body.Duration < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 0, true))
+		}
+	}
+	if body.Duration != nil {
+		if *body.Duration > 600 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 600, false))
+		}
+	}
+	if body.Visibility != nil {
 		if !(*body.Visibility == "public" || *body.Visibility == "private") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", *body.Visibility, []any{"public", "private"}))
 		}
@@ -7599,9 +10213,9 @@ func ValidateCreateItxMeetingResponseBody(body *CreateItxMeetingResponseBody) (e
 	return
 }
 
-// ValidateGetItxMeetingResponseBody runs the validations defined on
-// Get-Itx-MeetingResponseBody
-func ValidateGetItxMeetingResponseBody(body *GetItxMeetingResponseBody) (err error) {
+// ValidateCloneItxMeetingResponseBody runs the validations defined on
+// Clone-Itx-MeetingResponseBody
+func ValidateCloneItxMeetingResponseBody(body *CloneItxMeetingResponseBody) (err error) {
 	if body.StartTime != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
 	}
@@ -7691,6 +10305,22 @@ func ValidateGetItxMeetingCountResponseBody(body *GetItxMeetingCountResponseBody
 	return
 }
 
+// ValidatePreviewItxMeetingOccurrencesResponseBody runs the validations
+// defined on Preview-Itx-Meeting-OccurrencesResponseBody
+func ValidatePreviewItxMeetingOccurrencesResponseBody(body *PreviewItxMeetingOccurrencesResponseBody) (err error) {
+	if body.Occurrences == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("occurrences", "body"))
+	}
+	for _, e := range body.Occurrences {
+		if e != nil {
+			if err2 := ValidateITXOccurrenceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // ValidateCreateItxRegistrantResponseBody runs the validations defined on
 // Create-Itx-RegistrantResponseBody
 func ValidateCreateItxRegistrantResponseBody(body *CreateItxRegistrantResponseBody) (err error) {
@@ -7748,6 +10378,76 @@ func ValidateGetItxJoinLinkResponseBody(body *GetItxJoinLinkResponseBody) (err e
 	if body.Link != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.link", *body.Link, goa.FormatURI))
 	}
+	if body.OccurrenceStartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.occurrence_start_time", *body.OccurrenceStartTime, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAddItxRegistrantHostResponseBody runs the validations defined on
+// Add-Itx-Registrant-HostResponseBody
+func ValidateAddItxRegistrantHostResponseBody(body *AddItxRegistrantHostResponseBody) (err error) {
+	if body.Type != nil {
+		if !(*body.Type == "direct" || *body.Type == "committee") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"direct", "committee"}))
+		}
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.CreatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.UpdatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateRemoveItxRegistrantHostResponseBody runs the validations defined on
+// Remove-Itx-Registrant-HostResponseBody
+func ValidateRemoveItxRegistrantHostResponseBody(body *RemoveItxRegistrantHostResponseBody) (err error) {
+	if body.Type != nil {
+		if !(*body.Type == "direct" || *body.Type == "committee") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"direct", "committee"}))
+		}
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.CreatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.UpdatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateListItxMeetingOccurrencesResponseBody runs the validations defined
+// on List-Itx-Meeting-OccurrencesResponseBody
+func ValidateListItxMeetingOccurrencesResponseBody(body *ListItxMeetingOccurrencesResponseBody) (err error) {
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Occurrences == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("occurrences", "body"))
+	}
+	for _, e := range body.Occurrences {
+		if e != nil {
+			if err2 := ValidateITXLocalOccurrenceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -7811,69 +10511,216 @@ func ValidateCreateItxPastMeetingResponseBody(body *CreateItxPastMeetingResponse
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", *body.Visibility, []any{"public", "private"}))
 		}
 	}
-	if body.MeetingType != nil {
-		if !(*body.MeetingType == "Board" || *body.MeetingType == "Maintainers" || *body.MeetingType == "Marketing" || *body.MeetingType == "Technical" || *body.MeetingType == "Legal" || *body.MeetingType == "Other" || *body.MeetingType == "None") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.meeting_type", *body.MeetingType, []any{"Board", "Maintainers", "Marketing", "Technical", "Legal", "Other", "None"}))
-		}
+	if body.MeetingType != nil {
+		if !(*body.MeetingType == "Board" || *body.MeetingType == "Maintainers" || *body.MeetingType == "Marketing" || *body.MeetingType == "Technical" || *body.MeetingType == "Legal" || *body.MeetingType == "Other" || *body.MeetingType == "None") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.meeting_type", *body.MeetingType, []any{"Board", "Maintainers", "Marketing", "Technical", "Legal", "Other", "None"}))
+		}
+	}
+	for _, e := range body.Committees {
+		if e != nil {
+			if err2 := ValidateCommitteeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.ArtifactVisibility != nil {
+		if !(*body.ArtifactVisibility == "meeting_hosts" || *body.ArtifactVisibility == "meeting_participants" || *body.ArtifactVisibility == "public") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.artifact_visibility", *body.ArtifactVisibility, []any{"meeting_hosts", "meeting_participants", "public"}))
+		}
+	}
+	if body.MeetingPassword != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.meeting_password", *body.MeetingPassword, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingResponseBody runs the validations defined on
+// Get-Itx-Past-MeetingResponseBody
+func ValidateGetItxPastMeetingResponseBody(body *GetItxPastMeetingResponseBody) (err error) {
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	if body.StartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+	}
+	if body.Visibility != nil {
+		if !(*body.Visibility == "public" || *body.Visibility == "private") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", *body.Visibility, []any{"public", "private"}))
+		}
+	}
+	if body.MeetingType != nil {
+		if !(*body.MeetingType == "Board" || *body.MeetingType == "Maintainers" || *body.MeetingType == "Marketing" || *body.MeetingType == "Technical" || *body.MeetingType == "Legal" || *body.MeetingType == "Other" || *body.MeetingType == "None") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.meeting_type", *body.MeetingType, []any{"Board", "Maintainers", "Marketing", "Technical", "Legal", "Other", "None"}))
+		}
+	}
+	for _, e := range body.Committees {
+		if e != nil {
+			if err2 := ValidateCommitteeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.ArtifactVisibility != nil {
+		if !(*body.ArtifactVisibility == "meeting_hosts" || *body.ArtifactVisibility == "meeting_participants" || *body.ArtifactVisibility == "public") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.artifact_visibility", *body.ArtifactVisibility, []any{"meeting_hosts", "meeting_participants", "public"}))
+		}
+	}
+	if body.MeetingPassword != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.meeting_password", *body.MeetingPassword, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryResponseBody runs the validations defined on
+// Get-Itx-Past-Meeting-SummaryResponseBody
+func ValidateGetItxPastMeetingSummaryResponseBody(body *GetItxPastMeetingSummaryResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.PastMeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("past_meeting_id", "body"))
+	}
+	if body.MeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
+	}
+	if body.Platform == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("platform", "body"))
+	}
+	if body.SummaryData == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("summary_data", "body"))
+	}
+	if body.RequiresApproval == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requires_approval", "body"))
+	}
+	if body.Approved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("approved", "body"))
+	}
+	if body.EmailSent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email_sent", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	if body.Platform != nil {
+		if !(*body.Platform == "Zoom" || *body.Platform == "GoogleMeet" || *body.Platform == "MSTeams" || *body.Platform == "None") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.platform", *body.Platform, []any{"Zoom", "GoogleMeet", "MSTeams", "None"}))
+		}
+	}
+	if body.SummaryData != nil {
+		if err2 := ValidateSummaryDataResponseBody(body.SummaryData); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryDiffResponseBody runs the validations
+// defined on Get-Itx-Past-Meeting-Summary-DiffResponseBody
+func ValidateGetItxPastMeetingSummaryDiffResponseBody(body *GetItxPastMeetingSummaryDiffResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.PastMeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("past_meeting_id", "body"))
+	}
+	if body.HasEdits == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_edits", "body"))
+	}
+	if body.Additions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("additions", "body"))
+	}
+	if body.Deletions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deletions", "body"))
+	}
+	if body.Approximate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("approximate", "body"))
+	}
+	if body.Lines == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lines", "body"))
+	}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	for _, e := range body.Lines {
+		if e != nil {
+			if err2 := ValidateSummaryDiffLineResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingSummaryResponseBody runs the validations defined
+// on Update-Itx-Past-Meeting-SummaryResponseBody
+func ValidateUpdateItxPastMeetingSummaryResponseBody(body *UpdateItxPastMeetingSummaryResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.PastMeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("past_meeting_id", "body"))
+	}
+	if body.MeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
+	}
+	if body.Platform == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("platform", "body"))
+	}
+	if body.SummaryData == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("summary_data", "body"))
 	}
-	for _, e := range body.Committees {
-		if e != nil {
-			if err2 := ValidateCommitteeResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.RequiresApproval == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requires_approval", "body"))
 	}
-	if body.ArtifactVisibility != nil {
-		if !(*body.ArtifactVisibility == "meeting_hosts" || *body.ArtifactVisibility == "meeting_participants" || *body.ArtifactVisibility == "public") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.artifact_visibility", *body.ArtifactVisibility, []any{"meeting_hosts", "meeting_participants", "public"}))
-		}
+	if body.Approved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("approved", "body"))
 	}
-	if body.MeetingPassword != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.meeting_password", *body.MeetingPassword, goa.FormatUUID))
+	if body.EmailSent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email_sent", "body"))
 	}
-	return
-}
-
-// ValidateGetItxPastMeetingResponseBody runs the validations defined on
-// Get-Itx-Past-MeetingResponseBody
-func ValidateGetItxPastMeetingResponseBody(body *GetItxPastMeetingResponseBody) (err error) {
-	if body.ProjectUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
-	if body.StartTime != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
-	if body.Visibility != nil {
-		if !(*body.Visibility == "public" || *body.Visibility == "private") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", *body.Visibility, []any{"public", "private"}))
-		}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
 	}
-	if body.MeetingType != nil {
-		if !(*body.MeetingType == "Board" || *body.MeetingType == "Maintainers" || *body.MeetingType == "Marketing" || *body.MeetingType == "Technical" || *body.MeetingType == "Legal" || *body.MeetingType == "Other" || *body.MeetingType == "None") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.meeting_type", *body.MeetingType, []any{"Board", "Maintainers", "Marketing", "Technical", "Legal", "Other", "None"}))
+	if body.Platform != nil {
+		if !(*body.Platform == "Zoom" || *body.Platform == "GoogleMeet" || *body.Platform == "MSTeams" || *body.Platform == "None") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.platform", *body.Platform, []any{"Zoom", "GoogleMeet", "MSTeams", "None"}))
 		}
 	}
-	for _, e := range body.Committees {
-		if e != nil {
-			if err2 := ValidateCommitteeResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+	if body.SummaryData != nil {
+		if err2 := ValidateSummaryDataResponseBody(body.SummaryData); err2 != nil {
+			err = goa.MergeErrors(err, err2)
 		}
 	}
-	if body.ArtifactVisibility != nil {
-		if !(*body.ArtifactVisibility == "meeting_hosts" || *body.ArtifactVisibility == "meeting_participants" || *body.ArtifactVisibility == "public") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.artifact_visibility", *body.ArtifactVisibility, []any{"meeting_hosts", "meeting_participants", "public"}))
-		}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
-	if body.MeetingPassword != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.meeting_password", *body.MeetingPassword, goa.FormatUUID))
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
 	return
 }
 
-// ValidateGetItxPastMeetingSummaryResponseBody runs the validations defined on
-// Get-Itx-Past-Meeting-SummaryResponseBody
-func ValidateGetItxPastMeetingSummaryResponseBody(body *GetItxPastMeetingSummaryResponseBody) (err error) {
+// ValidateApproveItxPastMeetingSummaryResponseBody runs the validations
+// defined on Approve-Itx-Past-Meeting-SummaryResponseBody
+func ValidateApproveItxPastMeetingSummaryResponseBody(body *ApproveItxPastMeetingSummaryResponseBody) (err error) {
 	if body.UID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
 	}
@@ -7926,9 +10773,9 @@ func ValidateGetItxPastMeetingSummaryResponseBody(body *GetItxPastMeetingSummary
 	return
 }
 
-// ValidateUpdateItxPastMeetingSummaryResponseBody runs the validations defined
-// on Update-Itx-Past-Meeting-SummaryResponseBody
-func ValidateUpdateItxPastMeetingSummaryResponseBody(body *UpdateItxPastMeetingSummaryResponseBody) (err error) {
+// ValidateRejectItxPastMeetingSummaryResponseBody runs the validations defined
+// on Reject-Itx-Past-Meeting-SummaryResponseBody
+func ValidateRejectItxPastMeetingSummaryResponseBody(body *RejectItxPastMeetingSummaryResponseBody) (err error) {
 	if body.UID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
 	}
@@ -8008,35 +10855,221 @@ func ValidateCreateItxPastMeetingParticipantResponseBody(body *CreateItxPastMeet
 			err = goa.MergeErrors(err, err2)
 		}
 	}
-	if body.ModifiedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_at", *body.ModifiedAt, goa.FormatDateTime))
-	}
-	if body.ModifiedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.ModifiedBy); err2 != nil {
+	if body.ModifiedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_at", *body.ModifiedAt, goa.FormatDateTime))
+	}
+	if body.ModifiedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.ModifiedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingParticipantResponseBody runs the validations
+// defined on Update-Itx-Past-Meeting-ParticipantResponseBody
+func ValidateUpdateItxPastMeetingParticipantResponseBody(body *UpdateItxPastMeetingParticipantResponseBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.CommitteeID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_id", *body.CommitteeID, goa.FormatUUID))
+	}
+	if body.AvatarURL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.avatar_url", *body.AvatarURL, goa.FormatURI))
+	}
+	for _, e := range body.Sessions {
+		if e != nil {
+			if err2 := ValidateParticipantSessionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.CreatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ModifiedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_at", *body.ModifiedAt, goa.FormatDateTime))
+	}
+	if body.ModifiedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.ModifiedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateBulkUpdateItxPastMeetingParticipantsResponseBody runs the
+// validations defined on Bulk-Update-Itx-Past-Meeting-ParticipantsResponseBody
+func ValidateBulkUpdateItxPastMeetingParticipantsResponseBody(body *BulkUpdateItxPastMeetingParticipantsResponseBody) (err error) {
+	if body.Participants == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("participants", "body"))
+	}
+	for _, e := range body.Participants {
+		if e != nil {
+			if err2 := ValidateITXPastMeetingParticipantResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateItxMeetingAttachmentResponseBody runs the validations defined
+// on Create-Itx-Meeting-AttachmentResponseBody
+func ValidateCreateItxMeetingAttachmentResponseBody(body *CreateItxMeetingAttachmentResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.MeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("category", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "file" || *body.Type == "link") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"file", "link"}))
+		}
+	}
+	if body.Source != nil {
+		if !(*body.Source == "api" || *body.Source == "description") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "description"}))
+		}
+	}
+	if body.Category != nil {
+		if !(*body.Category == "Meeting Minutes" || *body.Category == "Notes" || *body.Category == "Presentation" || *body.Category == "Other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"Meeting Minutes", "Notes", "Presentation", "Other"}))
+		}
+	}
+	if body.FileUploadStatus != nil {
+		if !(*body.FileUploadStatus == "ongoing" || *body.FileUploadStatus == "completed" || *body.FileUploadStatus == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.file_upload_status", *body.FileUploadStatus, []any{"ongoing", "completed", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.CreatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.FileUploadedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.FileUploadedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.FileUploadedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.file_uploaded_at", *body.FileUploadedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetItxMeetingAttachmentResponseBody runs the validations defined on
+// Get-Itx-Meeting-AttachmentResponseBody
+func ValidateGetItxMeetingAttachmentResponseBody(body *GetItxMeetingAttachmentResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.MeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("category", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "file" || *body.Type == "link") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"file", "link"}))
+		}
+	}
+	if body.Source != nil {
+		if !(*body.Source == "api" || *body.Source == "description") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "description"}))
+		}
+	}
+	if body.Category != nil {
+		if !(*body.Category == "Meeting Minutes" || *body.Category == "Notes" || *body.Category == "Presentation" || *body.Category == "Other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"Meeting Minutes", "Notes", "Presentation", "Other"}))
+		}
+	}
+	if body.FileUploadStatus != nil {
+		if !(*body.FileUploadStatus == "ongoing" || *body.FileUploadStatus == "completed" || *body.FileUploadStatus == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.file_upload_status", *body.FileUploadStatus, []any{"ongoing", "completed", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.CreatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.FileUploadedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.FileUploadedBy); err2 != nil {
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.FileUploadedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.file_uploaded_at", *body.FileUploadedAt, goa.FormatDateTime))
+	}
 	return
 }
 
-// ValidateUpdateItxPastMeetingParticipantResponseBody runs the validations
-// defined on Update-Itx-Past-Meeting-ParticipantResponseBody
-func ValidateUpdateItxPastMeetingParticipantResponseBody(body *UpdateItxPastMeetingParticipantResponseBody) (err error) {
-	if body.Email != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+// ValidateCreateItxMeetingAttachmentPresignResponseBody runs the validations
+// defined on Create-Itx-Meeting-Attachment-PresignResponseBody
+func ValidateCreateItxMeetingAttachmentPresignResponseBody(body *CreateItxMeetingAttachmentPresignResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
 	}
-	if body.CommitteeID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_id", *body.CommitteeID, goa.FormatUUID))
+	if body.MeetingID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
 	}
-	if body.AvatarURL != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.avatar_url", *body.AvatarURL, goa.FormatURI))
+	if body.FileURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("file_url", "body"))
 	}
-	for _, e := range body.Sessions {
-		if e != nil {
-			if err2 := ValidateParticipantSessionResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
 	}
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
@@ -8046,23 +11079,35 @@ func ValidateUpdateItxPastMeetingParticipantResponseBody(body *UpdateItxPastMeet
 			err = goa.MergeErrors(err, err2)
 		}
 	}
-	if body.ModifiedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_at", *body.ModifiedAt, goa.FormatDateTime))
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
-	if body.ModifiedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.ModifiedBy); err2 != nil {
+	if body.UpdatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
 			err = goa.MergeErrors(err, err2)
 		}
 	}
 	return
 }
 
-// ValidateCreateItxMeetingAttachmentResponseBody runs the validations defined
-// on Create-Itx-Meeting-AttachmentResponseBody
-func ValidateCreateItxMeetingAttachmentResponseBody(body *CreateItxMeetingAttachmentResponseBody) (err error) {
+// ValidateGetItxMeetingAttachmentDownloadResponseBody runs the validations
+// defined on Get-Itx-Meeting-Attachment-DownloadResponseBody
+func ValidateGetItxMeetingAttachmentDownloadResponseBody(body *GetItxMeetingAttachmentDownloadResponseBody) (err error) {
+	if body.DownloadURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("download_url", "body"))
+	}
+	return
+}
+
+// ValidateCreateItxPastMeetingAttachmentResponseBody runs the validations
+// defined on Create-Itx-Past-Meeting-AttachmentResponseBody
+func ValidateCreateItxPastMeetingAttachmentResponseBody(body *CreateItxPastMeetingAttachmentResponseBody) (err error) {
 	if body.UID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
 	}
+	if body.MeetingAndOccurrenceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_and_occurrence_id", "body"))
+	}
 	if body.MeetingID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
 	}
@@ -8084,8 +11129,8 @@ func ValidateCreateItxMeetingAttachmentResponseBody(body *CreateItxMeetingAttach
 		}
 	}
 	if body.Source != nil {
-		if !(*body.Source == "api" || *body.Source == "description") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "description"}))
+		if !(*body.Source == "api" || *body.Source == "scheduled_meeting_api" || *body.Source == "scheduled_meeting_description") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "scheduled_meeting_api", "scheduled_meeting_description"}))
 		}
 	}
 	if body.Category != nil {
@@ -8125,12 +11170,15 @@ func ValidateCreateItxMeetingAttachmentResponseBody(body *CreateItxMeetingAttach
 	return
 }
 
-// ValidateGetItxMeetingAttachmentResponseBody runs the validations defined on
-// Get-Itx-Meeting-AttachmentResponseBody
-func ValidateGetItxMeetingAttachmentResponseBody(body *GetItxMeetingAttachmentResponseBody) (err error) {
+// ValidateGetItxPastMeetingAttachmentResponseBody runs the validations defined
+// on Get-Itx-Past-Meeting-AttachmentResponseBody
+func ValidateGetItxPastMeetingAttachmentResponseBody(body *GetItxPastMeetingAttachmentResponseBody) (err error) {
 	if body.UID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
 	}
+	if body.MeetingAndOccurrenceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_and_occurrence_id", "body"))
+	}
 	if body.MeetingID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
 	}
@@ -8152,8 +11200,8 @@ func ValidateGetItxMeetingAttachmentResponseBody(body *GetItxMeetingAttachmentRe
 		}
 	}
 	if body.Source != nil {
-		if !(*body.Source == "api" || *body.Source == "description") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "description"}))
+		if !(*body.Source == "api" || *body.Source == "scheduled_meeting_api" || *body.Source == "scheduled_meeting_description") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "scheduled_meeting_api", "scheduled_meeting_description"}))
 		}
 	}
 	if body.Category != nil {
@@ -8193,14 +11241,14 @@ func ValidateGetItxMeetingAttachmentResponseBody(body *GetItxMeetingAttachmentRe
 	return
 }
 
-// ValidateCreateItxMeetingAttachmentPresignResponseBody runs the validations
-// defined on Create-Itx-Meeting-Attachment-PresignResponseBody
-func ValidateCreateItxMeetingAttachmentPresignResponseBody(body *CreateItxMeetingAttachmentPresignResponseBody) (err error) {
+// ValidateCreateItxPastMeetingAttachmentPresignResponseBody runs the
+// validations defined on Create-Itx-Past-Meeting-Attachment-PresignResponseBody
+func ValidateCreateItxPastMeetingAttachmentPresignResponseBody(body *CreateItxPastMeetingAttachmentPresignResponseBody) (err error) {
 	if body.UID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
 	}
-	if body.MeetingID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
+	if body.MeetingAndOccurrenceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_and_occurrence_id", "body"))
 	}
 	if body.FileURL == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("file_url", "body"))
@@ -8227,203 +11275,462 @@ func ValidateCreateItxMeetingAttachmentPresignResponseBody(body *CreateItxMeetin
 	return
 }
 
-// ValidateGetItxMeetingAttachmentDownloadResponseBody runs the validations
-// defined on Get-Itx-Meeting-Attachment-DownloadResponseBody
-func ValidateGetItxMeetingAttachmentDownloadResponseBody(body *GetItxMeetingAttachmentDownloadResponseBody) (err error) {
-	if body.DownloadURL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("download_url", "body"))
+// ValidateGetItxPastMeetingAttachmentDownloadResponseBody runs the validations
+// defined on Get-Itx-Past-Meeting-Attachment-DownloadResponseBody
+func ValidateGetItxPastMeetingAttachmentDownloadResponseBody(body *GetItxPastMeetingAttachmentDownloadResponseBody) (err error) {
+	if body.DownloadURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("download_url", "body"))
+	}
+	return
+}
+
+// ValidateReadyzServiceUnavailableResponseBody runs the validations defined on
+// readyz_ServiceUnavailable_response_body
+func ValidateReadyzServiceUnavailableResponseBody(body *ReadyzServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceConfigBadRequestResponseBody runs the validations defined
+// on get-service-config_BadRequest_response_body
+func ValidateGetServiceConfigBadRequestResponseBody(body *GetServiceConfigBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceConfigForbiddenResponseBody runs the validations defined
+// on get-service-config_Forbidden_response_body
+func ValidateGetServiceConfigForbiddenResponseBody(body *GetServiceConfigForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceConfigServiceUnavailableResponseBody runs the validations
+// defined on get-service-config_ServiceUnavailable_response_body
+func ValidateGetServiceConfigServiceUnavailableResponseBody(body *GetServiceConfigServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceConfigUnauthorizedResponseBody runs the validations
+// defined on get-service-config_Unauthorized_response_body
+func ValidateGetServiceConfigUnauthorizedResponseBody(body *GetServiceConfigUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceHealthBadRequestResponseBody runs the validations defined
+// on get-service-health_BadRequest_response_body
+func ValidateGetServiceHealthBadRequestResponseBody(body *GetServiceHealthBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceHealthForbiddenResponseBody runs the validations defined
+// on get-service-health_Forbidden_response_body
+func ValidateGetServiceHealthForbiddenResponseBody(body *GetServiceHealthForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceHealthServiceUnavailableResponseBody runs the validations
+// defined on get-service-health_ServiceUnavailable_response_body
+func ValidateGetServiceHealthServiceUnavailableResponseBody(body *GetServiceHealthServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetServiceHealthUnauthorizedResponseBody runs the validations
+// defined on get-service-health_Unauthorized_response_body
+func ValidateGetServiceHealthUnauthorizedResponseBody(body *GetServiceHealthUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateItxMeetingBadRequestResponseBody runs the validations defined
+// on create-itx-meeting_BadRequest_response_body
+func ValidateCreateItxMeetingBadRequestResponseBody(body *CreateItxMeetingBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateItxMeetingConflictResponseBody runs the validations defined on
+// create-itx-meeting_Conflict_response_body
+func ValidateCreateItxMeetingConflictResponseBody(body *CreateItxMeetingConflictResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateItxMeetingForbiddenResponseBody runs the validations defined
+// on create-itx-meeting_Forbidden_response_body
+func ValidateCreateItxMeetingForbiddenResponseBody(body *CreateItxMeetingForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateItxMeetingInternalServerErrorResponseBody runs the validations
+// defined on create-itx-meeting_InternalServerError_response_body
+func ValidateCreateItxMeetingInternalServerErrorResponseBody(body *CreateItxMeetingInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateItxMeetingServiceUnavailableResponseBody runs the validations
+// defined on create-itx-meeting_ServiceUnavailable_response_body
+func ValidateCreateItxMeetingServiceUnavailableResponseBody(body *CreateItxMeetingServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateItxMeetingUnauthorizedResponseBody runs the validations
+// defined on create-itx-meeting_Unauthorized_response_body
+func ValidateCreateItxMeetingUnauthorizedResponseBody(body *CreateItxMeetingUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxMeetingBadRequestResponseBody runs the validations defined on
+// get-itx-meeting_BadRequest_response_body
+func ValidateGetItxMeetingBadRequestResponseBody(body *GetItxMeetingBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateItxPastMeetingAttachmentResponseBody runs the validations
-// defined on Create-Itx-Past-Meeting-AttachmentResponseBody
-func ValidateCreateItxPastMeetingAttachmentResponseBody(body *CreateItxPastMeetingAttachmentResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
-	}
-	if body.MeetingAndOccurrenceID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_and_occurrence_id", "body"))
+// ValidateGetItxMeetingForbiddenResponseBody runs the validations defined on
+// get-itx-meeting_Forbidden_response_body
+func ValidateGetItxMeetingForbiddenResponseBody(body *GetItxMeetingForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.MeetingID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Type == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	return
+}
+
+// ValidateGetItxMeetingInternalServerErrorResponseBody runs the validations
+// defined on get-itx-meeting_InternalServerError_response_body
+func ValidateGetItxMeetingInternalServerErrorResponseBody(body *GetItxMeetingInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Category == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("category", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Name == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	return
+}
+
+// ValidateGetItxMeetingNotFoundResponseBody runs the validations defined on
+// get-itx-meeting_NotFound_response_body
+func ValidateGetItxMeetingNotFoundResponseBody(body *GetItxMeetingNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.UID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Type != nil {
-		if !(*body.Type == "file" || *body.Type == "link") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"file", "link"}))
-		}
+	return
+}
+
+// ValidateGetItxMeetingServiceUnavailableResponseBody runs the validations
+// defined on get-itx-meeting_ServiceUnavailable_response_body
+func ValidateGetItxMeetingServiceUnavailableResponseBody(body *GetItxMeetingServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Source != nil {
-		if !(*body.Source == "api" || *body.Source == "scheduled_meeting_api" || *body.Source == "scheduled_meeting_description") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "scheduled_meeting_api", "scheduled_meeting_description"}))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Category != nil {
-		if !(*body.Category == "Meeting Minutes" || *body.Category == "Notes" || *body.Category == "Presentation" || *body.Category == "Other") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"Meeting Minutes", "Notes", "Presentation", "Other"}))
-		}
+	return
+}
+
+// ValidateGetItxMeetingUnauthorizedResponseBody runs the validations defined
+// on get-itx-meeting_Unauthorized_response_body
+func ValidateGetItxMeetingUnauthorizedResponseBody(body *GetItxMeetingUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.FileUploadStatus != nil {
-		if !(*body.FileUploadStatus == "ongoing" || *body.FileUploadStatus == "completed" || *body.FileUploadStatus == "failed") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.file_upload_status", *body.FileUploadStatus, []any{"ongoing", "completed", "failed"}))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateDeleteItxMeetingBadRequestResponseBody runs the validations defined
+// on delete-itx-meeting_BadRequest_response_body
+func ValidateDeleteItxMeetingBadRequestResponseBody(body *DeleteItxMeetingBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.CreatedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateDeleteItxMeetingForbiddenResponseBody runs the validations defined
+// on delete-itx-meeting_Forbidden_response_body
+func ValidateDeleteItxMeetingForbiddenResponseBody(body *DeleteItxMeetingForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.UpdatedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.FileUploadedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.FileUploadedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	return
+}
+
+// ValidateDeleteItxMeetingInternalServerErrorResponseBody runs the validations
+// defined on delete-itx-meeting_InternalServerError_response_body
+func ValidateDeleteItxMeetingInternalServerErrorResponseBody(body *DeleteItxMeetingInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.FileUploadedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.file_uploaded_at", *body.FileUploadedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetItxPastMeetingAttachmentResponseBody runs the validations defined
-// on Get-Itx-Past-Meeting-AttachmentResponseBody
-func ValidateGetItxPastMeetingAttachmentResponseBody(body *GetItxPastMeetingAttachmentResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
-	}
-	if body.MeetingAndOccurrenceID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_and_occurrence_id", "body"))
+// ValidateDeleteItxMeetingNotFoundResponseBody runs the validations defined on
+// delete-itx-meeting_NotFound_response_body
+func ValidateDeleteItxMeetingNotFoundResponseBody(body *DeleteItxMeetingNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.MeetingID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Type == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	return
+}
+
+// ValidateDeleteItxMeetingServiceUnavailableResponseBody runs the validations
+// defined on delete-itx-meeting_ServiceUnavailable_response_body
+func ValidateDeleteItxMeetingServiceUnavailableResponseBody(body *DeleteItxMeetingServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Category == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("category", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Name == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	return
+}
+
+// ValidateDeleteItxMeetingUnauthorizedResponseBody runs the validations
+// defined on delete-itx-meeting_Unauthorized_response_body
+func ValidateDeleteItxMeetingUnauthorizedResponseBody(body *DeleteItxMeetingUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.UID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Type != nil {
-		if !(*body.Type == "file" || *body.Type == "link") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"file", "link"}))
-		}
+	return
+}
+
+// ValidateCloneItxMeetingBadRequestResponseBody runs the validations defined
+// on clone-itx-meeting_BadRequest_response_body
+func ValidateCloneItxMeetingBadRequestResponseBody(body *CloneItxMeetingBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Source != nil {
-		if !(*body.Source == "api" || *body.Source == "scheduled_meeting_api" || *body.Source == "scheduled_meeting_description") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"api", "scheduled_meeting_api", "scheduled_meeting_description"}))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Category != nil {
-		if !(*body.Category == "Meeting Minutes" || *body.Category == "Notes" || *body.Category == "Presentation" || *body.Category == "Other") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"Meeting Minutes", "Notes", "Presentation", "Other"}))
-		}
+	return
+}
+
+// ValidateCloneItxMeetingConflictResponseBody runs the validations defined on
+// clone-itx-meeting_Conflict_response_body
+func ValidateCloneItxMeetingConflictResponseBody(body *CloneItxMeetingConflictResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.FileUploadStatus != nil {
-		if !(*body.FileUploadStatus == "ongoing" || *body.FileUploadStatus == "completed" || *body.FileUploadStatus == "failed") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.file_upload_status", *body.FileUploadStatus, []any{"ongoing", "completed", "failed"}))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateCloneItxMeetingForbiddenResponseBody runs the validations defined on
+// clone-itx-meeting_Forbidden_response_body
+func ValidateCloneItxMeetingForbiddenResponseBody(body *CloneItxMeetingForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.CreatedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateCloneItxMeetingInternalServerErrorResponseBody runs the validations
+// defined on clone-itx-meeting_InternalServerError_response_body
+func ValidateCloneItxMeetingInternalServerErrorResponseBody(body *CloneItxMeetingInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.UpdatedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.FileUploadedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.FileUploadedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	return
+}
+
+// ValidateCloneItxMeetingNotFoundResponseBody runs the validations defined on
+// clone-itx-meeting_NotFound_response_body
+func ValidateCloneItxMeetingNotFoundResponseBody(body *CloneItxMeetingNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.FileUploadedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.file_uploaded_at", *body.FileUploadedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateItxPastMeetingAttachmentPresignResponseBody runs the
-// validations defined on Create-Itx-Past-Meeting-Attachment-PresignResponseBody
-func ValidateCreateItxPastMeetingAttachmentPresignResponseBody(body *CreateItxPastMeetingAttachmentPresignResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+// ValidateCloneItxMeetingServiceUnavailableResponseBody runs the validations
+// defined on clone-itx-meeting_ServiceUnavailable_response_body
+func ValidateCloneItxMeetingServiceUnavailableResponseBody(body *CloneItxMeetingServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.MeetingAndOccurrenceID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("meeting_and_occurrence_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.FileURL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("file_url", "body"))
+	return
+}
+
+// ValidateCloneItxMeetingUnauthorizedResponseBody runs the validations defined
+// on clone-itx-meeting_Unauthorized_response_body
+func ValidateCloneItxMeetingUnauthorizedResponseBody(body *CloneItxMeetingUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.UID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateUpdateItxMeetingBadRequestResponseBody runs the validations defined
+// on update-itx-meeting_BadRequest_response_body
+func ValidateUpdateItxMeetingBadRequestResponseBody(body *UpdateItxMeetingBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.CreatedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateUpdateItxMeetingConflictResponseBody runs the validations defined on
+// update-itx-meeting_Conflict_response_body
+func ValidateUpdateItxMeetingConflictResponseBody(body *UpdateItxMeetingConflictResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.UpdatedBy != nil {
-		if err2 := ValidateITXUserResponseBody(body.UpdatedBy); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetItxPastMeetingAttachmentDownloadResponseBody runs the validations
-// defined on Get-Itx-Past-Meeting-Attachment-DownloadResponseBody
-func ValidateGetItxPastMeetingAttachmentDownloadResponseBody(body *GetItxPastMeetingAttachmentDownloadResponseBody) (err error) {
-	if body.DownloadURL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("download_url", "body"))
+// ValidateUpdateItxMeetingForbiddenResponseBody runs the validations defined
+// on update-itx-meeting_Forbidden_response_body
+func ValidateUpdateItxMeetingForbiddenResponseBody(body *UpdateItxMeetingForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateReadyzServiceUnavailableResponseBody runs the validations defined on
-// readyz_ServiceUnavailable_response_body
-func ValidateReadyzServiceUnavailableResponseBody(body *ReadyzServiceUnavailableResponseBody) (err error) {
+// ValidateUpdateItxMeetingInternalServerErrorResponseBody runs the validations
+// defined on update-itx-meeting_InternalServerError_response_body
+func ValidateUpdateItxMeetingInternalServerErrorResponseBody(body *UpdateItxMeetingInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8433,9 +11740,9 @@ func ValidateReadyzServiceUnavailableResponseBody(body *ReadyzServiceUnavailable
 	return
 }
 
-// ValidateCreateItxMeetingBadRequestResponseBody runs the validations defined
-// on create-itx-meeting_BadRequest_response_body
-func ValidateCreateItxMeetingBadRequestResponseBody(body *CreateItxMeetingBadRequestResponseBody) (err error) {
+// ValidateUpdateItxMeetingNotFoundResponseBody runs the validations defined on
+// update-itx-meeting_NotFound_response_body
+func ValidateUpdateItxMeetingNotFoundResponseBody(body *UpdateItxMeetingNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8445,9 +11752,9 @@ func ValidateCreateItxMeetingBadRequestResponseBody(body *CreateItxMeetingBadReq
 	return
 }
 
-// ValidateCreateItxMeetingConflictResponseBody runs the validations defined on
-// create-itx-meeting_Conflict_response_body
-func ValidateCreateItxMeetingConflictResponseBody(body *CreateItxMeetingConflictResponseBody) (err error) {
+// ValidateUpdateItxMeetingServiceUnavailableResponseBody runs the validations
+// defined on update-itx-meeting_ServiceUnavailable_response_body
+func ValidateUpdateItxMeetingServiceUnavailableResponseBody(body *UpdateItxMeetingServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8457,9 +11764,9 @@ func ValidateCreateItxMeetingConflictResponseBody(body *CreateItxMeetingConflict
 	return
 }
 
-// ValidateCreateItxMeetingForbiddenResponseBody runs the validations defined
-// on create-itx-meeting_Forbidden_response_body
-func ValidateCreateItxMeetingForbiddenResponseBody(body *CreateItxMeetingForbiddenResponseBody) (err error) {
+// ValidateUpdateItxMeetingUnauthorizedResponseBody runs the validations
+// defined on update-itx-meeting_Unauthorized_response_body
+func ValidateUpdateItxMeetingUnauthorizedResponseBody(body *UpdateItxMeetingUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8469,9 +11776,9 @@ func ValidateCreateItxMeetingForbiddenResponseBody(body *CreateItxMeetingForbidd
 	return
 }
 
-// ValidateCreateItxMeetingInternalServerErrorResponseBody runs the validations
-// defined on create-itx-meeting_InternalServerError_response_body
-func ValidateCreateItxMeetingInternalServerErrorResponseBody(body *CreateItxMeetingInternalServerErrorResponseBody) (err error) {
+// ValidateGetItxMeetingCountBadRequestResponseBody runs the validations
+// defined on get-itx-meeting-count_BadRequest_response_body
+func ValidateGetItxMeetingCountBadRequestResponseBody(body *GetItxMeetingCountBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8481,9 +11788,9 @@ func ValidateCreateItxMeetingInternalServerErrorResponseBody(body *CreateItxMeet
 	return
 }
 
-// ValidateCreateItxMeetingServiceUnavailableResponseBody runs the validations
-// defined on create-itx-meeting_ServiceUnavailable_response_body
-func ValidateCreateItxMeetingServiceUnavailableResponseBody(body *CreateItxMeetingServiceUnavailableResponseBody) (err error) {
+// ValidateGetItxMeetingCountForbiddenResponseBody runs the validations defined
+// on get-itx-meeting-count_Forbidden_response_body
+func ValidateGetItxMeetingCountForbiddenResponseBody(body *GetItxMeetingCountForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8493,9 +11800,10 @@ func ValidateCreateItxMeetingServiceUnavailableResponseBody(body *CreateItxMeeti
 	return
 }
 
-// ValidateCreateItxMeetingUnauthorizedResponseBody runs the validations
-// defined on create-itx-meeting_Unauthorized_response_body
-func ValidateCreateItxMeetingUnauthorizedResponseBody(body *CreateItxMeetingUnauthorizedResponseBody) (err error) {
+// ValidateGetItxMeetingCountInternalServerErrorResponseBody runs the
+// validations defined on
+// get-itx-meeting-count_InternalServerError_response_body
+func ValidateGetItxMeetingCountInternalServerErrorResponseBody(body *GetItxMeetingCountInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8505,9 +11813,9 @@ func ValidateCreateItxMeetingUnauthorizedResponseBody(body *CreateItxMeetingUnau
 	return
 }
 
-// ValidateGetItxMeetingBadRequestResponseBody runs the validations defined on
-// get-itx-meeting_BadRequest_response_body
-func ValidateGetItxMeetingBadRequestResponseBody(body *GetItxMeetingBadRequestResponseBody) (err error) {
+// ValidateGetItxMeetingCountNotFoundResponseBody runs the validations defined
+// on get-itx-meeting-count_NotFound_response_body
+func ValidateGetItxMeetingCountNotFoundResponseBody(body *GetItxMeetingCountNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8517,9 +11825,9 @@ func ValidateGetItxMeetingBadRequestResponseBody(body *GetItxMeetingBadRequestRe
 	return
 }
 
-// ValidateGetItxMeetingForbiddenResponseBody runs the validations defined on
-// get-itx-meeting_Forbidden_response_body
-func ValidateGetItxMeetingForbiddenResponseBody(body *GetItxMeetingForbiddenResponseBody) (err error) {
+// ValidateGetItxMeetingCountServiceUnavailableResponseBody runs the
+// validations defined on get-itx-meeting-count_ServiceUnavailable_response_body
+func ValidateGetItxMeetingCountServiceUnavailableResponseBody(body *GetItxMeetingCountServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8529,9 +11837,9 @@ func ValidateGetItxMeetingForbiddenResponseBody(body *GetItxMeetingForbiddenResp
 	return
 }
 
-// ValidateGetItxMeetingInternalServerErrorResponseBody runs the validations
-// defined on get-itx-meeting_InternalServerError_response_body
-func ValidateGetItxMeetingInternalServerErrorResponseBody(body *GetItxMeetingInternalServerErrorResponseBody) (err error) {
+// ValidateGetItxMeetingCountUnauthorizedResponseBody runs the validations
+// defined on get-itx-meeting-count_Unauthorized_response_body
+func ValidateGetItxMeetingCountUnauthorizedResponseBody(body *GetItxMeetingCountUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8541,9 +11849,10 @@ func ValidateGetItxMeetingInternalServerErrorResponseBody(body *GetItxMeetingInt
 	return
 }
 
-// ValidateGetItxMeetingNotFoundResponseBody runs the validations defined on
-// get-itx-meeting_NotFound_response_body
-func ValidateGetItxMeetingNotFoundResponseBody(body *GetItxMeetingNotFoundResponseBody) (err error) {
+// ValidatePreviewItxMeetingOccurrencesBadRequestResponseBody runs the
+// validations defined on
+// preview-itx-meeting-occurrences_BadRequest_response_body
+func ValidatePreviewItxMeetingOccurrencesBadRequestResponseBody(body *PreviewItxMeetingOccurrencesBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8553,9 +11862,10 @@ func ValidateGetItxMeetingNotFoundResponseBody(body *GetItxMeetingNotFoundRespon
 	return
 }
 
-// ValidateGetItxMeetingServiceUnavailableResponseBody runs the validations
-// defined on get-itx-meeting_ServiceUnavailable_response_body
-func ValidateGetItxMeetingServiceUnavailableResponseBody(body *GetItxMeetingServiceUnavailableResponseBody) (err error) {
+// ValidatePreviewItxMeetingOccurrencesInternalServerErrorResponseBody runs the
+// validations defined on
+// preview-itx-meeting-occurrences_InternalServerError_response_body
+func ValidatePreviewItxMeetingOccurrencesInternalServerErrorResponseBody(body *PreviewItxMeetingOccurrencesInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8565,9 +11875,10 @@ func ValidateGetItxMeetingServiceUnavailableResponseBody(body *GetItxMeetingServ
 	return
 }
 
-// ValidateGetItxMeetingUnauthorizedResponseBody runs the validations defined
-// on get-itx-meeting_Unauthorized_response_body
-func ValidateGetItxMeetingUnauthorizedResponseBody(body *GetItxMeetingUnauthorizedResponseBody) (err error) {
+// ValidatePreviewItxMeetingOccurrencesUnauthorizedResponseBody runs the
+// validations defined on
+// preview-itx-meeting-occurrences_Unauthorized_response_body
+func ValidatePreviewItxMeetingOccurrencesUnauthorizedResponseBody(body *PreviewItxMeetingOccurrencesUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8577,9 +11888,9 @@ func ValidateGetItxMeetingUnauthorizedResponseBody(body *GetItxMeetingUnauthoriz
 	return
 }
 
-// ValidateDeleteItxMeetingBadRequestResponseBody runs the validations defined
-// on delete-itx-meeting_BadRequest_response_body
-func ValidateDeleteItxMeetingBadRequestResponseBody(body *DeleteItxMeetingBadRequestResponseBody) (err error) {
+// ValidateCreateItxRegistrantBadRequestResponseBody runs the validations
+// defined on create-itx-registrant_BadRequest_response_body
+func ValidateCreateItxRegistrantBadRequestResponseBody(body *CreateItxRegistrantBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8589,9 +11900,9 @@ func ValidateDeleteItxMeetingBadRequestResponseBody(body *DeleteItxMeetingBadReq
 	return
 }
 
-// ValidateDeleteItxMeetingForbiddenResponseBody runs the validations defined
-// on delete-itx-meeting_Forbidden_response_body
-func ValidateDeleteItxMeetingForbiddenResponseBody(body *DeleteItxMeetingForbiddenResponseBody) (err error) {
+// ValidateCreateItxRegistrantConflictResponseBody runs the validations defined
+// on create-itx-registrant_Conflict_response_body
+func ValidateCreateItxRegistrantConflictResponseBody(body *CreateItxRegistrantConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8601,9 +11912,9 @@ func ValidateDeleteItxMeetingForbiddenResponseBody(body *DeleteItxMeetingForbidd
 	return
 }
 
-// ValidateDeleteItxMeetingInternalServerErrorResponseBody runs the validations
-// defined on delete-itx-meeting_InternalServerError_response_body
-func ValidateDeleteItxMeetingInternalServerErrorResponseBody(body *DeleteItxMeetingInternalServerErrorResponseBody) (err error) {
+// ValidateCreateItxRegistrantForbiddenResponseBody runs the validations
+// defined on create-itx-registrant_Forbidden_response_body
+func ValidateCreateItxRegistrantForbiddenResponseBody(body *CreateItxRegistrantForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8613,9 +11924,10 @@ func ValidateDeleteItxMeetingInternalServerErrorResponseBody(body *DeleteItxMeet
 	return
 }
 
-// ValidateDeleteItxMeetingNotFoundResponseBody runs the validations defined on
-// delete-itx-meeting_NotFound_response_body
-func ValidateDeleteItxMeetingNotFoundResponseBody(body *DeleteItxMeetingNotFoundResponseBody) (err error) {
+// ValidateCreateItxRegistrantInternalServerErrorResponseBody runs the
+// validations defined on
+// create-itx-registrant_InternalServerError_response_body
+func ValidateCreateItxRegistrantInternalServerErrorResponseBody(body *CreateItxRegistrantInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8625,9 +11937,9 @@ func ValidateDeleteItxMeetingNotFoundResponseBody(body *DeleteItxMeetingNotFound
 	return
 }
 
-// ValidateDeleteItxMeetingServiceUnavailableResponseBody runs the validations
-// defined on delete-itx-meeting_ServiceUnavailable_response_body
-func ValidateDeleteItxMeetingServiceUnavailableResponseBody(body *DeleteItxMeetingServiceUnavailableResponseBody) (err error) {
+// ValidateCreateItxRegistrantNotFoundResponseBody runs the validations defined
+// on create-itx-registrant_NotFound_response_body
+func ValidateCreateItxRegistrantNotFoundResponseBody(body *CreateItxRegistrantNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8637,9 +11949,9 @@ func ValidateDeleteItxMeetingServiceUnavailableResponseBody(body *DeleteItxMeeti
 	return
 }
 
-// ValidateDeleteItxMeetingUnauthorizedResponseBody runs the validations
-// defined on delete-itx-meeting_Unauthorized_response_body
-func ValidateDeleteItxMeetingUnauthorizedResponseBody(body *DeleteItxMeetingUnauthorizedResponseBody) (err error) {
+// ValidateCreateItxRegistrantServiceUnavailableResponseBody runs the
+// validations defined on create-itx-registrant_ServiceUnavailable_response_body
+func ValidateCreateItxRegistrantServiceUnavailableResponseBody(body *CreateItxRegistrantServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8649,9 +11961,9 @@ func ValidateDeleteItxMeetingUnauthorizedResponseBody(body *DeleteItxMeetingUnau
 	return
 }
 
-// ValidateUpdateItxMeetingBadRequestResponseBody runs the validations defined
-// on update-itx-meeting_BadRequest_response_body
-func ValidateUpdateItxMeetingBadRequestResponseBody(body *UpdateItxMeetingBadRequestResponseBody) (err error) {
+// ValidateCreateItxRegistrantUnauthorizedResponseBody runs the validations
+// defined on create-itx-registrant_Unauthorized_response_body
+func ValidateCreateItxRegistrantUnauthorizedResponseBody(body *CreateItxRegistrantUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8661,9 +11973,9 @@ func ValidateUpdateItxMeetingBadRequestResponseBody(body *UpdateItxMeetingBadReq
 	return
 }
 
-// ValidateUpdateItxMeetingConflictResponseBody runs the validations defined on
-// update-itx-meeting_Conflict_response_body
-func ValidateUpdateItxMeetingConflictResponseBody(body *UpdateItxMeetingConflictResponseBody) (err error) {
+// ValidateGetItxRegistrantBadRequestResponseBody runs the validations defined
+// on get-itx-registrant_BadRequest_response_body
+func ValidateGetItxRegistrantBadRequestResponseBody(body *GetItxRegistrantBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8673,9 +11985,9 @@ func ValidateUpdateItxMeetingConflictResponseBody(body *UpdateItxMeetingConflict
 	return
 }
 
-// ValidateUpdateItxMeetingForbiddenResponseBody runs the validations defined
-// on update-itx-meeting_Forbidden_response_body
-func ValidateUpdateItxMeetingForbiddenResponseBody(body *UpdateItxMeetingForbiddenResponseBody) (err error) {
+// ValidateGetItxRegistrantForbiddenResponseBody runs the validations defined
+// on get-itx-registrant_Forbidden_response_body
+func ValidateGetItxRegistrantForbiddenResponseBody(body *GetItxRegistrantForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8685,9 +11997,9 @@ func ValidateUpdateItxMeetingForbiddenResponseBody(body *UpdateItxMeetingForbidd
 	return
 }
 
-// ValidateUpdateItxMeetingInternalServerErrorResponseBody runs the validations
-// defined on update-itx-meeting_InternalServerError_response_body
-func ValidateUpdateItxMeetingInternalServerErrorResponseBody(body *UpdateItxMeetingInternalServerErrorResponseBody) (err error) {
+// ValidateGetItxRegistrantInternalServerErrorResponseBody runs the validations
+// defined on get-itx-registrant_InternalServerError_response_body
+func ValidateGetItxRegistrantInternalServerErrorResponseBody(body *GetItxRegistrantInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8697,9 +12009,9 @@ func ValidateUpdateItxMeetingInternalServerErrorResponseBody(body *UpdateItxMeet
 	return
 }
 
-// ValidateUpdateItxMeetingNotFoundResponseBody runs the validations defined on
-// update-itx-meeting_NotFound_response_body
-func ValidateUpdateItxMeetingNotFoundResponseBody(body *UpdateItxMeetingNotFoundResponseBody) (err error) {
+// ValidateGetItxRegistrantNotFoundResponseBody runs the validations defined on
+// get-itx-registrant_NotFound_response_body
+func ValidateGetItxRegistrantNotFoundResponseBody(body *GetItxRegistrantNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8709,9 +12021,9 @@ func ValidateUpdateItxMeetingNotFoundResponseBody(body *UpdateItxMeetingNotFound
 	return
 }
 
-// ValidateUpdateItxMeetingServiceUnavailableResponseBody runs the validations
-// defined on update-itx-meeting_ServiceUnavailable_response_body
-func ValidateUpdateItxMeetingServiceUnavailableResponseBody(body *UpdateItxMeetingServiceUnavailableResponseBody) (err error) {
+// ValidateGetItxRegistrantServiceUnavailableResponseBody runs the validations
+// defined on get-itx-registrant_ServiceUnavailable_response_body
+func ValidateGetItxRegistrantServiceUnavailableResponseBody(body *GetItxRegistrantServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8721,9 +12033,9 @@ func ValidateUpdateItxMeetingServiceUnavailableResponseBody(body *UpdateItxMeeti
 	return
 }
 
-// ValidateUpdateItxMeetingUnauthorizedResponseBody runs the validations
-// defined on update-itx-meeting_Unauthorized_response_body
-func ValidateUpdateItxMeetingUnauthorizedResponseBody(body *UpdateItxMeetingUnauthorizedResponseBody) (err error) {
+// ValidateGetItxRegistrantUnauthorizedResponseBody runs the validations
+// defined on get-itx-registrant_Unauthorized_response_body
+func ValidateGetItxRegistrantUnauthorizedResponseBody(body *GetItxRegistrantUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8733,9 +12045,9 @@ func ValidateUpdateItxMeetingUnauthorizedResponseBody(body *UpdateItxMeetingUnau
 	return
 }
 
-// ValidateGetItxMeetingCountBadRequestResponseBody runs the validations
-// defined on get-itx-meeting-count_BadRequest_response_body
-func ValidateGetItxMeetingCountBadRequestResponseBody(body *GetItxMeetingCountBadRequestResponseBody) (err error) {
+// ValidateUpdateItxRegistrantBadRequestResponseBody runs the validations
+// defined on update-itx-registrant_BadRequest_response_body
+func ValidateUpdateItxRegistrantBadRequestResponseBody(body *UpdateItxRegistrantBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8745,9 +12057,9 @@ func ValidateGetItxMeetingCountBadRequestResponseBody(body *GetItxMeetingCountBa
 	return
 }
 
-// ValidateGetItxMeetingCountForbiddenResponseBody runs the validations defined
-// on get-itx-meeting-count_Forbidden_response_body
-func ValidateGetItxMeetingCountForbiddenResponseBody(body *GetItxMeetingCountForbiddenResponseBody) (err error) {
+// ValidateUpdateItxRegistrantForbiddenResponseBody runs the validations
+// defined on update-itx-registrant_Forbidden_response_body
+func ValidateUpdateItxRegistrantForbiddenResponseBody(body *UpdateItxRegistrantForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8757,10 +12069,10 @@ func ValidateGetItxMeetingCountForbiddenResponseBody(body *GetItxMeetingCountFor
 	return
 }
 
-// ValidateGetItxMeetingCountInternalServerErrorResponseBody runs the
+// ValidateUpdateItxRegistrantInternalServerErrorResponseBody runs the
 // validations defined on
-// get-itx-meeting-count_InternalServerError_response_body
-func ValidateGetItxMeetingCountInternalServerErrorResponseBody(body *GetItxMeetingCountInternalServerErrorResponseBody) (err error) {
+// update-itx-registrant_InternalServerError_response_body
+func ValidateUpdateItxRegistrantInternalServerErrorResponseBody(body *UpdateItxRegistrantInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8770,9 +12082,21 @@ func ValidateGetItxMeetingCountInternalServerErrorResponseBody(body *GetItxMeeti
 	return
 }
 
-// ValidateGetItxMeetingCountNotFoundResponseBody runs the validations defined
-// on get-itx-meeting-count_NotFound_response_body
-func ValidateGetItxMeetingCountNotFoundResponseBody(body *GetItxMeetingCountNotFoundResponseBody) (err error) {
+// ValidateUpdateItxRegistrantNotFoundResponseBody runs the validations defined
+// on update-itx-registrant_NotFound_response_body
+func ValidateUpdateItxRegistrantNotFoundResponseBody(body *UpdateItxRegistrantNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItxRegistrantServiceUnavailableResponseBody runs the
+// validations defined on update-itx-registrant_ServiceUnavailable_response_body
+func ValidateUpdateItxRegistrantServiceUnavailableResponseBody(body *UpdateItxRegistrantServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8782,9 +12106,9 @@ func ValidateGetItxMeetingCountNotFoundResponseBody(body *GetItxMeetingCountNotF
 	return
 }
 
-// ValidateGetItxMeetingCountServiceUnavailableResponseBody runs the
-// validations defined on get-itx-meeting-count_ServiceUnavailable_response_body
-func ValidateGetItxMeetingCountServiceUnavailableResponseBody(body *GetItxMeetingCountServiceUnavailableResponseBody) (err error) {
+// ValidateUpdateItxRegistrantUnauthorizedResponseBody runs the validations
+// defined on update-itx-registrant_Unauthorized_response_body
+func ValidateUpdateItxRegistrantUnauthorizedResponseBody(body *UpdateItxRegistrantUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8794,9 +12118,9 @@ func ValidateGetItxMeetingCountServiceUnavailableResponseBody(body *GetItxMeetin
 	return
 }
 
-// ValidateGetItxMeetingCountUnauthorizedResponseBody runs the validations
-// defined on get-itx-meeting-count_Unauthorized_response_body
-func ValidateGetItxMeetingCountUnauthorizedResponseBody(body *GetItxMeetingCountUnauthorizedResponseBody) (err error) {
+// ValidateDeleteItxRegistrantBadRequestResponseBody runs the validations
+// defined on delete-itx-registrant_BadRequest_response_body
+func ValidateDeleteItxRegistrantBadRequestResponseBody(body *DeleteItxRegistrantBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8806,9 +12130,9 @@ func ValidateGetItxMeetingCountUnauthorizedResponseBody(body *GetItxMeetingCount
 	return
 }
 
-// ValidateCreateItxRegistrantBadRequestResponseBody runs the validations
-// defined on create-itx-registrant_BadRequest_response_body
-func ValidateCreateItxRegistrantBadRequestResponseBody(body *CreateItxRegistrantBadRequestResponseBody) (err error) {
+// ValidateDeleteItxRegistrantForbiddenResponseBody runs the validations
+// defined on delete-itx-registrant_Forbidden_response_body
+func ValidateDeleteItxRegistrantForbiddenResponseBody(body *DeleteItxRegistrantForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8818,9 +12142,10 @@ func ValidateCreateItxRegistrantBadRequestResponseBody(body *CreateItxRegistrant
 	return
 }
 
-// ValidateCreateItxRegistrantConflictResponseBody runs the validations defined
-// on create-itx-registrant_Conflict_response_body
-func ValidateCreateItxRegistrantConflictResponseBody(body *CreateItxRegistrantConflictResponseBody) (err error) {
+// ValidateDeleteItxRegistrantInternalServerErrorResponseBody runs the
+// validations defined on
+// delete-itx-registrant_InternalServerError_response_body
+func ValidateDeleteItxRegistrantInternalServerErrorResponseBody(body *DeleteItxRegistrantInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8830,9 +12155,9 @@ func ValidateCreateItxRegistrantConflictResponseBody(body *CreateItxRegistrantCo
 	return
 }
 
-// ValidateCreateItxRegistrantForbiddenResponseBody runs the validations
-// defined on create-itx-registrant_Forbidden_response_body
-func ValidateCreateItxRegistrantForbiddenResponseBody(body *CreateItxRegistrantForbiddenResponseBody) (err error) {
+// ValidateDeleteItxRegistrantNotFoundResponseBody runs the validations defined
+// on delete-itx-registrant_NotFound_response_body
+func ValidateDeleteItxRegistrantNotFoundResponseBody(body *DeleteItxRegistrantNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8842,10 +12167,9 @@ func ValidateCreateItxRegistrantForbiddenResponseBody(body *CreateItxRegistrantF
 	return
 }
 
-// ValidateCreateItxRegistrantInternalServerErrorResponseBody runs the
-// validations defined on
-// create-itx-registrant_InternalServerError_response_body
-func ValidateCreateItxRegistrantInternalServerErrorResponseBody(body *CreateItxRegistrantInternalServerErrorResponseBody) (err error) {
+// ValidateDeleteItxRegistrantServiceUnavailableResponseBody runs the
+// validations defined on delete-itx-registrant_ServiceUnavailable_response_body
+func ValidateDeleteItxRegistrantServiceUnavailableResponseBody(body *DeleteItxRegistrantServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8855,9 +12179,9 @@ func ValidateCreateItxRegistrantInternalServerErrorResponseBody(body *CreateItxR
 	return
 }
 
-// ValidateCreateItxRegistrantNotFoundResponseBody runs the validations defined
-// on create-itx-registrant_NotFound_response_body
-func ValidateCreateItxRegistrantNotFoundResponseBody(body *CreateItxRegistrantNotFoundResponseBody) (err error) {
+// ValidateDeleteItxRegistrantUnauthorizedResponseBody runs the validations
+// defined on delete-itx-registrant_Unauthorized_response_body
+func ValidateDeleteItxRegistrantUnauthorizedResponseBody(body *DeleteItxRegistrantUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8867,9 +12191,9 @@ func ValidateCreateItxRegistrantNotFoundResponseBody(body *CreateItxRegistrantNo
 	return
 }
 
-// ValidateCreateItxRegistrantServiceUnavailableResponseBody runs the
-// validations defined on create-itx-registrant_ServiceUnavailable_response_body
-func ValidateCreateItxRegistrantServiceUnavailableResponseBody(body *CreateItxRegistrantServiceUnavailableResponseBody) (err error) {
+// ValidateGetItxJoinLinkBadRequestResponseBody runs the validations defined on
+// get-itx-join-link_BadRequest_response_body
+func ValidateGetItxJoinLinkBadRequestResponseBody(body *GetItxJoinLinkBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8879,9 +12203,9 @@ func ValidateCreateItxRegistrantServiceUnavailableResponseBody(body *CreateItxRe
 	return
 }
 
-// ValidateCreateItxRegistrantUnauthorizedResponseBody runs the validations
-// defined on create-itx-registrant_Unauthorized_response_body
-func ValidateCreateItxRegistrantUnauthorizedResponseBody(body *CreateItxRegistrantUnauthorizedResponseBody) (err error) {
+// ValidateGetItxJoinLinkConflictResponseBody runs the validations defined on
+// get-itx-join-link_Conflict_response_body
+func ValidateGetItxJoinLinkConflictResponseBody(body *GetItxJoinLinkConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8891,9 +12215,9 @@ func ValidateCreateItxRegistrantUnauthorizedResponseBody(body *CreateItxRegistra
 	return
 }
 
-// ValidateGetItxRegistrantBadRequestResponseBody runs the validations defined
-// on get-itx-registrant_BadRequest_response_body
-func ValidateGetItxRegistrantBadRequestResponseBody(body *GetItxRegistrantBadRequestResponseBody) (err error) {
+// ValidateGetItxJoinLinkForbiddenResponseBody runs the validations defined on
+// get-itx-join-link_Forbidden_response_body
+func ValidateGetItxJoinLinkForbiddenResponseBody(body *GetItxJoinLinkForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8903,9 +12227,9 @@ func ValidateGetItxRegistrantBadRequestResponseBody(body *GetItxRegistrantBadReq
 	return
 }
 
-// ValidateGetItxRegistrantForbiddenResponseBody runs the validations defined
-// on get-itx-registrant_Forbidden_response_body
-func ValidateGetItxRegistrantForbiddenResponseBody(body *GetItxRegistrantForbiddenResponseBody) (err error) {
+// ValidateGetItxJoinLinkInternalServerErrorResponseBody runs the validations
+// defined on get-itx-join-link_InternalServerError_response_body
+func ValidateGetItxJoinLinkInternalServerErrorResponseBody(body *GetItxJoinLinkInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8915,9 +12239,9 @@ func ValidateGetItxRegistrantForbiddenResponseBody(body *GetItxRegistrantForbidd
 	return
 }
 
-// ValidateGetItxRegistrantInternalServerErrorResponseBody runs the validations
-// defined on get-itx-registrant_InternalServerError_response_body
-func ValidateGetItxRegistrantInternalServerErrorResponseBody(body *GetItxRegistrantInternalServerErrorResponseBody) (err error) {
+// ValidateGetItxJoinLinkNotFoundResponseBody runs the validations defined on
+// get-itx-join-link_NotFound_response_body
+func ValidateGetItxJoinLinkNotFoundResponseBody(body *GetItxJoinLinkNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8927,9 +12251,9 @@ func ValidateGetItxRegistrantInternalServerErrorResponseBody(body *GetItxRegistr
 	return
 }
 
-// ValidateGetItxRegistrantNotFoundResponseBody runs the validations defined on
-// get-itx-registrant_NotFound_response_body
-func ValidateGetItxRegistrantNotFoundResponseBody(body *GetItxRegistrantNotFoundResponseBody) (err error) {
+// ValidateGetItxJoinLinkServiceUnavailableResponseBody runs the validations
+// defined on get-itx-join-link_ServiceUnavailable_response_body
+func ValidateGetItxJoinLinkServiceUnavailableResponseBody(body *GetItxJoinLinkServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8939,9 +12263,9 @@ func ValidateGetItxRegistrantNotFoundResponseBody(body *GetItxRegistrantNotFound
 	return
 }
 
-// ValidateGetItxRegistrantServiceUnavailableResponseBody runs the validations
-// defined on get-itx-registrant_ServiceUnavailable_response_body
-func ValidateGetItxRegistrantServiceUnavailableResponseBody(body *GetItxRegistrantServiceUnavailableResponseBody) (err error) {
+// ValidateGetItxJoinLinkUnauthorizedResponseBody runs the validations defined
+// on get-itx-join-link_Unauthorized_response_body
+func ValidateGetItxJoinLinkUnauthorizedResponseBody(body *GetItxJoinLinkUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8951,9 +12275,9 @@ func ValidateGetItxRegistrantServiceUnavailableResponseBody(body *GetItxRegistra
 	return
 }
 
-// ValidateGetItxRegistrantUnauthorizedResponseBody runs the validations
-// defined on get-itx-registrant_Unauthorized_response_body
-func ValidateGetItxRegistrantUnauthorizedResponseBody(body *GetItxRegistrantUnauthorizedResponseBody) (err error) {
+// ValidateLaunchItxMeetingBadRequestResponseBody runs the validations defined
+// on launch-itx-meeting_BadRequest_response_body
+func ValidateLaunchItxMeetingBadRequestResponseBody(body *LaunchItxMeetingBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8963,9 +12287,9 @@ func ValidateGetItxRegistrantUnauthorizedResponseBody(body *GetItxRegistrantUnau
 	return
 }
 
-// ValidateUpdateItxRegistrantBadRequestResponseBody runs the validations
-// defined on update-itx-registrant_BadRequest_response_body
-func ValidateUpdateItxRegistrantBadRequestResponseBody(body *UpdateItxRegistrantBadRequestResponseBody) (err error) {
+// ValidateLaunchItxMeetingConflictResponseBody runs the validations defined on
+// launch-itx-meeting_Conflict_response_body
+func ValidateLaunchItxMeetingConflictResponseBody(body *LaunchItxMeetingConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8975,9 +12299,9 @@ func ValidateUpdateItxRegistrantBadRequestResponseBody(body *UpdateItxRegistrant
 	return
 }
 
-// ValidateUpdateItxRegistrantForbiddenResponseBody runs the validations
-// defined on update-itx-registrant_Forbidden_response_body
-func ValidateUpdateItxRegistrantForbiddenResponseBody(body *UpdateItxRegistrantForbiddenResponseBody) (err error) {
+// ValidateLaunchItxMeetingForbiddenResponseBody runs the validations defined
+// on launch-itx-meeting_Forbidden_response_body
+func ValidateLaunchItxMeetingForbiddenResponseBody(body *LaunchItxMeetingForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -8987,10 +12311,9 @@ func ValidateUpdateItxRegistrantForbiddenResponseBody(body *UpdateItxRegistrantF
 	return
 }
 
-// ValidateUpdateItxRegistrantInternalServerErrorResponseBody runs the
-// validations defined on
-// update-itx-registrant_InternalServerError_response_body
-func ValidateUpdateItxRegistrantInternalServerErrorResponseBody(body *UpdateItxRegistrantInternalServerErrorResponseBody) (err error) {
+// ValidateLaunchItxMeetingInternalServerErrorResponseBody runs the validations
+// defined on launch-itx-meeting_InternalServerError_response_body
+func ValidateLaunchItxMeetingInternalServerErrorResponseBody(body *LaunchItxMeetingInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9000,9 +12323,9 @@ func ValidateUpdateItxRegistrantInternalServerErrorResponseBody(body *UpdateItxR
 	return
 }
 
-// ValidateUpdateItxRegistrantNotFoundResponseBody runs the validations defined
-// on update-itx-registrant_NotFound_response_body
-func ValidateUpdateItxRegistrantNotFoundResponseBody(body *UpdateItxRegistrantNotFoundResponseBody) (err error) {
+// ValidateLaunchItxMeetingNotFoundResponseBody runs the validations defined on
+// launch-itx-meeting_NotFound_response_body
+func ValidateLaunchItxMeetingNotFoundResponseBody(body *LaunchItxMeetingNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9012,9 +12335,9 @@ func ValidateUpdateItxRegistrantNotFoundResponseBody(body *UpdateItxRegistrantNo
 	return
 }
 
-// ValidateUpdateItxRegistrantServiceUnavailableResponseBody runs the
-// validations defined on update-itx-registrant_ServiceUnavailable_response_body
-func ValidateUpdateItxRegistrantServiceUnavailableResponseBody(body *UpdateItxRegistrantServiceUnavailableResponseBody) (err error) {
+// ValidateLaunchItxMeetingServiceUnavailableResponseBody runs the validations
+// defined on launch-itx-meeting_ServiceUnavailable_response_body
+func ValidateLaunchItxMeetingServiceUnavailableResponseBody(body *LaunchItxMeetingServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9024,9 +12347,9 @@ func ValidateUpdateItxRegistrantServiceUnavailableResponseBody(body *UpdateItxRe
 	return
 }
 
-// ValidateUpdateItxRegistrantUnauthorizedResponseBody runs the validations
-// defined on update-itx-registrant_Unauthorized_response_body
-func ValidateUpdateItxRegistrantUnauthorizedResponseBody(body *UpdateItxRegistrantUnauthorizedResponseBody) (err error) {
+// ValidateLaunchItxMeetingUnauthorizedResponseBody runs the validations
+// defined on launch-itx-meeting_Unauthorized_response_body
+func ValidateLaunchItxMeetingUnauthorizedResponseBody(body *LaunchItxMeetingUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9036,9 +12359,9 @@ func ValidateUpdateItxRegistrantUnauthorizedResponseBody(body *UpdateItxRegistra
 	return
 }
 
-// ValidateDeleteItxRegistrantBadRequestResponseBody runs the validations
-// defined on delete-itx-registrant_BadRequest_response_body
-func ValidateDeleteItxRegistrantBadRequestResponseBody(body *DeleteItxRegistrantBadRequestResponseBody) (err error) {
+// ValidateGetItxRegistrantIcsBadRequestResponseBody runs the validations
+// defined on get-itx-registrant-ics_BadRequest_response_body
+func ValidateGetItxRegistrantIcsBadRequestResponseBody(body *GetItxRegistrantIcsBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9048,9 +12371,9 @@ func ValidateDeleteItxRegistrantBadRequestResponseBody(body *DeleteItxRegistrant
 	return
 }
 
-// ValidateDeleteItxRegistrantForbiddenResponseBody runs the validations
-// defined on delete-itx-registrant_Forbidden_response_body
-func ValidateDeleteItxRegistrantForbiddenResponseBody(body *DeleteItxRegistrantForbiddenResponseBody) (err error) {
+// ValidateGetItxRegistrantIcsForbiddenResponseBody runs the validations
+// defined on get-itx-registrant-ics_Forbidden_response_body
+func ValidateGetItxRegistrantIcsForbiddenResponseBody(body *GetItxRegistrantIcsForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9060,10 +12383,10 @@ func ValidateDeleteItxRegistrantForbiddenResponseBody(body *DeleteItxRegistrantF
 	return
 }
 
-// ValidateDeleteItxRegistrantInternalServerErrorResponseBody runs the
+// ValidateGetItxRegistrantIcsInternalServerErrorResponseBody runs the
 // validations defined on
-// delete-itx-registrant_InternalServerError_response_body
-func ValidateDeleteItxRegistrantInternalServerErrorResponseBody(body *DeleteItxRegistrantInternalServerErrorResponseBody) (err error) {
+// get-itx-registrant-ics_InternalServerError_response_body
+func ValidateGetItxRegistrantIcsInternalServerErrorResponseBody(body *GetItxRegistrantIcsInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9073,9 +12396,9 @@ func ValidateDeleteItxRegistrantInternalServerErrorResponseBody(body *DeleteItxR
 	return
 }
 
-// ValidateDeleteItxRegistrantNotFoundResponseBody runs the validations defined
-// on delete-itx-registrant_NotFound_response_body
-func ValidateDeleteItxRegistrantNotFoundResponseBody(body *DeleteItxRegistrantNotFoundResponseBody) (err error) {
+// ValidateGetItxRegistrantIcsNotFoundResponseBody runs the validations defined
+// on get-itx-registrant-ics_NotFound_response_body
+func ValidateGetItxRegistrantIcsNotFoundResponseBody(body *GetItxRegistrantIcsNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9085,9 +12408,10 @@ func ValidateDeleteItxRegistrantNotFoundResponseBody(body *DeleteItxRegistrantNo
 	return
 }
 
-// ValidateDeleteItxRegistrantServiceUnavailableResponseBody runs the
-// validations defined on delete-itx-registrant_ServiceUnavailable_response_body
-func ValidateDeleteItxRegistrantServiceUnavailableResponseBody(body *DeleteItxRegistrantServiceUnavailableResponseBody) (err error) {
+// ValidateGetItxRegistrantIcsServiceUnavailableResponseBody runs the
+// validations defined on
+// get-itx-registrant-ics_ServiceUnavailable_response_body
+func ValidateGetItxRegistrantIcsServiceUnavailableResponseBody(body *GetItxRegistrantIcsServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9097,9 +12421,9 @@ func ValidateDeleteItxRegistrantServiceUnavailableResponseBody(body *DeleteItxRe
 	return
 }
 
-// ValidateDeleteItxRegistrantUnauthorizedResponseBody runs the validations
-// defined on delete-itx-registrant_Unauthorized_response_body
-func ValidateDeleteItxRegistrantUnauthorizedResponseBody(body *DeleteItxRegistrantUnauthorizedResponseBody) (err error) {
+// ValidateGetItxRegistrantIcsUnauthorizedResponseBody runs the validations
+// defined on get-itx-registrant-ics_Unauthorized_response_body
+func ValidateGetItxRegistrantIcsUnauthorizedResponseBody(body *GetItxRegistrantIcsUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9109,9 +12433,9 @@ func ValidateDeleteItxRegistrantUnauthorizedResponseBody(body *DeleteItxRegistra
 	return
 }
 
-// ValidateGetItxJoinLinkBadRequestResponseBody runs the validations defined on
-// get-itx-join-link_BadRequest_response_body
-func ValidateGetItxJoinLinkBadRequestResponseBody(body *GetItxJoinLinkBadRequestResponseBody) (err error) {
+// ValidateAddItxRegistrantHostBadRequestResponseBody runs the validations
+// defined on add-itx-registrant-host_BadRequest_response_body
+func ValidateAddItxRegistrantHostBadRequestResponseBody(body *AddItxRegistrantHostBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9121,9 +12445,9 @@ func ValidateGetItxJoinLinkBadRequestResponseBody(body *GetItxJoinLinkBadRequest
 	return
 }
 
-// ValidateGetItxJoinLinkForbiddenResponseBody runs the validations defined on
-// get-itx-join-link_Forbidden_response_body
-func ValidateGetItxJoinLinkForbiddenResponseBody(body *GetItxJoinLinkForbiddenResponseBody) (err error) {
+// ValidateAddItxRegistrantHostForbiddenResponseBody runs the validations
+// defined on add-itx-registrant-host_Forbidden_response_body
+func ValidateAddItxRegistrantHostForbiddenResponseBody(body *AddItxRegistrantHostForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9133,9 +12457,10 @@ func ValidateGetItxJoinLinkForbiddenResponseBody(body *GetItxJoinLinkForbiddenRe
 	return
 }
 
-// ValidateGetItxJoinLinkInternalServerErrorResponseBody runs the validations
-// defined on get-itx-join-link_InternalServerError_response_body
-func ValidateGetItxJoinLinkInternalServerErrorResponseBody(body *GetItxJoinLinkInternalServerErrorResponseBody) (err error) {
+// ValidateAddItxRegistrantHostInternalServerErrorResponseBody runs the
+// validations defined on
+// add-itx-registrant-host_InternalServerError_response_body
+func ValidateAddItxRegistrantHostInternalServerErrorResponseBody(body *AddItxRegistrantHostInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9145,9 +12470,9 @@ func ValidateGetItxJoinLinkInternalServerErrorResponseBody(body *GetItxJoinLinkI
 	return
 }
 
-// ValidateGetItxJoinLinkNotFoundResponseBody runs the validations defined on
-// get-itx-join-link_NotFound_response_body
-func ValidateGetItxJoinLinkNotFoundResponseBody(body *GetItxJoinLinkNotFoundResponseBody) (err error) {
+// ValidateAddItxRegistrantHostNotFoundResponseBody runs the validations
+// defined on add-itx-registrant-host_NotFound_response_body
+func ValidateAddItxRegistrantHostNotFoundResponseBody(body *AddItxRegistrantHostNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9157,9 +12482,10 @@ func ValidateGetItxJoinLinkNotFoundResponseBody(body *GetItxJoinLinkNotFoundResp
 	return
 }
 
-// ValidateGetItxJoinLinkServiceUnavailableResponseBody runs the validations
-// defined on get-itx-join-link_ServiceUnavailable_response_body
-func ValidateGetItxJoinLinkServiceUnavailableResponseBody(body *GetItxJoinLinkServiceUnavailableResponseBody) (err error) {
+// ValidateAddItxRegistrantHostServiceUnavailableResponseBody runs the
+// validations defined on
+// add-itx-registrant-host_ServiceUnavailable_response_body
+func ValidateAddItxRegistrantHostServiceUnavailableResponseBody(body *AddItxRegistrantHostServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9169,9 +12495,9 @@ func ValidateGetItxJoinLinkServiceUnavailableResponseBody(body *GetItxJoinLinkSe
 	return
 }
 
-// ValidateGetItxJoinLinkUnauthorizedResponseBody runs the validations defined
-// on get-itx-join-link_Unauthorized_response_body
-func ValidateGetItxJoinLinkUnauthorizedResponseBody(body *GetItxJoinLinkUnauthorizedResponseBody) (err error) {
+// ValidateAddItxRegistrantHostUnauthorizedResponseBody runs the validations
+// defined on add-itx-registrant-host_Unauthorized_response_body
+func ValidateAddItxRegistrantHostUnauthorizedResponseBody(body *AddItxRegistrantHostUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9181,9 +12507,9 @@ func ValidateGetItxJoinLinkUnauthorizedResponseBody(body *GetItxJoinLinkUnauthor
 	return
 }
 
-// ValidateGetItxRegistrantIcsBadRequestResponseBody runs the validations
-// defined on get-itx-registrant-ics_BadRequest_response_body
-func ValidateGetItxRegistrantIcsBadRequestResponseBody(body *GetItxRegistrantIcsBadRequestResponseBody) (err error) {
+// ValidateRemoveItxRegistrantHostBadRequestResponseBody runs the validations
+// defined on remove-itx-registrant-host_BadRequest_response_body
+func ValidateRemoveItxRegistrantHostBadRequestResponseBody(body *RemoveItxRegistrantHostBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9193,9 +12519,9 @@ func ValidateGetItxRegistrantIcsBadRequestResponseBody(body *GetItxRegistrantIcs
 	return
 }
 
-// ValidateGetItxRegistrantIcsForbiddenResponseBody runs the validations
-// defined on get-itx-registrant-ics_Forbidden_response_body
-func ValidateGetItxRegistrantIcsForbiddenResponseBody(body *GetItxRegistrantIcsForbiddenResponseBody) (err error) {
+// ValidateRemoveItxRegistrantHostForbiddenResponseBody runs the validations
+// defined on remove-itx-registrant-host_Forbidden_response_body
+func ValidateRemoveItxRegistrantHostForbiddenResponseBody(body *RemoveItxRegistrantHostForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9205,10 +12531,10 @@ func ValidateGetItxRegistrantIcsForbiddenResponseBody(body *GetItxRegistrantIcsF
 	return
 }
 
-// ValidateGetItxRegistrantIcsInternalServerErrorResponseBody runs the
+// ValidateRemoveItxRegistrantHostInternalServerErrorResponseBody runs the
 // validations defined on
-// get-itx-registrant-ics_InternalServerError_response_body
-func ValidateGetItxRegistrantIcsInternalServerErrorResponseBody(body *GetItxRegistrantIcsInternalServerErrorResponseBody) (err error) {
+// remove-itx-registrant-host_InternalServerError_response_body
+func ValidateRemoveItxRegistrantHostInternalServerErrorResponseBody(body *RemoveItxRegistrantHostInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9218,9 +12544,9 @@ func ValidateGetItxRegistrantIcsInternalServerErrorResponseBody(body *GetItxRegi
 	return
 }
 
-// ValidateGetItxRegistrantIcsNotFoundResponseBody runs the validations defined
-// on get-itx-registrant-ics_NotFound_response_body
-func ValidateGetItxRegistrantIcsNotFoundResponseBody(body *GetItxRegistrantIcsNotFoundResponseBody) (err error) {
+// ValidateRemoveItxRegistrantHostNotFoundResponseBody runs the validations
+// defined on remove-itx-registrant-host_NotFound_response_body
+func ValidateRemoveItxRegistrantHostNotFoundResponseBody(body *RemoveItxRegistrantHostNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9230,10 +12556,10 @@ func ValidateGetItxRegistrantIcsNotFoundResponseBody(body *GetItxRegistrantIcsNo
 	return
 }
 
-// ValidateGetItxRegistrantIcsServiceUnavailableResponseBody runs the
+// ValidateRemoveItxRegistrantHostServiceUnavailableResponseBody runs the
 // validations defined on
-// get-itx-registrant-ics_ServiceUnavailable_response_body
-func ValidateGetItxRegistrantIcsServiceUnavailableResponseBody(body *GetItxRegistrantIcsServiceUnavailableResponseBody) (err error) {
+// remove-itx-registrant-host_ServiceUnavailable_response_body
+func ValidateRemoveItxRegistrantHostServiceUnavailableResponseBody(body *RemoveItxRegistrantHostServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9243,9 +12569,9 @@ func ValidateGetItxRegistrantIcsServiceUnavailableResponseBody(body *GetItxRegis
 	return
 }
 
-// ValidateGetItxRegistrantIcsUnauthorizedResponseBody runs the validations
-// defined on get-itx-registrant-ics_Unauthorized_response_body
-func ValidateGetItxRegistrantIcsUnauthorizedResponseBody(body *GetItxRegistrantIcsUnauthorizedResponseBody) (err error) {
+// ValidateRemoveItxRegistrantHostUnauthorizedResponseBody runs the validations
+// defined on remove-itx-registrant-host_Unauthorized_response_body
+func ValidateRemoveItxRegistrantHostUnauthorizedResponseBody(body *RemoveItxRegistrantHostUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9409,10 +12735,85 @@ func ValidateResendItxMeetingInvitationsUnauthorizedResponseBody(body *ResendItx
 	return
 }
 
-// ValidateRegisterItxCommitteeMembersBadRequestResponseBody runs the
-// validations defined on
-// register-itx-committee-members_BadRequest_response_body
-func ValidateRegisterItxCommitteeMembersBadRequestResponseBody(body *RegisterItxCommitteeMembersBadRequestResponseBody) (err error) {
+// ValidateRegisterItxCommitteeMembersBadRequestResponseBody runs the
+// validations defined on
+// register-itx-committee-members_BadRequest_response_body
+func ValidateRegisterItxCommitteeMembersBadRequestResponseBody(body *RegisterItxCommitteeMembersBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegisterItxCommitteeMembersForbiddenResponseBody runs the
+// validations defined on register-itx-committee-members_Forbidden_response_body
+func ValidateRegisterItxCommitteeMembersForbiddenResponseBody(body *RegisterItxCommitteeMembersForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegisterItxCommitteeMembersInternalServerErrorResponseBody runs the
+// validations defined on
+// register-itx-committee-members_InternalServerError_response_body
+func ValidateRegisterItxCommitteeMembersInternalServerErrorResponseBody(body *RegisterItxCommitteeMembersInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegisterItxCommitteeMembersNotFoundResponseBody runs the validations
+// defined on register-itx-committee-members_NotFound_response_body
+func ValidateRegisterItxCommitteeMembersNotFoundResponseBody(body *RegisterItxCommitteeMembersNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegisterItxCommitteeMembersServiceUnavailableResponseBody runs the
+// validations defined on
+// register-itx-committee-members_ServiceUnavailable_response_body
+func ValidateRegisterItxCommitteeMembersServiceUnavailableResponseBody(body *RegisterItxCommitteeMembersServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegisterItxCommitteeMembersUnauthorizedResponseBody runs the
+// validations defined on
+// register-itx-committee-members_Unauthorized_response_body
+func ValidateRegisterItxCommitteeMembersUnauthorizedResponseBody(body *RegisterItxCommitteeMembersUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListItxMeetingOccurrencesBadRequestResponseBody runs the validations
+// defined on list-itx-meeting-occurrences_BadRequest_response_body
+func ValidateListItxMeetingOccurrencesBadRequestResponseBody(body *ListItxMeetingOccurrencesBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9422,9 +12823,9 @@ func ValidateRegisterItxCommitteeMembersBadRequestResponseBody(body *RegisterItx
 	return
 }
 
-// ValidateRegisterItxCommitteeMembersForbiddenResponseBody runs the
-// validations defined on register-itx-committee-members_Forbidden_response_body
-func ValidateRegisterItxCommitteeMembersForbiddenResponseBody(body *RegisterItxCommitteeMembersForbiddenResponseBody) (err error) {
+// ValidateListItxMeetingOccurrencesForbiddenResponseBody runs the validations
+// defined on list-itx-meeting-occurrences_Forbidden_response_body
+func ValidateListItxMeetingOccurrencesForbiddenResponseBody(body *ListItxMeetingOccurrencesForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9434,10 +12835,10 @@ func ValidateRegisterItxCommitteeMembersForbiddenResponseBody(body *RegisterItxC
 	return
 }
 
-// ValidateRegisterItxCommitteeMembersInternalServerErrorResponseBody runs the
+// ValidateListItxMeetingOccurrencesInternalServerErrorResponseBody runs the
 // validations defined on
-// register-itx-committee-members_InternalServerError_response_body
-func ValidateRegisterItxCommitteeMembersInternalServerErrorResponseBody(body *RegisterItxCommitteeMembersInternalServerErrorResponseBody) (err error) {
+// list-itx-meeting-occurrences_InternalServerError_response_body
+func ValidateListItxMeetingOccurrencesInternalServerErrorResponseBody(body *ListItxMeetingOccurrencesInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9447,9 +12848,9 @@ func ValidateRegisterItxCommitteeMembersInternalServerErrorResponseBody(body *Re
 	return
 }
 
-// ValidateRegisterItxCommitteeMembersNotFoundResponseBody runs the validations
-// defined on register-itx-committee-members_NotFound_response_body
-func ValidateRegisterItxCommitteeMembersNotFoundResponseBody(body *RegisterItxCommitteeMembersNotFoundResponseBody) (err error) {
+// ValidateListItxMeetingOccurrencesNotFoundResponseBody runs the validations
+// defined on list-itx-meeting-occurrences_NotFound_response_body
+func ValidateListItxMeetingOccurrencesNotFoundResponseBody(body *ListItxMeetingOccurrencesNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9459,10 +12860,10 @@ func ValidateRegisterItxCommitteeMembersNotFoundResponseBody(body *RegisterItxCo
 	return
 }
 
-// ValidateRegisterItxCommitteeMembersServiceUnavailableResponseBody runs the
+// ValidateListItxMeetingOccurrencesServiceUnavailableResponseBody runs the
 // validations defined on
-// register-itx-committee-members_ServiceUnavailable_response_body
-func ValidateRegisterItxCommitteeMembersServiceUnavailableResponseBody(body *RegisterItxCommitteeMembersServiceUnavailableResponseBody) (err error) {
+// list-itx-meeting-occurrences_ServiceUnavailable_response_body
+func ValidateListItxMeetingOccurrencesServiceUnavailableResponseBody(body *ListItxMeetingOccurrencesServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9472,10 +12873,10 @@ func ValidateRegisterItxCommitteeMembersServiceUnavailableResponseBody(body *Reg
 	return
 }
 
-// ValidateRegisterItxCommitteeMembersUnauthorizedResponseBody runs the
+// ValidateListItxMeetingOccurrencesUnauthorizedResponseBody runs the
 // validations defined on
-// register-itx-committee-members_Unauthorized_response_body
-func ValidateRegisterItxCommitteeMembersUnauthorizedResponseBody(body *RegisterItxCommitteeMembersUnauthorizedResponseBody) (err error) {
+// list-itx-meeting-occurrences_Unauthorized_response_body
+func ValidateListItxMeetingOccurrencesUnauthorizedResponseBody(body *ListItxMeetingOccurrencesUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9887,10 +13288,261 @@ func ValidateDeleteItxPastMeetingForbiddenResponseBody(body *DeleteItxPastMeetin
 	return
 }
 
-// ValidateDeleteItxPastMeetingInternalServerErrorResponseBody runs the
+// ValidateDeleteItxPastMeetingInternalServerErrorResponseBody runs the
+// validations defined on
+// delete-itx-past-meeting_InternalServerError_response_body
+func ValidateDeleteItxPastMeetingInternalServerErrorResponseBody(body *DeleteItxPastMeetingInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteItxPastMeetingNotFoundResponseBody runs the validations
+// defined on delete-itx-past-meeting_NotFound_response_body
+func ValidateDeleteItxPastMeetingNotFoundResponseBody(body *DeleteItxPastMeetingNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteItxPastMeetingServiceUnavailableResponseBody runs the
+// validations defined on
+// delete-itx-past-meeting_ServiceUnavailable_response_body
+func ValidateDeleteItxPastMeetingServiceUnavailableResponseBody(body *DeleteItxPastMeetingServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteItxPastMeetingUnauthorizedResponseBody runs the validations
+// defined on delete-itx-past-meeting_Unauthorized_response_body
+func ValidateDeleteItxPastMeetingUnauthorizedResponseBody(body *DeleteItxPastMeetingUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingBadRequestResponseBody runs the validations
+// defined on update-itx-past-meeting_BadRequest_response_body
+func ValidateUpdateItxPastMeetingBadRequestResponseBody(body *UpdateItxPastMeetingBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingForbiddenResponseBody runs the validations
+// defined on update-itx-past-meeting_Forbidden_response_body
+func ValidateUpdateItxPastMeetingForbiddenResponseBody(body *UpdateItxPastMeetingForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingInternalServerErrorResponseBody runs the
+// validations defined on
+// update-itx-past-meeting_InternalServerError_response_body
+func ValidateUpdateItxPastMeetingInternalServerErrorResponseBody(body *UpdateItxPastMeetingInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingNotFoundResponseBody runs the validations
+// defined on update-itx-past-meeting_NotFound_response_body
+func ValidateUpdateItxPastMeetingNotFoundResponseBody(body *UpdateItxPastMeetingNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingServiceUnavailableResponseBody runs the
+// validations defined on
+// update-itx-past-meeting_ServiceUnavailable_response_body
+func ValidateUpdateItxPastMeetingServiceUnavailableResponseBody(body *UpdateItxPastMeetingServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItxPastMeetingUnauthorizedResponseBody runs the validations
+// defined on update-itx-past-meeting_Unauthorized_response_body
+func ValidateUpdateItxPastMeetingUnauthorizedResponseBody(body *UpdateItxPastMeetingUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryBadRequestResponseBody runs the validations
+// defined on get-itx-past-meeting-summary_BadRequest_response_body
+func ValidateGetItxPastMeetingSummaryBadRequestResponseBody(body *GetItxPastMeetingSummaryBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryForbiddenResponseBody runs the validations
+// defined on get-itx-past-meeting-summary_Forbidden_response_body
+func ValidateGetItxPastMeetingSummaryForbiddenResponseBody(body *GetItxPastMeetingSummaryForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryInternalServerErrorResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary_InternalServerError_response_body
+func ValidateGetItxPastMeetingSummaryInternalServerErrorResponseBody(body *GetItxPastMeetingSummaryInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryNotFoundResponseBody runs the validations
+// defined on get-itx-past-meeting-summary_NotFound_response_body
+func ValidateGetItxPastMeetingSummaryNotFoundResponseBody(body *GetItxPastMeetingSummaryNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryServiceUnavailableResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary_ServiceUnavailable_response_body
+func ValidateGetItxPastMeetingSummaryServiceUnavailableResponseBody(body *GetItxPastMeetingSummaryServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryUnauthorizedResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary_Unauthorized_response_body
+func ValidateGetItxPastMeetingSummaryUnauthorizedResponseBody(body *GetItxPastMeetingSummaryUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryDiffBadRequestResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary-diff_BadRequest_response_body
+func ValidateGetItxPastMeetingSummaryDiffBadRequestResponseBody(body *GetItxPastMeetingSummaryDiffBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryDiffForbiddenResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary-diff_Forbidden_response_body
+func ValidateGetItxPastMeetingSummaryDiffForbiddenResponseBody(body *GetItxPastMeetingSummaryDiffForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryDiffInternalServerErrorResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary-diff_InternalServerError_response_body
+func ValidateGetItxPastMeetingSummaryDiffInternalServerErrorResponseBody(body *GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryDiffNotFoundResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary-diff_NotFound_response_body
+func ValidateGetItxPastMeetingSummaryDiffNotFoundResponseBody(body *GetItxPastMeetingSummaryDiffNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItxPastMeetingSummaryDiffServiceUnavailableResponseBody runs the
 // validations defined on
-// delete-itx-past-meeting_InternalServerError_response_body
-func ValidateDeleteItxPastMeetingInternalServerErrorResponseBody(body *DeleteItxPastMeetingInternalServerErrorResponseBody) (err error) {
+// get-itx-past-meeting-summary-diff_ServiceUnavailable_response_body
+func ValidateGetItxPastMeetingSummaryDiffServiceUnavailableResponseBody(body *GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9900,9 +13552,10 @@ func ValidateDeleteItxPastMeetingInternalServerErrorResponseBody(body *DeleteItx
 	return
 }
 
-// ValidateDeleteItxPastMeetingNotFoundResponseBody runs the validations
-// defined on delete-itx-past-meeting_NotFound_response_body
-func ValidateDeleteItxPastMeetingNotFoundResponseBody(body *DeleteItxPastMeetingNotFoundResponseBody) (err error) {
+// ValidateGetItxPastMeetingSummaryDiffUnauthorizedResponseBody runs the
+// validations defined on
+// get-itx-past-meeting-summary-diff_Unauthorized_response_body
+func ValidateGetItxPastMeetingSummaryDiffUnauthorizedResponseBody(body *GetItxPastMeetingSummaryDiffUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9912,10 +13565,10 @@ func ValidateDeleteItxPastMeetingNotFoundResponseBody(body *DeleteItxPastMeeting
 	return
 }
 
-// ValidateDeleteItxPastMeetingServiceUnavailableResponseBody runs the
+// ValidateUpdateItxPastMeetingSummaryBadRequestResponseBody runs the
 // validations defined on
-// delete-itx-past-meeting_ServiceUnavailable_response_body
-func ValidateDeleteItxPastMeetingServiceUnavailableResponseBody(body *DeleteItxPastMeetingServiceUnavailableResponseBody) (err error) {
+// update-itx-past-meeting-summary_BadRequest_response_body
+func ValidateUpdateItxPastMeetingSummaryBadRequestResponseBody(body *UpdateItxPastMeetingSummaryBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9925,9 +13578,10 @@ func ValidateDeleteItxPastMeetingServiceUnavailableResponseBody(body *DeleteItxP
 	return
 }
 
-// ValidateDeleteItxPastMeetingUnauthorizedResponseBody runs the validations
-// defined on delete-itx-past-meeting_Unauthorized_response_body
-func ValidateDeleteItxPastMeetingUnauthorizedResponseBody(body *DeleteItxPastMeetingUnauthorizedResponseBody) (err error) {
+// ValidateUpdateItxPastMeetingSummaryForbiddenResponseBody runs the
+// validations defined on
+// update-itx-past-meeting-summary_Forbidden_response_body
+func ValidateUpdateItxPastMeetingSummaryForbiddenResponseBody(body *UpdateItxPastMeetingSummaryForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9937,9 +13591,10 @@ func ValidateDeleteItxPastMeetingUnauthorizedResponseBody(body *DeleteItxPastMee
 	return
 }
 
-// ValidateUpdateItxPastMeetingBadRequestResponseBody runs the validations
-// defined on update-itx-past-meeting_BadRequest_response_body
-func ValidateUpdateItxPastMeetingBadRequestResponseBody(body *UpdateItxPastMeetingBadRequestResponseBody) (err error) {
+// ValidateUpdateItxPastMeetingSummaryInternalServerErrorResponseBody runs the
+// validations defined on
+// update-itx-past-meeting-summary_InternalServerError_response_body
+func ValidateUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(body *UpdateItxPastMeetingSummaryInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9949,9 +13604,9 @@ func ValidateUpdateItxPastMeetingBadRequestResponseBody(body *UpdateItxPastMeeti
 	return
 }
 
-// ValidateUpdateItxPastMeetingForbiddenResponseBody runs the validations
-// defined on update-itx-past-meeting_Forbidden_response_body
-func ValidateUpdateItxPastMeetingForbiddenResponseBody(body *UpdateItxPastMeetingForbiddenResponseBody) (err error) {
+// ValidateUpdateItxPastMeetingSummaryNotFoundResponseBody runs the validations
+// defined on update-itx-past-meeting-summary_NotFound_response_body
+func ValidateUpdateItxPastMeetingSummaryNotFoundResponseBody(body *UpdateItxPastMeetingSummaryNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9961,10 +13616,10 @@ func ValidateUpdateItxPastMeetingForbiddenResponseBody(body *UpdateItxPastMeetin
 	return
 }
 
-// ValidateUpdateItxPastMeetingInternalServerErrorResponseBody runs the
+// ValidateUpdateItxPastMeetingSummaryServiceUnavailableResponseBody runs the
 // validations defined on
-// update-itx-past-meeting_InternalServerError_response_body
-func ValidateUpdateItxPastMeetingInternalServerErrorResponseBody(body *UpdateItxPastMeetingInternalServerErrorResponseBody) (err error) {
+// update-itx-past-meeting-summary_ServiceUnavailable_response_body
+func ValidateUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(body *UpdateItxPastMeetingSummaryServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9974,9 +13629,10 @@ func ValidateUpdateItxPastMeetingInternalServerErrorResponseBody(body *UpdateItx
 	return
 }
 
-// ValidateUpdateItxPastMeetingNotFoundResponseBody runs the validations
-// defined on update-itx-past-meeting_NotFound_response_body
-func ValidateUpdateItxPastMeetingNotFoundResponseBody(body *UpdateItxPastMeetingNotFoundResponseBody) (err error) {
+// ValidateUpdateItxPastMeetingSummaryUnauthorizedResponseBody runs the
+// validations defined on
+// update-itx-past-meeting-summary_Unauthorized_response_body
+func ValidateUpdateItxPastMeetingSummaryUnauthorizedResponseBody(body *UpdateItxPastMeetingSummaryUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9986,10 +13642,10 @@ func ValidateUpdateItxPastMeetingNotFoundResponseBody(body *UpdateItxPastMeeting
 	return
 }
 
-// ValidateUpdateItxPastMeetingServiceUnavailableResponseBody runs the
+// ValidateApproveItxPastMeetingSummaryBadRequestResponseBody runs the
 // validations defined on
-// update-itx-past-meeting_ServiceUnavailable_response_body
-func ValidateUpdateItxPastMeetingServiceUnavailableResponseBody(body *UpdateItxPastMeetingServiceUnavailableResponseBody) (err error) {
+// approve-itx-past-meeting-summary_BadRequest_response_body
+func ValidateApproveItxPastMeetingSummaryBadRequestResponseBody(body *ApproveItxPastMeetingSummaryBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -9999,9 +13655,10 @@ func ValidateUpdateItxPastMeetingServiceUnavailableResponseBody(body *UpdateItxP
 	return
 }
 
-// ValidateUpdateItxPastMeetingUnauthorizedResponseBody runs the validations
-// defined on update-itx-past-meeting_Unauthorized_response_body
-func ValidateUpdateItxPastMeetingUnauthorizedResponseBody(body *UpdateItxPastMeetingUnauthorizedResponseBody) (err error) {
+// ValidateApproveItxPastMeetingSummaryConflictResponseBody runs the
+// validations defined on
+// approve-itx-past-meeting-summary_Conflict_response_body
+func ValidateApproveItxPastMeetingSummaryConflictResponseBody(body *ApproveItxPastMeetingSummaryConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10011,9 +13668,10 @@ func ValidateUpdateItxPastMeetingUnauthorizedResponseBody(body *UpdateItxPastMee
 	return
 }
 
-// ValidateGetItxPastMeetingSummaryBadRequestResponseBody runs the validations
-// defined on get-itx-past-meeting-summary_BadRequest_response_body
-func ValidateGetItxPastMeetingSummaryBadRequestResponseBody(body *GetItxPastMeetingSummaryBadRequestResponseBody) (err error) {
+// ValidateApproveItxPastMeetingSummaryForbiddenResponseBody runs the
+// validations defined on
+// approve-itx-past-meeting-summary_Forbidden_response_body
+func ValidateApproveItxPastMeetingSummaryForbiddenResponseBody(body *ApproveItxPastMeetingSummaryForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10023,9 +13681,10 @@ func ValidateGetItxPastMeetingSummaryBadRequestResponseBody(body *GetItxPastMeet
 	return
 }
 
-// ValidateGetItxPastMeetingSummaryForbiddenResponseBody runs the validations
-// defined on get-itx-past-meeting-summary_Forbidden_response_body
-func ValidateGetItxPastMeetingSummaryForbiddenResponseBody(body *GetItxPastMeetingSummaryForbiddenResponseBody) (err error) {
+// ValidateApproveItxPastMeetingSummaryInternalServerErrorResponseBody runs the
+// validations defined on
+// approve-itx-past-meeting-summary_InternalServerError_response_body
+func ValidateApproveItxPastMeetingSummaryInternalServerErrorResponseBody(body *ApproveItxPastMeetingSummaryInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10035,10 +13694,10 @@ func ValidateGetItxPastMeetingSummaryForbiddenResponseBody(body *GetItxPastMeeti
 	return
 }
 
-// ValidateGetItxPastMeetingSummaryInternalServerErrorResponseBody runs the
+// ValidateApproveItxPastMeetingSummaryNotFoundResponseBody runs the
 // validations defined on
-// get-itx-past-meeting-summary_InternalServerError_response_body
-func ValidateGetItxPastMeetingSummaryInternalServerErrorResponseBody(body *GetItxPastMeetingSummaryInternalServerErrorResponseBody) (err error) {
+// approve-itx-past-meeting-summary_NotFound_response_body
+func ValidateApproveItxPastMeetingSummaryNotFoundResponseBody(body *ApproveItxPastMeetingSummaryNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10048,9 +13707,10 @@ func ValidateGetItxPastMeetingSummaryInternalServerErrorResponseBody(body *GetIt
 	return
 }
 
-// ValidateGetItxPastMeetingSummaryNotFoundResponseBody runs the validations
-// defined on get-itx-past-meeting-summary_NotFound_response_body
-func ValidateGetItxPastMeetingSummaryNotFoundResponseBody(body *GetItxPastMeetingSummaryNotFoundResponseBody) (err error) {
+// ValidateApproveItxPastMeetingSummaryServiceUnavailableResponseBody runs the
+// validations defined on
+// approve-itx-past-meeting-summary_ServiceUnavailable_response_body
+func ValidateApproveItxPastMeetingSummaryServiceUnavailableResponseBody(body *ApproveItxPastMeetingSummaryServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10060,10 +13720,10 @@ func ValidateGetItxPastMeetingSummaryNotFoundResponseBody(body *GetItxPastMeetin
 	return
 }
 
-// ValidateGetItxPastMeetingSummaryServiceUnavailableResponseBody runs the
+// ValidateApproveItxPastMeetingSummaryUnauthorizedResponseBody runs the
 // validations defined on
-// get-itx-past-meeting-summary_ServiceUnavailable_response_body
-func ValidateGetItxPastMeetingSummaryServiceUnavailableResponseBody(body *GetItxPastMeetingSummaryServiceUnavailableResponseBody) (err error) {
+// approve-itx-past-meeting-summary_Unauthorized_response_body
+func ValidateApproveItxPastMeetingSummaryUnauthorizedResponseBody(body *ApproveItxPastMeetingSummaryUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10073,10 +13733,10 @@ func ValidateGetItxPastMeetingSummaryServiceUnavailableResponseBody(body *GetItx
 	return
 }
 
-// ValidateGetItxPastMeetingSummaryUnauthorizedResponseBody runs the
+// ValidateRejectItxPastMeetingSummaryBadRequestResponseBody runs the
 // validations defined on
-// get-itx-past-meeting-summary_Unauthorized_response_body
-func ValidateGetItxPastMeetingSummaryUnauthorizedResponseBody(body *GetItxPastMeetingSummaryUnauthorizedResponseBody) (err error) {
+// reject-itx-past-meeting-summary_BadRequest_response_body
+func ValidateRejectItxPastMeetingSummaryBadRequestResponseBody(body *RejectItxPastMeetingSummaryBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10086,10 +13746,9 @@ func ValidateGetItxPastMeetingSummaryUnauthorizedResponseBody(body *GetItxPastMe
 	return
 }
 
-// ValidateUpdateItxPastMeetingSummaryBadRequestResponseBody runs the
-// validations defined on
-// update-itx-past-meeting-summary_BadRequest_response_body
-func ValidateUpdateItxPastMeetingSummaryBadRequestResponseBody(body *UpdateItxPastMeetingSummaryBadRequestResponseBody) (err error) {
+// ValidateRejectItxPastMeetingSummaryConflictResponseBody runs the validations
+// defined on reject-itx-past-meeting-summary_Conflict_response_body
+func ValidateRejectItxPastMeetingSummaryConflictResponseBody(body *RejectItxPastMeetingSummaryConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10099,10 +13758,10 @@ func ValidateUpdateItxPastMeetingSummaryBadRequestResponseBody(body *UpdateItxPa
 	return
 }
 
-// ValidateUpdateItxPastMeetingSummaryForbiddenResponseBody runs the
+// ValidateRejectItxPastMeetingSummaryForbiddenResponseBody runs the
 // validations defined on
-// update-itx-past-meeting-summary_Forbidden_response_body
-func ValidateUpdateItxPastMeetingSummaryForbiddenResponseBody(body *UpdateItxPastMeetingSummaryForbiddenResponseBody) (err error) {
+// reject-itx-past-meeting-summary_Forbidden_response_body
+func ValidateRejectItxPastMeetingSummaryForbiddenResponseBody(body *RejectItxPastMeetingSummaryForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10112,10 +13771,10 @@ func ValidateUpdateItxPastMeetingSummaryForbiddenResponseBody(body *UpdateItxPas
 	return
 }
 
-// ValidateUpdateItxPastMeetingSummaryInternalServerErrorResponseBody runs the
+// ValidateRejectItxPastMeetingSummaryInternalServerErrorResponseBody runs the
 // validations defined on
-// update-itx-past-meeting-summary_InternalServerError_response_body
-func ValidateUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(body *UpdateItxPastMeetingSummaryInternalServerErrorResponseBody) (err error) {
+// reject-itx-past-meeting-summary_InternalServerError_response_body
+func ValidateRejectItxPastMeetingSummaryInternalServerErrorResponseBody(body *RejectItxPastMeetingSummaryInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10125,9 +13784,9 @@ func ValidateUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(body *Up
 	return
 }
 
-// ValidateUpdateItxPastMeetingSummaryNotFoundResponseBody runs the validations
-// defined on update-itx-past-meeting-summary_NotFound_response_body
-func ValidateUpdateItxPastMeetingSummaryNotFoundResponseBody(body *UpdateItxPastMeetingSummaryNotFoundResponseBody) (err error) {
+// ValidateRejectItxPastMeetingSummaryNotFoundResponseBody runs the validations
+// defined on reject-itx-past-meeting-summary_NotFound_response_body
+func ValidateRejectItxPastMeetingSummaryNotFoundResponseBody(body *RejectItxPastMeetingSummaryNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10137,10 +13796,10 @@ func ValidateUpdateItxPastMeetingSummaryNotFoundResponseBody(body *UpdateItxPast
 	return
 }
 
-// ValidateUpdateItxPastMeetingSummaryServiceUnavailableResponseBody runs the
+// ValidateRejectItxPastMeetingSummaryServiceUnavailableResponseBody runs the
 // validations defined on
-// update-itx-past-meeting-summary_ServiceUnavailable_response_body
-func ValidateUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(body *UpdateItxPastMeetingSummaryServiceUnavailableResponseBody) (err error) {
+// reject-itx-past-meeting-summary_ServiceUnavailable_response_body
+func ValidateRejectItxPastMeetingSummaryServiceUnavailableResponseBody(body *RejectItxPastMeetingSummaryServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10150,10 +13809,10 @@ func ValidateUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(body *Upd
 	return
 }
 
-// ValidateUpdateItxPastMeetingSummaryUnauthorizedResponseBody runs the
+// ValidateRejectItxPastMeetingSummaryUnauthorizedResponseBody runs the
 // validations defined on
-// update-itx-past-meeting-summary_Unauthorized_response_body
-func ValidateUpdateItxPastMeetingSummaryUnauthorizedResponseBody(body *UpdateItxPastMeetingSummaryUnauthorizedResponseBody) (err error) {
+// reject-itx-past-meeting-summary_Unauthorized_response_body
+func ValidateRejectItxPastMeetingSummaryUnauthorizedResponseBody(body *RejectItxPastMeetingSummaryUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -10319,6 +13978,71 @@ func ValidateUpdateItxPastMeetingParticipantUnauthorizedResponseBody(body *Updat
 	return
 }
 
+// ValidateBulkUpdateItxPastMeetingParticipantsBadRequestResponseBody runs the
+// validations defined on
+// bulk-update-itx-past-meeting-participants_BadRequest_response_body
+func ValidateBulkUpdateItxPastMeetingParticipantsBadRequestResponseBody(body *BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateBulkUpdateItxPastMeetingParticipantsForbiddenResponseBody runs the
+// validations defined on
+// bulk-update-itx-past-meeting-participants_Forbidden_response_body
+func ValidateBulkUpdateItxPastMeetingParticipantsForbiddenResponseBody(body *BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateBulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody
+// runs the validations defined on
+// bulk-update-itx-past-meeting-participants_InternalServerError_response_body
+func ValidateBulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody(body *BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateBulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody
+// runs the validations defined on
+// bulk-update-itx-past-meeting-participants_ServiceUnavailable_response_body
+func ValidateBulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody(body *BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateBulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody runs
+// the validations defined on
+// bulk-update-itx-past-meeting-participants_Unauthorized_response_body
+func ValidateBulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody(body *BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateDeleteItxPastMeetingParticipantBadRequestResponseBody runs the
 // validations defined on
 // delete-itx-past-meeting-participant_BadRequest_response_body
@@ -11319,6 +15043,113 @@ func ValidateGetItxPastMeetingAttachmentDownloadUnauthorizedResponseBody(body *G
 	return
 }
 
+// ValidateServiceEventProcessingConfigResponseBody runs the validations
+// defined on ServiceEventProcessingConfigResponseBody
+func ValidateServiceEventProcessingConfigResponseBody(body *ServiceEventProcessingConfigResponseBody) (err error) {
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.ConsumerName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("consumer_name", "body"))
+	}
+	if body.StreamName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stream_name", "body"))
+	}
+	if body.FilterSubjects == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filter_subjects", "body"))
+	}
+	if body.MaxDeliver == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_deliver", "body"))
+	}
+	if body.AckWait == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ack_wait", "body"))
+	}
+	if body.MaxAckPending == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_ack_pending", "body"))
+	}
+	if body.V1MappingsBucket == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("v1_mappings_bucket", "body"))
+	}
+	if body.BreakerThreshold == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("breaker_threshold", "body"))
+	}
+	if body.BreakerCooldown == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("breaker_cooldown", "body"))
+	}
+	return
+}
+
+// ValidateServiceInvitesConfigResponseBody runs the validations defined on
+// ServiceInvitesConfigResponseBody
+func ValidateServiceInvitesConfigResponseBody(body *ServiceInvitesConfigResponseBody) (err error) {
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.SelfServeBaseURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("self_serve_base_url", "body"))
+	}
+	return
+}
+
+// ValidateServiceITXResilienceConfigResponseBody runs the validations defined
+// on ServiceITXResilienceConfigResponseBody
+func ValidateServiceITXResilienceConfigResponseBody(body *ServiceITXResilienceConfigResponseBody) (err error) {
+	if body.MaxRetries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_retries", "body"))
+	}
+	if body.RetryBaseDelay == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("retry_base_delay", "body"))
+	}
+	if body.RetryMaxDelay == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("retry_max_delay", "body"))
+	}
+	if body.AttemptTimeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempt_timeout", "body"))
+	}
+	if body.EndpointAttemptTimeouts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_attempt_timeouts", "body"))
+	}
+	if body.BreakerThreshold == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("breaker_threshold", "body"))
+	}
+	if body.BreakerCooldown == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("breaker_cooldown", "body"))
+	}
+	return
+}
+
+// ValidateServiceAttachmentsConfigResponseBody runs the validations defined on
+// ServiceAttachmentsConfigResponseBody
+func ValidateServiceAttachmentsConfigResponseBody(body *ServiceAttachmentsConfigResponseBody) (err error) {
+	if body.MaxFileSize == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_file_size", "body"))
+	}
+	if body.AllowedContentTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("allowed_content_types", "body"))
+	}
+	return
+}
+
+// ValidateDependencyHealthResponseBody runs the validations defined on
+// DependencyHealthResponseBody
+func ValidateDependencyHealthResponseBody(body *DependencyHealthResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.LatencyMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("latency_ms", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "ok" || *body.Status == "error") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"ok", "error"}))
+		}
+	}
+	return
+}
+
 // ValidateCommitteeRequestBody runs the validations defined on
 // CommitteeRequestBody
 func ValidateCommitteeRequestBody(body *CommitteeRequestBody) (err error) {
@@ -11341,6 +15172,11 @@ func ValidateRecurrenceRequestBody(body *RecurrenceRequestBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{1, 2, 3}))
 		}
 	}
+	if body.EndTimes != nil {
+		if *body.EndTimes > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.end_times", *body.EndTimes, 1000, false))
+		}
+	}
 	if body.EndDateTime != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.end_date_time", *body.EndDateTime, goa.FormatDateTime))
 	}
@@ -11369,6 +15205,11 @@ func ValidateRecurrenceResponseBody(body *RecurrenceResponseBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{1, 2, 3}))
 		}
 	}
+	if body.EndTimes != nil {
+		if *body.EndTimes > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.end_times", *body.EndTimes, 1000, false))
+		}
+	}
 	if body.EndDateTime != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.end_date_time", *body.EndDateTime, goa.FormatDateTime))
 	}
@@ -11412,6 +15253,44 @@ func ValidateITXUserResponseBody(body *ITXUserResponseBody) (err error) {
 	return
 }
 
+// ValidateITXLocalOccurrenceResponseBody runs the validations defined on
+// ITXLocalOccurrenceResponseBody
+func ValidateITXLocalOccurrenceResponseBody(body *ITXLocalOccurrenceResponseBody) (err error) {
+	if body.OccurrenceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("occurrence_id", "body"))
+	}
+	if body.StartTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start_time", "body"))
+	}
+	if body.LocalStartTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("local_start_time", "body"))
+	}
+	if body.LocalEndTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("local_end_time", "body"))
+	}
+	if body.Duration == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duration", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+	}
+	if body.LocalStartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.local_start_time", *body.LocalStartTime, goa.FormatDateTime))
+	}
+	if body.LocalEndTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.local_end_time", *body.LocalEndTime, goa.FormatDateTime))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "available" || *body.Status == "cancel") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"available", "cancel"}))
+		}
+	}
+	return
+}
+
 // ValidateSummaryDataResponseBody runs the validations defined on
 // SummaryDataResponseBody
 func ValidateSummaryDataResponseBody(body *SummaryDataResponseBody) (err error) {
@@ -11430,6 +15309,23 @@ func ValidateSummaryDataResponseBody(body *SummaryDataResponseBody) (err error)
 	return
 }
 
+// ValidateSummaryDiffLineResponseBody runs the validations defined on
+// SummaryDiffLineResponseBody
+func ValidateSummaryDiffLineResponseBody(body *SummaryDiffLineResponseBody) (err error) {
+	if body.Operation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operation", "body"))
+	}
+	if body.Text == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("text", "body"))
+	}
+	if body.Operation != nil {
+		if !(*body.Operation == "equal" || *body.Operation == "insert" || *body.Operation == "delete") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", *body.Operation, []any{"equal", "insert", "delete"}))
+		}
+	}
+	return
+}
+
 // ValidateParticipantSessionRequestBody runs the validations defined on
 // ParticipantSessionRequestBody
 func ValidateParticipantSessionRequestBody(body *ParticipantSessionRequestBody) (err error) {
@@ -11453,3 +15349,41 @@ func ValidateParticipantSessionResponseBody(body *ParticipantSessionResponseBody
 	}
 	return
 }
+
+// ValidateITXPastMeetingParticipantResponseBody runs the validations defined
+// on ITXPastMeetingParticipantResponseBody
+func ValidateITXPastMeetingParticipantResponseBody(body *ITXPastMeetingParticipantResponseBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.CommitteeID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_id", *body.CommitteeID, goa.FormatUUID))
+	}
+	if body.AvatarURL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.avatar_url", *body.AvatarURL, goa.FormatURI))
+	}
+	for _, e := range body.Sessions {
+		if e != nil {
+			if err2 := ValidateParticipantSessionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.CreatedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.CreatedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ModifiedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_at", *body.ModifiedAt, goa.FormatDateTime))
+	}
+	if body.ModifiedBy != nil {
+		if err2 := ValidateITXUserResponseBody(body.ModifiedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
@@ -75,6 +75,244 @@ func EncodeLivezResponse(encoder func(context.Context, http.ResponseWriter) goah
 	}
 }
 
+// EncodeGetServiceConfigResponse returns an encoder for responses returned by
+// the Meeting Service get-service-config endpoint.
+func EncodeGetServiceConfigResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ServiceConfig)
+		enc := encoder(ctx, w)
+		body := NewGetServiceConfigResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetServiceConfigRequest returns a decoder for requests sent to the
+// Meeting Service get-service-config endpoint.
+func DecodeGetServiceConfigRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetServiceConfigPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetServiceConfigPayload, error) {
+		var payload *meetingservice.GetServiceConfigPayload
+		var (
+			version     *string
+			bearerToken *string
+			err         error
+		)
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewGetServiceConfigPayload(version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetServiceConfigError returns an encoder for errors returned by the
+// get-service-config Meeting Service endpoint.
+func EncodeGetServiceConfigError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceConfigBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceConfigForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceConfigServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceConfigUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetServiceHealthResponse returns an encoder for responses returned by
+// the Meeting Service get-service-health endpoint.
+func EncodeGetServiceHealthResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ServiceHealth)
+		enc := encoder(ctx, w)
+		body := NewGetServiceHealthResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetServiceHealthRequest returns a decoder for requests sent to the
+// Meeting Service get-service-health endpoint.
+func DecodeGetServiceHealthRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetServiceHealthPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetServiceHealthPayload, error) {
+		var payload *meetingservice.GetServiceHealthPayload
+		var (
+			version     *string
+			bearerToken *string
+			err         error
+		)
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewGetServiceHealthPayload(version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetServiceHealthError returns an encoder for errors returned by the
+// get-service-health Meeting Service endpoint.
+func EncodeGetServiceHealthError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceHealthBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceHealthForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceHealthServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetServiceHealthUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeCreateItxMeetingResponse returns an encoder for responses returned by
 // the Meeting Service create-itx-meeting endpoint.
 func EncodeCreateItxMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -545,22 +783,25 @@ func EncodeDeleteItxMeetingError(encoder func(context.Context, http.ResponseWrit
 	}
 }
 
-// EncodeUpdateItxMeetingResponse returns an encoder for responses returned by
-// the Meeting Service update-itx-meeting endpoint.
-func EncodeUpdateItxMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCloneItxMeetingResponse returns an encoder for responses returned by
+// the Meeting Service clone-itx-meeting endpoint.
+func EncodeCloneItxMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*meetingservice.ITXZoomMeetingResponse)
+		enc := encoder(ctx, w)
+		body := NewCloneItxMeetingResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateItxMeetingRequest returns a decoder for requests sent to the
-// Meeting Service update-itx-meeting endpoint.
-func DecodeUpdateItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxMeetingPayload, error) {
-	return func(r *http.Request) (*meetingservice.UpdateItxMeetingPayload, error) {
-		var payload *meetingservice.UpdateItxMeetingPayload
+// DecodeCloneItxMeetingRequest returns a decoder for requests sent to the
+// Meeting Service clone-itx-meeting endpoint.
+func DecodeCloneItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.CloneItxMeetingPayload, error) {
+	return func(r *http.Request) (*meetingservice.CloneItxMeetingPayload, error) {
+		var payload *meetingservice.CloneItxMeetingPayload
 		var (
-			body UpdateItxMeetingRequestBody
+			body CloneItxMeetingRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -574,7 +815,7 @@ func DecodeUpdateItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateUpdateItxMeetingRequestBody(&body)
+		err = ValidateCloneItxMeetingRequestBody(&body)
 		if err != nil {
 			return payload, err
 		}
@@ -583,7 +824,6 @@ func DecodeUpdateItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request
 			meetingID   string
 			version     *string
 			bearerToken *string
-			xSync       *bool
 
 			params = mux.Vars(r)
 		)
@@ -601,20 +841,10 @@ func DecodeUpdateItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		{
-			xSyncRaw := r.Header.Get("X-Sync")
-			if xSyncRaw != "" {
-				v, err2 := strconv.ParseBool(xSyncRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
-				}
-				xSync = &v
-			}
-		}
 		if err != nil {
 			return payload, err
 		}
-		payload = NewUpdateItxMeetingPayload(&body, meetingID, version, bearerToken, xSync)
+		payload = NewCloneItxMeetingPayload(&body, meetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -627,9 +857,9 @@ func DecodeUpdateItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request
 	}
 }
 
-// EncodeUpdateItxMeetingError returns an encoder for errors returned by the
-// update-itx-meeting Meeting Service endpoint.
-func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCloneItxMeetingError returns an encoder for errors returned by the
+// clone-itx-meeting Meeting Service endpoint.
+func EncodeCloneItxMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -645,7 +875,7 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxMeetingBadRequestResponseBody(res)
+				body = NewCloneItxMeetingBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -658,7 +888,7 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxMeetingConflictResponseBody(res)
+				body = NewCloneItxMeetingConflictResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -671,7 +901,7 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxMeetingForbiddenResponseBody(res)
+				body = NewCloneItxMeetingForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -684,7 +914,7 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxMeetingInternalServerErrorResponseBody(res)
+				body = NewCloneItxMeetingInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -697,7 +927,7 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxMeetingNotFoundResponseBody(res)
+				body = NewCloneItxMeetingNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -710,7 +940,7 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxMeetingServiceUnavailableResponseBody(res)
+				body = NewCloneItxMeetingServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -723,7 +953,7 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxMeetingUnauthorizedResponseBody(res)
+				body = NewCloneItxMeetingUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -734,21 +964,210 @@ func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWrit
 	}
 }
 
-// EncodeGetItxMeetingCountResponse returns an encoder for responses returned
-// by the Meeting Service get-itx-meeting-count endpoint.
-func EncodeGetItxMeetingCountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUpdateItxMeetingResponse returns an encoder for responses returned by
+// the Meeting Service update-itx-meeting endpoint.
+func EncodeUpdateItxMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.ITXMeetingCountResponse)
-		enc := encoder(ctx, w)
-		body := NewGetItxMeetingCountResponseBody(res)
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// DecodeGetItxMeetingCountRequest returns a decoder for requests sent to the
-// Meeting Service get-itx-meeting-count endpoint.
-func DecodeGetItxMeetingCountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxMeetingCountPayload, error) {
+// DecodeUpdateItxMeetingRequest returns a decoder for requests sent to the
+// Meeting Service update-itx-meeting endpoint.
+func DecodeUpdateItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxMeetingPayload, error) {
+	return func(r *http.Request) (*meetingservice.UpdateItxMeetingPayload, error) {
+		var payload *meetingservice.UpdateItxMeetingPayload
+		var (
+			body UpdateItxMeetingRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateItxMeetingRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			meetingID   string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewUpdateItxMeetingPayload(&body, meetingID, version, bearerToken, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateItxMeetingError returns an encoder for errors returned by the
+// update-itx-meeting Meeting Service endpoint.
+func EncodeUpdateItxMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxMeetingBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *meetingservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxMeetingConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxMeetingForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxMeetingInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxMeetingNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxMeetingServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxMeetingUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetItxMeetingCountResponse returns an encoder for responses returned
+// by the Meeting Service get-itx-meeting-count endpoint.
+func EncodeGetItxMeetingCountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ITXMeetingCountResponse)
+		enc := encoder(ctx, w)
+		body := NewGetItxMeetingCountResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetItxMeetingCountRequest returns a decoder for requests sent to the
+// Meeting Service get-itx-meeting-count endpoint.
+func DecodeGetItxMeetingCountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxMeetingCountPayload, error) {
 	return func(r *http.Request) (*meetingservice.GetItxMeetingCountPayload, error) {
 		var payload *meetingservice.GetItxMeetingCountPayload
 		var (
@@ -757,8 +1176,1366 @@ func DecodeGetItxMeetingCountRequest(mux goahttp.Muxer, decoder func(*http.Reque
 			bearerToken *string
 			err         error
 		)
-		qp := r.URL.Query()
-		versionRaw := qp.Get("v")
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		projectUID = qp.Get("project_uid")
+		if projectUID == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewGetItxMeetingCountPayload(version, projectUID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetItxMeetingCountError returns an encoder for errors returned by the
+// get-itx-meeting-count Meeting Service endpoint.
+func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxMeetingCountBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxMeetingCountForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxMeetingCountInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxMeetingCountNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxMeetingCountServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxMeetingCountUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePreviewItxMeetingOccurrencesResponse returns an encoder for responses
+// returned by the Meeting Service preview-itx-meeting-occurrences endpoint.
+func EncodePreviewItxMeetingOccurrencesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ITXOccurrencePreviewResponse)
+		enc := encoder(ctx, w)
+		body := NewPreviewItxMeetingOccurrencesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePreviewItxMeetingOccurrencesRequest returns a decoder for requests
+// sent to the Meeting Service preview-itx-meeting-occurrences endpoint.
+func DecodePreviewItxMeetingOccurrencesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.PreviewItxMeetingOccurrencesPayload, error) {
+	return func(r *http.Request) (*meetingservice.PreviewItxMeetingOccurrencesPayload, error) {
+		var payload *meetingservice.PreviewItxMeetingOccurrencesPayload
+		var (
+			body PreviewItxMeetingOccurrencesRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePreviewItxMeetingOccurrencesRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			version     *string
+			bearerToken *string
+		)
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewPreviewItxMeetingOccurrencesPayload(&body, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodePreviewItxMeetingOccurrencesError returns an encoder for errors
+// returned by the preview-itx-meeting-occurrences Meeting Service endpoint.
+func EncodePreviewItxMeetingOccurrencesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPreviewItxMeetingOccurrencesBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPreviewItxMeetingOccurrencesInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPreviewItxMeetingOccurrencesUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateItxRegistrantResponse returns an encoder for responses returned
+// by the Meeting Service create-itx-registrant endpoint.
+func EncodeCreateItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ITXZoomMeetingRegistrant)
+		enc := encoder(ctx, w)
+		body := NewCreateItxRegistrantResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateItxRegistrantRequest returns a decoder for requests sent to the
+// Meeting Service create-itx-registrant endpoint.
+func DecodeCreateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.CreateItxRegistrantPayload, error) {
+	return func(r *http.Request) (*meetingservice.CreateItxRegistrantPayload, error) {
+		var payload *meetingservice.CreateItxRegistrantPayload
+		var (
+			body CreateItxRegistrantRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateItxRegistrantRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			meetingID   string
+			version     *string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewCreateItxRegistrantPayload(&body, meetingID, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateItxRegistrantError returns an encoder for errors returned by the
+// create-itx-registrant Meeting Service endpoint.
+func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxRegistrantBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *meetingservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxRegistrantConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxRegistrantForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxRegistrantInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxRegistrantNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxRegistrantServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxRegistrantUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetItxRegistrantResponse returns an encoder for responses returned by
+// the Meeting Service get-itx-registrant endpoint.
+func EncodeGetItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ITXZoomMeetingRegistrant)
+		enc := encoder(ctx, w)
+		body := NewGetItxRegistrantResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetItxRegistrantRequest returns a decoder for requests sent to the
+// Meeting Service get-itx-registrant endpoint.
+func DecodeGetItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxRegistrantPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetItxRegistrantPayload, error) {
+		var payload *meetingservice.GetItxRegistrantPayload
+		var (
+			meetingID    string
+			registrantID string
+			version      *string
+			bearerToken  *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		registrantID = params["registrant_id"]
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewGetItxRegistrantPayload(meetingID, registrantID, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetItxRegistrantError returns an encoder for errors returned by the
+// get-itx-registrant Meeting Service endpoint.
+func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxRegistrantBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxRegistrantForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxRegistrantInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxRegistrantNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxRegistrantServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxRegistrantUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateItxRegistrantResponse returns an encoder for responses returned
+// by the Meeting Service update-itx-registrant endpoint.
+func EncodeUpdateItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeUpdateItxRegistrantRequest returns a decoder for requests sent to the
+// Meeting Service update-itx-registrant endpoint.
+func DecodeUpdateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxRegistrantPayload, error) {
+	return func(r *http.Request) (*meetingservice.UpdateItxRegistrantPayload, error) {
+		var payload *meetingservice.UpdateItxRegistrantPayload
+		var (
+			body UpdateItxRegistrantRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateItxRegistrantRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			meetingID    string
+			registrantID string
+			version      *string
+			bearerToken  *string
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		registrantID = params["registrant_id"]
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewUpdateItxRegistrantPayload(&body, meetingID, registrantID, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateItxRegistrantError returns an encoder for errors returned by the
+// update-itx-registrant Meeting Service endpoint.
+func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxRegistrantBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxRegistrantForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxRegistrantInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxRegistrantNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxRegistrantServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItxRegistrantUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteItxRegistrantResponse returns an encoder for responses returned
+// by the Meeting Service delete-itx-registrant endpoint.
+func EncodeDeleteItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteItxRegistrantRequest returns a decoder for requests sent to the
+// Meeting Service delete-itx-registrant endpoint.
+func DecodeDeleteItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.DeleteItxRegistrantPayload, error) {
+	return func(r *http.Request) (*meetingservice.DeleteItxRegistrantPayload, error) {
+		var payload *meetingservice.DeleteItxRegistrantPayload
+		var (
+			meetingID    string
+			registrantID string
+			version      *string
+			bearerToken  *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		registrantID = params["registrant_id"]
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewDeleteItxRegistrantPayload(meetingID, registrantID, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteItxRegistrantError returns an encoder for errors returned by the
+// delete-itx-registrant Meeting Service endpoint.
+func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteItxRegistrantBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteItxRegistrantForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteItxRegistrantInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteItxRegistrantNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteItxRegistrantServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteItxRegistrantUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetItxJoinLinkResponse returns an encoder for responses returned by
+// the Meeting Service get-itx-join-link endpoint.
+func EncodeGetItxJoinLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ITXZoomMeetingJoinLink)
+		enc := encoder(ctx, w)
+		body := NewGetItxJoinLinkResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetItxJoinLinkRequest returns a decoder for requests sent to the
+// Meeting Service get-itx-join-link endpoint.
+func DecodeGetItxJoinLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxJoinLinkPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetItxJoinLinkPayload, error) {
+		var payload *meetingservice.GetItxJoinLinkPayload
+		var (
+			meetingID    string
+			version      *string
+			useEmail     *bool
+			userID       *string
+			name         *string
+			email        *string
+			register     *bool
+			occurrenceID *string
+			bearerToken  *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		{
+			useEmailRaw := qp.Get("use_email")
+			if useEmailRaw != "" {
+				v, err2 := strconv.ParseBool(useEmailRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("use_email", useEmailRaw, "boolean"))
+				}
+				useEmail = &v
+			}
+		}
+		userIDRaw := qp.Get("user_id")
+		if userIDRaw != "" {
+			userID = &userIDRaw
+		}
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		emailRaw := qp.Get("email")
+		if emailRaw != "" {
+			email = &emailRaw
+		}
+		if email != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("email", *email, goa.FormatEmail))
+		}
+		{
+			registerRaw := qp.Get("register")
+			if registerRaw != "" {
+				v, err2 := strconv.ParseBool(registerRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("register", registerRaw, "boolean"))
+				}
+				register = &v
+			}
+		}
+		occurrenceIDRaw := qp.Get("occurrence_id")
+		if occurrenceIDRaw != "" {
+			occurrenceID = &occurrenceIDRaw
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewGetItxJoinLinkPayload(meetingID, version, useEmail, userID, name, email, register, occurrenceID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetItxJoinLinkError returns an encoder for errors returned by the
+// get-itx-join-link Meeting Service endpoint.
+func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxJoinLinkBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *meetingservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxJoinLinkConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxJoinLinkForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxJoinLinkInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxJoinLinkNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxJoinLinkServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItxJoinLinkUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeLaunchItxMeetingResponse returns an encoder for responses returned by
+// the Meeting Service launch-itx-meeting endpoint.
+func EncodeLaunchItxMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.LaunchItxMeetingResult)
+		w.Header().Set("Location", res.Location)
+		w.WriteHeader(http.StatusFound)
+		return nil
+	}
+}
+
+// DecodeLaunchItxMeetingRequest returns a decoder for requests sent to the
+// Meeting Service launch-itx-meeting endpoint.
+func DecodeLaunchItxMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.LaunchItxMeetingPayload, error) {
+	return func(r *http.Request) (*meetingservice.LaunchItxMeetingPayload, error) {
+		var payload *meetingservice.LaunchItxMeetingPayload
+		var (
+			meetingID    string
+			version      *string
+			useEmail     *bool
+			userID       *string
+			name         *string
+			email        *string
+			register     *bool
+			occurrenceID *string
+			client       string
+			bearerToken  *string
+			userAgent    *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		{
+			useEmailRaw := qp.Get("use_email")
+			if useEmailRaw != "" {
+				v, err2 := strconv.ParseBool(useEmailRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("use_email", useEmailRaw, "boolean"))
+				}
+				useEmail = &v
+			}
+		}
+		userIDRaw := qp.Get("user_id")
+		if userIDRaw != "" {
+			userID = &userIDRaw
+		}
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		emailRaw := qp.Get("email")
+		if emailRaw != "" {
+			email = &emailRaw
+		}
+		if email != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("email", *email, goa.FormatEmail))
+		}
+		{
+			registerRaw := qp.Get("register")
+			if registerRaw != "" {
+				v, err2 := strconv.ParseBool(registerRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("register", registerRaw, "boolean"))
+				}
+				register = &v
+			}
+		}
+		occurrenceIDRaw := qp.Get("occurrence_id")
+		if occurrenceIDRaw != "" {
+			occurrenceID = &occurrenceIDRaw
+		}
+		clientRaw := qp.Get("client")
+		if clientRaw != "" {
+			client = clientRaw
+		} else {
+			client = "auto"
+		}
+		if !(client == "auto" || client == "native" || client == "web") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("client", client, []any{"auto", "native", "web"}))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		userAgentRaw := r.Header.Get("User-Agent")
+		if userAgentRaw != "" {
+			userAgent = &userAgentRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewLaunchItxMeetingPayload(meetingID, version, useEmail, userID, name, email, register, occurrenceID, client, bearerToken, userAgent)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeLaunchItxMeetingError returns an encoder for errors returned by the
+// launch-itx-meeting Meeting Service endpoint.
+func EncodeLaunchItxMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLaunchItxMeetingBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *meetingservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLaunchItxMeetingConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLaunchItxMeetingForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLaunchItxMeetingInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLaunchItxMeetingNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLaunchItxMeetingServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLaunchItxMeetingUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetItxRegistrantIcsResponse returns an encoder for responses returned
+// by the Meeting Service get-itx-registrant-ics endpoint.
+func EncodeGetItxRegistrantIcsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]byte)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/calendar")
+		enc := encoder(ctx, w)
+		body := res
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetItxRegistrantIcsRequest returns a decoder for requests sent to the
+// Meeting Service get-itx-registrant-ics endpoint.
+func DecodeGetItxRegistrantIcsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxRegistrantIcsPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetItxRegistrantIcsPayload, error) {
+		var payload *meetingservice.GetItxRegistrantIcsPayload
+		var (
+			meetingID    string
+			registrantID string
+			version      *string
+			bearerToken  *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		registrantID = params["registrant_id"]
+		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
 		}
@@ -767,10 +2544,6 @@ func DecodeGetItxMeetingCountRequest(mux goahttp.Muxer, decoder func(*http.Reque
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
 		}
-		projectUID = qp.Get("project_uid")
-		if projectUID == "" {
-			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
-		}
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
@@ -778,7 +2551,7 @@ func DecodeGetItxMeetingCountRequest(mux goahttp.Muxer, decoder func(*http.Reque
 		if err != nil {
 			return payload, err
 		}
-		payload = NewGetItxMeetingCountPayload(version, projectUID, bearerToken)
+		payload = NewGetItxRegistrantIcsPayload(meetingID, registrantID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -791,9 +2564,9 @@ func DecodeGetItxMeetingCountRequest(mux goahttp.Muxer, decoder func(*http.Reque
 	}
 }
 
-// EncodeGetItxMeetingCountError returns an encoder for errors returned by the
-// get-itx-meeting-count Meeting Service endpoint.
-func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetItxRegistrantIcsError returns an encoder for errors returned by the
+// get-itx-registrant-ics Meeting Service endpoint.
+func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -809,7 +2582,7 @@ func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxMeetingCountBadRequestResponseBody(res)
+				body = NewGetItxRegistrantIcsBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -822,7 +2595,7 @@ func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxMeetingCountForbiddenResponseBody(res)
+				body = NewGetItxRegistrantIcsForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -835,7 +2608,7 @@ func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxMeetingCountInternalServerErrorResponseBody(res)
+				body = NewGetItxRegistrantIcsInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -848,7 +2621,7 @@ func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxMeetingCountNotFoundResponseBody(res)
+				body = NewGetItxRegistrantIcsNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -861,7 +2634,7 @@ func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxMeetingCountServiceUnavailableResponseBody(res)
+				body = NewGetItxRegistrantIcsServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -874,7 +2647,7 @@ func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxMeetingCountUnauthorizedResponseBody(res)
+				body = NewGetItxRegistrantIcsUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -885,51 +2658,34 @@ func EncodeGetItxMeetingCountError(encoder func(context.Context, http.ResponseWr
 	}
 }
 
-// EncodeCreateItxRegistrantResponse returns an encoder for responses returned
-// by the Meeting Service create-itx-registrant endpoint.
-func EncodeCreateItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeAddItxRegistrantHostResponse returns an encoder for responses returned
+// by the Meeting Service add-itx-registrant-host endpoint.
+func EncodeAddItxRegistrantHostResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		res, _ := v.(*meetingservice.ITXZoomMeetingRegistrant)
 		enc := encoder(ctx, w)
-		body := NewCreateItxRegistrantResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewAddItxRegistrantHostResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCreateItxRegistrantRequest returns a decoder for requests sent to the
-// Meeting Service create-itx-registrant endpoint.
-func DecodeCreateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.CreateItxRegistrantPayload, error) {
-	return func(r *http.Request) (*meetingservice.CreateItxRegistrantPayload, error) {
-		var payload *meetingservice.CreateItxRegistrantPayload
-		var (
-			body CreateItxRegistrantRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return payload, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return payload, gerr
-			}
-			return payload, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateCreateItxRegistrantRequestBody(&body)
-		if err != nil {
-			return payload, err
-		}
-
+// DecodeAddItxRegistrantHostRequest returns a decoder for requests sent to the
+// Meeting Service add-itx-registrant-host endpoint.
+func DecodeAddItxRegistrantHostRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.AddItxRegistrantHostPayload, error) {
+	return func(r *http.Request) (*meetingservice.AddItxRegistrantHostPayload, error) {
+		var payload *meetingservice.AddItxRegistrantHostPayload
 		var (
-			meetingID   string
-			version     *string
-			bearerToken *string
+			meetingID    string
+			registrantID string
+			version      *string
+			bearerToken  *string
+			err          error
 
 			params = mux.Vars(r)
 		)
 		meetingID = params["meeting_id"]
+		registrantID = params["registrant_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -946,7 +2702,7 @@ func DecodeCreateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		if err != nil {
 			return payload, err
 		}
-		payload = NewCreateItxRegistrantPayload(&body, meetingID, version, bearerToken)
+		payload = NewAddItxRegistrantHostPayload(meetingID, registrantID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -959,9 +2715,9 @@ func DecodeCreateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeCreateItxRegistrantError returns an encoder for errors returned by the
-// create-itx-registrant Meeting Service endpoint.
-func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeAddItxRegistrantHostError returns an encoder for errors returned by
+// the add-itx-registrant-host Meeting Service endpoint.
+func EncodeAddItxRegistrantHostError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -977,23 +2733,161 @@ func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxRegistrantBadRequestResponseBody(res)
+				body = NewAddItxRegistrantHostBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "Conflict":
-			var res *meetingservice.ConflictError
+		case "Forbidden":
+			var res *meetingservice.ForbiddenError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxRegistrantConflictResponseBody(res)
+				body = NewAddItxRegistrantHostForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *meetingservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddItxRegistrantHostInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *meetingservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddItxRegistrantHostNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *meetingservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddItxRegistrantHostServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *meetingservice.UnauthorizedError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddItxRegistrantHostUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRemoveItxRegistrantHostResponse returns an encoder for responses
+// returned by the Meeting Service remove-itx-registrant-host endpoint.
+func EncodeRemoveItxRegistrantHostResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.ITXZoomMeetingRegistrant)
+		enc := encoder(ctx, w)
+		body := NewRemoveItxRegistrantHostResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRemoveItxRegistrantHostRequest returns a decoder for requests sent to
+// the Meeting Service remove-itx-registrant-host endpoint.
+func DecodeRemoveItxRegistrantHostRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.RemoveItxRegistrantHostPayload, error) {
+	return func(r *http.Request) (*meetingservice.RemoveItxRegistrantHostPayload, error) {
+		var payload *meetingservice.RemoveItxRegistrantHostPayload
+		var (
+			meetingID    string
+			registrantID string
+			version      *string
+			bearerToken  *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		meetingID = params["meeting_id"]
+		registrantID = params["registrant_id"]
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewRemoveItxRegistrantHostPayload(meetingID, registrantID, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRemoveItxRegistrantHostError returns an encoder for errors returned by
+// the remove-itx-registrant-host Meeting Service endpoint.
+func EncodeRemoveItxRegistrantHostError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *meetingservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveItxRegistrantHostBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
 		case "Forbidden":
 			var res *meetingservice.ForbiddenError
@@ -1003,7 +2897,7 @@ func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxRegistrantForbiddenResponseBody(res)
+				body = NewRemoveItxRegistrantHostForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -1016,7 +2910,7 @@ func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxRegistrantInternalServerErrorResponseBody(res)
+				body = NewRemoveItxRegistrantHostInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1029,7 +2923,7 @@ func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxRegistrantNotFoundResponseBody(res)
+				body = NewRemoveItxRegistrantHostNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1042,7 +2936,7 @@ func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxRegistrantServiceUnavailableResponseBody(res)
+				body = NewRemoveItxRegistrantHostServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1055,7 +2949,7 @@ func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxRegistrantUnauthorizedResponseBody(res)
+				body = NewRemoveItxRegistrantHostUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -1066,23 +2960,20 @@ func EncodeCreateItxRegistrantError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeGetItxRegistrantResponse returns an encoder for responses returned by
-// the Meeting Service get-itx-registrant endpoint.
-func EncodeGetItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeResendItxRegistrantInvitationResponse returns an encoder for responses
+// returned by the Meeting Service resend-itx-registrant-invitation endpoint.
+func EncodeResendItxRegistrantInvitationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.ITXZoomMeetingRegistrant)
-		enc := encoder(ctx, w)
-		body := NewGetItxRegistrantResponseBody(res)
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// DecodeGetItxRegistrantRequest returns a decoder for requests sent to the
-// Meeting Service get-itx-registrant endpoint.
-func DecodeGetItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxRegistrantPayload, error) {
-	return func(r *http.Request) (*meetingservice.GetItxRegistrantPayload, error) {
-		var payload *meetingservice.GetItxRegistrantPayload
+// DecodeResendItxRegistrantInvitationRequest returns a decoder for requests
+// sent to the Meeting Service resend-itx-registrant-invitation endpoint.
+func DecodeResendItxRegistrantInvitationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.ResendItxRegistrantInvitationPayload, error) {
+	return func(r *http.Request) (*meetingservice.ResendItxRegistrantInvitationPayload, error) {
+		var payload *meetingservice.ResendItxRegistrantInvitationPayload
 		var (
 			meetingID    string
 			registrantID string
@@ -1110,7 +3001,7 @@ func DecodeGetItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request
 		if err != nil {
 			return payload, err
 		}
-		payload = NewGetItxRegistrantPayload(meetingID, registrantID, version, bearerToken)
+		payload = NewResendItxRegistrantInvitationPayload(meetingID, registrantID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1123,9 +3014,9 @@ func DecodeGetItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request
 	}
 }
 
-// EncodeGetItxRegistrantError returns an encoder for errors returned by the
-// get-itx-registrant Meeting Service endpoint.
-func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeResendItxRegistrantInvitationError returns an encoder for errors
+// returned by the resend-itx-registrant-invitation Meeting Service endpoint.
+func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1141,7 +3032,7 @@ func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantBadRequestResponseBody(res)
+				body = NewResendItxRegistrantInvitationBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1154,7 +3045,7 @@ func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantForbiddenResponseBody(res)
+				body = NewResendItxRegistrantInvitationForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -1167,7 +3058,7 @@ func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantInternalServerErrorResponseBody(res)
+				body = NewResendItxRegistrantInvitationInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1180,7 +3071,7 @@ func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantNotFoundResponseBody(res)
+				body = NewResendItxRegistrantInvitationNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1193,7 +3084,7 @@ func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantServiceUnavailableResponseBody(res)
+				body = NewResendItxRegistrantInvitationServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1206,7 +3097,7 @@ func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantUnauthorizedResponseBody(res)
+				body = NewResendItxRegistrantInvitationUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -1217,22 +3108,22 @@ func EncodeGetItxRegistrantError(encoder func(context.Context, http.ResponseWrit
 	}
 }
 
-// EncodeUpdateItxRegistrantResponse returns an encoder for responses returned
-// by the Meeting Service update-itx-registrant endpoint.
-func EncodeUpdateItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeResendItxMeetingInvitationsResponse returns an encoder for responses
+// returned by the Meeting Service resend-itx-meeting-invitations endpoint.
+func EncodeResendItxMeetingInvitationsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		w.WriteHeader(http.StatusNoContent)
 		return nil
 	}
 }
 
-// DecodeUpdateItxRegistrantRequest returns a decoder for requests sent to the
-// Meeting Service update-itx-registrant endpoint.
-func DecodeUpdateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxRegistrantPayload, error) {
-	return func(r *http.Request) (*meetingservice.UpdateItxRegistrantPayload, error) {
-		var payload *meetingservice.UpdateItxRegistrantPayload
+// DecodeResendItxMeetingInvitationsRequest returns a decoder for requests sent
+// to the Meeting Service resend-itx-meeting-invitations endpoint.
+func DecodeResendItxMeetingInvitationsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.ResendItxMeetingInvitationsPayload, error) {
+	return func(r *http.Request) (*meetingservice.ResendItxMeetingInvitationsPayload, error) {
+		var payload *meetingservice.ResendItxMeetingInvitationsPayload
 		var (
-			body UpdateItxRegistrantRequestBody
+			body ResendItxMeetingInvitationsRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -1246,21 +3137,15 @@ func DecodeUpdateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Requ
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateUpdateItxRegistrantRequestBody(&body)
-		if err != nil {
-			return payload, err
-		}
 
 		var (
-			meetingID    string
-			registrantID string
-			version      *string
-			bearerToken  *string
+			meetingID   string
+			version     *string
+			bearerToken *string
 
 			params = mux.Vars(r)
 		)
 		meetingID = params["meeting_id"]
-		registrantID = params["registrant_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1277,7 +3162,7 @@ func DecodeUpdateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		if err != nil {
 			return payload, err
 		}
-		payload = NewUpdateItxRegistrantPayload(&body, meetingID, registrantID, version, bearerToken)
+		payload = NewResendItxMeetingInvitationsPayload(&body, meetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1290,9 +3175,9 @@ func DecodeUpdateItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeUpdateItxRegistrantError returns an encoder for errors returned by the
-// update-itx-registrant Meeting Service endpoint.
-func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeResendItxMeetingInvitationsError returns an encoder for errors
+// returned by the resend-itx-meeting-invitations Meeting Service endpoint.
+func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1308,7 +3193,7 @@ func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxRegistrantBadRequestResponseBody(res)
+				body = NewResendItxMeetingInvitationsBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1321,7 +3206,7 @@ func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxRegistrantForbiddenResponseBody(res)
+				body = NewResendItxMeetingInvitationsForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -1334,7 +3219,7 @@ func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxRegistrantInternalServerErrorResponseBody(res)
+				body = NewResendItxMeetingInvitationsInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1347,7 +3232,7 @@ func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxRegistrantNotFoundResponseBody(res)
+				body = NewResendItxMeetingInvitationsNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1360,7 +3245,7 @@ func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxRegistrantServiceUnavailableResponseBody(res)
+				body = NewResendItxMeetingInvitationsServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1373,7 +3258,7 @@ func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxRegistrantUnauthorizedResponseBody(res)
+				body = NewResendItxMeetingInvitationsUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -1384,31 +3269,29 @@ func EncodeUpdateItxRegistrantError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeDeleteItxRegistrantResponse returns an encoder for responses returned
-// by the Meeting Service delete-itx-registrant endpoint.
-func EncodeDeleteItxRegistrantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeRegisterItxCommitteeMembersResponse returns an encoder for responses
+// returned by the Meeting Service register-itx-committee-members endpoint.
+func EncodeRegisterItxCommitteeMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		w.WriteHeader(http.StatusNoContent)
 		return nil
 	}
 }
 
-// DecodeDeleteItxRegistrantRequest returns a decoder for requests sent to the
-// Meeting Service delete-itx-registrant endpoint.
-func DecodeDeleteItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.DeleteItxRegistrantPayload, error) {
-	return func(r *http.Request) (*meetingservice.DeleteItxRegistrantPayload, error) {
-		var payload *meetingservice.DeleteItxRegistrantPayload
+// DecodeRegisterItxCommitteeMembersRequest returns a decoder for requests sent
+// to the Meeting Service register-itx-committee-members endpoint.
+func DecodeRegisterItxCommitteeMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.RegisterItxCommitteeMembersPayload, error) {
+	return func(r *http.Request) (*meetingservice.RegisterItxCommitteeMembersPayload, error) {
+		var payload *meetingservice.RegisterItxCommitteeMembersPayload
 		var (
-			meetingID    string
-			registrantID string
-			version      *string
-			bearerToken  *string
-			err          error
+			meetingID   string
+			version     *string
+			bearerToken *string
+			err         error
 
 			params = mux.Vars(r)
 		)
 		meetingID = params["meeting_id"]
-		registrantID = params["registrant_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1425,7 +3308,7 @@ func DecodeDeleteItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		if err != nil {
 			return payload, err
 		}
-		payload = NewDeleteItxRegistrantPayload(meetingID, registrantID, version, bearerToken)
+		payload = NewRegisterItxCommitteeMembersPayload(meetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1438,9 +3321,9 @@ func DecodeDeleteItxRegistrantRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeDeleteItxRegistrantError returns an encoder for errors returned by the
-// delete-itx-registrant Meeting Service endpoint.
-func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRegisterItxCommitteeMembersError returns an encoder for errors
+// returned by the register-itx-committee-members Meeting Service endpoint.
+func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1456,7 +3339,7 @@ func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxRegistrantBadRequestResponseBody(res)
+				body = NewRegisterItxCommitteeMembersBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1469,7 +3352,7 @@ func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxRegistrantForbiddenResponseBody(res)
+				body = NewRegisterItxCommitteeMembersForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -1482,7 +3365,7 @@ func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxRegistrantInternalServerErrorResponseBody(res)
+				body = NewRegisterItxCommitteeMembersInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1495,7 +3378,7 @@ func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxRegistrantNotFoundResponseBody(res)
+				body = NewRegisterItxCommitteeMembersNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1508,7 +3391,7 @@ func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxRegistrantServiceUnavailableResponseBody(res)
+				body = NewRegisterItxCommitteeMembersServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1521,7 +3404,7 @@ func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxRegistrantUnauthorizedResponseBody(res)
+				body = NewRegisterItxCommitteeMembersUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -1532,31 +3415,29 @@ func EncodeDeleteItxRegistrantError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeGetItxJoinLinkResponse returns an encoder for responses returned by
-// the Meeting Service get-itx-join-link endpoint.
-func EncodeGetItxJoinLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeListItxMeetingOccurrencesResponse returns an encoder for responses
+// returned by the Meeting Service list-itx-meeting-occurrences endpoint.
+func EncodeListItxMeetingOccurrencesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.ITXZoomMeetingJoinLink)
+		res, _ := v.(*meetingservice.ITXOccurrenceListResponse)
 		enc := encoder(ctx, w)
-		body := NewGetItxJoinLinkResponseBody(res)
+		body := NewListItxMeetingOccurrencesResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetItxJoinLinkRequest returns a decoder for requests sent to the
-// Meeting Service get-itx-join-link endpoint.
-func DecodeGetItxJoinLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxJoinLinkPayload, error) {
-	return func(r *http.Request) (*meetingservice.GetItxJoinLinkPayload, error) {
-		var payload *meetingservice.GetItxJoinLinkPayload
+// DecodeListItxMeetingOccurrencesRequest returns a decoder for requests sent
+// to the Meeting Service list-itx-meeting-occurrences endpoint.
+func DecodeListItxMeetingOccurrencesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.ListItxMeetingOccurrencesPayload, error) {
+	return func(r *http.Request) (*meetingservice.ListItxMeetingOccurrencesPayload, error) {
+		var payload *meetingservice.ListItxMeetingOccurrencesPayload
 		var (
 			meetingID   string
 			version     *string
-			useEmail    *bool
-			userID      *string
-			name        *string
-			email       *string
-			register    *bool
+			tz          *string
+			from        *string
+			to          *string
 			bearerToken *string
 			err         error
 
@@ -1573,40 +3454,23 @@ func DecodeGetItxJoinLinkRequest(mux goahttp.Muxer, decoder func(*http.Request)
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
 		}
-		{
-			useEmailRaw := qp.Get("use_email")
-			if useEmailRaw != "" {
-				v, err2 := strconv.ParseBool(useEmailRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("use_email", useEmailRaw, "boolean"))
-				}
-				useEmail = &v
-			}
-		}
-		userIDRaw := qp.Get("user_id")
-		if userIDRaw != "" {
-			userID = &userIDRaw
+		tzRaw := qp.Get("tz")
+		if tzRaw != "" {
+			tz = &tzRaw
 		}
-		nameRaw := qp.Get("name")
-		if nameRaw != "" {
-			name = &nameRaw
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
 		}
-		emailRaw := qp.Get("email")
-		if emailRaw != "" {
-			email = &emailRaw
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
 		}
-		if email != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("email", *email, goa.FormatEmail))
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
 		}
-		{
-			registerRaw := qp.Get("register")
-			if registerRaw != "" {
-				v, err2 := strconv.ParseBool(registerRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("register", registerRaw, "boolean"))
-				}
-				register = &v
-			}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
 		}
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
@@ -1615,7 +3479,7 @@ func DecodeGetItxJoinLinkRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if err != nil {
 			return payload, err
 		}
-		payload = NewGetItxJoinLinkPayload(meetingID, version, useEmail, userID, name, email, register, bearerToken)
+		payload = NewListItxMeetingOccurrencesPayload(meetingID, version, tz, from, to, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1628,9 +3492,9 @@ func DecodeGetItxJoinLinkRequest(mux goahttp.Muxer, decoder func(*http.Request)
 	}
 }
 
-// EncodeGetItxJoinLinkError returns an encoder for errors returned by the
-// get-itx-join-link Meeting Service endpoint.
-func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeListItxMeetingOccurrencesError returns an encoder for errors returned
+// by the list-itx-meeting-occurrences Meeting Service endpoint.
+func EncodeListItxMeetingOccurrencesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1646,7 +3510,7 @@ func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxJoinLinkBadRequestResponseBody(res)
+				body = NewListItxMeetingOccurrencesBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1659,7 +3523,7 @@ func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxJoinLinkForbiddenResponseBody(res)
+				body = NewListItxMeetingOccurrencesForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -1672,7 +3536,7 @@ func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxJoinLinkInternalServerErrorResponseBody(res)
+				body = NewListItxMeetingOccurrencesInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1685,7 +3549,7 @@ func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxJoinLinkNotFoundResponseBody(res)
+				body = NewListItxMeetingOccurrencesNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1698,7 +3562,7 @@ func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxJoinLinkServiceUnavailableResponseBody(res)
+				body = NewListItxMeetingOccurrencesServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1711,7 +3575,7 @@ func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxJoinLinkUnauthorizedResponseBody(res)
+				body = NewListItxMeetingOccurrencesUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -1722,35 +3586,50 @@ func EncodeGetItxJoinLinkError(encoder func(context.Context, http.ResponseWriter
 	}
 }
 
-// EncodeGetItxRegistrantIcsResponse returns an encoder for responses returned
-// by the Meeting Service get-itx-registrant-ics endpoint.
-func EncodeGetItxRegistrantIcsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUpdateItxOccurrenceResponse returns an encoder for responses returned
+// by the Meeting Service update-itx-occurrence endpoint.
+func EncodeUpdateItxOccurrenceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]byte)
-		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/calendar")
-		enc := encoder(ctx, w)
-		body := res
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// DecodeGetItxRegistrantIcsRequest returns a decoder for requests sent to the
-// Meeting Service get-itx-registrant-ics endpoint.
-func DecodeGetItxRegistrantIcsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxRegistrantIcsPayload, error) {
-	return func(r *http.Request) (*meetingservice.GetItxRegistrantIcsPayload, error) {
-		var payload *meetingservice.GetItxRegistrantIcsPayload
+// DecodeUpdateItxOccurrenceRequest returns a decoder for requests sent to the
+// Meeting Service update-itx-occurrence endpoint.
+func DecodeUpdateItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxOccurrencePayload, error) {
+	return func(r *http.Request) (*meetingservice.UpdateItxOccurrencePayload, error) {
+		var payload *meetingservice.UpdateItxOccurrencePayload
+		var (
+			body UpdateItxOccurrenceRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateItxOccurrenceRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
 		var (
 			meetingID    string
-			registrantID string
+			occurrenceID string
 			version      *string
 			bearerToken  *string
-			err          error
 
 			params = mux.Vars(r)
 		)
 		meetingID = params["meeting_id"]
-		registrantID = params["registrant_id"]
+		occurrenceID = params["occurrence_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1767,7 +3646,7 @@ func DecodeGetItxRegistrantIcsRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		if err != nil {
 			return payload, err
 		}
-		payload = NewGetItxRegistrantIcsPayload(meetingID, registrantID, version, bearerToken)
+		payload = NewUpdateItxOccurrencePayload(&body, meetingID, occurrenceID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1780,9 +3659,9 @@ func DecodeGetItxRegistrantIcsRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeGetItxRegistrantIcsError returns an encoder for errors returned by the
-// get-itx-registrant-ics Meeting Service endpoint.
-func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeUpdateItxOccurrenceError returns an encoder for errors returned by the
+// update-itx-occurrence Meeting Service endpoint.
+func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1798,7 +3677,7 @@ func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantIcsBadRequestResponseBody(res)
+				body = NewUpdateItxOccurrenceBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1811,7 +3690,7 @@ func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantIcsForbiddenResponseBody(res)
+				body = NewUpdateItxOccurrenceForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -1824,7 +3703,7 @@ func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantIcsInternalServerErrorResponseBody(res)
+				body = NewUpdateItxOccurrenceInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1837,7 +3716,7 @@ func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantIcsNotFoundResponseBody(res)
+				body = NewUpdateItxOccurrenceNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1850,7 +3729,7 @@ func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantIcsServiceUnavailableResponseBody(res)
+				body = NewUpdateItxOccurrenceServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1863,7 +3742,7 @@ func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxRegistrantIcsUnauthorizedResponseBody(res)
+				body = NewUpdateItxOccurrenceUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -1874,23 +3753,23 @@ func EncodeGetItxRegistrantIcsError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeResendItxRegistrantInvitationResponse returns an encoder for responses
-// returned by the Meeting Service resend-itx-registrant-invitation endpoint.
-func EncodeResendItxRegistrantInvitationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDeleteItxOccurrenceResponse returns an encoder for responses returned
+// by the Meeting Service delete-itx-occurrence endpoint.
+func EncodeDeleteItxOccurrenceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		w.WriteHeader(http.StatusNoContent)
 		return nil
 	}
 }
 
-// DecodeResendItxRegistrantInvitationRequest returns a decoder for requests
-// sent to the Meeting Service resend-itx-registrant-invitation endpoint.
-func DecodeResendItxRegistrantInvitationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.ResendItxRegistrantInvitationPayload, error) {
-	return func(r *http.Request) (*meetingservice.ResendItxRegistrantInvitationPayload, error) {
-		var payload *meetingservice.ResendItxRegistrantInvitationPayload
+// DecodeDeleteItxOccurrenceRequest returns a decoder for requests sent to the
+// Meeting Service delete-itx-occurrence endpoint.
+func DecodeDeleteItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.DeleteItxOccurrencePayload, error) {
+	return func(r *http.Request) (*meetingservice.DeleteItxOccurrencePayload, error) {
+		var payload *meetingservice.DeleteItxOccurrencePayload
 		var (
 			meetingID    string
-			registrantID string
+			occurrenceID string
 			version      *string
 			bearerToken  *string
 			err          error
@@ -1898,7 +3777,7 @@ func DecodeResendItxRegistrantInvitationRequest(mux goahttp.Muxer, decoder func(
 			params = mux.Vars(r)
 		)
 		meetingID = params["meeting_id"]
-		registrantID = params["registrant_id"]
+		occurrenceID = params["occurrence_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1915,7 +3794,7 @@ func DecodeResendItxRegistrantInvitationRequest(mux goahttp.Muxer, decoder func(
 		if err != nil {
 			return payload, err
 		}
-		payload = NewResendItxRegistrantInvitationPayload(meetingID, registrantID, version, bearerToken)
+		payload = NewDeleteItxOccurrencePayload(meetingID, occurrenceID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1928,9 +3807,9 @@ func DecodeResendItxRegistrantInvitationRequest(mux goahttp.Muxer, decoder func(
 	}
 }
 
-// EncodeResendItxRegistrantInvitationError returns an encoder for errors
-// returned by the resend-itx-registrant-invitation Meeting Service endpoint.
-func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDeleteItxOccurrenceError returns an encoder for errors returned by the
+// delete-itx-occurrence Meeting Service endpoint.
+func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1946,7 +3825,7 @@ func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxRegistrantInvitationBadRequestResponseBody(res)
+				body = NewDeleteItxOccurrenceBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1959,7 +3838,7 @@ func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxRegistrantInvitationForbiddenResponseBody(res)
+				body = NewDeleteItxOccurrenceForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -1972,7 +3851,7 @@ func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxRegistrantInvitationInternalServerErrorResponseBody(res)
+				body = NewDeleteItxOccurrenceInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1985,7 +3864,7 @@ func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxRegistrantInvitationNotFoundResponseBody(res)
+				body = NewDeleteItxOccurrenceNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1998,7 +3877,7 @@ func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxRegistrantInvitationServiceUnavailableResponseBody(res)
+				body = NewDeleteItxOccurrenceServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2011,7 +3890,7 @@ func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxRegistrantInvitationUnauthorizedResponseBody(res)
+				body = NewDeleteItxOccurrenceUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -2022,22 +3901,25 @@ func EncodeResendItxRegistrantInvitationError(encoder func(context.Context, http
 	}
 }
 
-// EncodeResendItxMeetingInvitationsResponse returns an encoder for responses
-// returned by the Meeting Service resend-itx-meeting-invitations endpoint.
-func EncodeResendItxMeetingInvitationsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeSubmitItxMeetingResponseResponse returns an encoder for responses
+// returned by the Meeting Service submit-itx-meeting-response endpoint.
+func EncodeSubmitItxMeetingResponseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*meetingservice.ITXMeetingResponseResult)
+		enc := encoder(ctx, w)
+		body := NewSubmitItxMeetingResponseResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeResendItxMeetingInvitationsRequest returns a decoder for requests sent
-// to the Meeting Service resend-itx-meeting-invitations endpoint.
-func DecodeResendItxMeetingInvitationsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.ResendItxMeetingInvitationsPayload, error) {
-	return func(r *http.Request) (*meetingservice.ResendItxMeetingInvitationsPayload, error) {
-		var payload *meetingservice.ResendItxMeetingInvitationsPayload
+// DecodeSubmitItxMeetingResponseRequest returns a decoder for requests sent to
+// the Meeting Service submit-itx-meeting-response endpoint.
+func DecodeSubmitItxMeetingResponseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.SubmitItxMeetingResponsePayload, error) {
+	return func(r *http.Request) (*meetingservice.SubmitItxMeetingResponsePayload, error) {
+		var payload *meetingservice.SubmitItxMeetingResponsePayload
 		var (
-			body ResendItxMeetingInvitationsRequestBody
+			body SubmitItxMeetingResponseRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -2051,6 +3933,10 @@ func DecodeResendItxMeetingInvitationsRequest(mux goahttp.Muxer, decoder func(*h
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
+		err = ValidateSubmitItxMeetingResponseRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
 
 		var (
 			meetingID   string
@@ -2076,7 +3962,7 @@ func DecodeResendItxMeetingInvitationsRequest(mux goahttp.Muxer, decoder func(*h
 		if err != nil {
 			return payload, err
 		}
-		payload = NewResendItxMeetingInvitationsPayload(&body, meetingID, version, bearerToken)
+		payload = NewSubmitItxMeetingResponsePayload(&body, meetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2089,9 +3975,9 @@ func DecodeResendItxMeetingInvitationsRequest(mux goahttp.Muxer, decoder func(*h
 	}
 }
 
-// EncodeResendItxMeetingInvitationsError returns an encoder for errors
-// returned by the resend-itx-meeting-invitations Meeting Service endpoint.
-func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeSubmitItxMeetingResponseError returns an encoder for errors returned
+// by the submit-itx-meeting-response Meeting Service endpoint.
+func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2107,7 +3993,7 @@ func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxMeetingInvitationsBadRequestResponseBody(res)
+				body = NewSubmitItxMeetingResponseBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2120,7 +4006,7 @@ func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxMeetingInvitationsForbiddenResponseBody(res)
+				body = NewSubmitItxMeetingResponseForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -2133,7 +4019,7 @@ func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxMeetingInvitationsInternalServerErrorResponseBody(res)
+				body = NewSubmitItxMeetingResponseInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2146,7 +4032,7 @@ func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxMeetingInvitationsNotFoundResponseBody(res)
+				body = NewSubmitItxMeetingResponseNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2159,7 +4045,7 @@ func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxMeetingInvitationsServiceUnavailableResponseBody(res)
+				body = NewSubmitItxMeetingResponseServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2172,7 +4058,7 @@ func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewResendItxMeetingInvitationsUnauthorizedResponseBody(res)
+				body = NewSubmitItxMeetingResponseUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -2183,29 +4069,47 @@ func EncodeResendItxMeetingInvitationsError(encoder func(context.Context, http.R
 	}
 }
 
-// EncodeRegisterItxCommitteeMembersResponse returns an encoder for responses
-// returned by the Meeting Service register-itx-committee-members endpoint.
-func EncodeRegisterItxCommitteeMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCreateItxPastMeetingResponse returns an encoder for responses returned
+// by the Meeting Service create-itx-past-meeting endpoint.
+func EncodeCreateItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*meetingservice.ITXPastZoomMeeting)
+		enc := encoder(ctx, w)
+		body := NewCreateItxPastMeetingResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeRegisterItxCommitteeMembersRequest returns a decoder for requests sent
-// to the Meeting Service register-itx-committee-members endpoint.
-func DecodeRegisterItxCommitteeMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.RegisterItxCommitteeMembersPayload, error) {
-	return func(r *http.Request) (*meetingservice.RegisterItxCommitteeMembersPayload, error) {
-		var payload *meetingservice.RegisterItxCommitteeMembersPayload
+// DecodeCreateItxPastMeetingRequest returns a decoder for requests sent to the
+// Meeting Service create-itx-past-meeting endpoint.
+func DecodeCreateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.CreateItxPastMeetingPayload, error) {
+	return func(r *http.Request) (*meetingservice.CreateItxPastMeetingPayload, error) {
+		var payload *meetingservice.CreateItxPastMeetingPayload
+		var (
+			body CreateItxPastMeetingRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateItxPastMeetingRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
 		var (
-			meetingID   string
 			version     *string
 			bearerToken *string
-			err         error
-
-			params = mux.Vars(r)
 		)
-		meetingID = params["meeting_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -2222,7 +4126,7 @@ func DecodeRegisterItxCommitteeMembersRequest(mux goahttp.Muxer, decoder func(*h
 		if err != nil {
 			return payload, err
 		}
-		payload = NewRegisterItxCommitteeMembersPayload(meetingID, version, bearerToken)
+		payload = NewCreateItxPastMeetingPayload(&body, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2235,9 +4139,9 @@ func DecodeRegisterItxCommitteeMembersRequest(mux goahttp.Muxer, decoder func(*h
 	}
 }
 
-// EncodeRegisterItxCommitteeMembersError returns an encoder for errors
-// returned by the register-itx-committee-members Meeting Service endpoint.
-func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCreateItxPastMeetingError returns an encoder for errors returned by
+// the create-itx-past-meeting Meeting Service endpoint.
+func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2253,11 +4157,24 @@ func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRegisterItxCommitteeMembersBadRequestResponseBody(res)
+				body = NewCreateItxPastMeetingBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *meetingservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateItxPastMeetingConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "Forbidden":
 			var res *meetingservice.ForbiddenError
 			errors.As(v, &res)
@@ -2266,7 +4183,7 @@ func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRegisterItxCommitteeMembersForbiddenResponseBody(res)
+				body = NewCreateItxPastMeetingForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -2279,7 +4196,7 @@ func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRegisterItxCommitteeMembersInternalServerErrorResponseBody(res)
+				body = NewCreateItxPastMeetingInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2292,7 +4209,7 @@ func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRegisterItxCommitteeMembersNotFoundResponseBody(res)
+				body = NewCreateItxPastMeetingNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2305,7 +4222,7 @@ func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRegisterItxCommitteeMembersServiceUnavailableResponseBody(res)
+				body = NewCreateItxPastMeetingServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2318,7 +4235,7 @@ func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRegisterItxCommitteeMembersUnauthorizedResponseBody(res)
+				body = NewCreateItxPastMeetingUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -2329,50 +4246,32 @@ func EncodeRegisterItxCommitteeMembersError(encoder func(context.Context, http.R
 	}
 }
 
-// EncodeUpdateItxOccurrenceResponse returns an encoder for responses returned
-// by the Meeting Service update-itx-occurrence endpoint.
-func EncodeUpdateItxOccurrenceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetItxPastMeetingResponse returns an encoder for responses returned by
+// the Meeting Service get-itx-past-meeting endpoint.
+func EncodeGetItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*meetingservice.ITXPastZoomMeeting)
+		enc := encoder(ctx, w)
+		body := NewGetItxPastMeetingResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateItxOccurrenceRequest returns a decoder for requests sent to the
-// Meeting Service update-itx-occurrence endpoint.
-func DecodeUpdateItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxOccurrencePayload, error) {
-	return func(r *http.Request) (*meetingservice.UpdateItxOccurrencePayload, error) {
-		var payload *meetingservice.UpdateItxOccurrencePayload
-		var (
-			body UpdateItxOccurrenceRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return payload, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return payload, gerr
-			}
-			return payload, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateUpdateItxOccurrenceRequestBody(&body)
-		if err != nil {
-			return payload, err
-		}
-
+// DecodeGetItxPastMeetingRequest returns a decoder for requests sent to the
+// Meeting Service get-itx-past-meeting endpoint.
+func DecodeGetItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxPastMeetingPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetItxPastMeetingPayload, error) {
+		var payload *meetingservice.GetItxPastMeetingPayload
 		var (
-			meetingID    string
-			occurrenceID string
-			version      *string
-			bearerToken  *string
+			pastMeetingID string
+			version       *string
+			bearerToken   *string
+			err           error
 
 			params = mux.Vars(r)
 		)
-		meetingID = params["meeting_id"]
-		occurrenceID = params["occurrence_id"]
+		pastMeetingID = params["past_meeting_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -2389,7 +4288,7 @@ func DecodeUpdateItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		if err != nil {
 			return payload, err
 		}
-		payload = NewUpdateItxOccurrencePayload(&body, meetingID, occurrenceID, version, bearerToken)
+		payload = NewGetItxPastMeetingPayload(pastMeetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2402,9 +4301,9 @@ func DecodeUpdateItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeUpdateItxOccurrenceError returns an encoder for errors returned by the
-// update-itx-occurrence Meeting Service endpoint.
-func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetItxPastMeetingError returns an encoder for errors returned by the
+// get-itx-past-meeting Meeting Service endpoint.
+func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2420,7 +4319,7 @@ func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxOccurrenceBadRequestResponseBody(res)
+				body = NewGetItxPastMeetingBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2433,7 +4332,7 @@ func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxOccurrenceForbiddenResponseBody(res)
+				body = NewGetItxPastMeetingForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -2446,7 +4345,7 @@ func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxOccurrenceInternalServerErrorResponseBody(res)
+				body = NewGetItxPastMeetingInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2459,7 +4358,7 @@ func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxOccurrenceNotFoundResponseBody(res)
+				body = NewGetItxPastMeetingNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2472,7 +4371,7 @@ func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxOccurrenceServiceUnavailableResponseBody(res)
+				body = NewGetItxPastMeetingServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2485,7 +4384,7 @@ func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxOccurrenceUnauthorizedResponseBody(res)
+				body = NewGetItxPastMeetingUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -2496,31 +4395,29 @@ func EncodeUpdateItxOccurrenceError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeDeleteItxOccurrenceResponse returns an encoder for responses returned
-// by the Meeting Service delete-itx-occurrence endpoint.
-func EncodeDeleteItxOccurrenceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDeleteItxPastMeetingResponse returns an encoder for responses returned
+// by the Meeting Service delete-itx-past-meeting endpoint.
+func EncodeDeleteItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		w.WriteHeader(http.StatusNoContent)
 		return nil
 	}
 }
 
-// DecodeDeleteItxOccurrenceRequest returns a decoder for requests sent to the
-// Meeting Service delete-itx-occurrence endpoint.
-func DecodeDeleteItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.DeleteItxOccurrencePayload, error) {
-	return func(r *http.Request) (*meetingservice.DeleteItxOccurrencePayload, error) {
-		var payload *meetingservice.DeleteItxOccurrencePayload
+// DecodeDeleteItxPastMeetingRequest returns a decoder for requests sent to the
+// Meeting Service delete-itx-past-meeting endpoint.
+func DecodeDeleteItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.DeleteItxPastMeetingPayload, error) {
+	return func(r *http.Request) (*meetingservice.DeleteItxPastMeetingPayload, error) {
+		var payload *meetingservice.DeleteItxPastMeetingPayload
 		var (
-			meetingID    string
-			occurrenceID string
-			version      *string
-			bearerToken  *string
-			err          error
+			pastMeetingID string
+			version       *string
+			bearerToken   *string
+			err           error
 
 			params = mux.Vars(r)
 		)
-		meetingID = params["meeting_id"]
-		occurrenceID = params["occurrence_id"]
+		pastMeetingID = params["past_meeting_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -2537,7 +4434,7 @@ func DecodeDeleteItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		if err != nil {
 			return payload, err
 		}
-		payload = NewDeleteItxOccurrencePayload(meetingID, occurrenceID, version, bearerToken)
+		payload = NewDeleteItxPastMeetingPayload(pastMeetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2550,9 +4447,9 @@ func DecodeDeleteItxOccurrenceRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeDeleteItxOccurrenceError returns an encoder for errors returned by the
-// delete-itx-occurrence Meeting Service endpoint.
-func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDeleteItxPastMeetingError returns an encoder for errors returned by
+// the delete-itx-past-meeting Meeting Service endpoint.
+func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2568,7 +4465,7 @@ func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxOccurrenceBadRequestResponseBody(res)
+				body = NewDeleteItxPastMeetingBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2581,7 +4478,7 @@ func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxOccurrenceForbiddenResponseBody(res)
+				body = NewDeleteItxPastMeetingForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -2594,7 +4491,7 @@ func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxOccurrenceInternalServerErrorResponseBody(res)
+				body = NewDeleteItxPastMeetingInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2607,7 +4504,7 @@ func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxOccurrenceNotFoundResponseBody(res)
+				body = NewDeleteItxPastMeetingNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2620,7 +4517,7 @@ func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxOccurrenceServiceUnavailableResponseBody(res)
+				body = NewDeleteItxPastMeetingServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2633,7 +4530,7 @@ func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxOccurrenceUnauthorizedResponseBody(res)
+				body = NewDeleteItxPastMeetingUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -2644,25 +4541,22 @@ func EncodeDeleteItxOccurrenceError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeSubmitItxMeetingResponseResponse returns an encoder for responses
-// returned by the Meeting Service submit-itx-meeting-response endpoint.
-func EncodeSubmitItxMeetingResponseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUpdateItxPastMeetingResponse returns an encoder for responses returned
+// by the Meeting Service update-itx-past-meeting endpoint.
+func EncodeUpdateItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.ITXMeetingResponseResult)
-		enc := encoder(ctx, w)
-		body := NewSubmitItxMeetingResponseResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// DecodeSubmitItxMeetingResponseRequest returns a decoder for requests sent to
-// the Meeting Service submit-itx-meeting-response endpoint.
-func DecodeSubmitItxMeetingResponseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.SubmitItxMeetingResponsePayload, error) {
-	return func(r *http.Request) (*meetingservice.SubmitItxMeetingResponsePayload, error) {
-		var payload *meetingservice.SubmitItxMeetingResponsePayload
+// DecodeUpdateItxPastMeetingRequest returns a decoder for requests sent to the
+// Meeting Service update-itx-past-meeting endpoint.
+func DecodeUpdateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxPastMeetingPayload, error) {
+	return func(r *http.Request) (*meetingservice.UpdateItxPastMeetingPayload, error) {
+		var payload *meetingservice.UpdateItxPastMeetingPayload
 		var (
-			body SubmitItxMeetingResponseRequestBody
+			body UpdateItxPastMeetingRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -2676,19 +4570,19 @@ func DecodeSubmitItxMeetingResponseRequest(mux goahttp.Muxer, decoder func(*http
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateSubmitItxMeetingResponseRequestBody(&body)
+		err = ValidateUpdateItxPastMeetingRequestBody(&body)
 		if err != nil {
 			return payload, err
 		}
 
 		var (
-			meetingID   string
-			version     *string
-			bearerToken *string
+			pastMeetingID string
+			version       *string
+			bearerToken   *string
 
 			params = mux.Vars(r)
 		)
-		meetingID = params["meeting_id"]
+		pastMeetingID = params["past_meeting_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -2705,7 +4599,7 @@ func DecodeSubmitItxMeetingResponseRequest(mux goahttp.Muxer, decoder func(*http
 		if err != nil {
 			return payload, err
 		}
-		payload = NewSubmitItxMeetingResponsePayload(&body, meetingID, version, bearerToken)
+		payload = NewUpdateItxPastMeetingPayload(&body, pastMeetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2718,9 +4612,9 @@ func DecodeSubmitItxMeetingResponseRequest(mux goahttp.Muxer, decoder func(*http
 	}
 }
 
-// EncodeSubmitItxMeetingResponseError returns an encoder for errors returned
-// by the submit-itx-meeting-response Meeting Service endpoint.
-func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeUpdateItxPastMeetingError returns an encoder for errors returned by
+// the update-itx-past-meeting Meeting Service endpoint.
+func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2736,7 +4630,7 @@ func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSubmitItxMeetingResponseBadRequestResponseBody(res)
+				body = NewUpdateItxPastMeetingBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2749,7 +4643,7 @@ func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSubmitItxMeetingResponseForbiddenResponseBody(res)
+				body = NewUpdateItxPastMeetingForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -2762,7 +4656,7 @@ func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSubmitItxMeetingResponseInternalServerErrorResponseBody(res)
+				body = NewUpdateItxPastMeetingInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2775,7 +4669,7 @@ func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSubmitItxMeetingResponseNotFoundResponseBody(res)
+				body = NewUpdateItxPastMeetingNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2788,7 +4682,7 @@ func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSubmitItxMeetingResponseServiceUnavailableResponseBody(res)
+				body = NewUpdateItxPastMeetingServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2801,7 +4695,7 @@ func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSubmitItxMeetingResponseUnauthorizedResponseBody(res)
+				body = NewUpdateItxPastMeetingUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -2812,47 +4706,35 @@ func EncodeSubmitItxMeetingResponseError(encoder func(context.Context, http.Resp
 	}
 }
 
-// EncodeCreateItxPastMeetingResponse returns an encoder for responses returned
-// by the Meeting Service create-itx-past-meeting endpoint.
-func EncodeCreateItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetItxPastMeetingSummaryResponse returns an encoder for responses
+// returned by the Meeting Service get-itx-past-meeting-summary endpoint.
+func EncodeGetItxPastMeetingSummaryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.ITXPastZoomMeeting)
+		res, _ := v.(*meetingservice.PastMeetingSummary)
 		enc := encoder(ctx, w)
-		body := NewCreateItxPastMeetingResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewGetItxPastMeetingSummaryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCreateItxPastMeetingRequest returns a decoder for requests sent to the
-// Meeting Service create-itx-past-meeting endpoint.
-func DecodeCreateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.CreateItxPastMeetingPayload, error) {
-	return func(r *http.Request) (*meetingservice.CreateItxPastMeetingPayload, error) {
-		var payload *meetingservice.CreateItxPastMeetingPayload
+// DecodeGetItxPastMeetingSummaryRequest returns a decoder for requests sent to
+// the Meeting Service get-itx-past-meeting-summary endpoint.
+func DecodeGetItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxPastMeetingSummaryPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetItxPastMeetingSummaryPayload, error) {
+		var payload *meetingservice.GetItxPastMeetingSummaryPayload
 		var (
-			body CreateItxPastMeetingRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return payload, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return payload, gerr
-			}
-			return payload, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateCreateItxPastMeetingRequestBody(&body)
-		if err != nil {
-			return payload, err
-		}
+			pastMeetingID string
+			summaryUID    string
+			version       *string
+			bearerToken   *string
+			err           error
 
-		var (
-			version     *string
-			bearerToken *string
+			params = mux.Vars(r)
 		)
+		pastMeetingID = params["past_meeting_id"]
+		summaryUID = params["summary_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -2869,7 +4751,7 @@ func DecodeCreateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Req
 		if err != nil {
 			return payload, err
 		}
-		payload = NewCreateItxPastMeetingPayload(&body, version, bearerToken)
+		payload = NewGetItxPastMeetingSummaryPayload(pastMeetingID, summaryUID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2882,9 +4764,9 @@ func DecodeCreateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Req
 	}
 }
 
-// EncodeCreateItxPastMeetingError returns an encoder for errors returned by
-// the create-itx-past-meeting Meeting Service endpoint.
-func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetItxPastMeetingSummaryError returns an encoder for errors returned
+// by the get-itx-past-meeting-summary Meeting Service endpoint.
+func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2900,24 +4782,11 @@ func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingBadRequestResponseBody(res)
+				body = NewGetItxPastMeetingSummaryBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "Conflict":
-			var res *meetingservice.ConflictError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewCreateItxPastMeetingConflictResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
-			return enc.Encode(body)
 		case "Forbidden":
 			var res *meetingservice.ForbiddenError
 			errors.As(v, &res)
@@ -2926,7 +4795,7 @@ func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingForbiddenResponseBody(res)
+				body = NewGetItxPastMeetingSummaryForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -2939,7 +4808,7 @@ func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingInternalServerErrorResponseBody(res)
+				body = NewGetItxPastMeetingSummaryInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2952,7 +4821,7 @@ func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingNotFoundResponseBody(res)
+				body = NewGetItxPastMeetingSummaryNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2965,7 +4834,7 @@ func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingServiceUnavailableResponseBody(res)
+				body = NewGetItxPastMeetingSummaryServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2978,7 +4847,7 @@ func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingUnauthorizedResponseBody(res)
+				body = NewGetItxPastMeetingSummaryUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -2989,25 +4858,26 @@ func EncodeCreateItxPastMeetingError(encoder func(context.Context, http.Response
 	}
 }
 
-// EncodeGetItxPastMeetingResponse returns an encoder for responses returned by
-// the Meeting Service get-itx-past-meeting endpoint.
-func EncodeGetItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetItxPastMeetingSummaryDiffResponse returns an encoder for responses
+// returned by the Meeting Service get-itx-past-meeting-summary-diff endpoint.
+func EncodeGetItxPastMeetingSummaryDiffResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.ITXPastZoomMeeting)
+		res, _ := v.(*meetingservice.PastMeetingSummaryDiff)
 		enc := encoder(ctx, w)
-		body := NewGetItxPastMeetingResponseBody(res)
+		body := NewGetItxPastMeetingSummaryDiffResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetItxPastMeetingRequest returns a decoder for requests sent to the
-// Meeting Service get-itx-past-meeting endpoint.
-func DecodeGetItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxPastMeetingPayload, error) {
-	return func(r *http.Request) (*meetingservice.GetItxPastMeetingPayload, error) {
-		var payload *meetingservice.GetItxPastMeetingPayload
+// DecodeGetItxPastMeetingSummaryDiffRequest returns a decoder for requests
+// sent to the Meeting Service get-itx-past-meeting-summary-diff endpoint.
+func DecodeGetItxPastMeetingSummaryDiffRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxPastMeetingSummaryDiffPayload, error) {
+	return func(r *http.Request) (*meetingservice.GetItxPastMeetingSummaryDiffPayload, error) {
+		var payload *meetingservice.GetItxPastMeetingSummaryDiffPayload
 		var (
 			pastMeetingID string
+			summaryUID    string
 			version       *string
 			bearerToken   *string
 			err           error
@@ -3015,6 +4885,8 @@ func DecodeGetItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Reques
 			params = mux.Vars(r)
 		)
 		pastMeetingID = params["past_meeting_id"]
+		summaryUID = params["summary_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -3031,7 +4903,7 @@ func DecodeGetItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		if err != nil {
 			return payload, err
 		}
-		payload = NewGetItxPastMeetingPayload(pastMeetingID, version, bearerToken)
+		payload = NewGetItxPastMeetingSummaryDiffPayload(pastMeetingID, summaryUID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -3044,9 +4916,9 @@ func DecodeGetItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeGetItxPastMeetingError returns an encoder for errors returned by the
-// get-itx-past-meeting Meeting Service endpoint.
-func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetItxPastMeetingSummaryDiffError returns an encoder for errors
+// returned by the get-itx-past-meeting-summary-diff Meeting Service endpoint.
+func EncodeGetItxPastMeetingSummaryDiffError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -3062,7 +4934,7 @@ func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingBadRequestResponseBody(res)
+				body = NewGetItxPastMeetingSummaryDiffBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -3075,7 +4947,7 @@ func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingForbiddenResponseBody(res)
+				body = NewGetItxPastMeetingSummaryDiffForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -3088,7 +4960,7 @@ func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingInternalServerErrorResponseBody(res)
+				body = NewGetItxPastMeetingSummaryDiffInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3101,7 +4973,7 @@ func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingNotFoundResponseBody(res)
+				body = NewGetItxPastMeetingSummaryDiffNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -3114,7 +4986,7 @@ func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingServiceUnavailableResponseBody(res)
+				body = NewGetItxPastMeetingSummaryDiffServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -3127,7 +4999,7 @@ func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingUnauthorizedResponseBody(res)
+				body = NewGetItxPastMeetingSummaryDiffUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -3138,29 +5010,50 @@ func EncodeGetItxPastMeetingError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
-// EncodeDeleteItxPastMeetingResponse returns an encoder for responses returned
-// by the Meeting Service delete-itx-past-meeting endpoint.
-func EncodeDeleteItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUpdateItxPastMeetingSummaryResponse returns an encoder for responses
+// returned by the Meeting Service update-itx-past-meeting-summary endpoint.
+func EncodeUpdateItxPastMeetingSummaryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*meetingservice.PastMeetingSummary)
+		enc := encoder(ctx, w)
+		body := NewUpdateItxPastMeetingSummaryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDeleteItxPastMeetingRequest returns a decoder for requests sent to the
-// Meeting Service delete-itx-past-meeting endpoint.
-func DecodeDeleteItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.DeleteItxPastMeetingPayload, error) {
-	return func(r *http.Request) (*meetingservice.DeleteItxPastMeetingPayload, error) {
-		var payload *meetingservice.DeleteItxPastMeetingPayload
+// DecodeUpdateItxPastMeetingSummaryRequest returns a decoder for requests sent
+// to the Meeting Service update-itx-past-meeting-summary endpoint.
+func DecodeUpdateItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxPastMeetingSummaryPayload, error) {
+	return func(r *http.Request) (*meetingservice.UpdateItxPastMeetingSummaryPayload, error) {
+		var payload *meetingservice.UpdateItxPastMeetingSummaryPayload
+		var (
+			body UpdateItxPastMeetingSummaryRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+
 		var (
 			pastMeetingID string
+			summaryUID    string
 			version       *string
 			bearerToken   *string
-			err           error
 
 			params = mux.Vars(r)
 		)
 		pastMeetingID = params["past_meeting_id"]
+		summaryUID = params["summary_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -3177,7 +5070,7 @@ func DecodeDeleteItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Req
 		if err != nil {
 			return payload, err
 		}
-		payload = NewDeleteItxPastMeetingPayload(pastMeetingID, version, bearerToken)
+		payload = NewUpdateItxPastMeetingSummaryPayload(&body, pastMeetingID, summaryUID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -3190,9 +5083,9 @@ func DecodeDeleteItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Req
 	}
 }
 
-// EncodeDeleteItxPastMeetingError returns an encoder for errors returned by
-// the delete-itx-past-meeting Meeting Service endpoint.
-func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeUpdateItxPastMeetingSummaryError returns an encoder for errors
+// returned by the update-itx-past-meeting-summary Meeting Service endpoint.
+func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -3208,7 +5101,7 @@ func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxPastMeetingBadRequestResponseBody(res)
+				body = NewUpdateItxPastMeetingSummaryBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -3221,7 +5114,7 @@ func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxPastMeetingForbiddenResponseBody(res)
+				body = NewUpdateItxPastMeetingSummaryForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -3234,7 +5127,7 @@ func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxPastMeetingInternalServerErrorResponseBody(res)
+				body = NewUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3247,7 +5140,7 @@ func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxPastMeetingNotFoundResponseBody(res)
+				body = NewUpdateItxPastMeetingSummaryNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -3260,7 +5153,7 @@ func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxPastMeetingServiceUnavailableResponseBody(res)
+				body = NewUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -3273,7 +5166,7 @@ func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteItxPastMeetingUnauthorizedResponseBody(res)
+				body = NewUpdateItxPastMeetingSummaryUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -3284,48 +5177,35 @@ func EncodeDeleteItxPastMeetingError(encoder func(context.Context, http.Response
 	}
 }
 
-// EncodeUpdateItxPastMeetingResponse returns an encoder for responses returned
-// by the Meeting Service update-itx-past-meeting endpoint.
-func EncodeUpdateItxPastMeetingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
-	}
-}
-
-// DecodeUpdateItxPastMeetingRequest returns a decoder for requests sent to the
-// Meeting Service update-itx-past-meeting endpoint.
-func DecodeUpdateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxPastMeetingPayload, error) {
-	return func(r *http.Request) (*meetingservice.UpdateItxPastMeetingPayload, error) {
-		var payload *meetingservice.UpdateItxPastMeetingPayload
-		var (
-			body UpdateItxPastMeetingRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return payload, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return payload, gerr
-			}
-			return payload, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateUpdateItxPastMeetingRequestBody(&body)
-		if err != nil {
-			return payload, err
-		}
-
+// EncodeApproveItxPastMeetingSummaryResponse returns an encoder for responses
+// returned by the Meeting Service approve-itx-past-meeting-summary endpoint.
+func EncodeApproveItxPastMeetingSummaryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*meetingservice.PastMeetingSummary)
+		enc := encoder(ctx, w)
+		body := NewApproveItxPastMeetingSummaryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeApproveItxPastMeetingSummaryRequest returns a decoder for requests
+// sent to the Meeting Service approve-itx-past-meeting-summary endpoint.
+func DecodeApproveItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.ApproveItxPastMeetingSummaryPayload, error) {
+	return func(r *http.Request) (*meetingservice.ApproveItxPastMeetingSummaryPayload, error) {
+		var payload *meetingservice.ApproveItxPastMeetingSummaryPayload
 		var (
 			pastMeetingID string
+			summaryUID    string
 			version       *string
 			bearerToken   *string
+			err           error
 
 			params = mux.Vars(r)
 		)
 		pastMeetingID = params["past_meeting_id"]
+		summaryUID = params["summary_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -3342,7 +5222,7 @@ func DecodeUpdateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Req
 		if err != nil {
 			return payload, err
 		}
-		payload = NewUpdateItxPastMeetingPayload(&body, pastMeetingID, version, bearerToken)
+		payload = NewApproveItxPastMeetingSummaryPayload(pastMeetingID, summaryUID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -3355,9 +5235,9 @@ func DecodeUpdateItxPastMeetingRequest(mux goahttp.Muxer, decoder func(*http.Req
 	}
 }
 
-// EncodeUpdateItxPastMeetingError returns an encoder for errors returned by
-// the update-itx-past-meeting Meeting Service endpoint.
-func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeApproveItxPastMeetingSummaryError returns an encoder for errors
+// returned by the approve-itx-past-meeting-summary Meeting Service endpoint.
+func EncodeApproveItxPastMeetingSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -3373,11 +5253,24 @@ func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingBadRequestResponseBody(res)
+				body = NewApproveItxPastMeetingSummaryBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *meetingservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewApproveItxPastMeetingSummaryConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "Forbidden":
 			var res *meetingservice.ForbiddenError
 			errors.As(v, &res)
@@ -3386,7 +5279,7 @@ func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingForbiddenResponseBody(res)
+				body = NewApproveItxPastMeetingSummaryForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -3399,7 +5292,7 @@ func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingInternalServerErrorResponseBody(res)
+				body = NewApproveItxPastMeetingSummaryInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3412,7 +5305,7 @@ func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingNotFoundResponseBody(res)
+				body = NewApproveItxPastMeetingSummaryNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -3425,7 +5318,7 @@ func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingServiceUnavailableResponseBody(res)
+				body = NewApproveItxPastMeetingSummaryServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -3438,7 +5331,7 @@ func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingUnauthorizedResponseBody(res)
+				body = NewApproveItxPastMeetingSummaryUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -3449,23 +5342,23 @@ func EncodeUpdateItxPastMeetingError(encoder func(context.Context, http.Response
 	}
 }
 
-// EncodeGetItxPastMeetingSummaryResponse returns an encoder for responses
-// returned by the Meeting Service get-itx-past-meeting-summary endpoint.
-func EncodeGetItxPastMeetingSummaryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeRejectItxPastMeetingSummaryResponse returns an encoder for responses
+// returned by the Meeting Service reject-itx-past-meeting-summary endpoint.
+func EncodeRejectItxPastMeetingSummaryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		res, _ := v.(*meetingservice.PastMeetingSummary)
 		enc := encoder(ctx, w)
-		body := NewGetItxPastMeetingSummaryResponseBody(res)
+		body := NewRejectItxPastMeetingSummaryResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetItxPastMeetingSummaryRequest returns a decoder for requests sent to
-// the Meeting Service get-itx-past-meeting-summary endpoint.
-func DecodeGetItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.GetItxPastMeetingSummaryPayload, error) {
-	return func(r *http.Request) (*meetingservice.GetItxPastMeetingSummaryPayload, error) {
-		var payload *meetingservice.GetItxPastMeetingSummaryPayload
+// DecodeRejectItxPastMeetingSummaryRequest returns a decoder for requests sent
+// to the Meeting Service reject-itx-past-meeting-summary endpoint.
+func DecodeRejectItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.RejectItxPastMeetingSummaryPayload, error) {
+	return func(r *http.Request) (*meetingservice.RejectItxPastMeetingSummaryPayload, error) {
+		var payload *meetingservice.RejectItxPastMeetingSummaryPayload
 		var (
 			pastMeetingID string
 			summaryUID    string
@@ -3494,7 +5387,7 @@ func DecodeGetItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http
 		if err != nil {
 			return payload, err
 		}
-		payload = NewGetItxPastMeetingSummaryPayload(pastMeetingID, summaryUID, version, bearerToken)
+		payload = NewRejectItxPastMeetingSummaryPayload(pastMeetingID, summaryUID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -3507,9 +5400,9 @@ func DecodeGetItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http
 	}
 }
 
-// EncodeGetItxPastMeetingSummaryError returns an encoder for errors returned
-// by the get-itx-past-meeting-summary Meeting Service endpoint.
-func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRejectItxPastMeetingSummaryError returns an encoder for errors
+// returned by the reject-itx-past-meeting-summary Meeting Service endpoint.
+func EncodeRejectItxPastMeetingSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -3525,11 +5418,24 @@ func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingSummaryBadRequestResponseBody(res)
+				body = NewRejectItxPastMeetingSummaryBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *meetingservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRejectItxPastMeetingSummaryConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "Forbidden":
 			var res *meetingservice.ForbiddenError
 			errors.As(v, &res)
@@ -3538,7 +5444,7 @@ func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingSummaryForbiddenResponseBody(res)
+				body = NewRejectItxPastMeetingSummaryForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -3551,7 +5457,7 @@ func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingSummaryInternalServerErrorResponseBody(res)
+				body = NewRejectItxPastMeetingSummaryInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3564,7 +5470,7 @@ func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingSummaryNotFoundResponseBody(res)
+				body = NewRejectItxPastMeetingSummaryNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -3577,7 +5483,7 @@ func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingSummaryServiceUnavailableResponseBody(res)
+				body = NewRejectItxPastMeetingSummaryServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -3590,7 +5496,7 @@ func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetItxPastMeetingSummaryUnauthorizedResponseBody(res)
+				body = NewRejectItxPastMeetingSummaryUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -3601,25 +5507,26 @@ func EncodeGetItxPastMeetingSummaryError(encoder func(context.Context, http.Resp
 	}
 }
 
-// EncodeUpdateItxPastMeetingSummaryResponse returns an encoder for responses
-// returned by the Meeting Service update-itx-past-meeting-summary endpoint.
-func EncodeUpdateItxPastMeetingSummaryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCreateItxPastMeetingParticipantResponse returns an encoder for
+// responses returned by the Meeting Service
+// create-itx-past-meeting-participant endpoint.
+func EncodeCreateItxPastMeetingParticipantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.PastMeetingSummary)
+		res, _ := v.(*meetingservice.ITXPastMeetingParticipant)
 		enc := encoder(ctx, w)
-		body := NewUpdateItxPastMeetingSummaryResponseBody(res)
-		w.WriteHeader(http.StatusOK)
+		body := NewCreateItxPastMeetingParticipantResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateItxPastMeetingSummaryRequest returns a decoder for requests sent
-// to the Meeting Service update-itx-past-meeting-summary endpoint.
-func DecodeUpdateItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxPastMeetingSummaryPayload, error) {
-	return func(r *http.Request) (*meetingservice.UpdateItxPastMeetingSummaryPayload, error) {
-		var payload *meetingservice.UpdateItxPastMeetingSummaryPayload
+// DecodeCreateItxPastMeetingParticipantRequest returns a decoder for requests
+// sent to the Meeting Service create-itx-past-meeting-participant endpoint.
+func DecodeCreateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.CreateItxPastMeetingParticipantPayload, error) {
+	return func(r *http.Request) (*meetingservice.CreateItxPastMeetingParticipantPayload, error) {
+		var payload *meetingservice.CreateItxPastMeetingParticipantPayload
 		var (
-			body UpdateItxPastMeetingSummaryRequestBody
+			body CreateItxPastMeetingParticipantRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -3633,18 +5540,19 @@ func DecodeUpdateItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*h
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
+		err = ValidateCreateItxPastMeetingParticipantRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
 
 		var (
 			pastMeetingID string
-			summaryUID    string
 			version       *string
 			bearerToken   *string
 
 			params = mux.Vars(r)
 		)
 		pastMeetingID = params["past_meeting_id"]
-		summaryUID = params["summary_uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("summary_uid", summaryUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -3661,7 +5569,7 @@ func DecodeUpdateItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*h
 		if err != nil {
 			return payload, err
 		}
-		payload = NewUpdateItxPastMeetingSummaryPayload(&body, pastMeetingID, summaryUID, version, bearerToken)
+		payload = NewCreateItxPastMeetingParticipantPayload(&body, pastMeetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -3674,9 +5582,9 @@ func DecodeUpdateItxPastMeetingSummaryRequest(mux goahttp.Muxer, decoder func(*h
 	}
 }
 
-// EncodeUpdateItxPastMeetingSummaryError returns an encoder for errors
-// returned by the update-itx-past-meeting-summary Meeting Service endpoint.
-func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCreateItxPastMeetingParticipantError returns an encoder for errors
+// returned by the create-itx-past-meeting-participant Meeting Service endpoint.
+func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -3692,7 +5600,7 @@ func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingSummaryBadRequestResponseBody(res)
+				body = NewCreateItxPastMeetingParticipantBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -3705,7 +5613,7 @@ func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingSummaryForbiddenResponseBody(res)
+				body = NewCreateItxPastMeetingParticipantForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -3718,7 +5626,7 @@ func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(res)
+				body = NewCreateItxPastMeetingParticipantInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3731,7 +5639,7 @@ func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingSummaryNotFoundResponseBody(res)
+				body = NewCreateItxPastMeetingParticipantNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -3744,7 +5652,7 @@ func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(res)
+				body = NewCreateItxPastMeetingParticipantServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -3757,7 +5665,7 @@ func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingSummaryUnauthorizedResponseBody(res)
+				body = NewCreateItxPastMeetingParticipantUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -3768,26 +5676,26 @@ func EncodeUpdateItxPastMeetingSummaryError(encoder func(context.Context, http.R
 	}
 }
 
-// EncodeCreateItxPastMeetingParticipantResponse returns an encoder for
+// EncodeUpdateItxPastMeetingParticipantResponse returns an encoder for
 // responses returned by the Meeting Service
-// create-itx-past-meeting-participant endpoint.
-func EncodeCreateItxPastMeetingParticipantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// update-itx-past-meeting-participant endpoint.
+func EncodeUpdateItxPastMeetingParticipantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		res, _ := v.(*meetingservice.ITXPastMeetingParticipant)
 		enc := encoder(ctx, w)
-		body := NewCreateItxPastMeetingParticipantResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewUpdateItxPastMeetingParticipantResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCreateItxPastMeetingParticipantRequest returns a decoder for requests
-// sent to the Meeting Service create-itx-past-meeting-participant endpoint.
-func DecodeCreateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.CreateItxPastMeetingParticipantPayload, error) {
-	return func(r *http.Request) (*meetingservice.CreateItxPastMeetingParticipantPayload, error) {
-		var payload *meetingservice.CreateItxPastMeetingParticipantPayload
+// DecodeUpdateItxPastMeetingParticipantRequest returns a decoder for requests
+// sent to the Meeting Service update-itx-past-meeting-participant endpoint.
+func DecodeUpdateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxPastMeetingParticipantPayload, error) {
+	return func(r *http.Request) (*meetingservice.UpdateItxPastMeetingParticipantPayload, error) {
+		var payload *meetingservice.UpdateItxPastMeetingParticipantPayload
 		var (
-			body CreateItxPastMeetingParticipantRequestBody
+			body UpdateItxPastMeetingParticipantRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -3801,19 +5709,17 @@ func DecodeCreateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder fun
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateCreateItxPastMeetingParticipantRequestBody(&body)
-		if err != nil {
-			return payload, err
-		}
 
 		var (
 			pastMeetingID string
+			participantID string
 			version       *string
 			bearerToken   *string
 
 			params = mux.Vars(r)
 		)
 		pastMeetingID = params["past_meeting_id"]
+		participantID = params["participant_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -3830,7 +5736,7 @@ func DecodeCreateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder fun
 		if err != nil {
 			return payload, err
 		}
-		payload = NewCreateItxPastMeetingParticipantPayload(&body, pastMeetingID, version, bearerToken)
+		payload = NewUpdateItxPastMeetingParticipantPayload(&body, pastMeetingID, participantID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -3843,9 +5749,9 @@ func DecodeCreateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder fun
 	}
 }
 
-// EncodeCreateItxPastMeetingParticipantError returns an encoder for errors
-// returned by the create-itx-past-meeting-participant Meeting Service endpoint.
-func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeUpdateItxPastMeetingParticipantError returns an encoder for errors
+// returned by the update-itx-past-meeting-participant Meeting Service endpoint.
+func EncodeUpdateItxPastMeetingParticipantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -3861,7 +5767,7 @@ func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingParticipantBadRequestResponseBody(res)
+				body = NewUpdateItxPastMeetingParticipantBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -3874,7 +5780,7 @@ func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingParticipantForbiddenResponseBody(res)
+				body = NewUpdateItxPastMeetingParticipantForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -3887,7 +5793,7 @@ func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingParticipantInternalServerErrorResponseBody(res)
+				body = NewUpdateItxPastMeetingParticipantInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3900,7 +5806,7 @@ func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingParticipantNotFoundResponseBody(res)
+				body = NewUpdateItxPastMeetingParticipantNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -3913,7 +5819,7 @@ func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingParticipantServiceUnavailableResponseBody(res)
+				body = NewUpdateItxPastMeetingParticipantServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -3926,7 +5832,7 @@ func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateItxPastMeetingParticipantUnauthorizedResponseBody(res)
+				body = NewUpdateItxPastMeetingParticipantUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -3937,26 +5843,27 @@ func EncodeCreateItxPastMeetingParticipantError(encoder func(context.Context, ht
 	}
 }
 
-// EncodeUpdateItxPastMeetingParticipantResponse returns an encoder for
+// EncodeBulkUpdateItxPastMeetingParticipantsResponse returns an encoder for
 // responses returned by the Meeting Service
-// update-itx-past-meeting-participant endpoint.
-func EncodeUpdateItxPastMeetingParticipantResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// bulk-update-itx-past-meeting-participants endpoint.
+func EncodeBulkUpdateItxPastMeetingParticipantsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*meetingservice.ITXPastMeetingParticipant)
+		res, _ := v.(*meetingservice.ITXPastMeetingParticipantBulkUpdateResponse)
 		enc := encoder(ctx, w)
-		body := NewUpdateItxPastMeetingParticipantResponseBody(res)
+		body := NewBulkUpdateItxPastMeetingParticipantsResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateItxPastMeetingParticipantRequest returns a decoder for requests
-// sent to the Meeting Service update-itx-past-meeting-participant endpoint.
-func DecodeUpdateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.UpdateItxPastMeetingParticipantPayload, error) {
-	return func(r *http.Request) (*meetingservice.UpdateItxPastMeetingParticipantPayload, error) {
-		var payload *meetingservice.UpdateItxPastMeetingParticipantPayload
+// DecodeBulkUpdateItxPastMeetingParticipantsRequest returns a decoder for
+// requests sent to the Meeting Service
+// bulk-update-itx-past-meeting-participants endpoint.
+func DecodeBulkUpdateItxPastMeetingParticipantsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*meetingservice.BulkUpdateItxPastMeetingParticipantsPayload, error) {
+	return func(r *http.Request) (*meetingservice.BulkUpdateItxPastMeetingParticipantsPayload, error) {
+		var payload *meetingservice.BulkUpdateItxPastMeetingParticipantsPayload
 		var (
-			body UpdateItxPastMeetingParticipantRequestBody
+			body BulkUpdateItxPastMeetingParticipantsRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -3970,17 +5877,19 @@ func DecodeUpdateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder fun
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
+		err = ValidateBulkUpdateItxPastMeetingParticipantsRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
 
 		var (
 			pastMeetingID string
-			participantID string
 			version       *string
 			bearerToken   *string
 
 			params = mux.Vars(r)
 		)
 		pastMeetingID = params["past_meeting_id"]
-		participantID = params["participant_id"]
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -3997,7 +5906,7 @@ func DecodeUpdateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder fun
 		if err != nil {
 			return payload, err
 		}
-		payload = NewUpdateItxPastMeetingParticipantPayload(&body, pastMeetingID, participantID, version, bearerToken)
+		payload = NewBulkUpdateItxPastMeetingParticipantsPayload(&body, pastMeetingID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -4010,9 +5919,10 @@ func DecodeUpdateItxPastMeetingParticipantRequest(mux goahttp.Muxer, decoder fun
 	}
 }
 
-// EncodeUpdateItxPastMeetingParticipantError returns an encoder for errors
-// returned by the update-itx-past-meeting-participant Meeting Service endpoint.
-func EncodeUpdateItxPastMeetingParticipantError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeBulkUpdateItxPastMeetingParticipantsError returns an encoder for
+// errors returned by the bulk-update-itx-past-meeting-participants Meeting
+// Service endpoint.
+func EncodeBulkUpdateItxPastMeetingParticipantsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -4028,7 +5938,7 @@ func EncodeUpdateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingParticipantBadRequestResponseBody(res)
+				body = NewBulkUpdateItxPastMeetingParticipantsBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -4041,7 +5951,7 @@ func EncodeUpdateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingParticipantForbiddenResponseBody(res)
+				body = NewBulkUpdateItxPastMeetingParticipantsForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
@@ -4054,24 +5964,11 @@ func EncodeUpdateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingParticipantInternalServerErrorResponseBody(res)
+				body = NewBulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *meetingservice.NotFoundError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewUpdateItxPastMeetingParticipantNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *meetingservice.ServiceUnavailableError
 			errors.As(v, &res)
@@ -4080,7 +5977,7 @@ func EncodeUpdateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingParticipantServiceUnavailableResponseBody(res)
+				body = NewBulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -4093,7 +5990,7 @@ func EncodeUpdateItxPastMeetingParticipantError(encoder func(context.Context, ht
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateItxPastMeetingParticipantUnauthorizedResponseBody(res)
+				body = NewBulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
@@ -6180,6 +8077,102 @@ func EncodeGetItxPastMeetingAttachmentDownloadError(encoder func(context.Context
 	}
 }
 
+// marshalMeetingserviceServiceEventProcessingConfigToServiceEventProcessingConfigResponseBody
+// builds a value of type *ServiceEventProcessingConfigResponseBody from a
+// value of type *meetingservice.ServiceEventProcessingConfig.
+func marshalMeetingserviceServiceEventProcessingConfigToServiceEventProcessingConfigResponseBody(v *meetingservice.ServiceEventProcessingConfig) *ServiceEventProcessingConfigResponseBody {
+	res := &ServiceEventProcessingConfigResponseBody{
+		Enabled:          v.Enabled,
+		ConsumerName:     v.ConsumerName,
+		StreamName:       v.StreamName,
+		MaxDeliver:       v.MaxDeliver,
+		AckWait:          v.AckWait,
+		MaxAckPending:    v.MaxAckPending,
+		V1MappingsBucket: v.V1MappingsBucket,
+		BreakerThreshold: v.BreakerThreshold,
+		BreakerCooldown:  v.BreakerCooldown,
+	}
+	if v.FilterSubjects != nil {
+		res.FilterSubjects = make([]string, len(v.FilterSubjects))
+		for i, val := range v.FilterSubjects {
+			res.FilterSubjects[i] = val
+		}
+	} else {
+		res.FilterSubjects = []string{}
+	}
+
+	return res
+}
+
+// marshalMeetingserviceServiceInvitesConfigToServiceInvitesConfigResponseBody
+// builds a value of type *ServiceInvitesConfigResponseBody from a value of
+// type *meetingservice.ServiceInvitesConfig.
+func marshalMeetingserviceServiceInvitesConfigToServiceInvitesConfigResponseBody(v *meetingservice.ServiceInvitesConfig) *ServiceInvitesConfigResponseBody {
+	res := &ServiceInvitesConfigResponseBody{
+		Enabled:          v.Enabled,
+		SelfServeBaseURL: v.SelfServeBaseURL,
+	}
+
+	return res
+}
+
+// marshalMeetingserviceServiceITXResilienceConfigToServiceITXResilienceConfigResponseBody
+// builds a value of type *ServiceITXResilienceConfigResponseBody from a value
+// of type *meetingservice.ServiceITXResilienceConfig.
+func marshalMeetingserviceServiceITXResilienceConfigToServiceITXResilienceConfigResponseBody(v *meetingservice.ServiceITXResilienceConfig) *ServiceITXResilienceConfigResponseBody {
+	res := &ServiceITXResilienceConfigResponseBody{
+		MaxRetries:       v.MaxRetries,
+		RetryBaseDelay:   v.RetryBaseDelay,
+		RetryMaxDelay:    v.RetryMaxDelay,
+		AttemptTimeout:   v.AttemptTimeout,
+		BreakerThreshold: v.BreakerThreshold,
+		BreakerCooldown:  v.BreakerCooldown,
+	}
+	if v.EndpointAttemptTimeouts != nil {
+		res.EndpointAttemptTimeouts = make(map[string]string, len(v.EndpointAttemptTimeouts))
+		for key, val := range v.EndpointAttemptTimeouts {
+			tk := key
+			tv := val
+			res.EndpointAttemptTimeouts[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalMeetingserviceServiceAttachmentsConfigToServiceAttachmentsConfigResponseBody
+// builds a value of type *ServiceAttachmentsConfigResponseBody from a value of
+// type *meetingservice.ServiceAttachmentsConfig.
+func marshalMeetingserviceServiceAttachmentsConfigToServiceAttachmentsConfigResponseBody(v *meetingservice.ServiceAttachmentsConfig) *ServiceAttachmentsConfigResponseBody {
+	res := &ServiceAttachmentsConfigResponseBody{
+		MaxFileSize: v.MaxFileSize,
+	}
+	if v.AllowedContentTypes != nil {
+		res.AllowedContentTypes = make([]string, len(v.AllowedContentTypes))
+		for i, val := range v.AllowedContentTypes {
+			res.AllowedContentTypes[i] = val
+		}
+	} else {
+		res.AllowedContentTypes = []string{}
+	}
+
+	return res
+}
+
+// marshalMeetingserviceDependencyHealthToDependencyHealthResponseBody builds a
+// value of type *DependencyHealthResponseBody from a value of type
+// *meetingservice.DependencyHealth.
+func marshalMeetingserviceDependencyHealthToDependencyHealthResponseBody(v *meetingservice.DependencyHealth) *DependencyHealthResponseBody {
+	res := &DependencyHealthResponseBody{
+		Name:      v.Name,
+		Status:    v.Status,
+		LatencyMs: v.LatencyMs,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
 // unmarshalCommitteeRequestBodyToMeetingserviceCommittee builds a value of
 // type *meetingservice.Committee from a value of type *CommitteeRequestBody.
 func unmarshalCommitteeRequestBodyToMeetingserviceCommittee(v *CommitteeRequestBody) *meetingservice.Committee {
@@ -6214,6 +8207,7 @@ func unmarshalRecurrenceRequestBodyToMeetingserviceRecurrence(v *RecurrenceReque
 		MonthlyWeekDay: v.MonthlyWeekDay,
 		EndTimes:       v.EndTimes,
 		EndDateTime:    v.EndDateTime,
+		Rrule:          v.Rrule,
 	}
 
 	return res
@@ -6253,6 +8247,7 @@ func marshalMeetingserviceRecurrenceToRecurrenceResponseBody(v *meetingservice.R
 		MonthlyWeekDay: v.MonthlyWeekDay,
 		EndTimes:       v.EndTimes,
 		EndDateTime:    v.EndDateTime,
+		Rrule:          v.Rrule,
 	}
 
 	return res
@@ -6308,6 +8303,22 @@ func marshalMeetingserviceITXUserToITXUserResponseBody(v *meetingservice.ITXUser
 	return res
 }
 
+// marshalMeetingserviceITXLocalOccurrenceToITXLocalOccurrenceResponseBody
+// builds a value of type *ITXLocalOccurrenceResponseBody from a value of type
+// *meetingservice.ITXLocalOccurrence.
+func marshalMeetingserviceITXLocalOccurrenceToITXLocalOccurrenceResponseBody(v *meetingservice.ITXLocalOccurrence) *ITXLocalOccurrenceResponseBody {
+	res := &ITXLocalOccurrenceResponseBody{
+		OccurrenceID:   v.OccurrenceID,
+		StartTime:      v.StartTime,
+		LocalStartTime: v.LocalStartTime,
+		LocalEndTime:   v.LocalEndTime,
+		Duration:       v.Duration,
+		Status:         v.Status,
+	}
+
+	return res
+}
+
 // marshalMeetingservicePastMeetingSummaryZoomConfigToPastMeetingSummaryZoomConfigResponseBody
 // builds a value of type *PastMeetingSummaryZoomConfigResponseBody from a
 // value of type *meetingservice.PastMeetingSummaryZoomConfig.
@@ -6339,6 +8350,20 @@ func marshalMeetingserviceSummaryDataToSummaryDataResponseBody(v *meetingservice
 	return res
 }
 
+// marshalMeetingserviceSummaryDiffLineToSummaryDiffLineResponseBody builds a
+// value of type *SummaryDiffLineResponseBody from a value of type
+// *meetingservice.SummaryDiffLine.
+func marshalMeetingserviceSummaryDiffLineToSummaryDiffLineResponseBody(v *meetingservice.SummaryDiffLine) *SummaryDiffLineResponseBody {
+	res := &SummaryDiffLineResponseBody{
+		Operation:    v.Operation,
+		Text:         v.Text,
+		OriginalLine: v.OriginalLine,
+		EditedLine:   v.EditedLine,
+	}
+
+	return res
+}
+
 // unmarshalParticipantSessionRequestBodyToMeetingserviceParticipantSession
 // builds a value of type *meetingservice.ParticipantSession from a value of
 // type *ParticipantSessionRequestBody.
@@ -6372,3 +8397,84 @@ func marshalMeetingserviceParticipantSessionToParticipantSessionResponseBody(v *
 
 	return res
 }
+
+// unmarshalITXPastMeetingParticipantUpdateRequestBodyToMeetingserviceITXPastMeetingParticipantUpdate
+// builds a value of type *meetingservice.ITXPastMeetingParticipantUpdate from
+// a value of type *ITXPastMeetingParticipantUpdateRequestBody.
+func unmarshalITXPastMeetingParticipantUpdateRequestBodyToMeetingserviceITXPastMeetingParticipantUpdate(v *ITXPastMeetingParticipantUpdateRequestBody) *meetingservice.ITXPastMeetingParticipantUpdate {
+	res := &meetingservice.ITXPastMeetingParticipantUpdate{
+		ParticipantID:         *v.ParticipantID,
+		InviteeID:             v.InviteeID,
+		AttendeeID:            v.AttendeeID,
+		IsInvited:             v.IsInvited,
+		IsAttended:            v.IsAttended,
+		Email:                 v.Email,
+		Username:              v.Username,
+		LfUserID:              v.LfUserID,
+		FirstName:             v.FirstName,
+		LastName:              v.LastName,
+		OrgName:               v.OrgName,
+		JobTitle:              v.JobTitle,
+		CommitteeRole:         v.CommitteeRole,
+		CommitteeVotingStatus: v.CommitteeVotingStatus,
+		IsVerified:            v.IsVerified,
+	}
+
+	return res
+}
+
+// marshalMeetingserviceITXPastMeetingParticipantToITXPastMeetingParticipantResponseBody
+// builds a value of type *ITXPastMeetingParticipantResponseBody from a value
+// of type *meetingservice.ITXPastMeetingParticipant.
+func marshalMeetingserviceITXPastMeetingParticipantToITXPastMeetingParticipantResponseBody(v *meetingservice.ITXPastMeetingParticipant) *ITXPastMeetingParticipantResponseBody {
+	res := &ITXPastMeetingParticipantResponseBody{
+		ID:                    v.ID,
+		InviteeID:             v.InviteeID,
+		AttendeeID:            v.AttendeeID,
+		PastMeetingID:         v.PastMeetingID,
+		MeetingID:             v.MeetingID,
+		Email:                 v.Email,
+		FirstName:             v.FirstName,
+		LastName:              v.LastName,
+		Username:              v.Username,
+		LfUserID:              v.LfUserID,
+		OrgName:               v.OrgName,
+		JobTitle:              v.JobTitle,
+		OrgIsMember:           v.OrgIsMember,
+		OrgIsProjectMember:    v.OrgIsProjectMember,
+		CommitteeID:           v.CommitteeID,
+		CommitteeRole:         v.CommitteeRole,
+		IsCommitteeMember:     v.IsCommitteeMember,
+		CommitteeVotingStatus: v.CommitteeVotingStatus,
+		AvatarURL:             v.AvatarURL,
+		IsInvited:             v.IsInvited,
+		IsAttended:            v.IsAttended,
+		IsVerified:            v.IsVerified,
+		IsUnknown:             v.IsUnknown,
+		IsAiReconciled:        v.IsAiReconciled,
+		IsAutoMatched:         v.IsAutoMatched,
+		ZoomUserName:          v.ZoomUserName,
+		MappedInviteeName:     v.MappedInviteeName,
+		AverageAttendance:     v.AverageAttendance,
+		CreatedAt:             v.CreatedAt,
+		ModifiedAt:            v.ModifiedAt,
+	}
+	if v.Sessions != nil {
+		res.Sessions = make([]*ParticipantSessionResponseBody, len(v.Sessions))
+		for i, val := range v.Sessions {
+			if val == nil {
+				res.Sessions[i] = nil
+				continue
+			}
+			res.Sessions[i] = marshalMeetingserviceParticipantSessionToParticipantSessionResponseBody(val)
+		}
+	}
+	if v.CreatedBy != nil {
+		res.CreatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(v.CreatedBy)
+	}
+	if v.ModifiedBy != nil {
+		res.ModifiedBy = marshalMeetingserviceITXUserToITXUserResponseBody(v.ModifiedBy)
+	}
+
+	return res
+}
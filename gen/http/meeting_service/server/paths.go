@@ -21,6 +21,16 @@ func LivezMeetingServicePath() string {
 	return "/livez"
 }
 
+// GetServiceConfigMeetingServicePath returns the URL path to the Meeting Service service get-service-config HTTP endpoint.
+func GetServiceConfigMeetingServicePath() string {
+	return "/_meetings/config"
+}
+
+// GetServiceHealthMeetingServicePath returns the URL path to the Meeting Service service get-service-health HTTP endpoint.
+func GetServiceHealthMeetingServicePath() string {
+	return "/_meetings/health"
+}
+
 // CreateItxMeetingMeetingServicePath returns the URL path to the Meeting Service service create-itx-meeting HTTP endpoint.
 func CreateItxMeetingMeetingServicePath() string {
 	return "/itx/meetings"
@@ -36,6 +46,11 @@ func DeleteItxMeetingMeetingServicePath(meetingID string) string {
 	return fmt.Sprintf("/itx/meetings/%v", meetingID)
 }
 
+// CloneItxMeetingMeetingServicePath returns the URL path to the Meeting Service service clone-itx-meeting HTTP endpoint.
+func CloneItxMeetingMeetingServicePath(meetingID string) string {
+	return fmt.Sprintf("/itx/meetings/%v/clone", meetingID)
+}
+
 // UpdateItxMeetingMeetingServicePath returns the URL path to the Meeting Service service update-itx-meeting HTTP endpoint.
 func UpdateItxMeetingMeetingServicePath(meetingID string) string {
 	return fmt.Sprintf("/itx/meetings/%v", meetingID)
@@ -46,6 +61,11 @@ func GetItxMeetingCountMeetingServicePath() string {
 	return "/itx/meeting_count"
 }
 
+// PreviewItxMeetingOccurrencesMeetingServicePath returns the URL path to the Meeting Service service preview-itx-meeting-occurrences HTTP endpoint.
+func PreviewItxMeetingOccurrencesMeetingServicePath() string {
+	return "/itx/occurrence_preview"
+}
+
 // CreateItxRegistrantMeetingServicePath returns the URL path to the Meeting Service service create-itx-registrant HTTP endpoint.
 func CreateItxRegistrantMeetingServicePath(meetingID string) string {
 	return fmt.Sprintf("/itx/meetings/%v/registrants", meetingID)
@@ -71,11 +91,26 @@ func GetItxJoinLinkMeetingServicePath(meetingID string) string {
 	return fmt.Sprintf("/itx/meetings/%v/join_link", meetingID)
 }
 
+// LaunchItxMeetingMeetingServicePath returns the URL path to the Meeting Service service launch-itx-meeting HTTP endpoint.
+func LaunchItxMeetingMeetingServicePath(meetingID string) string {
+	return fmt.Sprintf("/itx/meetings/%v/launch", meetingID)
+}
+
 // GetItxRegistrantIcsMeetingServicePath returns the URL path to the Meeting Service service get-itx-registrant-ics HTTP endpoint.
 func GetItxRegistrantIcsMeetingServicePath(meetingID string, registrantID string) string {
 	return fmt.Sprintf("/itx/meetings/%v/registrants/%v/ics", meetingID, registrantID)
 }
 
+// AddItxRegistrantHostMeetingServicePath returns the URL path to the Meeting Service service add-itx-registrant-host HTTP endpoint.
+func AddItxRegistrantHostMeetingServicePath(meetingID string, registrantID string) string {
+	return fmt.Sprintf("/itx/meetings/%v/registrants/%v/host", meetingID, registrantID)
+}
+
+// RemoveItxRegistrantHostMeetingServicePath returns the URL path to the Meeting Service service remove-itx-registrant-host HTTP endpoint.
+func RemoveItxRegistrantHostMeetingServicePath(meetingID string, registrantID string) string {
+	return fmt.Sprintf("/itx/meetings/%v/registrants/%v/host", meetingID, registrantID)
+}
+
 // ResendItxRegistrantInvitationMeetingServicePath returns the URL path to the Meeting Service service resend-itx-registrant-invitation HTTP endpoint.
 func ResendItxRegistrantInvitationMeetingServicePath(meetingID string, registrantID string) string {
 	return fmt.Sprintf("/itx/meetings/%v/registrants/%v/resend", meetingID, registrantID)
@@ -91,6 +126,11 @@ func RegisterItxCommitteeMembersMeetingServicePath(meetingID string) string {
 	return fmt.Sprintf("/itx/meetings/%v/register_committee_members", meetingID)
 }
 
+// ListItxMeetingOccurrencesMeetingServicePath returns the URL path to the Meeting Service service list-itx-meeting-occurrences HTTP endpoint.
+func ListItxMeetingOccurrencesMeetingServicePath(meetingID string) string {
+	return fmt.Sprintf("/itx/meetings/%v/occurrences", meetingID)
+}
+
 // UpdateItxOccurrenceMeetingServicePath returns the URL path to the Meeting Service service update-itx-occurrence HTTP endpoint.
 func UpdateItxOccurrenceMeetingServicePath(meetingID string, occurrenceID string) string {
 	return fmt.Sprintf("/itx/meetings/%v/occurrences/%v", meetingID, occurrenceID)
@@ -131,11 +171,26 @@ func GetItxPastMeetingSummaryMeetingServicePath(pastMeetingID string, summaryUID
 	return fmt.Sprintf("/itx/past_meetings/%v/summaries/%v", pastMeetingID, summaryUID)
 }
 
+// GetItxPastMeetingSummaryDiffMeetingServicePath returns the URL path to the Meeting Service service get-itx-past-meeting-summary-diff HTTP endpoint.
+func GetItxPastMeetingSummaryDiffMeetingServicePath(pastMeetingID string, summaryUID string) string {
+	return fmt.Sprintf("/itx/past_meetings/%v/summaries/%v/diff", pastMeetingID, summaryUID)
+}
+
 // UpdateItxPastMeetingSummaryMeetingServicePath returns the URL path to the Meeting Service service update-itx-past-meeting-summary HTTP endpoint.
 func UpdateItxPastMeetingSummaryMeetingServicePath(pastMeetingID string, summaryUID string) string {
 	return fmt.Sprintf("/itx/past_meetings/%v/summaries/%v", pastMeetingID, summaryUID)
 }
 
+// ApproveItxPastMeetingSummaryMeetingServicePath returns the URL path to the Meeting Service service approve-itx-past-meeting-summary HTTP endpoint.
+func ApproveItxPastMeetingSummaryMeetingServicePath(pastMeetingID string, summaryUID string) string {
+	return fmt.Sprintf("/itx/past_meetings/%v/summaries/%v/approve", pastMeetingID, summaryUID)
+}
+
+// RejectItxPastMeetingSummaryMeetingServicePath returns the URL path to the Meeting Service service reject-itx-past-meeting-summary HTTP endpoint.
+func RejectItxPastMeetingSummaryMeetingServicePath(pastMeetingID string, summaryUID string) string {
+	return fmt.Sprintf("/itx/past_meetings/%v/summaries/%v/reject", pastMeetingID, summaryUID)
+}
+
 // CreateItxPastMeetingParticipantMeetingServicePath returns the URL path to the Meeting Service service create-itx-past-meeting-participant HTTP endpoint.
 func CreateItxPastMeetingParticipantMeetingServicePath(pastMeetingID string) string {
 	return fmt.Sprintf("/itx/past_meetings/%v/participants", pastMeetingID)
@@ -146,6 +201,11 @@ func UpdateItxPastMeetingParticipantMeetingServicePath(pastMeetingID string, par
 	return fmt.Sprintf("/itx/past_meetings/%v/participants/%v", pastMeetingID, participantID)
 }
 
+// BulkUpdateItxPastMeetingParticipantsMeetingServicePath returns the URL path to the Meeting Service service bulk-update-itx-past-meeting-participants HTTP endpoint.
+func BulkUpdateItxPastMeetingParticipantsMeetingServicePath(pastMeetingID string) string {
+	return fmt.Sprintf("/itx/past_meetings/%v/participants/bulk", pastMeetingID)
+}
+
 // DeleteItxPastMeetingParticipantMeetingServicePath returns the URL path to the Meeting Service service delete-itx-past-meeting-participant HTTP endpoint.
 func DeleteItxPastMeetingParticipantMeetingServicePath(pastMeetingID string, participantID string) string {
 	return fmt.Sprintf("/itx/past_meetings/%v/participants/%v", pastMeetingID, participantID)
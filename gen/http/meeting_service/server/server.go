@@ -22,20 +22,28 @@ type Server struct {
 	Mounts                                []*MountPoint
 	Readyz                                http.Handler
 	Livez                                 http.Handler
+	GetServiceConfig                      http.Handler
+	GetServiceHealth                      http.Handler
 	CreateItxMeeting                      http.Handler
 	GetItxMeeting                         http.Handler
 	DeleteItxMeeting                      http.Handler
+	CloneItxMeeting                       http.Handler
 	UpdateItxMeeting                      http.Handler
 	GetItxMeetingCount                    http.Handler
+	PreviewItxMeetingOccurrences          http.Handler
 	CreateItxRegistrant                   http.Handler
 	GetItxRegistrant                      http.Handler
 	UpdateItxRegistrant                   http.Handler
 	DeleteItxRegistrant                   http.Handler
 	GetItxJoinLink                        http.Handler
+	LaunchItxMeeting                      http.Handler
 	GetItxRegistrantIcs                   http.Handler
+	AddItxRegistrantHost                  http.Handler
+	RemoveItxRegistrantHost               http.Handler
 	ResendItxRegistrantInvitation         http.Handler
 	ResendItxMeetingInvitations           http.Handler
 	RegisterItxCommitteeMembers           http.Handler
+	ListItxMeetingOccurrences             http.Handler
 	UpdateItxOccurrence                   http.Handler
 	DeleteItxOccurrence                   http.Handler
 	SubmitItxMeetingResponse              http.Handler
@@ -44,9 +52,13 @@ type Server struct {
 	DeleteItxPastMeeting                  http.Handler
 	UpdateItxPastMeeting                  http.Handler
 	GetItxPastMeetingSummary              http.Handler
+	GetItxPastMeetingSummaryDiff          http.Handler
 	UpdateItxPastMeetingSummary           http.Handler
+	ApproveItxPastMeetingSummary          http.Handler
+	RejectItxPastMeetingSummary           http.Handler
 	CreateItxPastMeetingParticipant       http.Handler
 	UpdateItxPastMeetingParticipant       http.Handler
+	BulkUpdateItxPastMeetingParticipants  http.Handler
 	DeleteItxPastMeetingParticipant       http.Handler
 	CreateItxMeetingAttachment            http.Handler
 	GetItxMeetingAttachment               http.Handler
@@ -115,20 +127,28 @@ func New(
 		Mounts: []*MountPoint{
 			{"Readyz", "GET", "/readyz"},
 			{"Livez", "GET", "/livez"},
+			{"GetServiceConfig", "GET", "/_meetings/config"},
+			{"GetServiceHealth", "GET", "/_meetings/health"},
 			{"CreateItxMeeting", "POST", "/itx/meetings"},
 			{"GetItxMeeting", "GET", "/itx/meetings/{meeting_id}"},
 			{"DeleteItxMeeting", "DELETE", "/itx/meetings/{meeting_id}"},
+			{"CloneItxMeeting", "POST", "/itx/meetings/{meeting_id}/clone"},
 			{"UpdateItxMeeting", "PUT", "/itx/meetings/{meeting_id}"},
 			{"GetItxMeetingCount", "GET", "/itx/meeting_count"},
+			{"PreviewItxMeetingOccurrences", "POST", "/itx/occurrence_preview"},
 			{"CreateItxRegistrant", "POST", "/itx/meetings/{meeting_id}/registrants"},
 			{"GetItxRegistrant", "GET", "/itx/meetings/{meeting_id}/registrants/{registrant_id}"},
 			{"UpdateItxRegistrant", "PUT", "/itx/meetings/{meeting_id}/registrants/{registrant_id}"},
 			{"DeleteItxRegistrant", "DELETE", "/itx/meetings/{meeting_id}/registrants/{registrant_id}"},
 			{"GetItxJoinLink", "GET", "/itx/meetings/{meeting_id}/join_link"},
+			{"LaunchItxMeeting", "GET", "/itx/meetings/{meeting_id}/launch"},
 			{"GetItxRegistrantIcs", "GET", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/ics"},
+			{"AddItxRegistrantHost", "PUT", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/host"},
+			{"RemoveItxRegistrantHost", "DELETE", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/host"},
 			{"ResendItxRegistrantInvitation", "POST", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/resend"},
 			{"ResendItxMeetingInvitations", "POST", "/itx/meetings/{meeting_id}/resend"},
 			{"RegisterItxCommitteeMembers", "POST", "/itx/meetings/{meeting_id}/register_committee_members"},
+			{"ListItxMeetingOccurrences", "GET", "/itx/meetings/{meeting_id}/occurrences"},
 			{"UpdateItxOccurrence", "PUT", "/itx/meetings/{meeting_id}/occurrences/{occurrence_id}"},
 			{"DeleteItxOccurrence", "DELETE", "/itx/meetings/{meeting_id}/occurrences/{occurrence_id}"},
 			{"SubmitItxMeetingResponse", "POST", "/itx/meetings/{meeting_id}/responses"},
@@ -137,9 +157,13 @@ func New(
 			{"DeleteItxPastMeeting", "DELETE", "/itx/past_meetings/{past_meeting_id}"},
 			{"UpdateItxPastMeeting", "PUT", "/itx/past_meetings/{past_meeting_id}"},
 			{"GetItxPastMeetingSummary", "GET", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}"},
+			{"GetItxPastMeetingSummaryDiff", "GET", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/diff"},
 			{"UpdateItxPastMeetingSummary", "PUT", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}"},
+			{"ApproveItxPastMeetingSummary", "POST", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/approve"},
+			{"RejectItxPastMeetingSummary", "POST", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/reject"},
 			{"CreateItxPastMeetingParticipant", "POST", "/itx/past_meetings/{past_meeting_id}/participants"},
 			{"UpdateItxPastMeetingParticipant", "PUT", "/itx/past_meetings/{past_meeting_id}/participants/{participant_id}"},
+			{"BulkUpdateItxPastMeetingParticipants", "PATCH", "/itx/past_meetings/{past_meeting_id}/participants/bulk"},
 			{"DeleteItxPastMeetingParticipant", "DELETE", "/itx/past_meetings/{past_meeting_id}/participants/{participant_id}"},
 			{"CreateItxMeetingAttachment", "POST", "/itx/meetings/{meeting_id}/attachments"},
 			{"GetItxMeetingAttachment", "GET", "/itx/meetings/{meeting_id}/attachments/{attachment_id}"},
@@ -160,20 +184,28 @@ func New(
 		},
 		Readyz:                                NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
 		Livez:                                 NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
+		GetServiceConfig:                      NewGetServiceConfigHandler(e.GetServiceConfig, mux, decoder, encoder, errhandler, formatter),
+		GetServiceHealth:                      NewGetServiceHealthHandler(e.GetServiceHealth, mux, decoder, encoder, errhandler, formatter),
 		CreateItxMeeting:                      NewCreateItxMeetingHandler(e.CreateItxMeeting, mux, decoder, encoder, errhandler, formatter),
 		GetItxMeeting:                         NewGetItxMeetingHandler(e.GetItxMeeting, mux, decoder, encoder, errhandler, formatter),
 		DeleteItxMeeting:                      NewDeleteItxMeetingHandler(e.DeleteItxMeeting, mux, decoder, encoder, errhandler, formatter),
+		CloneItxMeeting:                       NewCloneItxMeetingHandler(e.CloneItxMeeting, mux, decoder, encoder, errhandler, formatter),
 		UpdateItxMeeting:                      NewUpdateItxMeetingHandler(e.UpdateItxMeeting, mux, decoder, encoder, errhandler, formatter),
 		GetItxMeetingCount:                    NewGetItxMeetingCountHandler(e.GetItxMeetingCount, mux, decoder, encoder, errhandler, formatter),
+		PreviewItxMeetingOccurrences:          NewPreviewItxMeetingOccurrencesHandler(e.PreviewItxMeetingOccurrences, mux, decoder, encoder, errhandler, formatter),
 		CreateItxRegistrant:                   NewCreateItxRegistrantHandler(e.CreateItxRegistrant, mux, decoder, encoder, errhandler, formatter),
 		GetItxRegistrant:                      NewGetItxRegistrantHandler(e.GetItxRegistrant, mux, decoder, encoder, errhandler, formatter),
 		UpdateItxRegistrant:                   NewUpdateItxRegistrantHandler(e.UpdateItxRegistrant, mux, decoder, encoder, errhandler, formatter),
 		DeleteItxRegistrant:                   NewDeleteItxRegistrantHandler(e.DeleteItxRegistrant, mux, decoder, encoder, errhandler, formatter),
 		GetItxJoinLink:                        NewGetItxJoinLinkHandler(e.GetItxJoinLink, mux, decoder, encoder, errhandler, formatter),
+		LaunchItxMeeting:                      NewLaunchItxMeetingHandler(e.LaunchItxMeeting, mux, decoder, encoder, errhandler, formatter),
 		GetItxRegistrantIcs:                   NewGetItxRegistrantIcsHandler(e.GetItxRegistrantIcs, mux, decoder, encoder, errhandler, formatter),
+		AddItxRegistrantHost:                  NewAddItxRegistrantHostHandler(e.AddItxRegistrantHost, mux, decoder, encoder, errhandler, formatter),
+		RemoveItxRegistrantHost:               NewRemoveItxRegistrantHostHandler(e.RemoveItxRegistrantHost, mux, decoder, encoder, errhandler, formatter),
 		ResendItxRegistrantInvitation:         NewResendItxRegistrantInvitationHandler(e.ResendItxRegistrantInvitation, mux, decoder, encoder, errhandler, formatter),
 		ResendItxMeetingInvitations:           NewResendItxMeetingInvitationsHandler(e.ResendItxMeetingInvitations, mux, decoder, encoder, errhandler, formatter),
 		RegisterItxCommitteeMembers:           NewRegisterItxCommitteeMembersHandler(e.RegisterItxCommitteeMembers, mux, decoder, encoder, errhandler, formatter),
+		ListItxMeetingOccurrences:             NewListItxMeetingOccurrencesHandler(e.ListItxMeetingOccurrences, mux, decoder, encoder, errhandler, formatter),
 		UpdateItxOccurrence:                   NewUpdateItxOccurrenceHandler(e.UpdateItxOccurrence, mux, decoder, encoder, errhandler, formatter),
 		DeleteItxOccurrence:                   NewDeleteItxOccurrenceHandler(e.DeleteItxOccurrence, mux, decoder, encoder, errhandler, formatter),
 		SubmitItxMeetingResponse:              NewSubmitItxMeetingResponseHandler(e.SubmitItxMeetingResponse, mux, decoder, encoder, errhandler, formatter),
@@ -182,9 +214,13 @@ func New(
 		DeleteItxPastMeeting:                  NewDeleteItxPastMeetingHandler(e.DeleteItxPastMeeting, mux, decoder, encoder, errhandler, formatter),
 		UpdateItxPastMeeting:                  NewUpdateItxPastMeetingHandler(e.UpdateItxPastMeeting, mux, decoder, encoder, errhandler, formatter),
 		GetItxPastMeetingSummary:              NewGetItxPastMeetingSummaryHandler(e.GetItxPastMeetingSummary, mux, decoder, encoder, errhandler, formatter),
+		GetItxPastMeetingSummaryDiff:          NewGetItxPastMeetingSummaryDiffHandler(e.GetItxPastMeetingSummaryDiff, mux, decoder, encoder, errhandler, formatter),
 		UpdateItxPastMeetingSummary:           NewUpdateItxPastMeetingSummaryHandler(e.UpdateItxPastMeetingSummary, mux, decoder, encoder, errhandler, formatter),
+		ApproveItxPastMeetingSummary:          NewApproveItxPastMeetingSummaryHandler(e.ApproveItxPastMeetingSummary, mux, decoder, encoder, errhandler, formatter),
+		RejectItxPastMeetingSummary:           NewRejectItxPastMeetingSummaryHandler(e.RejectItxPastMeetingSummary, mux, decoder, encoder, errhandler, formatter),
 		CreateItxPastMeetingParticipant:       NewCreateItxPastMeetingParticipantHandler(e.CreateItxPastMeetingParticipant, mux, decoder, encoder, errhandler, formatter),
 		UpdateItxPastMeetingParticipant:       NewUpdateItxPastMeetingParticipantHandler(e.UpdateItxPastMeetingParticipant, mux, decoder, encoder, errhandler, formatter),
+		BulkUpdateItxPastMeetingParticipants:  NewBulkUpdateItxPastMeetingParticipantsHandler(e.BulkUpdateItxPastMeetingParticipants, mux, decoder, encoder, errhandler, formatter),
 		DeleteItxPastMeetingParticipant:       NewDeleteItxPastMeetingParticipantHandler(e.DeleteItxPastMeetingParticipant, mux, decoder, encoder, errhandler, formatter),
 		CreateItxMeetingAttachment:            NewCreateItxMeetingAttachmentHandler(e.CreateItxMeetingAttachment, mux, decoder, encoder, errhandler, formatter),
 		GetItxMeetingAttachment:               NewGetItxMeetingAttachmentHandler(e.GetItxMeetingAttachment, mux, decoder, encoder, errhandler, formatter),
@@ -212,20 +248,28 @@ func (s *Server) Service() string { return "Meeting Service" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Readyz = m(s.Readyz)
 	s.Livez = m(s.Livez)
+	s.GetServiceConfig = m(s.GetServiceConfig)
+	s.GetServiceHealth = m(s.GetServiceHealth)
 	s.CreateItxMeeting = m(s.CreateItxMeeting)
 	s.GetItxMeeting = m(s.GetItxMeeting)
 	s.DeleteItxMeeting = m(s.DeleteItxMeeting)
+	s.CloneItxMeeting = m(s.CloneItxMeeting)
 	s.UpdateItxMeeting = m(s.UpdateItxMeeting)
 	s.GetItxMeetingCount = m(s.GetItxMeetingCount)
+	s.PreviewItxMeetingOccurrences = m(s.PreviewItxMeetingOccurrences)
 	s.CreateItxRegistrant = m(s.CreateItxRegistrant)
 	s.GetItxRegistrant = m(s.GetItxRegistrant)
 	s.UpdateItxRegistrant = m(s.UpdateItxRegistrant)
 	s.DeleteItxRegistrant = m(s.DeleteItxRegistrant)
 	s.GetItxJoinLink = m(s.GetItxJoinLink)
+	s.LaunchItxMeeting = m(s.LaunchItxMeeting)
 	s.GetItxRegistrantIcs = m(s.GetItxRegistrantIcs)
+	s.AddItxRegistrantHost = m(s.AddItxRegistrantHost)
+	s.RemoveItxRegistrantHost = m(s.RemoveItxRegistrantHost)
 	s.ResendItxRegistrantInvitation = m(s.ResendItxRegistrantInvitation)
 	s.ResendItxMeetingInvitations = m(s.ResendItxMeetingInvitations)
 	s.RegisterItxCommitteeMembers = m(s.RegisterItxCommitteeMembers)
+	s.ListItxMeetingOccurrences = m(s.ListItxMeetingOccurrences)
 	s.UpdateItxOccurrence = m(s.UpdateItxOccurrence)
 	s.DeleteItxOccurrence = m(s.DeleteItxOccurrence)
 	s.SubmitItxMeetingResponse = m(s.SubmitItxMeetingResponse)
@@ -234,9 +278,13 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.DeleteItxPastMeeting = m(s.DeleteItxPastMeeting)
 	s.UpdateItxPastMeeting = m(s.UpdateItxPastMeeting)
 	s.GetItxPastMeetingSummary = m(s.GetItxPastMeetingSummary)
+	s.GetItxPastMeetingSummaryDiff = m(s.GetItxPastMeetingSummaryDiff)
 	s.UpdateItxPastMeetingSummary = m(s.UpdateItxPastMeetingSummary)
+	s.ApproveItxPastMeetingSummary = m(s.ApproveItxPastMeetingSummary)
+	s.RejectItxPastMeetingSummary = m(s.RejectItxPastMeetingSummary)
 	s.CreateItxPastMeetingParticipant = m(s.CreateItxPastMeetingParticipant)
 	s.UpdateItxPastMeetingParticipant = m(s.UpdateItxPastMeetingParticipant)
+	s.BulkUpdateItxPastMeetingParticipants = m(s.BulkUpdateItxPastMeetingParticipants)
 	s.DeleteItxPastMeetingParticipant = m(s.DeleteItxPastMeetingParticipant)
 	s.CreateItxMeetingAttachment = m(s.CreateItxMeetingAttachment)
 	s.GetItxMeetingAttachment = m(s.GetItxMeetingAttachment)
@@ -259,20 +307,28 @@ func (s *Server) MethodNames() []string { return meetingservice.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountReadyzHandler(mux, h.Readyz)
 	MountLivezHandler(mux, h.Livez)
+	MountGetServiceConfigHandler(mux, h.GetServiceConfig)
+	MountGetServiceHealthHandler(mux, h.GetServiceHealth)
 	MountCreateItxMeetingHandler(mux, h.CreateItxMeeting)
 	MountGetItxMeetingHandler(mux, h.GetItxMeeting)
 	MountDeleteItxMeetingHandler(mux, h.DeleteItxMeeting)
+	MountCloneItxMeetingHandler(mux, h.CloneItxMeeting)
 	MountUpdateItxMeetingHandler(mux, h.UpdateItxMeeting)
 	MountGetItxMeetingCountHandler(mux, h.GetItxMeetingCount)
+	MountPreviewItxMeetingOccurrencesHandler(mux, h.PreviewItxMeetingOccurrences)
 	MountCreateItxRegistrantHandler(mux, h.CreateItxRegistrant)
 	MountGetItxRegistrantHandler(mux, h.GetItxRegistrant)
 	MountUpdateItxRegistrantHandler(mux, h.UpdateItxRegistrant)
 	MountDeleteItxRegistrantHandler(mux, h.DeleteItxRegistrant)
 	MountGetItxJoinLinkHandler(mux, h.GetItxJoinLink)
+	MountLaunchItxMeetingHandler(mux, h.LaunchItxMeeting)
 	MountGetItxRegistrantIcsHandler(mux, h.GetItxRegistrantIcs)
+	MountAddItxRegistrantHostHandler(mux, h.AddItxRegistrantHost)
+	MountRemoveItxRegistrantHostHandler(mux, h.RemoveItxRegistrantHost)
 	MountResendItxRegistrantInvitationHandler(mux, h.ResendItxRegistrantInvitation)
 	MountResendItxMeetingInvitationsHandler(mux, h.ResendItxMeetingInvitations)
 	MountRegisterItxCommitteeMembersHandler(mux, h.RegisterItxCommitteeMembers)
+	MountListItxMeetingOccurrencesHandler(mux, h.ListItxMeetingOccurrences)
 	MountUpdateItxOccurrenceHandler(mux, h.UpdateItxOccurrence)
 	MountDeleteItxOccurrenceHandler(mux, h.DeleteItxOccurrence)
 	MountSubmitItxMeetingResponseHandler(mux, h.SubmitItxMeetingResponse)
@@ -281,9 +337,13 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountDeleteItxPastMeetingHandler(mux, h.DeleteItxPastMeeting)
 	MountUpdateItxPastMeetingHandler(mux, h.UpdateItxPastMeeting)
 	MountGetItxPastMeetingSummaryHandler(mux, h.GetItxPastMeetingSummary)
+	MountGetItxPastMeetingSummaryDiffHandler(mux, h.GetItxPastMeetingSummaryDiff)
 	MountUpdateItxPastMeetingSummaryHandler(mux, h.UpdateItxPastMeetingSummary)
+	MountApproveItxPastMeetingSummaryHandler(mux, h.ApproveItxPastMeetingSummary)
+	MountRejectItxPastMeetingSummaryHandler(mux, h.RejectItxPastMeetingSummary)
 	MountCreateItxPastMeetingParticipantHandler(mux, h.CreateItxPastMeetingParticipant)
 	MountUpdateItxPastMeetingParticipantHandler(mux, h.UpdateItxPastMeetingParticipant)
+	MountBulkUpdateItxPastMeetingParticipantsHandler(mux, h.BulkUpdateItxPastMeetingParticipants)
 	MountDeleteItxPastMeetingParticipantHandler(mux, h.DeleteItxPastMeetingParticipant)
 	MountCreateItxMeetingAttachmentHandler(mux, h.CreateItxMeetingAttachment)
 	MountGetItxMeetingAttachmentHandler(mux, h.GetItxMeetingAttachment)
@@ -400,6 +460,114 @@ func NewLivezHandler(
 	})
 }
 
+// MountGetServiceConfigHandler configures the mux to serve the "Meeting
+// Service" service "get-service-config" endpoint.
+func MountGetServiceConfigHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/_meetings/config", f)
+}
+
+// NewGetServiceConfigHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Meeting Service" service "get-service-config"
+// endpoint.
+func NewGetServiceConfigHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetServiceConfigRequest(mux, decoder)
+		encodeResponse = EncodeGetServiceConfigResponse(encoder)
+		encodeError    = EncodeGetServiceConfigError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-service-config")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetServiceHealthHandler configures the mux to serve the "Meeting
+// Service" service "get-service-health" endpoint.
+func MountGetServiceHealthHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/_meetings/health", f)
+}
+
+// NewGetServiceHealthHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Meeting Service" service "get-service-health"
+// endpoint.
+func NewGetServiceHealthHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetServiceHealthRequest(mux, decoder)
+		encodeResponse = EncodeGetServiceHealthResponse(encoder)
+		encodeError    = EncodeGetServiceHealthError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-service-health")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCreateItxMeetingHandler configures the mux to serve the "Meeting
 // Service" service "create-itx-meeting" endpoint.
 func MountCreateItxMeetingHandler(mux goahttp.Muxer, h http.Handler) {
@@ -561,6 +729,59 @@ func NewDeleteItxMeetingHandler(
 	})
 }
 
+// MountCloneItxMeetingHandler configures the mux to serve the "Meeting
+// Service" service "clone-itx-meeting" endpoint.
+func MountCloneItxMeetingHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/itx/meetings/{meeting_id}/clone", f)
+}
+
+// NewCloneItxMeetingHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Meeting Service" service "clone-itx-meeting" endpoint.
+func NewCloneItxMeetingHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCloneItxMeetingRequest(mux, decoder)
+		encodeResponse = EncodeCloneItxMeetingResponse(encoder)
+		encodeError    = EncodeCloneItxMeetingError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "clone-itx-meeting")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountUpdateItxMeetingHandler configures the mux to serve the "Meeting
 // Service" service "update-itx-meeting" endpoint.
 func MountUpdateItxMeetingHandler(mux goahttp.Muxer, h http.Handler) {
@@ -669,6 +890,60 @@ func NewGetItxMeetingCountHandler(
 	})
 }
 
+// MountPreviewItxMeetingOccurrencesHandler configures the mux to serve the
+// "Meeting Service" service "preview-itx-meeting-occurrences" endpoint.
+func MountPreviewItxMeetingOccurrencesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/itx/occurrence_preview", f)
+}
+
+// NewPreviewItxMeetingOccurrencesHandler creates a HTTP handler which loads
+// the HTTP request and calls the "Meeting Service" service
+// "preview-itx-meeting-occurrences" endpoint.
+func NewPreviewItxMeetingOccurrencesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePreviewItxMeetingOccurrencesRequest(mux, decoder)
+		encodeResponse = EncodePreviewItxMeetingOccurrencesResponse(encoder)
+		encodeError    = EncodePreviewItxMeetingOccurrencesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "preview-itx-meeting-occurrences")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCreateItxRegistrantHandler configures the mux to serve the "Meeting
 // Service" service "create-itx-registrant" endpoint.
 func MountCreateItxRegistrantHandler(mux goahttp.Muxer, h http.Handler) {
@@ -843,10 +1118,171 @@ func MountDeleteItxRegistrantHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("DELETE", "/itx/meetings/{meeting_id}/registrants/{registrant_id}", f)
 }
 
-// NewDeleteItxRegistrantHandler creates a HTTP handler which loads the HTTP
-// request and calls the "Meeting Service" service "delete-itx-registrant"
+// NewDeleteItxRegistrantHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Meeting Service" service "delete-itx-registrant"
+// endpoint.
+func NewDeleteItxRegistrantHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteItxRegistrantRequest(mux, decoder)
+		encodeResponse = EncodeDeleteItxRegistrantResponse(encoder)
+		encodeError    = EncodeDeleteItxRegistrantError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-itx-registrant")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetItxJoinLinkHandler configures the mux to serve the "Meeting Service"
+// service "get-itx-join-link" endpoint.
+func MountGetItxJoinLinkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/itx/meetings/{meeting_id}/join_link", f)
+}
+
+// NewGetItxJoinLinkHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Meeting Service" service "get-itx-join-link" endpoint.
+func NewGetItxJoinLinkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetItxJoinLinkRequest(mux, decoder)
+		encodeResponse = EncodeGetItxJoinLinkResponse(encoder)
+		encodeError    = EncodeGetItxJoinLinkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-itx-join-link")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountLaunchItxMeetingHandler configures the mux to serve the "Meeting
+// Service" service "launch-itx-meeting" endpoint.
+func MountLaunchItxMeetingHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/itx/meetings/{meeting_id}/launch", f)
+}
+
+// NewLaunchItxMeetingHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Meeting Service" service "launch-itx-meeting"
+// endpoint.
+func NewLaunchItxMeetingHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeLaunchItxMeetingRequest(mux, decoder)
+		encodeResponse = EncodeLaunchItxMeetingResponse(encoder)
+		encodeError    = EncodeLaunchItxMeetingError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "launch-itx-meeting")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetItxRegistrantIcsHandler configures the mux to serve the "Meeting
+// Service" service "get-itx-registrant-ics" endpoint.
+func MountGetItxRegistrantIcsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/ics", f)
+}
+
+// NewGetItxRegistrantIcsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Meeting Service" service "get-itx-registrant-ics"
 // endpoint.
-func NewDeleteItxRegistrantHandler(
+func NewGetItxRegistrantIcsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -855,13 +1291,13 @@ func NewDeleteItxRegistrantHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDeleteItxRegistrantRequest(mux, decoder)
-		encodeResponse = EncodeDeleteItxRegistrantResponse(encoder)
-		encodeError    = EncodeDeleteItxRegistrantError(encoder, formatter)
+		decodeRequest  = DecodeGetItxRegistrantIcsRequest(mux, decoder)
+		encodeResponse = EncodeGetItxRegistrantIcsResponse(encoder)
+		encodeError    = EncodeGetItxRegistrantIcsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "delete-itx-registrant")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-itx-registrant-ics")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -885,21 +1321,22 @@ func NewDeleteItxRegistrantHandler(
 	})
 }
 
-// MountGetItxJoinLinkHandler configures the mux to serve the "Meeting Service"
-// service "get-itx-join-link" endpoint.
-func MountGetItxJoinLinkHandler(mux goahttp.Muxer, h http.Handler) {
+// MountAddItxRegistrantHostHandler configures the mux to serve the "Meeting
+// Service" service "add-itx-registrant-host" endpoint.
+func MountAddItxRegistrantHostHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/itx/meetings/{meeting_id}/join_link", f)
+	mux.Handle("PUT", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/host", f)
 }
 
-// NewGetItxJoinLinkHandler creates a HTTP handler which loads the HTTP request
-// and calls the "Meeting Service" service "get-itx-join-link" endpoint.
-func NewGetItxJoinLinkHandler(
+// NewAddItxRegistrantHostHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Meeting Service" service "add-itx-registrant-host"
+// endpoint.
+func NewAddItxRegistrantHostHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -908,13 +1345,13 @@ func NewGetItxJoinLinkHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetItxJoinLinkRequest(mux, decoder)
-		encodeResponse = EncodeGetItxJoinLinkResponse(encoder)
-		encodeError    = EncodeGetItxJoinLinkError(encoder, formatter)
+		decodeRequest  = DecodeAddItxRegistrantHostRequest(mux, decoder)
+		encodeResponse = EncodeAddItxRegistrantHostResponse(encoder)
+		encodeError    = EncodeAddItxRegistrantHostError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-itx-join-link")
+		ctx = context.WithValue(ctx, goa.MethodKey, "add-itx-registrant-host")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -938,22 +1375,22 @@ func NewGetItxJoinLinkHandler(
 	})
 }
 
-// MountGetItxRegistrantIcsHandler configures the mux to serve the "Meeting
-// Service" service "get-itx-registrant-ics" endpoint.
-func MountGetItxRegistrantIcsHandler(mux goahttp.Muxer, h http.Handler) {
+// MountRemoveItxRegistrantHostHandler configures the mux to serve the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint.
+func MountRemoveItxRegistrantHostHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/ics", f)
+	mux.Handle("DELETE", "/itx/meetings/{meeting_id}/registrants/{registrant_id}/host", f)
 }
 
-// NewGetItxRegistrantIcsHandler creates a HTTP handler which loads the HTTP
-// request and calls the "Meeting Service" service "get-itx-registrant-ics"
-// endpoint.
-func NewGetItxRegistrantIcsHandler(
+// NewRemoveItxRegistrantHostHandler creates a HTTP handler which loads the
+// HTTP request and calls the "Meeting Service" service
+// "remove-itx-registrant-host" endpoint.
+func NewRemoveItxRegistrantHostHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -962,13 +1399,13 @@ func NewGetItxRegistrantIcsHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetItxRegistrantIcsRequest(mux, decoder)
-		encodeResponse = EncodeGetItxRegistrantIcsResponse(encoder)
-		encodeError    = EncodeGetItxRegistrantIcsError(encoder, formatter)
+		decodeRequest  = DecodeRemoveItxRegistrantHostRequest(mux, decoder)
+		encodeResponse = EncodeRemoveItxRegistrantHostResponse(encoder)
+		encodeError    = EncodeRemoveItxRegistrantHostError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-itx-registrant-ics")
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove-itx-registrant-host")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1154,6 +1591,60 @@ func NewRegisterItxCommitteeMembersHandler(
 	})
 }
 
+// MountListItxMeetingOccurrencesHandler configures the mux to serve the
+// "Meeting Service" service "list-itx-meeting-occurrences" endpoint.
+func MountListItxMeetingOccurrencesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/itx/meetings/{meeting_id}/occurrences", f)
+}
+
+// NewListItxMeetingOccurrencesHandler creates a HTTP handler which loads the
+// HTTP request and calls the "Meeting Service" service
+// "list-itx-meeting-occurrences" endpoint.
+func NewListItxMeetingOccurrencesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListItxMeetingOccurrencesRequest(mux, decoder)
+		encodeResponse = EncodeListItxMeetingOccurrencesResponse(encoder)
+		encodeError    = EncodeListItxMeetingOccurrencesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-itx-meeting-occurrences")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountUpdateItxOccurrenceHandler configures the mux to serve the "Meeting
 // Service" service "update-itx-occurrence" endpoint.
 func MountUpdateItxOccurrenceHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1586,6 +2077,60 @@ func NewGetItxPastMeetingSummaryHandler(
 	})
 }
 
+// MountGetItxPastMeetingSummaryDiffHandler configures the mux to serve the
+// "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint.
+func MountGetItxPastMeetingSummaryDiffHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/diff", f)
+}
+
+// NewGetItxPastMeetingSummaryDiffHandler creates a HTTP handler which loads
+// the HTTP request and calls the "Meeting Service" service
+// "get-itx-past-meeting-summary-diff" endpoint.
+func NewGetItxPastMeetingSummaryDiffHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetItxPastMeetingSummaryDiffRequest(mux, decoder)
+		encodeResponse = EncodeGetItxPastMeetingSummaryDiffResponse(encoder)
+		encodeError    = EncodeGetItxPastMeetingSummaryDiffError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-itx-past-meeting-summary-diff")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountUpdateItxPastMeetingSummaryHandler configures the mux to serve the
 // "Meeting Service" service "update-itx-past-meeting-summary" endpoint.
 func MountUpdateItxPastMeetingSummaryHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1640,6 +2185,114 @@ func NewUpdateItxPastMeetingSummaryHandler(
 	})
 }
 
+// MountApproveItxPastMeetingSummaryHandler configures the mux to serve the
+// "Meeting Service" service "approve-itx-past-meeting-summary" endpoint.
+func MountApproveItxPastMeetingSummaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/approve", f)
+}
+
+// NewApproveItxPastMeetingSummaryHandler creates a HTTP handler which loads
+// the HTTP request and calls the "Meeting Service" service
+// "approve-itx-past-meeting-summary" endpoint.
+func NewApproveItxPastMeetingSummaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeApproveItxPastMeetingSummaryRequest(mux, decoder)
+		encodeResponse = EncodeApproveItxPastMeetingSummaryResponse(encoder)
+		encodeError    = EncodeApproveItxPastMeetingSummaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "approve-itx-past-meeting-summary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRejectItxPastMeetingSummaryHandler configures the mux to serve the
+// "Meeting Service" service "reject-itx-past-meeting-summary" endpoint.
+func MountRejectItxPastMeetingSummaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/itx/past_meetings/{past_meeting_id}/summaries/{summary_uid}/reject", f)
+}
+
+// NewRejectItxPastMeetingSummaryHandler creates a HTTP handler which loads the
+// HTTP request and calls the "Meeting Service" service
+// "reject-itx-past-meeting-summary" endpoint.
+func NewRejectItxPastMeetingSummaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRejectItxPastMeetingSummaryRequest(mux, decoder)
+		encodeResponse = EncodeRejectItxPastMeetingSummaryResponse(encoder)
+		encodeError    = EncodeRejectItxPastMeetingSummaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "reject-itx-past-meeting-summary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCreateItxPastMeetingParticipantHandler configures the mux to serve the
 // "Meeting Service" service "create-itx-past-meeting-participant" endpoint.
 func MountCreateItxPastMeetingParticipantHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1748,6 +2401,61 @@ func NewUpdateItxPastMeetingParticipantHandler(
 	})
 }
 
+// MountBulkUpdateItxPastMeetingParticipantsHandler configures the mux to serve
+// the "Meeting Service" service "bulk-update-itx-past-meeting-participants"
+// endpoint.
+func MountBulkUpdateItxPastMeetingParticipantsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/itx/past_meetings/{past_meeting_id}/participants/bulk", f)
+}
+
+// NewBulkUpdateItxPastMeetingParticipantsHandler creates a HTTP handler which
+// loads the HTTP request and calls the "Meeting Service" service
+// "bulk-update-itx-past-meeting-participants" endpoint.
+func NewBulkUpdateItxPastMeetingParticipantsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBulkUpdateItxPastMeetingParticipantsRequest(mux, decoder)
+		encodeResponse = EncodeBulkUpdateItxPastMeetingParticipantsResponse(encoder)
+		encodeError    = EncodeBulkUpdateItxPastMeetingParticipantsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "bulk-update-itx-past-meeting-participants")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Meeting Service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountDeleteItxPastMeetingParticipantHandler configures the mux to serve the
 // "Meeting Service" service "delete-itx-past-meeting-participant" endpoint.
 func MountDeleteItxPastMeetingParticipantHandler(mux goahttp.Muxer, h http.Handler) {
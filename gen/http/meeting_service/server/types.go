@@ -56,6 +56,15 @@ type CreateItxMeetingRequestBody struct {
 	Recurrence *RecurrenceRequestBody `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
 }
 
+// CloneItxMeetingRequestBody is the type of the "Meeting Service" service
+// "clone-itx-meeting" endpoint HTTP request body.
+type CloneItxMeetingRequestBody struct {
+	// The start time of the meeting in RFC3339 format
+	StartTime *string `form:"start_time,omitempty" json:"start_time,omitempty" xml:"start_time,omitempty"`
+	// Title of the new meeting; defaults to the source meeting title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+}
+
 // UpdateItxMeetingRequestBody is the type of the "Meeting Service" service
 // "update-itx-meeting" endpoint HTTP request body.
 type UpdateItxMeetingRequestBody struct {
@@ -101,6 +110,21 @@ type UpdateItxMeetingRequestBody struct {
 	UpdateNote *string `form:"update_note,omitempty" json:"update_note,omitempty" xml:"update_note,omitempty"`
 }
 
+// PreviewItxMeetingOccurrencesRequestBody is the type of the "Meeting Service"
+// service "preview-itx-meeting-occurrences" endpoint HTTP request body.
+type PreviewItxMeetingOccurrencesRequestBody struct {
+	// The start time of the meeting in RFC3339 format
+	StartTime *string `form:"start_time,omitempty" json:"start_time,omitempty" xml:"start_time,omitempty"`
+	// The timezone of the meeting (e.g. 'America/New_York')
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// The duration of the meeting in minutes
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// The recurrence of the meeting
+	Recurrence *RecurrenceRequestBody `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+	// Maximum number of occurrences to return
+	Count *int `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+}
+
 // CreateItxRegistrantRequestBody is the type of the "Meeting Service" service
 // "create-itx-registrant" endpoint HTTP request body.
 type CreateItxRegistrantRequestBody struct {
@@ -392,6 +416,14 @@ type UpdateItxPastMeetingParticipantRequestBody struct {
 	IsVerified *bool `form:"is_verified,omitempty" json:"is_verified,omitempty" xml:"is_verified,omitempty"`
 }
 
+// BulkUpdateItxPastMeetingParticipantsRequestBody is the type of the "Meeting
+// Service" service "bulk-update-itx-past-meeting-participants" endpoint HTTP
+// request body.
+type BulkUpdateItxPastMeetingParticipantsRequestBody struct {
+	// Participant corrections
+	Participants []*ITXPastMeetingParticipantUpdateRequestBody `form:"participants,omitempty" json:"participants,omitempty" xml:"participants,omitempty"`
+}
+
 // CreateItxMeetingAttachmentRequestBody is the type of the "Meeting Service"
 // service "create-itx-meeting-attachment" endpoint HTTP request body.
 type CreateItxMeetingAttachmentRequestBody struct {
@@ -486,6 +518,49 @@ type CreateItxPastMeetingAttachmentPresignRequestBody struct {
 	FileType *string `form:"file_type,omitempty" json:"file_type,omitempty" xml:"file_type,omitempty"`
 }
 
+// GetServiceConfigResponseBody is the type of the "Meeting Service" service
+// "get-service-config" endpoint HTTP response body.
+type GetServiceConfigResponseBody struct {
+	// Service build version
+	Version string `form:"version" json:"version" xml:"version"`
+	// Normalized LFX environment
+	LfxEnvironment string `form:"lfx_environment" json:"lfx_environment" xml:"lfx_environment"`
+	// ITX API base URL
+	ItxBaseURL string `form:"itx_base_url" json:"itx_base_url" xml:"itx_base_url"`
+	// Auth0 domain used for ITX M2M tokens
+	ItxAuth0Domain string `form:"itx_auth0_domain" json:"itx_auth0_domain" xml:"itx_auth0_domain"`
+	// Audience of the ITX M2M tokens
+	ItxAudience string `form:"itx_audience" json:"itx_audience" xml:"itx_audience"`
+	// v1 user-service (API gateway) base URL
+	UserServiceBaseURL string `form:"user_service_base_url" json:"user_service_base_url" xml:"user_service_base_url"`
+	// Whether v1/v2 ID mapping through NATS is enabled
+	IDMappingEnabled bool `form:"id_mapping_enabled" json:"id_mapping_enabled" xml:"id_mapping_enabled"`
+	// Event processing configuration
+	EventProcessing *ServiceEventProcessingConfigResponseBody `form:"event_processing" json:"event_processing" xml:"event_processing"`
+	// LFID invite feature configuration
+	Invites *ServiceInvitesConfigResponseBody `form:"invites" json:"invites" xml:"invites"`
+	// ITX client timeout, retry and circuit breaker configuration
+	ItxResilience *ServiceITXResilienceConfigResponseBody `form:"itx_resilience" json:"itx_resilience" xml:"itx_resilience"`
+	// Attachment upload limits
+	Attachments *ServiceAttachmentsConfigResponseBody `form:"attachments" json:"attachments" xml:"attachments"`
+	// How long ITX join links are cached, as a Go duration (0s when caching is
+	// disabled)
+	JoinLinkCacheTTL string `form:"join_link_cache_ttl" json:"join_link_cache_ttl" xml:"join_link_cache_ttl"`
+	// Global log level (LOG_LEVEL)
+	LogLevel string `form:"log_level" json:"log_level" xml:"log_level"`
+	// Effective per-subsystem log level overrides
+	SubsystemLogLevels map[string]string `form:"subsystem_log_levels" json:"subsystem_log_levels" xml:"subsystem_log_levels"`
+}
+
+// GetServiceHealthResponseBody is the type of the "Meeting Service" service
+// "get-service-health" endpoint HTTP response body.
+type GetServiceHealthResponseBody struct {
+	// ok when every dependency check passed, degraded otherwise
+	Status string `form:"status" json:"status" xml:"status"`
+	// Result of each dependency check
+	Checks []*DependencyHealthResponseBody `form:"checks" json:"checks" xml:"checks"`
+}
+
 // CreateItxMeetingResponseBody is the type of the "Meeting Service" service
 // "create-itx-meeting" endpoint HTTP response body.
 type CreateItxMeetingResponseBody struct {
@@ -660,6 +735,93 @@ type GetItxMeetingResponseBody struct {
 	RegistrantCount *int `form:"registrant_count,omitempty" json:"registrant_count,omitempty" xml:"registrant_count,omitempty"`
 }
 
+// CloneItxMeetingResponseBody is the type of the "Meeting Service" service
+// "clone-itx-meeting" endpoint HTTP response body.
+type CloneItxMeetingResponseBody struct {
+	// The UID of the LF project
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// The title of the meeting
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// The start time of the meeting in RFC3339 format
+	StartTime *string `form:"start_time,omitempty" json:"start_time,omitempty" xml:"start_time,omitempty"`
+	// The duration of the meeting in minutes
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// The timezone of the meeting (e.g. 'America/New_York')
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// The visibility of the meeting's existence to other users
+	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
+	// The description of the meeting
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The restrictedness of joining the meeting (i.e. is the meeting restricted to
+	// only invited users or anyone?)
+	Restricted *bool `form:"restricted,omitempty" json:"restricted,omitempty" xml:"restricted,omitempty"`
+	// The committees associated with the meeting
+	Committees []*CommitteeResponseBody `form:"committees,omitempty" json:"committees,omitempty" xml:"committees,omitempty"`
+	// The type of meeting
+	MeetingType *string `form:"meeting_type,omitempty" json:"meeting_type,omitempty" xml:"meeting_type,omitempty"`
+	// The number of minutes that users are allowed to join the meeting early
+	EarlyJoinTimeMinutes *int `form:"early_join_time_minutes,omitempty" json:"early_join_time_minutes,omitempty" xml:"early_join_time_minutes,omitempty"`
+	// Whether recording is enabled for the meeting
+	RecordingEnabled *bool `form:"recording_enabled,omitempty" json:"recording_enabled,omitempty" xml:"recording_enabled,omitempty"`
+	// Whether transcription is enabled for the meeting
+	TranscriptEnabled *bool `form:"transcript_enabled,omitempty" json:"transcript_enabled,omitempty" xml:"transcript_enabled,omitempty"`
+	// Whether automatic youtube uploading is enabled for the meeting
+	YoutubeUploadEnabled *bool `form:"youtube_upload_enabled,omitempty" json:"youtube_upload_enabled,omitempty" xml:"youtube_upload_enabled,omitempty"`
+	// Whether Zoom AI Companion summary is enabled for the meeting
+	AiSummaryEnabled *bool `form:"ai_summary_enabled,omitempty" json:"ai_summary_enabled,omitempty" xml:"ai_summary_enabled,omitempty"`
+	// Whether AI summary requires approval before being shared
+	RequireAiSummaryApproval *bool `form:"require_ai_summary_approval,omitempty" json:"require_ai_summary_approval,omitempty" xml:"require_ai_summary_approval,omitempty"`
+	// The visibility of artifacts to users
+	ArtifactVisibility *string `form:"artifact_visibility,omitempty" json:"artifact_visibility,omitempty" xml:"artifact_visibility,omitempty"`
+	// The recurrence of the meeting
+	Recurrence *RecurrenceResponseBody `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+	// Whether automatic email reminders are enabled for the meeting
+	AutoEmailReminderEnabled *bool `form:"auto_email_reminder_enabled,omitempty" json:"auto_email_reminder_enabled,omitempty" xml:"auto_email_reminder_enabled,omitempty"`
+	// Time in minutes before the meeting to send the automatic email reminder
+	AutoEmailReminderTime *int `form:"auto_email_reminder_time,omitempty" json:"auto_email_reminder_time,omitempty" xml:"auto_email_reminder_time,omitempty"`
+	// Status of the last bulk registrant import job
+	LastBulkRegistrantJobStatus *string `form:"last_bulk_registrant_job_status,omitempty" json:"last_bulk_registrant_job_status,omitempty" xml:"last_bulk_registrant_job_status,omitempty"`
+	// Number of records with warnings in the last bulk registrant import job
+	LastBulkRegistrantsJobWarningCount *int `form:"last_bulk_registrants_job_warning_count,omitempty" json:"last_bulk_registrants_job_warning_count,omitempty" xml:"last_bulk_registrants_job_warning_count,omitempty"`
+	// Number of email delivery errors for the meeting
+	EmailDeliveryErrorCount *int `form:"email_delivery_error_count,omitempty" json:"email_delivery_error_count,omitempty" xml:"email_delivery_error_count,omitempty"`
+	// Whether invite responses (RSVP) are enabled for the meeting
+	IsInviteResponsesEnabled *bool `form:"is_invite_responses_enabled,omitempty" json:"is_invite_responses_enabled,omitempty" xml:"is_invite_responses_enabled,omitempty"`
+	// Number of 'yes' RSVP responses for the meeting
+	ResponseCountYes *int `form:"response_count_yes,omitempty" json:"response_count_yes,omitempty" xml:"response_count_yes,omitempty"`
+	// Number of 'maybe' RSVP responses for the meeting
+	ResponseCountMaybe *int `form:"response_count_maybe,omitempty" json:"response_count_maybe,omitempty" xml:"response_count_maybe,omitempty"`
+	// Number of 'no' RSVP responses for the meeting
+	ResponseCountNo *int `form:"response_count_no,omitempty" json:"response_count_no,omitempty" xml:"response_count_no,omitempty"`
+	// Status of the last mailing list members sync job
+	LastMailingListMembersSyncJobStatus *string `form:"last_mailing_list_members_sync_job_status,omitempty" json:"last_mailing_list_members_sync_job_status,omitempty" xml:"last_mailing_list_members_sync_job_status,omitempty"`
+	// Number of failed records in the last mailing list members sync job
+	LastMailingListMembersSyncJobFailedCount *int `form:"last_mailing_list_members_sync_job_failed_count,omitempty" json:"last_mailing_list_members_sync_job_failed_count,omitempty" xml:"last_mailing_list_members_sync_job_failed_count,omitempty"`
+	// Number of records with warnings in the last mailing list members sync job
+	LastMailingListMembersSyncJobWarningCount *int `form:"last_mailing_list_members_sync_job_warning_count,omitempty" json:"last_mailing_list_members_sync_job_warning_count,omitempty" xml:"last_mailing_list_members_sync_job_warning_count,omitempty"`
+	// RFC3339 start time of the next upcoming occurrence. Empty when no future
+	// occurrence exists.
+	NextOccurrenceStartTime *string `form:"next_occurrence_start_time,omitempty" json:"next_occurrence_start_time,omitempty" xml:"next_occurrence_start_time,omitempty"`
+	// Zoom meeting ID from ITX
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// 6-digit host key
+	HostKey *string `form:"host_key,omitempty" json:"host_key,omitempty" xml:"host_key,omitempty"`
+	// Zoom meeting passcode
+	Passcode *string `form:"passcode,omitempty" json:"passcode,omitempty" xml:"passcode,omitempty"`
+	// UUID password for join page
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Public meeting join URL
+	PublicLink *string `form:"public_link,omitempty" json:"public_link,omitempty" xml:"public_link,omitempty"`
+	// Creation timestamp (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last modification timestamp (RFC3339)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Meeting occurrences (for recurring)
+	Occurrences []*ITXOccurrenceResponseBody `form:"occurrences,omitempty" json:"occurrences,omitempty" xml:"occurrences,omitempty"`
+	// Number of registrants
+	RegistrantCount *int `form:"registrant_count,omitempty" json:"registrant_count,omitempty" xml:"registrant_count,omitempty"`
+}
+
 // GetItxMeetingCountResponseBody is the type of the "Meeting Service" service
 // "get-itx-meeting-count" endpoint HTTP response body.
 type GetItxMeetingCountResponseBody struct {
@@ -667,6 +829,14 @@ type GetItxMeetingCountResponseBody struct {
 	MeetingCount int `form:"meeting_count" json:"meeting_count" xml:"meeting_count"`
 }
 
+// PreviewItxMeetingOccurrencesResponseBody is the type of the "Meeting
+// Service" service "preview-itx-meeting-occurrences" endpoint HTTP response
+// body.
+type PreviewItxMeetingOccurrencesResponseBody struct {
+	// Computed occurrences
+	Occurrences []*ITXOccurrenceResponseBody `form:"occurrences" json:"occurrences" xml:"occurrences"`
+}
+
 // CreateItxRegistrantResponseBody is the type of the "Meeting Service" service
 // "create-itx-registrant" endpoint HTTP response body.
 type CreateItxRegistrantResponseBody struct {
@@ -770,6 +940,119 @@ type GetItxRegistrantResponseBody struct {
 type GetItxJoinLinkResponseBody struct {
 	// Zoom meeting join URL
 	Link string `form:"link" json:"link" xml:"link"`
+	// Occurrence the link was requested for (Unix timestamp)
+	OccurrenceID *string `form:"occurrence_id,omitempty" json:"occurrence_id,omitempty" xml:"occurrence_id,omitempty"`
+	// Start time of the occurrence in RFC3339 format
+	OccurrenceStartTime *string `form:"occurrence_start_time,omitempty" json:"occurrence_start_time,omitempty" xml:"occurrence_start_time,omitempty"`
+	// Duration of the occurrence in minutes
+	OccurrenceDuration *int `form:"occurrence_duration,omitempty" json:"occurrence_duration,omitempty" xml:"occurrence_duration,omitempty"`
+}
+
+// AddItxRegistrantHostResponseBody is the type of the "Meeting Service"
+// service "add-itx-registrant-host" endpoint HTTP response body.
+type AddItxRegistrantHostResponseBody struct {
+	// Registrant UID (read-only)
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Registrant type: direct or committee (read-only)
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Committee UID (for committee registrants)
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Registrant email
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LF username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// First name (required with email)
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name (required with email)
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Organization
+	Org *string `form:"org,omitempty" json:"org,omitempty" xml:"org,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Profile picture URL
+	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
+	// Access to host key for the meeting
+	Host *bool `form:"host,omitempty" json:"host,omitempty" xml:"host,omitempty"`
+	// Specific occurrence ID (blank = all occurrences)
+	Occurrence *string `form:"occurrence,omitempty" json:"occurrence,omitempty" xml:"occurrence,omitempty"`
+	// Number of meetings attended (read-only)
+	AttendedOccurrenceCount *int `form:"attended_occurrence_count,omitempty" json:"attended_occurrence_count,omitempty" xml:"attended_occurrence_count,omitempty"`
+	// Total meetings registered (read-only)
+	TotalOccurrenceCount *int `form:"total_occurrence_count,omitempty" json:"total_occurrence_count,omitempty" xml:"total_occurrence_count,omitempty"`
+	// Last invite timestamp RFC3339 (read-only)
+	LastInviteReceivedTime *string `form:"last_invite_received_time,omitempty" json:"last_invite_received_time,omitempty" xml:"last_invite_received_time,omitempty"`
+	// Last email message ID (read-only)
+	LastInviteReceivedMessageID *string `form:"last_invite_received_message_id,omitempty" json:"last_invite_received_message_id,omitempty" xml:"last_invite_received_message_id,omitempty"`
+	// delivered or failed (read-only)
+	LastInviteDeliveryStatus *string `form:"last_invite_delivery_status,omitempty" json:"last_invite_delivery_status,omitempty" xml:"last_invite_delivery_status,omitempty"`
+	// Delivery status details (read-only)
+	LastInviteDeliveryDescription *string `form:"last_invite_delivery_description,omitempty" json:"last_invite_delivery_description,omitempty" xml:"last_invite_delivery_description,omitempty"`
+	// Creation timestamp RFC3339 (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Creator user info (read-only)
+	CreatedBy *ITXUserResponseBody `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Last modified timestamp RFC3339 (read-only)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Last updater user info (read-only)
+	UpdatedBy *ITXUserResponseBody `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// RemoveItxRegistrantHostResponseBody is the type of the "Meeting Service"
+// service "remove-itx-registrant-host" endpoint HTTP response body.
+type RemoveItxRegistrantHostResponseBody struct {
+	// Registrant UID (read-only)
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Registrant type: direct or committee (read-only)
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Committee UID (for committee registrants)
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Registrant email
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LF username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// First name (required with email)
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name (required with email)
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Organization
+	Org *string `form:"org,omitempty" json:"org,omitempty" xml:"org,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Profile picture URL
+	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
+	// Access to host key for the meeting
+	Host *bool `form:"host,omitempty" json:"host,omitempty" xml:"host,omitempty"`
+	// Specific occurrence ID (blank = all occurrences)
+	Occurrence *string `form:"occurrence,omitempty" json:"occurrence,omitempty" xml:"occurrence,omitempty"`
+	// Number of meetings attended (read-only)
+	AttendedOccurrenceCount *int `form:"attended_occurrence_count,omitempty" json:"attended_occurrence_count,omitempty" xml:"attended_occurrence_count,omitempty"`
+	// Total meetings registered (read-only)
+	TotalOccurrenceCount *int `form:"total_occurrence_count,omitempty" json:"total_occurrence_count,omitempty" xml:"total_occurrence_count,omitempty"`
+	// Last invite timestamp RFC3339 (read-only)
+	LastInviteReceivedTime *string `form:"last_invite_received_time,omitempty" json:"last_invite_received_time,omitempty" xml:"last_invite_received_time,omitempty"`
+	// Last email message ID (read-only)
+	LastInviteReceivedMessageID *string `form:"last_invite_received_message_id,omitempty" json:"last_invite_received_message_id,omitempty" xml:"last_invite_received_message_id,omitempty"`
+	// delivered or failed (read-only)
+	LastInviteDeliveryStatus *string `form:"last_invite_delivery_status,omitempty" json:"last_invite_delivery_status,omitempty" xml:"last_invite_delivery_status,omitempty"`
+	// Delivery status details (read-only)
+	LastInviteDeliveryDescription *string `form:"last_invite_delivery_description,omitempty" json:"last_invite_delivery_description,omitempty" xml:"last_invite_delivery_description,omitempty"`
+	// Creation timestamp RFC3339 (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Creator user info (read-only)
+	CreatedBy *ITXUserResponseBody `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Last modified timestamp RFC3339 (read-only)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Last updater user info (read-only)
+	UpdatedBy *ITXUserResponseBody `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// ListItxMeetingOccurrencesResponseBody is the type of the "Meeting Service"
+// service "list-itx-meeting-occurrences" endpoint HTTP response body.
+type ListItxMeetingOccurrencesResponseBody struct {
+	// IANA timezone of the local times
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// Occurrences
+	Occurrences []*ITXLocalOccurrenceResponseBody `form:"occurrences" json:"occurrences" xml:"occurrences"`
 }
 
 // SubmitItxMeetingResponseResponseBody is the type of the "Meeting Service"
@@ -908,6 +1191,29 @@ type GetItxPastMeetingSummaryResponseBody struct {
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// GetItxPastMeetingSummaryDiffResponseBody is the type of the "Meeting
+// Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP response
+// body.
+type GetItxPastMeetingSummaryDiffResponseBody struct {
+	// The unique identifier of the summary
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// The past meeting identifier (meeting_id-occurrence_id)
+	PastMeetingID string `form:"past_meeting_id" json:"past_meeting_id" xml:"past_meeting_id"`
+	// Whether the summary has edited content that differs from the AI-generated
+	// content
+	HasEdits bool `form:"has_edits" json:"has_edits" xml:"has_edits"`
+	// Number of lines added in the edited content
+	Additions int `form:"additions" json:"additions" xml:"additions"`
+	// Number of lines removed from the AI-generated content
+	Deletions int `form:"deletions" json:"deletions" xml:"deletions"`
+	// Whether the changed region was too large to diff line by line, in which case
+	// its AI-generated lines are reported as deleted and its edited lines as
+	// inserted
+	Approximate bool `form:"approximate" json:"approximate" xml:"approximate"`
+	// Diff lines in document order
+	Lines []*SummaryDiffLineResponseBody `form:"lines" json:"lines" xml:"lines"`
+}
+
 // UpdateItxPastMeetingSummaryResponseBody is the type of the "Meeting Service"
 // service "update-itx-past-meeting-summary" endpoint HTTP response body.
 type UpdateItxPastMeetingSummaryResponseBody struct {
@@ -937,53 +1243,112 @@ type UpdateItxPastMeetingSummaryResponseBody struct {
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
-// CreateItxPastMeetingParticipantResponseBody is the type of the "Meeting
-// Service" service "create-itx-past-meeting-participant" endpoint HTTP
-// response body.
-type CreateItxPastMeetingParticipantResponseBody struct {
-	// Participant identifier (invitee_id or attendee_id or both)
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Invitee record UUID (if is_invited=true)
-	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
-	// Attendee record UUID (if is_attended=true)
-	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
-	// Past meeting ID (meeting_id-occurrence_id)
-	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
-	// Meeting ID
-	MeetingID *string `form:"meeting_id,omitempty" json:"meeting_id,omitempty" xml:"meeting_id,omitempty"`
-	// Primary email address
-	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
-	// First name
-	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
-	// Last name
-	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
-	// LF SSO username
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// LF user ID (Salesforce ID)
-	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
-	// Organization name
-	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
-	// Job title
-	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
-	// Whether org has LF membership
-	OrgIsMember *bool `form:"org_is_member,omitempty" json:"org_is_member,omitempty" xml:"org_is_member,omitempty"`
-	// Whether org has project membership
-	OrgIsProjectMember *bool `form:"org_is_project_member,omitempty" json:"org_is_project_member,omitempty" xml:"org_is_project_member,omitempty"`
-	// Associated committee UUID
-	CommitteeID *string `form:"committee_id,omitempty" json:"committee_id,omitempty" xml:"committee_id,omitempty"`
-	// Role within committee
-	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
-	// Whether participant is a committee member
-	IsCommitteeMember *bool `form:"is_committee_member,omitempty" json:"is_committee_member,omitempty" xml:"is_committee_member,omitempty"`
-	// Voting status in committee
-	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
-	// URL to profile picture
-	AvatarURL *string `form:"avatar_url,omitempty" json:"avatar_url,omitempty" xml:"avatar_url,omitempty"`
-	// Whether the participant was invited/registered to this past meeting
-	IsInvited *bool `form:"is_invited,omitempty" json:"is_invited,omitempty" xml:"is_invited,omitempty"`
-	// Whether the participant attended this past meeting
-	IsAttended *bool `form:"is_attended,omitempty" json:"is_attended,omitempty" xml:"is_attended,omitempty"`
-	// Whether the attendee has been verified (attendees only)
+// ApproveItxPastMeetingSummaryResponseBody is the type of the "Meeting
+// Service" service "approve-itx-past-meeting-summary" endpoint HTTP response
+// body.
+type ApproveItxPastMeetingSummaryResponseBody struct {
+	// The unique identifier of the summary
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// The past meeting identifier (meeting_id-occurrence_id)
+	PastMeetingID string `form:"past_meeting_id" json:"past_meeting_id" xml:"past_meeting_id"`
+	// The meeting identifier
+	MeetingID string `form:"meeting_id" json:"meeting_id" xml:"meeting_id"`
+	// Meeting platform
+	Platform string `form:"platform" json:"platform" xml:"platform"`
+	// Password for accessing the summary (if required)
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Zoom-specific configuration
+	ZoomConfig *PastMeetingSummaryZoomConfigResponseBody `form:"zoom_config,omitempty" json:"zoom_config,omitempty" xml:"zoom_config,omitempty"`
+	// The actual summary content
+	SummaryData *SummaryDataResponseBody `form:"summary_data" json:"summary_data" xml:"summary_data"`
+	// Whether the summary requires approval
+	RequiresApproval bool `form:"requires_approval" json:"requires_approval" xml:"requires_approval"`
+	// Whether the summary has been approved
+	Approved bool `form:"approved" json:"approved" xml:"approved"`
+	// Whether summary email has been sent
+	EmailSent bool `form:"email_sent" json:"email_sent" xml:"email_sent"`
+	// Creation timestamp (RFC3339)
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Update timestamp (RFC3339)
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// RejectItxPastMeetingSummaryResponseBody is the type of the "Meeting Service"
+// service "reject-itx-past-meeting-summary" endpoint HTTP response body.
+type RejectItxPastMeetingSummaryResponseBody struct {
+	// The unique identifier of the summary
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// The past meeting identifier (meeting_id-occurrence_id)
+	PastMeetingID string `form:"past_meeting_id" json:"past_meeting_id" xml:"past_meeting_id"`
+	// The meeting identifier
+	MeetingID string `form:"meeting_id" json:"meeting_id" xml:"meeting_id"`
+	// Meeting platform
+	Platform string `form:"platform" json:"platform" xml:"platform"`
+	// Password for accessing the summary (if required)
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Zoom-specific configuration
+	ZoomConfig *PastMeetingSummaryZoomConfigResponseBody `form:"zoom_config,omitempty" json:"zoom_config,omitempty" xml:"zoom_config,omitempty"`
+	// The actual summary content
+	SummaryData *SummaryDataResponseBody `form:"summary_data" json:"summary_data" xml:"summary_data"`
+	// Whether the summary requires approval
+	RequiresApproval bool `form:"requires_approval" json:"requires_approval" xml:"requires_approval"`
+	// Whether the summary has been approved
+	Approved bool `form:"approved" json:"approved" xml:"approved"`
+	// Whether summary email has been sent
+	EmailSent bool `form:"email_sent" json:"email_sent" xml:"email_sent"`
+	// Creation timestamp (RFC3339)
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Update timestamp (RFC3339)
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// CreateItxPastMeetingParticipantResponseBody is the type of the "Meeting
+// Service" service "create-itx-past-meeting-participant" endpoint HTTP
+// response body.
+type CreateItxPastMeetingParticipantResponseBody struct {
+	// Participant identifier (invitee_id or attendee_id or both)
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Invitee record UUID (if is_invited=true)
+	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
+	// Attendee record UUID (if is_attended=true)
+	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
+	// Past meeting ID (meeting_id-occurrence_id)
+	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
+	// Meeting ID
+	MeetingID *string `form:"meeting_id,omitempty" json:"meeting_id,omitempty" xml:"meeting_id,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// LF SSO username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// LF user ID (Salesforce ID)
+	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
+	// Organization name
+	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Whether org has LF membership
+	OrgIsMember *bool `form:"org_is_member,omitempty" json:"org_is_member,omitempty" xml:"org_is_member,omitempty"`
+	// Whether org has project membership
+	OrgIsProjectMember *bool `form:"org_is_project_member,omitempty" json:"org_is_project_member,omitempty" xml:"org_is_project_member,omitempty"`
+	// Associated committee UUID
+	CommitteeID *string `form:"committee_id,omitempty" json:"committee_id,omitempty" xml:"committee_id,omitempty"`
+	// Role within committee
+	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
+	// Whether participant is a committee member
+	IsCommitteeMember *bool `form:"is_committee_member,omitempty" json:"is_committee_member,omitempty" xml:"is_committee_member,omitempty"`
+	// Voting status in committee
+	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
+	// URL to profile picture
+	AvatarURL *string `form:"avatar_url,omitempty" json:"avatar_url,omitempty" xml:"avatar_url,omitempty"`
+	// Whether the participant was invited/registered to this past meeting
+	IsInvited *bool `form:"is_invited,omitempty" json:"is_invited,omitempty" xml:"is_invited,omitempty"`
+	// Whether the participant attended this past meeting
+	IsAttended *bool `form:"is_attended,omitempty" json:"is_attended,omitempty" xml:"is_attended,omitempty"`
+	// Whether the attendee has been verified (attendees only)
 	IsVerified *bool `form:"is_verified,omitempty" json:"is_verified,omitempty" xml:"is_verified,omitempty"`
 	// Whether attendee is marked as unknown (attendees only)
 	IsUnknown *bool `form:"is_unknown,omitempty" json:"is_unknown,omitempty" xml:"is_unknown,omitempty"`
@@ -1085,6 +1450,14 @@ type UpdateItxPastMeetingParticipantResponseBody struct {
 	ModifiedBy *ITXUserResponseBody `form:"modified_by,omitempty" json:"modified_by,omitempty" xml:"modified_by,omitempty"`
 }
 
+// BulkUpdateItxPastMeetingParticipantsResponseBody is the type of the "Meeting
+// Service" service "bulk-update-itx-past-meeting-participants" endpoint HTTP
+// response body.
+type BulkUpdateItxPastMeetingParticipantsResponseBody struct {
+	// Updated participants
+	Participants []*ITXPastMeetingParticipantResponseBody `form:"participants" json:"participants" xml:"participants"`
+}
+
 // CreateItxMeetingAttachmentResponseBody is the type of the "Meeting Service"
 // service "create-itx-meeting-attachment" endpoint HTTP response body.
 type CreateItxMeetingAttachmentResponseBody struct {
@@ -1370,6 +1743,86 @@ type ReadyzServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// GetServiceConfigBadRequestResponseBody is the type of the "Meeting Service"
+// service "get-service-config" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetServiceConfigBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetServiceConfigForbiddenResponseBody is the type of the "Meeting Service"
+// service "get-service-config" endpoint HTTP response body for the "Forbidden"
+// error.
+type GetServiceConfigForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetServiceConfigServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "get-service-config" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetServiceConfigServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetServiceConfigUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "get-service-config" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetServiceConfigUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetServiceHealthBadRequestResponseBody is the type of the "Meeting Service"
+// service "get-service-health" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetServiceHealthBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetServiceHealthForbiddenResponseBody is the type of the "Meeting Service"
+// service "get-service-health" endpoint HTTP response body for the "Forbidden"
+// error.
+type GetServiceHealthForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetServiceHealthServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "get-service-health" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetServiceHealthServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetServiceHealthUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "get-service-health" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetServiceHealthUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // CreateItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
 // service "create-itx-meeting" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -1550,6 +2003,76 @@ type DeleteItxMeetingUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// CloneItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "BadRequest"
+// error.
+type CloneItxMeetingBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneItxMeetingConflictResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "Conflict"
+// error.
+type CloneItxMeetingConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneItxMeetingForbiddenResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "Forbidden"
+// error.
+type CloneItxMeetingForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneItxMeetingInternalServerErrorResponseBody is the type of the "Meeting
+// Service" service "clone-itx-meeting" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CloneItxMeetingInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneItxMeetingNotFoundResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the "NotFound"
+// error.
+type CloneItxMeetingNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneItxMeetingServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "clone-itx-meeting" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CloneItxMeetingServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneItxMeetingUnauthorizedResponseBody is the type of the "Meeting Service"
+// service "clone-itx-meeting" endpoint HTTP response body for the
+// "Unauthorized" error.
+type CloneItxMeetingUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // UpdateItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
 // service "update-itx-meeting" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -1680,6 +2203,36 @@ type GetItxMeetingCountUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// PreviewItxMeetingOccurrencesBadRequestResponseBody is the type of the
+// "Meeting Service" service "preview-itx-meeting-occurrences" endpoint HTTP
+// response body for the "BadRequest" error.
+type PreviewItxMeetingOccurrencesBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PreviewItxMeetingOccurrencesInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "preview-itx-meeting-occurrences" endpoint
+// HTTP response body for the "InternalServerError" error.
+type PreviewItxMeetingOccurrencesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PreviewItxMeetingOccurrencesUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "preview-itx-meeting-occurrences" endpoint HTTP
+// response body for the "Unauthorized" error.
+type PreviewItxMeetingOccurrencesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // CreateItxRegistrantBadRequestResponseBody is the type of the "Meeting
 // Service" service "create-itx-registrant" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -1940,6 +2493,16 @@ type GetItxJoinLinkBadRequestResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// GetItxJoinLinkConflictResponseBody is the type of the "Meeting Service"
+// service "get-itx-join-link" endpoint HTTP response body for the "Conflict"
+// error.
+type GetItxJoinLinkConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // GetItxJoinLinkForbiddenResponseBody is the type of the "Meeting Service"
 // service "get-itx-join-link" endpoint HTTP response body for the "Forbidden"
 // error.
@@ -1990,40 +2553,110 @@ type GetItxJoinLinkUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GetItxRegistrantIcsBadRequestResponseBody is the type of the "Meeting
-// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
-// the "BadRequest" error.
-type GetItxRegistrantIcsBadRequestResponseBody struct {
+// LaunchItxMeetingBadRequestResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the
+// "BadRequest" error.
+type LaunchItxMeetingBadRequestResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GetItxRegistrantIcsForbiddenResponseBody is the type of the "Meeting
-// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
-// the "Forbidden" error.
-type GetItxRegistrantIcsForbiddenResponseBody struct {
+// LaunchItxMeetingConflictResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the "Conflict"
+// error.
+type LaunchItxMeetingConflictResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GetItxRegistrantIcsInternalServerErrorResponseBody is the type of the
-// "Meeting Service" service "get-itx-registrant-ics" endpoint HTTP response
-// body for the "InternalServerError" error.
-type GetItxRegistrantIcsInternalServerErrorResponseBody struct {
+// LaunchItxMeetingForbiddenResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the "Forbidden"
+// error.
+type LaunchItxMeetingForbiddenResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GetItxRegistrantIcsNotFoundResponseBody is the type of the "Meeting Service"
-// service "get-itx-registrant-ics" endpoint HTTP response body for the
-// "NotFound" error.
-type GetItxRegistrantIcsNotFoundResponseBody struct {
+// LaunchItxMeetingInternalServerErrorResponseBody is the type of the "Meeting
+// Service" service "launch-itx-meeting" endpoint HTTP response body for the
+// "InternalServerError" error.
+type LaunchItxMeetingInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LaunchItxMeetingNotFoundResponseBody is the type of the "Meeting Service"
+// service "launch-itx-meeting" endpoint HTTP response body for the "NotFound"
+// error.
+type LaunchItxMeetingNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LaunchItxMeetingServiceUnavailableResponseBody is the type of the "Meeting
+// Service" service "launch-itx-meeting" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type LaunchItxMeetingServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LaunchItxMeetingUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "launch-itx-meeting" endpoint HTTP response body for the
+// "Unauthorized" error.
+type LaunchItxMeetingUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxRegistrantIcsBadRequestResponseBody is the type of the "Meeting
+// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
+// the "BadRequest" error.
+type GetItxRegistrantIcsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxRegistrantIcsForbiddenResponseBody is the type of the "Meeting
+// Service" service "get-itx-registrant-ics" endpoint HTTP response body for
+// the "Forbidden" error.
+type GetItxRegistrantIcsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxRegistrantIcsInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "get-itx-registrant-ics" endpoint HTTP response
+// body for the "InternalServerError" error.
+type GetItxRegistrantIcsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxRegistrantIcsNotFoundResponseBody is the type of the "Meeting Service"
+// service "get-itx-registrant-ics" endpoint HTTP response body for the
+// "NotFound" error.
+type GetItxRegistrantIcsNotFoundResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
@@ -2050,6 +2683,126 @@ type GetItxRegistrantIcsUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// AddItxRegistrantHostBadRequestResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "BadRequest" error.
+type AddItxRegistrantHostBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddItxRegistrantHostForbiddenResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "Forbidden" error.
+type AddItxRegistrantHostForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddItxRegistrantHostInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "add-itx-registrant-host" endpoint HTTP response
+// body for the "InternalServerError" error.
+type AddItxRegistrantHostInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddItxRegistrantHostNotFoundResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "NotFound" error.
+type AddItxRegistrantHostNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddItxRegistrantHostServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "add-itx-registrant-host" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type AddItxRegistrantHostServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddItxRegistrantHostUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "add-itx-registrant-host" endpoint HTTP response body for
+// the "Unauthorized" error.
+type AddItxRegistrantHostUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItxRegistrantHostBadRequestResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "BadRequest" error.
+type RemoveItxRegistrantHostBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItxRegistrantHostForbiddenResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "Forbidden" error.
+type RemoveItxRegistrantHostForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItxRegistrantHostInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "remove-itx-registrant-host" endpoint HTTP
+// response body for the "InternalServerError" error.
+type RemoveItxRegistrantHostInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItxRegistrantHostNotFoundResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "NotFound" error.
+type RemoveItxRegistrantHostNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItxRegistrantHostServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "remove-itx-registrant-host" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type RemoveItxRegistrantHostServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItxRegistrantHostUnauthorizedResponseBody is the type of the "Meeting
+// Service" service "remove-itx-registrant-host" endpoint HTTP response body
+// for the "Unauthorized" error.
+type RemoveItxRegistrantHostUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // ResendItxRegistrantInvitationBadRequestResponseBody is the type of the
 // "Meeting Service" service "resend-itx-registrant-invitation" endpoint HTTP
 // response body for the "BadRequest" error.
@@ -2230,6 +2983,66 @@ type RegisterItxCommitteeMembersUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ListItxMeetingOccurrencesBadRequestResponseBody is the type of the "Meeting
+// Service" service "list-itx-meeting-occurrences" endpoint HTTP response body
+// for the "BadRequest" error.
+type ListItxMeetingOccurrencesBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListItxMeetingOccurrencesForbiddenResponseBody is the type of the "Meeting
+// Service" service "list-itx-meeting-occurrences" endpoint HTTP response body
+// for the "Forbidden" error.
+type ListItxMeetingOccurrencesForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListItxMeetingOccurrencesInternalServerErrorResponseBody is the type of the
+// "Meeting Service" service "list-itx-meeting-occurrences" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListItxMeetingOccurrencesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListItxMeetingOccurrencesNotFoundResponseBody is the type of the "Meeting
+// Service" service "list-itx-meeting-occurrences" endpoint HTTP response body
+// for the "NotFound" error.
+type ListItxMeetingOccurrencesNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListItxMeetingOccurrencesServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "list-itx-meeting-occurrences" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListItxMeetingOccurrencesServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListItxMeetingOccurrencesUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "list-itx-meeting-occurrences" endpoint HTTP
+// response body for the "Unauthorized" error.
+type ListItxMeetingOccurrencesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // UpdateItxOccurrenceBadRequestResponseBody is the type of the "Meeting
 // Service" service "update-itx-occurrence" endpoint HTTP response body for the
 // "BadRequest" error.
@@ -2720,6 +3533,66 @@ type GetItxPastMeetingSummaryUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// GetItxPastMeetingSummaryDiffBadRequestResponseBody is the type of the
+// "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP
+// response body for the "BadRequest" error.
+type GetItxPastMeetingSummaryDiffBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxPastMeetingSummaryDiffForbiddenResponseBody is the type of the
+// "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP
+// response body for the "Forbidden" error.
+type GetItxPastMeetingSummaryDiffForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint
+// HTTP response body for the "InternalServerError" error.
+type GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxPastMeetingSummaryDiffNotFoundResponseBody is the type of the "Meeting
+// Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP response
+// body for the "NotFound" error.
+type GetItxPastMeetingSummaryDiffNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody is the type of
+// the "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint
+// HTTP response body for the "ServiceUnavailable" error.
+type GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItxPastMeetingSummaryDiffUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "get-itx-past-meeting-summary-diff" endpoint HTTP
+// response body for the "Unauthorized" error.
+type GetItxPastMeetingSummaryDiffUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // UpdateItxPastMeetingSummaryBadRequestResponseBody is the type of the
 // "Meeting Service" service "update-itx-past-meeting-summary" endpoint HTTP
 // response body for the "BadRequest" error.
@@ -2780,59 +3653,199 @@ type UpdateItxPastMeetingSummaryUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// CreateItxPastMeetingParticipantBadRequestResponseBody is the type of the
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "BadRequest" error.
-type CreateItxPastMeetingParticipantBadRequestResponseBody struct {
+// ApproveItxPastMeetingSummaryBadRequestResponseBody is the type of the
+// "Meeting Service" service "approve-itx-past-meeting-summary" endpoint HTTP
+// response body for the "BadRequest" error.
+type ApproveItxPastMeetingSummaryBadRequestResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// CreateItxPastMeetingParticipantForbiddenResponseBody is the type of the
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "Forbidden" error.
-type CreateItxPastMeetingParticipantForbiddenResponseBody struct {
+// ApproveItxPastMeetingSummaryConflictResponseBody is the type of the "Meeting
+// Service" service "approve-itx-past-meeting-summary" endpoint HTTP response
+// body for the "Conflict" error.
+type ApproveItxPastMeetingSummaryConflictResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// CreateItxPastMeetingParticipantInternalServerErrorResponseBody is the type
-// of the "Meeting Service" service "create-itx-past-meeting-participant"
-// endpoint HTTP response body for the "InternalServerError" error.
-type CreateItxPastMeetingParticipantInternalServerErrorResponseBody struct {
+// ApproveItxPastMeetingSummaryForbiddenResponseBody is the type of the
+// "Meeting Service" service "approve-itx-past-meeting-summary" endpoint HTTP
+// response body for the "Forbidden" error.
+type ApproveItxPastMeetingSummaryForbiddenResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// CreateItxPastMeetingParticipantNotFoundResponseBody is the type of the
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "NotFound" error.
-type CreateItxPastMeetingParticipantNotFoundResponseBody struct {
+// ApproveItxPastMeetingSummaryInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "approve-itx-past-meeting-summary" endpoint
+// HTTP response body for the "InternalServerError" error.
+type ApproveItxPastMeetingSummaryInternalServerErrorResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// CreateItxPastMeetingParticipantServiceUnavailableResponseBody is the type of
-// the "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "ServiceUnavailable" error.
-type CreateItxPastMeetingParticipantServiceUnavailableResponseBody struct {
+// ApproveItxPastMeetingSummaryNotFoundResponseBody is the type of the "Meeting
+// Service" service "approve-itx-past-meeting-summary" endpoint HTTP response
+// body for the "NotFound" error.
+type ApproveItxPastMeetingSummaryNotFoundResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// CreateItxPastMeetingParticipantUnauthorizedResponseBody is the type of the
-// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
-// HTTP response body for the "Unauthorized" error.
+// ApproveItxPastMeetingSummaryServiceUnavailableResponseBody is the type of
+// the "Meeting Service" service "approve-itx-past-meeting-summary" endpoint
+// HTTP response body for the "ServiceUnavailable" error.
+type ApproveItxPastMeetingSummaryServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ApproveItxPastMeetingSummaryUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "approve-itx-past-meeting-summary" endpoint HTTP
+// response body for the "Unauthorized" error.
+type ApproveItxPastMeetingSummaryUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RejectItxPastMeetingSummaryBadRequestResponseBody is the type of the
+// "Meeting Service" service "reject-itx-past-meeting-summary" endpoint HTTP
+// response body for the "BadRequest" error.
+type RejectItxPastMeetingSummaryBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RejectItxPastMeetingSummaryConflictResponseBody is the type of the "Meeting
+// Service" service "reject-itx-past-meeting-summary" endpoint HTTP response
+// body for the "Conflict" error.
+type RejectItxPastMeetingSummaryConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RejectItxPastMeetingSummaryForbiddenResponseBody is the type of the "Meeting
+// Service" service "reject-itx-past-meeting-summary" endpoint HTTP response
+// body for the "Forbidden" error.
+type RejectItxPastMeetingSummaryForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RejectItxPastMeetingSummaryInternalServerErrorResponseBody is the type of
+// the "Meeting Service" service "reject-itx-past-meeting-summary" endpoint
+// HTTP response body for the "InternalServerError" error.
+type RejectItxPastMeetingSummaryInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RejectItxPastMeetingSummaryNotFoundResponseBody is the type of the "Meeting
+// Service" service "reject-itx-past-meeting-summary" endpoint HTTP response
+// body for the "NotFound" error.
+type RejectItxPastMeetingSummaryNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RejectItxPastMeetingSummaryServiceUnavailableResponseBody is the type of the
+// "Meeting Service" service "reject-itx-past-meeting-summary" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type RejectItxPastMeetingSummaryServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RejectItxPastMeetingSummaryUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "reject-itx-past-meeting-summary" endpoint HTTP
+// response body for the "Unauthorized" error.
+type RejectItxPastMeetingSummaryUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateItxPastMeetingParticipantBadRequestResponseBody is the type of the
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "BadRequest" error.
+type CreateItxPastMeetingParticipantBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateItxPastMeetingParticipantForbiddenResponseBody is the type of the
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "Forbidden" error.
+type CreateItxPastMeetingParticipantForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateItxPastMeetingParticipantInternalServerErrorResponseBody is the type
+// of the "Meeting Service" service "create-itx-past-meeting-participant"
+// endpoint HTTP response body for the "InternalServerError" error.
+type CreateItxPastMeetingParticipantInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateItxPastMeetingParticipantNotFoundResponseBody is the type of the
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "NotFound" error.
+type CreateItxPastMeetingParticipantNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateItxPastMeetingParticipantServiceUnavailableResponseBody is the type of
+// the "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "ServiceUnavailable" error.
+type CreateItxPastMeetingParticipantServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateItxPastMeetingParticipantUnauthorizedResponseBody is the type of the
+// "Meeting Service" service "create-itx-past-meeting-participant" endpoint
+// HTTP response body for the "Unauthorized" error.
 type CreateItxPastMeetingParticipantUnauthorizedResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
@@ -2900,6 +3913,58 @@ type UpdateItxPastMeetingParticipantUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody is the type of
+// the "Meeting Service" service "bulk-update-itx-past-meeting-participants"
+// endpoint HTTP response body for the "BadRequest" error.
+type BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody is the type of the
+// "Meeting Service" service "bulk-update-itx-past-meeting-participants"
+// endpoint HTTP response body for the "Forbidden" error.
+type BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody is the
+// type of the "Meeting Service" service
+// "bulk-update-itx-past-meeting-participants" endpoint HTTP response body for
+// the "InternalServerError" error.
+type BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody is the
+// type of the "Meeting Service" service
+// "bulk-update-itx-past-meeting-participants" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody is the type of
+// the "Meeting Service" service "bulk-update-itx-past-meeting-participants"
+// endpoint HTTP response body for the "Unauthorized" error.
+type BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // DeleteItxPastMeetingParticipantBadRequestResponseBody is the type of the
 // "Meeting Service" service "delete-itx-past-meeting-participant" endpoint
 // HTTP response body for the "BadRequest" error.
@@ -3684,6 +4749,83 @@ type GetItxPastMeetingAttachmentDownloadUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ServiceEventProcessingConfigResponseBody is used to define fields on
+// response body types.
+type ServiceEventProcessingConfigResponseBody struct {
+	// Whether the v1-objects KV consumer is started
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// JetStream consumer name
+	ConsumerName string `form:"consumer_name" json:"consumer_name" xml:"consumer_name"`
+	// JetStream stream name
+	StreamName string `form:"stream_name" json:"stream_name" xml:"stream_name"`
+	// KV subjects the consumer is filtered to
+	FilterSubjects []string `form:"filter_subjects" json:"filter_subjects" xml:"filter_subjects"`
+	// Maximum delivery attempts per message
+	MaxDeliver int `form:"max_deliver" json:"max_deliver" xml:"max_deliver"`
+	// Ack wait timeout as a Go duration
+	AckWait string `form:"ack_wait" json:"ack_wait" xml:"ack_wait"`
+	// Maximum unacknowledged messages
+	MaxAckPending int `form:"max_ack_pending" json:"max_ack_pending" xml:"max_ack_pending"`
+	// KV bucket used for v1 mappings
+	V1MappingsBucket string `form:"v1_mappings_bucket" json:"v1_mappings_bucket" xml:"v1_mappings_bucket"`
+	// Consecutive failures that open an object type's circuit breaker (0 disables)
+	BreakerThreshold int `form:"breaker_threshold" json:"breaker_threshold" xml:"breaker_threshold"`
+	// Circuit breaker cooldown as a Go duration
+	BreakerCooldown string `form:"breaker_cooldown" json:"breaker_cooldown" xml:"breaker_cooldown"`
+}
+
+// ServiceInvitesConfigResponseBody is used to define fields on response body
+// types.
+type ServiceInvitesConfigResponseBody struct {
+	// Whether outbound LFID invites are enabled
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// LFX self-serve base URL used in invite links (empty when invalid)
+	SelfServeBaseURL string `form:"self_serve_base_url" json:"self_serve_base_url" xml:"self_serve_base_url"`
+}
+
+// ServiceITXResilienceConfigResponseBody is used to define fields on response
+// body types.
+type ServiceITXResilienceConfigResponseBody struct {
+	// Retries of an idempotent request after a transient failure (0 disables
+	// retries)
+	MaxRetries int `form:"max_retries" json:"max_retries" xml:"max_retries"`
+	// Base retry backoff as a Go duration
+	RetryBaseDelay string `form:"retry_base_delay" json:"retry_base_delay" xml:"retry_base_delay"`
+	// Maximum retry backoff as a Go duration
+	RetryMaxDelay string `form:"retry_max_delay" json:"retry_max_delay" xml:"retry_max_delay"`
+	// Timeout of a single attempt of an idempotent request as a Go duration (0s
+	// means no per-attempt bound)
+	AttemptTimeout string `form:"attempt_timeout" json:"attempt_timeout" xml:"attempt_timeout"`
+	// attempt_timeout overrides by endpoint group (ics, attachments) as Go
+	// durations
+	EndpointAttemptTimeouts map[string]string `form:"endpoint_attempt_timeouts" json:"endpoint_attempt_timeouts" xml:"endpoint_attempt_timeouts"`
+	// Consecutive failures that open the ITX circuit breaker (0 disables)
+	BreakerThreshold int `form:"breaker_threshold" json:"breaker_threshold" xml:"breaker_threshold"`
+	// Circuit breaker cooldown as a Go duration
+	BreakerCooldown string `form:"breaker_cooldown" json:"breaker_cooldown" xml:"breaker_cooldown"`
+}
+
+// ServiceAttachmentsConfigResponseBody is used to define fields on response
+// body types.
+type ServiceAttachmentsConfigResponseBody struct {
+	// Maximum attachment size in bytes (0 means no limit)
+	MaxFileSize int64 `form:"max_file_size" json:"max_file_size" xml:"max_file_size"`
+	// Accepted attachment content types (empty means any)
+	AllowedContentTypes []string `form:"allowed_content_types" json:"allowed_content_types" xml:"allowed_content_types"`
+}
+
+// DependencyHealthResponseBody is used to define fields on response body types.
+type DependencyHealthResponseBody struct {
+	// Dependency name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Check outcome
+	Status string `form:"status" json:"status" xml:"status"`
+	// How long the check took, in milliseconds
+	LatencyMs int64 `form:"latency_ms" json:"latency_ms" xml:"latency_ms"`
+	// Why the check failed (only when status is error)
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
 // CommitteeResponseBody is used to define fields on response body types.
 type CommitteeResponseBody struct {
 	// Committee UID
@@ -3708,8 +4850,14 @@ type RecurrenceResponseBody struct {
 	MonthlyWeekDay *int `form:"monthly_week_day,omitempty" json:"monthly_week_day,omitempty" xml:"monthly_week_day,omitempty"`
 	// Number of occurrences
 	EndTimes *int `form:"end_times,omitempty" json:"end_times,omitempty" xml:"end_times,omitempty"`
-	// End date/time in RFC3339
+	// End date/time in RFC3339. Occurrence previews reject end dates more than 10
+	// years after the start time
 	EndDateTime *string `form:"end_date_time,omitempty" json:"end_date_time,omitempty" xml:"end_date_time,omitempty"`
+	// RFC 5545 RRULE equivalent of the recurrence. Set on responses unless the
+	// recurrence ITX returns has an invalid type or end_date_time. On requests it
+	// may be sent instead of type and the other fields above, for rules Zoom can
+	// represent
+	Rrule *string `form:"rrule,omitempty" json:"rrule,omitempty" xml:"rrule,omitempty"`
 }
 
 // ITXOccurrenceResponseBody is used to define fields on response body types.
@@ -3738,6 +4886,23 @@ type ITXUserResponseBody struct {
 	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
 }
 
+// ITXLocalOccurrenceResponseBody is used to define fields on response body
+// types.
+type ITXLocalOccurrenceResponseBody struct {
+	// Unix timestamp
+	OccurrenceID string `form:"occurrence_id" json:"occurrence_id" xml:"occurrence_id"`
+	// RFC3339 start time in UTC
+	StartTime string `form:"start_time" json:"start_time" xml:"start_time"`
+	// RFC3339 start time in the list's timezone
+	LocalStartTime string `form:"local_start_time" json:"local_start_time" xml:"local_start_time"`
+	// RFC3339 end time in the list's timezone
+	LocalEndTime string `form:"local_end_time" json:"local_end_time" xml:"local_end_time"`
+	// Duration in minutes
+	Duration int `form:"duration" json:"duration" xml:"duration"`
+	// available or cancel
+	Status string `form:"status" json:"status" xml:"status"`
+}
+
 // PastMeetingSummaryZoomConfigResponseBody is used to define fields on
 // response body types.
 type PastMeetingSummaryZoomConfigResponseBody struct {
@@ -3763,6 +4928,18 @@ type SummaryDataResponseBody struct {
 	EditedContent *string `form:"edited_content,omitempty" json:"edited_content,omitempty" xml:"edited_content,omitempty"`
 }
 
+// SummaryDiffLineResponseBody is used to define fields on response body types.
+type SummaryDiffLineResponseBody struct {
+	// How the line changed from the AI-generated content to the edited content
+	Operation string `form:"operation" json:"operation" xml:"operation"`
+	// The line text
+	Text string `form:"text" json:"text" xml:"text"`
+	// 1-based line number in the AI-generated content (omitted for inserted lines)
+	OriginalLine *int `form:"original_line,omitempty" json:"original_line,omitempty" xml:"original_line,omitempty"`
+	// 1-based line number in the edited content (omitted for deleted lines)
+	EditedLine *int `form:"edited_line,omitempty" json:"edited_line,omitempty" xml:"edited_line,omitempty"`
+}
+
 // ParticipantSessionResponseBody is used to define fields on response body
 // types.
 type ParticipantSessionResponseBody struct {
@@ -3776,6 +4953,79 @@ type ParticipantSessionResponseBody struct {
 	LeaveReason *string `form:"leave_reason,omitempty" json:"leave_reason,omitempty" xml:"leave_reason,omitempty"`
 }
 
+// ITXPastMeetingParticipantResponseBody is used to define fields on response
+// body types.
+type ITXPastMeetingParticipantResponseBody struct {
+	// Participant identifier (invitee_id or attendee_id or both)
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Invitee record UUID (if is_invited=true)
+	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
+	// Attendee record UUID (if is_attended=true)
+	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
+	// Past meeting ID (meeting_id-occurrence_id)
+	PastMeetingID *string `form:"past_meeting_id,omitempty" json:"past_meeting_id,omitempty" xml:"past_meeting_id,omitempty"`
+	// Meeting ID
+	MeetingID *string `form:"meeting_id,omitempty" json:"meeting_id,omitempty" xml:"meeting_id,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// LF SSO username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// LF user ID (Salesforce ID)
+	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
+	// Organization name
+	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Whether org has LF membership
+	OrgIsMember *bool `form:"org_is_member,omitempty" json:"org_is_member,omitempty" xml:"org_is_member,omitempty"`
+	// Whether org has project membership
+	OrgIsProjectMember *bool `form:"org_is_project_member,omitempty" json:"org_is_project_member,omitempty" xml:"org_is_project_member,omitempty"`
+	// Associated committee UUID
+	CommitteeID *string `form:"committee_id,omitempty" json:"committee_id,omitempty" xml:"committee_id,omitempty"`
+	// Role within committee
+	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
+	// Whether participant is a committee member
+	IsCommitteeMember *bool `form:"is_committee_member,omitempty" json:"is_committee_member,omitempty" xml:"is_committee_member,omitempty"`
+	// Voting status in committee
+	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
+	// URL to profile picture
+	AvatarURL *string `form:"avatar_url,omitempty" json:"avatar_url,omitempty" xml:"avatar_url,omitempty"`
+	// Whether the participant was invited/registered to this past meeting
+	IsInvited *bool `form:"is_invited,omitempty" json:"is_invited,omitempty" xml:"is_invited,omitempty"`
+	// Whether the participant attended this past meeting
+	IsAttended *bool `form:"is_attended,omitempty" json:"is_attended,omitempty" xml:"is_attended,omitempty"`
+	// Whether the attendee has been verified (attendees only)
+	IsVerified *bool `form:"is_verified,omitempty" json:"is_verified,omitempty" xml:"is_verified,omitempty"`
+	// Whether attendee is marked as unknown (attendees only)
+	IsUnknown *bool `form:"is_unknown,omitempty" json:"is_unknown,omitempty" xml:"is_unknown,omitempty"`
+	// Whether the attendee record was updated via AI reconciliation (attendees
+	// only)
+	IsAiReconciled *bool `form:"is_ai_reconciled,omitempty" json:"is_ai_reconciled,omitempty" xml:"is_ai_reconciled,omitempty"`
+	// Whether the attendee name was auto-matched to a registrant's email
+	// (attendees only)
+	IsAutoMatched *bool `form:"is_auto_matched,omitempty" json:"is_auto_matched,omitempty" xml:"is_auto_matched,omitempty"`
+	// Zoom display name of the attendee (attendees only)
+	ZoomUserName *string `form:"zoom_user_name,omitempty" json:"zoom_user_name,omitempty" xml:"zoom_user_name,omitempty"`
+	// Full name of the invitee the attendee was matched to (attendees only)
+	MappedInviteeName *string `form:"mapped_invitee_name,omitempty" json:"mapped_invitee_name,omitempty" xml:"mapped_invitee_name,omitempty"`
+	// Array of session objects with join/leave times (attendees only)
+	Sessions []*ParticipantSessionResponseBody `form:"sessions,omitempty" json:"sessions,omitempty" xml:"sessions,omitempty"`
+	// Average attendance percentage (attendees only, calculated)
+	AverageAttendance *int `form:"average_attendance,omitempty" json:"average_attendance,omitempty" xml:"average_attendance,omitempty"`
+	// Creation timestamp (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Creator user info
+	CreatedBy *ITXUserResponseBody `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Last modified timestamp (RFC3339)
+	ModifiedAt *string `form:"modified_at,omitempty" json:"modified_at,omitempty" xml:"modified_at,omitempty"`
+	// Last modifier user info
+	ModifiedBy *ITXUserResponseBody `form:"modified_by,omitempty" json:"modified_by,omitempty" xml:"modified_by,omitempty"`
+}
+
 // CommitteeRequestBody is used to define fields on request body types.
 type CommitteeRequestBody struct {
 	// Committee UID
@@ -3800,8 +5050,14 @@ type RecurrenceRequestBody struct {
 	MonthlyWeekDay *int `form:"monthly_week_day,omitempty" json:"monthly_week_day,omitempty" xml:"monthly_week_day,omitempty"`
 	// Number of occurrences
 	EndTimes *int `form:"end_times,omitempty" json:"end_times,omitempty" xml:"end_times,omitempty"`
-	// End date/time in RFC3339
+	// End date/time in RFC3339. Occurrence previews reject end dates more than 10
+	// years after the start time
 	EndDateTime *string `form:"end_date_time,omitempty" json:"end_date_time,omitempty" xml:"end_date_time,omitempty"`
+	// RFC 5545 RRULE equivalent of the recurrence. Set on responses unless the
+	// recurrence ITX returns has an invalid type or end_date_time. On requests it
+	// may be sent instead of type and the other fields above, for rules Zoom can
+	// represent
+	Rrule *string `form:"rrule,omitempty" json:"rrule,omitempty" xml:"rrule,omitempty"`
 }
 
 // ITXUserRequestBody is used to define fields on request body types.
@@ -3828,26 +5084,119 @@ type ParticipantSessionRequestBody struct {
 	LeaveReason *string `form:"leave_reason,omitempty" json:"leave_reason,omitempty" xml:"leave_reason,omitempty"`
 }
 
-// NewCreateItxMeetingResponseBody builds the HTTP response body from the
-// result of the "create-itx-meeting" endpoint of the "Meeting Service" service.
-func NewCreateItxMeetingResponseBody(res *meetingservice.ITXZoomMeetingResponse) *CreateItxMeetingResponseBody {
-	body := &CreateItxMeetingResponseBody{
-		ProjectUID:                               res.ProjectUID,
-		Title:                                    res.Title,
-		StartTime:                                res.StartTime,
-		Duration:                                 res.Duration,
-		Timezone:                                 res.Timezone,
-		Visibility:                               res.Visibility,
-		Description:                              res.Description,
-		Restricted:                               res.Restricted,
-		MeetingType:                              res.MeetingType,
-		EarlyJoinTimeMinutes:                     res.EarlyJoinTimeMinutes,
-		RecordingEnabled:                         res.RecordingEnabled,
-		TranscriptEnabled:                        res.TranscriptEnabled,
-		YoutubeUploadEnabled:                     res.YoutubeUploadEnabled,
-		AiSummaryEnabled:                         res.AiSummaryEnabled,
-		RequireAiSummaryApproval:                 res.RequireAiSummaryApproval,
-		ArtifactVisibility:                       res.ArtifactVisibility,
+// ITXPastMeetingParticipantUpdateRequestBody is used to define fields on
+// request body types.
+type ITXPastMeetingParticipantUpdateRequestBody struct {
+	// Participant ID (invitee_id or attendee_id)
+	ParticipantID *string `form:"participant_id,omitempty" json:"participant_id,omitempty" xml:"participant_id,omitempty"`
+	// Optional invitee ID to use directly (avoids ID mapping lookup)
+	InviteeID *string `form:"invitee_id,omitempty" json:"invitee_id,omitempty" xml:"invitee_id,omitempty"`
+	// Optional attendee ID to use directly (avoids ID mapping lookup)
+	AttendeeID *string `form:"attendee_id,omitempty" json:"attendee_id,omitempty" xml:"attendee_id,omitempty"`
+	// Whether the participant is invited (if false, invitee record will be deleted)
+	IsInvited *bool `form:"is_invited,omitempty" json:"is_invited,omitempty" xml:"is_invited,omitempty"`
+	// Whether the participant attended (if false, attendee record will be deleted)
+	IsAttended *bool `form:"is_attended,omitempty" json:"is_attended,omitempty" xml:"is_attended,omitempty"`
+	// Email address (used for creation)
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LF SSO username (used for creation)
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// LF user ID (used for creation)
+	LfUserID *string `form:"lf_user_id,omitempty" json:"lf_user_id,omitempty" xml:"lf_user_id,omitempty"`
+	// First name (required for invitee updates)
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name (required for invitee updates)
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Organization name
+	OrgName *string `form:"org_name,omitempty" json:"org_name,omitempty" xml:"org_name,omitempty"`
+	// Job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Role within committee
+	CommitteeRole *string `form:"committee_role,omitempty" json:"committee_role,omitempty" xml:"committee_role,omitempty"`
+	// Voting status in committee
+	CommitteeVotingStatus *string `form:"committee_voting_status,omitempty" json:"committee_voting_status,omitempty" xml:"committee_voting_status,omitempty"`
+	// Whether the attendee has been verified (attendee only)
+	IsVerified *bool `form:"is_verified,omitempty" json:"is_verified,omitempty" xml:"is_verified,omitempty"`
+}
+
+// NewGetServiceConfigResponseBody builds the HTTP response body from the
+// result of the "get-service-config" endpoint of the "Meeting Service" service.
+func NewGetServiceConfigResponseBody(res *meetingservice.ServiceConfig) *GetServiceConfigResponseBody {
+	body := &GetServiceConfigResponseBody{
+		Version:            res.Version,
+		LfxEnvironment:     res.LfxEnvironment,
+		ItxBaseURL:         res.ItxBaseURL,
+		ItxAuth0Domain:     res.ItxAuth0Domain,
+		ItxAudience:        res.ItxAudience,
+		UserServiceBaseURL: res.UserServiceBaseURL,
+		IDMappingEnabled:   res.IDMappingEnabled,
+		JoinLinkCacheTTL:   res.JoinLinkCacheTTL,
+		LogLevel:           res.LogLevel,
+	}
+	if res.EventProcessing != nil {
+		body.EventProcessing = marshalMeetingserviceServiceEventProcessingConfigToServiceEventProcessingConfigResponseBody(res.EventProcessing)
+	}
+	if res.Invites != nil {
+		body.Invites = marshalMeetingserviceServiceInvitesConfigToServiceInvitesConfigResponseBody(res.Invites)
+	}
+	if res.ItxResilience != nil {
+		body.ItxResilience = marshalMeetingserviceServiceITXResilienceConfigToServiceITXResilienceConfigResponseBody(res.ItxResilience)
+	}
+	if res.Attachments != nil {
+		body.Attachments = marshalMeetingserviceServiceAttachmentsConfigToServiceAttachmentsConfigResponseBody(res.Attachments)
+	}
+	if res.SubsystemLogLevels != nil {
+		body.SubsystemLogLevels = make(map[string]string, len(res.SubsystemLogLevels))
+		for key, val := range res.SubsystemLogLevels {
+			tk := key
+			tv := val
+			body.SubsystemLogLevels[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetServiceHealthResponseBody builds the HTTP response body from the
+// result of the "get-service-health" endpoint of the "Meeting Service" service.
+func NewGetServiceHealthResponseBody(res *meetingservice.ServiceHealth) *GetServiceHealthResponseBody {
+	body := &GetServiceHealthResponseBody{
+		Status: res.Status,
+	}
+	if res.Checks != nil {
+		body.Checks = make([]*DependencyHealthResponseBody, len(res.Checks))
+		for i, val := range res.Checks {
+			if val == nil {
+				body.Checks[i] = nil
+				continue
+			}
+			body.Checks[i] = marshalMeetingserviceDependencyHealthToDependencyHealthResponseBody(val)
+		}
+	} else {
+		body.Checks = []*DependencyHealthResponseBody{}
+	}
+	return body
+}
+
+// NewCreateItxMeetingResponseBody builds the HTTP response body from the
+// result of the "create-itx-meeting" endpoint of the "Meeting Service" service.
+func NewCreateItxMeetingResponseBody(res *meetingservice.ITXZoomMeetingResponse) *CreateItxMeetingResponseBody {
+	body := &CreateItxMeetingResponseBody{
+		ProjectUID:                               res.ProjectUID,
+		Title:                                    res.Title,
+		StartTime:                                res.StartTime,
+		Duration:                                 res.Duration,
+		Timezone:                                 res.Timezone,
+		Visibility:                               res.Visibility,
+		Description:                              res.Description,
+		Restricted:                               res.Restricted,
+		MeetingType:                              res.MeetingType,
+		EarlyJoinTimeMinutes:                     res.EarlyJoinTimeMinutes,
+		RecordingEnabled:                         res.RecordingEnabled,
+		TranscriptEnabled:                        res.TranscriptEnabled,
+		YoutubeUploadEnabled:                     res.YoutubeUploadEnabled,
+		AiSummaryEnabled:                         res.AiSummaryEnabled,
+		RequireAiSummaryApproval:                 res.RequireAiSummaryApproval,
+		ArtifactVisibility:                       res.ArtifactVisibility,
 		AutoEmailReminderEnabled:                 res.AutoEmailReminderEnabled,
 		AutoEmailReminderTime:                    res.AutoEmailReminderTime,
 		LastBulkRegistrantJobStatus:              res.LastBulkRegistrantJobStatus,
@@ -3964,6 +5313,74 @@ func NewGetItxMeetingResponseBody(res *meetingservice.ITXZoomMeetingResponse) *G
 	return body
 }
 
+// NewCloneItxMeetingResponseBody builds the HTTP response body from the result
+// of the "clone-itx-meeting" endpoint of the "Meeting Service" service.
+func NewCloneItxMeetingResponseBody(res *meetingservice.ITXZoomMeetingResponse) *CloneItxMeetingResponseBody {
+	body := &CloneItxMeetingResponseBody{
+		ProjectUID:                               res.ProjectUID,
+		Title:                                    res.Title,
+		StartTime:                                res.StartTime,
+		Duration:                                 res.Duration,
+		Timezone:                                 res.Timezone,
+		Visibility:                               res.Visibility,
+		Description:                              res.Description,
+		Restricted:                               res.Restricted,
+		MeetingType:                              res.MeetingType,
+		EarlyJoinTimeMinutes:                     res.EarlyJoinTimeMinutes,
+		RecordingEnabled:                         res.RecordingEnabled,
+		TranscriptEnabled:                        res.TranscriptEnabled,
+		YoutubeUploadEnabled:                     res.YoutubeUploadEnabled,
+		AiSummaryEnabled:                         res.AiSummaryEnabled,
+		RequireAiSummaryApproval:                 res.RequireAiSummaryApproval,
+		ArtifactVisibility:                       res.ArtifactVisibility,
+		AutoEmailReminderEnabled:                 res.AutoEmailReminderEnabled,
+		AutoEmailReminderTime:                    res.AutoEmailReminderTime,
+		LastBulkRegistrantJobStatus:              res.LastBulkRegistrantJobStatus,
+		LastBulkRegistrantsJobWarningCount:       res.LastBulkRegistrantsJobWarningCount,
+		EmailDeliveryErrorCount:                  res.EmailDeliveryErrorCount,
+		IsInviteResponsesEnabled:                 res.IsInviteResponsesEnabled,
+		ResponseCountYes:                         res.ResponseCountYes,
+		ResponseCountMaybe:                       res.ResponseCountMaybe,
+		ResponseCountNo:                          res.ResponseCountNo,
+		LastMailingListMembersSyncJobStatus:      res.LastMailingListMembersSyncJobStatus,
+		LastMailingListMembersSyncJobFailedCount: res.LastMailingListMembersSyncJobFailedCount,
+		LastMailingListMembersSyncJobWarningCount: res.LastMailingListMembersSyncJobWarningCount,
+		NextOccurrenceStartTime:                   res.NextOccurrenceStartTime,
+		ID:                                        res.ID,
+		HostKey:                                   res.HostKey,
+		Passcode:                                  res.Passcode,
+		Password:                                  res.Password,
+		PublicLink:                                res.PublicLink,
+		CreatedAt:                                 res.CreatedAt,
+		ModifiedAt:                                res.ModifiedAt,
+		RegistrantCount:                           res.RegistrantCount,
+	}
+	if res.Committees != nil {
+		body.Committees = make([]*CommitteeResponseBody, len(res.Committees))
+		for i, val := range res.Committees {
+			if val == nil {
+				body.Committees[i] = nil
+				continue
+			}
+			body.Committees[i] = marshalMeetingserviceCommitteeToCommitteeResponseBody(val)
+		}
+	}
+	if res.Recurrence != nil {
+		body.Recurrence = marshalMeetingserviceRecurrenceToRecurrenceResponseBody(res.Recurrence)
+	}
+	if res.Occurrences != nil {
+		body.Occurrences = make([]*ITXOccurrenceResponseBody, len(res.Occurrences))
+		for i, val := range res.Occurrences {
+			if val == nil {
+				body.Occurrences[i] = nil
+				continue
+			}
+			body.Occurrences[i] = marshalMeetingserviceITXOccurrenceToITXOccurrenceResponseBody(val)
+		}
+	}
+	return body
+}
+
 // NewGetItxMeetingCountResponseBody builds the HTTP response body from the
 // result of the "get-itx-meeting-count" endpoint of the "Meeting Service"
 // service.
@@ -3974,6 +5391,26 @@ func NewGetItxMeetingCountResponseBody(res *meetingservice.ITXMeetingCountRespon
 	return body
 }
 
+// NewPreviewItxMeetingOccurrencesResponseBody builds the HTTP response body
+// from the result of the "preview-itx-meeting-occurrences" endpoint of the
+// "Meeting Service" service.
+func NewPreviewItxMeetingOccurrencesResponseBody(res *meetingservice.ITXOccurrencePreviewResponse) *PreviewItxMeetingOccurrencesResponseBody {
+	body := &PreviewItxMeetingOccurrencesResponseBody{}
+	if res.Occurrences != nil {
+		body.Occurrences = make([]*ITXOccurrenceResponseBody, len(res.Occurrences))
+		for i, val := range res.Occurrences {
+			if val == nil {
+				body.Occurrences[i] = nil
+				continue
+			}
+			body.Occurrences[i] = marshalMeetingserviceITXOccurrenceToITXOccurrenceResponseBody(val)
+		}
+	} else {
+		body.Occurrences = []*ITXOccurrenceResponseBody{}
+	}
+	return body
+}
+
 // NewCreateItxRegistrantResponseBody builds the HTTP response body from the
 // result of the "create-itx-registrant" endpoint of the "Meeting Service"
 // service.
@@ -4047,7 +5484,102 @@ func NewGetItxRegistrantResponseBody(res *meetingservice.ITXZoomMeetingRegistran
 // of the "get-itx-join-link" endpoint of the "Meeting Service" service.
 func NewGetItxJoinLinkResponseBody(res *meetingservice.ITXZoomMeetingJoinLink) *GetItxJoinLinkResponseBody {
 	body := &GetItxJoinLinkResponseBody{
-		Link: res.Link,
+		Link:                res.Link,
+		OccurrenceID:        res.OccurrenceID,
+		OccurrenceStartTime: res.OccurrenceStartTime,
+		OccurrenceDuration:  res.OccurrenceDuration,
+	}
+	return body
+}
+
+// NewAddItxRegistrantHostResponseBody builds the HTTP response body from the
+// result of the "add-itx-registrant-host" endpoint of the "Meeting Service"
+// service.
+func NewAddItxRegistrantHostResponseBody(res *meetingservice.ITXZoomMeetingRegistrant) *AddItxRegistrantHostResponseBody {
+	body := &AddItxRegistrantHostResponseBody{
+		UID:                           res.UID,
+		Type:                          res.Type,
+		CommitteeUID:                  res.CommitteeUID,
+		Email:                         res.Email,
+		Username:                      res.Username,
+		FirstName:                     res.FirstName,
+		LastName:                      res.LastName,
+		Org:                           res.Org,
+		JobTitle:                      res.JobTitle,
+		ProfilePicture:                res.ProfilePicture,
+		Host:                          res.Host,
+		Occurrence:                    res.Occurrence,
+		AttendedOccurrenceCount:       res.AttendedOccurrenceCount,
+		TotalOccurrenceCount:          res.TotalOccurrenceCount,
+		LastInviteReceivedTime:        res.LastInviteReceivedTime,
+		LastInviteReceivedMessageID:   res.LastInviteReceivedMessageID,
+		LastInviteDeliveryStatus:      res.LastInviteDeliveryStatus,
+		LastInviteDeliveryDescription: res.LastInviteDeliveryDescription,
+		CreatedAt:                     res.CreatedAt,
+		ModifiedAt:                    res.ModifiedAt,
+	}
+	if res.CreatedBy != nil {
+		body.CreatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(res.CreatedBy)
+	}
+	if res.UpdatedBy != nil {
+		body.UpdatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(res.UpdatedBy)
+	}
+	return body
+}
+
+// NewRemoveItxRegistrantHostResponseBody builds the HTTP response body from
+// the result of the "remove-itx-registrant-host" endpoint of the "Meeting
+// Service" service.
+func NewRemoveItxRegistrantHostResponseBody(res *meetingservice.ITXZoomMeetingRegistrant) *RemoveItxRegistrantHostResponseBody {
+	body := &RemoveItxRegistrantHostResponseBody{
+		UID:                           res.UID,
+		Type:                          res.Type,
+		CommitteeUID:                  res.CommitteeUID,
+		Email:                         res.Email,
+		Username:                      res.Username,
+		FirstName:                     res.FirstName,
+		LastName:                      res.LastName,
+		Org:                           res.Org,
+		JobTitle:                      res.JobTitle,
+		ProfilePicture:                res.ProfilePicture,
+		Host:                          res.Host,
+		Occurrence:                    res.Occurrence,
+		AttendedOccurrenceCount:       res.AttendedOccurrenceCount,
+		TotalOccurrenceCount:          res.TotalOccurrenceCount,
+		LastInviteReceivedTime:        res.LastInviteReceivedTime,
+		LastInviteReceivedMessageID:   res.LastInviteReceivedMessageID,
+		LastInviteDeliveryStatus:      res.LastInviteDeliveryStatus,
+		LastInviteDeliveryDescription: res.LastInviteDeliveryDescription,
+		CreatedAt:                     res.CreatedAt,
+		ModifiedAt:                    res.ModifiedAt,
+	}
+	if res.CreatedBy != nil {
+		body.CreatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(res.CreatedBy)
+	}
+	if res.UpdatedBy != nil {
+		body.UpdatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(res.UpdatedBy)
+	}
+	return body
+}
+
+// NewListItxMeetingOccurrencesResponseBody builds the HTTP response body from
+// the result of the "list-itx-meeting-occurrences" endpoint of the "Meeting
+// Service" service.
+func NewListItxMeetingOccurrencesResponseBody(res *meetingservice.ITXOccurrenceListResponse) *ListItxMeetingOccurrencesResponseBody {
+	body := &ListItxMeetingOccurrencesResponseBody{
+		Timezone: res.Timezone,
+	}
+	if res.Occurrences != nil {
+		body.Occurrences = make([]*ITXLocalOccurrenceResponseBody, len(res.Occurrences))
+		for i, val := range res.Occurrences {
+			if val == nil {
+				body.Occurrences[i] = nil
+				continue
+			}
+			body.Occurrences[i] = marshalMeetingserviceITXLocalOccurrenceToITXLocalOccurrenceResponseBody(val)
+		}
+	} else {
+		body.Occurrences = []*ITXLocalOccurrenceResponseBody{}
 	}
 	return body
 }
@@ -4168,6 +5700,33 @@ func NewGetItxPastMeetingSummaryResponseBody(res *meetingservice.PastMeetingSumm
 	return body
 }
 
+// NewGetItxPastMeetingSummaryDiffResponseBody builds the HTTP response body
+// from the result of the "get-itx-past-meeting-summary-diff" endpoint of the
+// "Meeting Service" service.
+func NewGetItxPastMeetingSummaryDiffResponseBody(res *meetingservice.PastMeetingSummaryDiff) *GetItxPastMeetingSummaryDiffResponseBody {
+	body := &GetItxPastMeetingSummaryDiffResponseBody{
+		UID:           res.UID,
+		PastMeetingID: res.PastMeetingID,
+		HasEdits:      res.HasEdits,
+		Additions:     res.Additions,
+		Deletions:     res.Deletions,
+		Approximate:   res.Approximate,
+	}
+	if res.Lines != nil {
+		body.Lines = make([]*SummaryDiffLineResponseBody, len(res.Lines))
+		for i, val := range res.Lines {
+			if val == nil {
+				body.Lines[i] = nil
+				continue
+			}
+			body.Lines[i] = marshalMeetingserviceSummaryDiffLineToSummaryDiffLineResponseBody(val)
+		}
+	} else {
+		body.Lines = []*SummaryDiffLineResponseBody{}
+	}
+	return body
+}
+
 // NewUpdateItxPastMeetingSummaryResponseBody builds the HTTP response body
 // from the result of the "update-itx-past-meeting-summary" endpoint of the
 // "Meeting Service" service.
@@ -4193,6 +5752,56 @@ func NewUpdateItxPastMeetingSummaryResponseBody(res *meetingservice.PastMeetingS
 	return body
 }
 
+// NewApproveItxPastMeetingSummaryResponseBody builds the HTTP response body
+// from the result of the "approve-itx-past-meeting-summary" endpoint of the
+// "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryResponseBody(res *meetingservice.PastMeetingSummary) *ApproveItxPastMeetingSummaryResponseBody {
+	body := &ApproveItxPastMeetingSummaryResponseBody{
+		UID:              res.UID,
+		PastMeetingID:    res.PastMeetingID,
+		MeetingID:        res.MeetingID,
+		Platform:         res.Platform,
+		Password:         res.Password,
+		RequiresApproval: res.RequiresApproval,
+		Approved:         res.Approved,
+		EmailSent:        res.EmailSent,
+		CreatedAt:        res.CreatedAt,
+		UpdatedAt:        res.UpdatedAt,
+	}
+	if res.ZoomConfig != nil {
+		body.ZoomConfig = marshalMeetingservicePastMeetingSummaryZoomConfigToPastMeetingSummaryZoomConfigResponseBody(res.ZoomConfig)
+	}
+	if res.SummaryData != nil {
+		body.SummaryData = marshalMeetingserviceSummaryDataToSummaryDataResponseBody(res.SummaryData)
+	}
+	return body
+}
+
+// NewRejectItxPastMeetingSummaryResponseBody builds the HTTP response body
+// from the result of the "reject-itx-past-meeting-summary" endpoint of the
+// "Meeting Service" service.
+func NewRejectItxPastMeetingSummaryResponseBody(res *meetingservice.PastMeetingSummary) *RejectItxPastMeetingSummaryResponseBody {
+	body := &RejectItxPastMeetingSummaryResponseBody{
+		UID:              res.UID,
+		PastMeetingID:    res.PastMeetingID,
+		MeetingID:        res.MeetingID,
+		Platform:         res.Platform,
+		Password:         res.Password,
+		RequiresApproval: res.RequiresApproval,
+		Approved:         res.Approved,
+		EmailSent:        res.EmailSent,
+		CreatedAt:        res.CreatedAt,
+		UpdatedAt:        res.UpdatedAt,
+	}
+	if res.ZoomConfig != nil {
+		body.ZoomConfig = marshalMeetingservicePastMeetingSummaryZoomConfigToPastMeetingSummaryZoomConfigResponseBody(res.ZoomConfig)
+	}
+	if res.SummaryData != nil {
+		body.SummaryData = marshalMeetingserviceSummaryDataToSummaryDataResponseBody(res.SummaryData)
+	}
+	return body
+}
+
 // NewCreateItxPastMeetingParticipantResponseBody builds the HTTP response body
 // from the result of the "create-itx-past-meeting-participant" endpoint of the
 // "Meeting Service" service.
@@ -4303,6 +5912,26 @@ func NewUpdateItxPastMeetingParticipantResponseBody(res *meetingservice.ITXPastM
 	return body
 }
 
+// NewBulkUpdateItxPastMeetingParticipantsResponseBody builds the HTTP response
+// body from the result of the "bulk-update-itx-past-meeting-participants"
+// endpoint of the "Meeting Service" service.
+func NewBulkUpdateItxPastMeetingParticipantsResponseBody(res *meetingservice.ITXPastMeetingParticipantBulkUpdateResponse) *BulkUpdateItxPastMeetingParticipantsResponseBody {
+	body := &BulkUpdateItxPastMeetingParticipantsResponseBody{}
+	if res.Participants != nil {
+		body.Participants = make([]*ITXPastMeetingParticipantResponseBody, len(res.Participants))
+		for i, val := range res.Participants {
+			if val == nil {
+				body.Participants[i] = nil
+				continue
+			}
+			body.Participants[i] = marshalMeetingserviceITXPastMeetingParticipantToITXPastMeetingParticipantResponseBody(val)
+		}
+	} else {
+		body.Participants = []*ITXPastMeetingParticipantResponseBody{}
+	}
+	return body
+}
+
 // NewCreateItxMeetingAttachmentResponseBody builds the HTTP response body from
 // the result of the "create-itx-meeting-attachment" endpoint of the "Meeting
 // Service" service.
@@ -4507,26 +6136,114 @@ func NewCreateItxPastMeetingAttachmentPresignResponseBody(res *meetingservice.IT
 	if res.CreatedBy != nil {
 		body.CreatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(res.CreatedBy)
 	}
-	if res.UpdatedBy != nil {
-		body.UpdatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(res.UpdatedBy)
+	if res.UpdatedBy != nil {
+		body.UpdatedBy = marshalMeetingserviceITXUserToITXUserResponseBody(res.UpdatedBy)
+	}
+	return body
+}
+
+// NewGetItxPastMeetingAttachmentDownloadResponseBody builds the HTTP response
+// body from the result of the "get-itx-past-meeting-attachment-download"
+// endpoint of the "Meeting Service" service.
+func NewGetItxPastMeetingAttachmentDownloadResponseBody(res *meetingservice.ITXAttachmentDownloadResponse) *GetItxPastMeetingAttachmentDownloadResponseBody {
+	body := &GetItxPastMeetingAttachmentDownloadResponseBody{
+		DownloadURL: res.DownloadURL,
+	}
+	return body
+}
+
+// NewReadyzServiceUnavailableResponseBody builds the HTTP response body from
+// the result of the "readyz" endpoint of the "Meeting Service" service.
+func NewReadyzServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *ReadyzServiceUnavailableResponseBody {
+	body := &ReadyzServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetServiceConfigBadRequestResponseBody builds the HTTP response body from
+// the result of the "get-service-config" endpoint of the "Meeting Service"
+// service.
+func NewGetServiceConfigBadRequestResponseBody(res *meetingservice.BadRequestError) *GetServiceConfigBadRequestResponseBody {
+	body := &GetServiceConfigBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetServiceConfigForbiddenResponseBody builds the HTTP response body from
+// the result of the "get-service-config" endpoint of the "Meeting Service"
+// service.
+func NewGetServiceConfigForbiddenResponseBody(res *meetingservice.ForbiddenError) *GetServiceConfigForbiddenResponseBody {
+	body := &GetServiceConfigForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetServiceConfigServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get-service-config" endpoint of the "Meeting
+// Service" service.
+func NewGetServiceConfigServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *GetServiceConfigServiceUnavailableResponseBody {
+	body := &GetServiceConfigServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetServiceConfigUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get-service-config" endpoint of the "Meeting
+// Service" service.
+func NewGetServiceConfigUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *GetServiceConfigUnauthorizedResponseBody {
+	body := &GetServiceConfigUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetServiceHealthBadRequestResponseBody builds the HTTP response body from
+// the result of the "get-service-health" endpoint of the "Meeting Service"
+// service.
+func NewGetServiceHealthBadRequestResponseBody(res *meetingservice.BadRequestError) *GetServiceHealthBadRequestResponseBody {
+	body := &GetServiceHealthBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetServiceHealthForbiddenResponseBody builds the HTTP response body from
+// the result of the "get-service-health" endpoint of the "Meeting Service"
+// service.
+func NewGetServiceHealthForbiddenResponseBody(res *meetingservice.ForbiddenError) *GetServiceHealthForbiddenResponseBody {
+	body := &GetServiceHealthForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxPastMeetingAttachmentDownloadResponseBody builds the HTTP response
-// body from the result of the "get-itx-past-meeting-attachment-download"
-// endpoint of the "Meeting Service" service.
-func NewGetItxPastMeetingAttachmentDownloadResponseBody(res *meetingservice.ITXAttachmentDownloadResponse) *GetItxPastMeetingAttachmentDownloadResponseBody {
-	body := &GetItxPastMeetingAttachmentDownloadResponseBody{
-		DownloadURL: res.DownloadURL,
+// NewGetServiceHealthServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get-service-health" endpoint of the "Meeting
+// Service" service.
+func NewGetServiceHealthServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *GetServiceHealthServiceUnavailableResponseBody {
+	body := &GetServiceHealthServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewReadyzServiceUnavailableResponseBody builds the HTTP response body from
-// the result of the "readyz" endpoint of the "Meeting Service" service.
-func NewReadyzServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *ReadyzServiceUnavailableResponseBody {
-	body := &ReadyzServiceUnavailableResponseBody{
+// NewGetServiceHealthUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get-service-health" endpoint of the "Meeting
+// Service" service.
+func NewGetServiceHealthUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *GetServiceHealthUnauthorizedResponseBody {
+	body := &GetServiceHealthUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
@@ -4729,6 +6446,83 @@ func NewDeleteItxMeetingUnauthorizedResponseBody(res *meetingservice.Unauthorize
 	return body
 }
 
+// NewCloneItxMeetingBadRequestResponseBody builds the HTTP response body from
+// the result of the "clone-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewCloneItxMeetingBadRequestResponseBody(res *meetingservice.BadRequestError) *CloneItxMeetingBadRequestResponseBody {
+	body := &CloneItxMeetingBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneItxMeetingConflictResponseBody builds the HTTP response body from
+// the result of the "clone-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewCloneItxMeetingConflictResponseBody(res *meetingservice.ConflictError) *CloneItxMeetingConflictResponseBody {
+	body := &CloneItxMeetingConflictResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneItxMeetingForbiddenResponseBody builds the HTTP response body from
+// the result of the "clone-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewCloneItxMeetingForbiddenResponseBody(res *meetingservice.ForbiddenError) *CloneItxMeetingForbiddenResponseBody {
+	body := &CloneItxMeetingForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneItxMeetingInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "clone-itx-meeting" endpoint of the "Meeting
+// Service" service.
+func NewCloneItxMeetingInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *CloneItxMeetingInternalServerErrorResponseBody {
+	body := &CloneItxMeetingInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneItxMeetingNotFoundResponseBody builds the HTTP response body from
+// the result of the "clone-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewCloneItxMeetingNotFoundResponseBody(res *meetingservice.NotFoundError) *CloneItxMeetingNotFoundResponseBody {
+	body := &CloneItxMeetingNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneItxMeetingServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "clone-itx-meeting" endpoint of the "Meeting
+// Service" service.
+func NewCloneItxMeetingServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *CloneItxMeetingServiceUnavailableResponseBody {
+	body := &CloneItxMeetingServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneItxMeetingUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "clone-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewCloneItxMeetingUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *CloneItxMeetingUnauthorizedResponseBody {
+	body := &CloneItxMeetingUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewUpdateItxMeetingBadRequestResponseBody builds the HTTP response body from
 // the result of the "update-itx-meeting" endpoint of the "Meeting Service"
 // service.
@@ -4872,6 +6666,39 @@ func NewGetItxMeetingCountUnauthorizedResponseBody(res *meetingservice.Unauthori
 	return body
 }
 
+// NewPreviewItxMeetingOccurrencesBadRequestResponseBody builds the HTTP
+// response body from the result of the "preview-itx-meeting-occurrences"
+// endpoint of the "Meeting Service" service.
+func NewPreviewItxMeetingOccurrencesBadRequestResponseBody(res *meetingservice.BadRequestError) *PreviewItxMeetingOccurrencesBadRequestResponseBody {
+	body := &PreviewItxMeetingOccurrencesBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPreviewItxMeetingOccurrencesInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "preview-itx-meeting-occurrences"
+// endpoint of the "Meeting Service" service.
+func NewPreviewItxMeetingOccurrencesInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *PreviewItxMeetingOccurrencesInternalServerErrorResponseBody {
+	body := &PreviewItxMeetingOccurrencesInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPreviewItxMeetingOccurrencesUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "preview-itx-meeting-occurrences"
+// endpoint of the "Meeting Service" service.
+func NewPreviewItxMeetingOccurrencesUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *PreviewItxMeetingOccurrencesUnauthorizedResponseBody {
+	body := &PreviewItxMeetingOccurrencesUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewCreateItxRegistrantBadRequestResponseBody builds the HTTP response body
 // from the result of the "create-itx-registrant" endpoint of the "Meeting
 // Service" service.
@@ -5158,6 +6985,16 @@ func NewGetItxJoinLinkBadRequestResponseBody(res *meetingservice.BadRequestError
 	return body
 }
 
+// NewGetItxJoinLinkConflictResponseBody builds the HTTP response body from the
+// result of the "get-itx-join-link" endpoint of the "Meeting Service" service.
+func NewGetItxJoinLinkConflictResponseBody(res *meetingservice.ConflictError) *GetItxJoinLinkConflictResponseBody {
+	body := &GetItxJoinLinkConflictResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewGetItxJoinLinkForbiddenResponseBody builds the HTTP response body from
 // the result of the "get-itx-join-link" endpoint of the "Meeting Service"
 // service.
@@ -5212,66 +7049,275 @@ func NewGetItxJoinLinkUnauthorizedResponseBody(res *meetingservice.UnauthorizedE
 	return body
 }
 
-// NewGetItxRegistrantIcsBadRequestResponseBody builds the HTTP response body
-// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
-// Service" service.
-func NewGetItxRegistrantIcsBadRequestResponseBody(res *meetingservice.BadRequestError) *GetItxRegistrantIcsBadRequestResponseBody {
-	body := &GetItxRegistrantIcsBadRequestResponseBody{
+// NewLaunchItxMeetingBadRequestResponseBody builds the HTTP response body from
+// the result of the "launch-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewLaunchItxMeetingBadRequestResponseBody(res *meetingservice.BadRequestError) *LaunchItxMeetingBadRequestResponseBody {
+	body := &LaunchItxMeetingBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLaunchItxMeetingConflictResponseBody builds the HTTP response body from
+// the result of the "launch-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewLaunchItxMeetingConflictResponseBody(res *meetingservice.ConflictError) *LaunchItxMeetingConflictResponseBody {
+	body := &LaunchItxMeetingConflictResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLaunchItxMeetingForbiddenResponseBody builds the HTTP response body from
+// the result of the "launch-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewLaunchItxMeetingForbiddenResponseBody(res *meetingservice.ForbiddenError) *LaunchItxMeetingForbiddenResponseBody {
+	body := &LaunchItxMeetingForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLaunchItxMeetingInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "launch-itx-meeting" endpoint of the "Meeting
+// Service" service.
+func NewLaunchItxMeetingInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *LaunchItxMeetingInternalServerErrorResponseBody {
+	body := &LaunchItxMeetingInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLaunchItxMeetingNotFoundResponseBody builds the HTTP response body from
+// the result of the "launch-itx-meeting" endpoint of the "Meeting Service"
+// service.
+func NewLaunchItxMeetingNotFoundResponseBody(res *meetingservice.NotFoundError) *LaunchItxMeetingNotFoundResponseBody {
+	body := &LaunchItxMeetingNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLaunchItxMeetingServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "launch-itx-meeting" endpoint of the "Meeting
+// Service" service.
+func NewLaunchItxMeetingServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *LaunchItxMeetingServiceUnavailableResponseBody {
+	body := &LaunchItxMeetingServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLaunchItxMeetingUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "launch-itx-meeting" endpoint of the "Meeting
+// Service" service.
+func NewLaunchItxMeetingUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *LaunchItxMeetingUnauthorizedResponseBody {
+	body := &LaunchItxMeetingUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxRegistrantIcsBadRequestResponseBody builds the HTTP response body
+// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
+// Service" service.
+func NewGetItxRegistrantIcsBadRequestResponseBody(res *meetingservice.BadRequestError) *GetItxRegistrantIcsBadRequestResponseBody {
+	body := &GetItxRegistrantIcsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxRegistrantIcsForbiddenResponseBody builds the HTTP response body
+// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
+// Service" service.
+func NewGetItxRegistrantIcsForbiddenResponseBody(res *meetingservice.ForbiddenError) *GetItxRegistrantIcsForbiddenResponseBody {
+	body := &GetItxRegistrantIcsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxRegistrantIcsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-itx-registrant-ics" endpoint of
+// the "Meeting Service" service.
+func NewGetItxRegistrantIcsInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *GetItxRegistrantIcsInternalServerErrorResponseBody {
+	body := &GetItxRegistrantIcsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxRegistrantIcsNotFoundResponseBody builds the HTTP response body
+// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
+// Service" service.
+func NewGetItxRegistrantIcsNotFoundResponseBody(res *meetingservice.NotFoundError) *GetItxRegistrantIcsNotFoundResponseBody {
+	body := &GetItxRegistrantIcsNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxRegistrantIcsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-itx-registrant-ics" endpoint of
+// the "Meeting Service" service.
+func NewGetItxRegistrantIcsServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *GetItxRegistrantIcsServiceUnavailableResponseBody {
+	body := &GetItxRegistrantIcsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxRegistrantIcsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
+// Service" service.
+func NewGetItxRegistrantIcsUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *GetItxRegistrantIcsUnauthorizedResponseBody {
+	body := &GetItxRegistrantIcsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItxRegistrantHostBadRequestResponseBody builds the HTTP response body
+// from the result of the "add-itx-registrant-host" endpoint of the "Meeting
+// Service" service.
+func NewAddItxRegistrantHostBadRequestResponseBody(res *meetingservice.BadRequestError) *AddItxRegistrantHostBadRequestResponseBody {
+	body := &AddItxRegistrantHostBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItxRegistrantHostForbiddenResponseBody builds the HTTP response body
+// from the result of the "add-itx-registrant-host" endpoint of the "Meeting
+// Service" service.
+func NewAddItxRegistrantHostForbiddenResponseBody(res *meetingservice.ForbiddenError) *AddItxRegistrantHostForbiddenResponseBody {
+	body := &AddItxRegistrantHostForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItxRegistrantHostInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "add-itx-registrant-host" endpoint of
+// the "Meeting Service" service.
+func NewAddItxRegistrantHostInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *AddItxRegistrantHostInternalServerErrorResponseBody {
+	body := &AddItxRegistrantHostInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItxRegistrantHostNotFoundResponseBody builds the HTTP response body
+// from the result of the "add-itx-registrant-host" endpoint of the "Meeting
+// Service" service.
+func NewAddItxRegistrantHostNotFoundResponseBody(res *meetingservice.NotFoundError) *AddItxRegistrantHostNotFoundResponseBody {
+	body := &AddItxRegistrantHostNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItxRegistrantHostServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "add-itx-registrant-host" endpoint of
+// the "Meeting Service" service.
+func NewAddItxRegistrantHostServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *AddItxRegistrantHostServiceUnavailableResponseBody {
+	body := &AddItxRegistrantHostServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItxRegistrantHostUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "add-itx-registrant-host" endpoint of the
+// "Meeting Service" service.
+func NewAddItxRegistrantHostUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *AddItxRegistrantHostUnauthorizedResponseBody {
+	body := &AddItxRegistrantHostUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRemoveItxRegistrantHostBadRequestResponseBody builds the HTTP response
+// body from the result of the "remove-itx-registrant-host" endpoint of the
+// "Meeting Service" service.
+func NewRemoveItxRegistrantHostBadRequestResponseBody(res *meetingservice.BadRequestError) *RemoveItxRegistrantHostBadRequestResponseBody {
+	body := &RemoveItxRegistrantHostBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxRegistrantIcsForbiddenResponseBody builds the HTTP response body
-// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
-// Service" service.
-func NewGetItxRegistrantIcsForbiddenResponseBody(res *meetingservice.ForbiddenError) *GetItxRegistrantIcsForbiddenResponseBody {
-	body := &GetItxRegistrantIcsForbiddenResponseBody{
+// NewRemoveItxRegistrantHostForbiddenResponseBody builds the HTTP response
+// body from the result of the "remove-itx-registrant-host" endpoint of the
+// "Meeting Service" service.
+func NewRemoveItxRegistrantHostForbiddenResponseBody(res *meetingservice.ForbiddenError) *RemoveItxRegistrantHostForbiddenResponseBody {
+	body := &RemoveItxRegistrantHostForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxRegistrantIcsInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "get-itx-registrant-ics" endpoint of
-// the "Meeting Service" service.
-func NewGetItxRegistrantIcsInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *GetItxRegistrantIcsInternalServerErrorResponseBody {
-	body := &GetItxRegistrantIcsInternalServerErrorResponseBody{
+// NewRemoveItxRegistrantHostInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "remove-itx-registrant-host" endpoint
+// of the "Meeting Service" service.
+func NewRemoveItxRegistrantHostInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *RemoveItxRegistrantHostInternalServerErrorResponseBody {
+	body := &RemoveItxRegistrantHostInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxRegistrantIcsNotFoundResponseBody builds the HTTP response body
-// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
+// NewRemoveItxRegistrantHostNotFoundResponseBody builds the HTTP response body
+// from the result of the "remove-itx-registrant-host" endpoint of the "Meeting
 // Service" service.
-func NewGetItxRegistrantIcsNotFoundResponseBody(res *meetingservice.NotFoundError) *GetItxRegistrantIcsNotFoundResponseBody {
-	body := &GetItxRegistrantIcsNotFoundResponseBody{
+func NewRemoveItxRegistrantHostNotFoundResponseBody(res *meetingservice.NotFoundError) *RemoveItxRegistrantHostNotFoundResponseBody {
+	body := &RemoveItxRegistrantHostNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxRegistrantIcsServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "get-itx-registrant-ics" endpoint of
-// the "Meeting Service" service.
-func NewGetItxRegistrantIcsServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *GetItxRegistrantIcsServiceUnavailableResponseBody {
-	body := &GetItxRegistrantIcsServiceUnavailableResponseBody{
+// NewRemoveItxRegistrantHostServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "remove-itx-registrant-host" endpoint
+// of the "Meeting Service" service.
+func NewRemoveItxRegistrantHostServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *RemoveItxRegistrantHostServiceUnavailableResponseBody {
+	body := &RemoveItxRegistrantHostServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxRegistrantIcsUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "get-itx-registrant-ics" endpoint of the "Meeting
-// Service" service.
-func NewGetItxRegistrantIcsUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *GetItxRegistrantIcsUnauthorizedResponseBody {
-	body := &GetItxRegistrantIcsUnauthorizedResponseBody{
+// NewRemoveItxRegistrantHostUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "remove-itx-registrant-host" endpoint of the
+// "Meeting Service" service.
+func NewRemoveItxRegistrantHostUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *RemoveItxRegistrantHostUnauthorizedResponseBody {
+	body := &RemoveItxRegistrantHostUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
@@ -5476,6 +7522,72 @@ func NewRegisterItxCommitteeMembersUnauthorizedResponseBody(res *meetingservice.
 	return body
 }
 
+// NewListItxMeetingOccurrencesBadRequestResponseBody builds the HTTP response
+// body from the result of the "list-itx-meeting-occurrences" endpoint of the
+// "Meeting Service" service.
+func NewListItxMeetingOccurrencesBadRequestResponseBody(res *meetingservice.BadRequestError) *ListItxMeetingOccurrencesBadRequestResponseBody {
+	body := &ListItxMeetingOccurrencesBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListItxMeetingOccurrencesForbiddenResponseBody builds the HTTP response
+// body from the result of the "list-itx-meeting-occurrences" endpoint of the
+// "Meeting Service" service.
+func NewListItxMeetingOccurrencesForbiddenResponseBody(res *meetingservice.ForbiddenError) *ListItxMeetingOccurrencesForbiddenResponseBody {
+	body := &ListItxMeetingOccurrencesForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListItxMeetingOccurrencesInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list-itx-meeting-occurrences" endpoint
+// of the "Meeting Service" service.
+func NewListItxMeetingOccurrencesInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *ListItxMeetingOccurrencesInternalServerErrorResponseBody {
+	body := &ListItxMeetingOccurrencesInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListItxMeetingOccurrencesNotFoundResponseBody builds the HTTP response
+// body from the result of the "list-itx-meeting-occurrences" endpoint of the
+// "Meeting Service" service.
+func NewListItxMeetingOccurrencesNotFoundResponseBody(res *meetingservice.NotFoundError) *ListItxMeetingOccurrencesNotFoundResponseBody {
+	body := &ListItxMeetingOccurrencesNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListItxMeetingOccurrencesServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list-itx-meeting-occurrences" endpoint
+// of the "Meeting Service" service.
+func NewListItxMeetingOccurrencesServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *ListItxMeetingOccurrencesServiceUnavailableResponseBody {
+	body := &ListItxMeetingOccurrencesServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListItxMeetingOccurrencesUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "list-itx-meeting-occurrences" endpoint
+// of the "Meeting Service" service.
+func NewListItxMeetingOccurrencesUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *ListItxMeetingOccurrencesUnauthorizedResponseBody {
+	body := &ListItxMeetingOccurrencesUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewUpdateItxOccurrenceBadRequestResponseBody builds the HTTP response body
 // from the result of the "update-itx-occurrence" endpoint of the "Meeting
 // Service" service.
@@ -5971,110 +8083,332 @@ func NewGetItxPastMeetingSummaryForbiddenResponseBody(res *meetingservice.Forbid
 	return body
 }
 
-// NewGetItxPastMeetingSummaryInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "get-itx-past-meeting-summary" endpoint
-// of the "Meeting Service" service.
-func NewGetItxPastMeetingSummaryInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *GetItxPastMeetingSummaryInternalServerErrorResponseBody {
-	body := &GetItxPastMeetingSummaryInternalServerErrorResponseBody{
+// NewGetItxPastMeetingSummaryInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-itx-past-meeting-summary" endpoint
+// of the "Meeting Service" service.
+func NewGetItxPastMeetingSummaryInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *GetItxPastMeetingSummaryInternalServerErrorResponseBody {
+	body := &GetItxPastMeetingSummaryInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryNotFoundResponseBody builds the HTTP response
+// body from the result of the "get-itx-past-meeting-summary" endpoint of the
+// "Meeting Service" service.
+func NewGetItxPastMeetingSummaryNotFoundResponseBody(res *meetingservice.NotFoundError) *GetItxPastMeetingSummaryNotFoundResponseBody {
+	body := &GetItxPastMeetingSummaryNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-itx-past-meeting-summary" endpoint
+// of the "Meeting Service" service.
+func NewGetItxPastMeetingSummaryServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *GetItxPastMeetingSummaryServiceUnavailableResponseBody {
+	body := &GetItxPastMeetingSummaryServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "get-itx-past-meeting-summary" endpoint of the
+// "Meeting Service" service.
+func NewGetItxPastMeetingSummaryUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *GetItxPastMeetingSummaryUnauthorizedResponseBody {
+	body := &GetItxPastMeetingSummaryUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryDiffBadRequestResponseBody builds the HTTP
+// response body from the result of the "get-itx-past-meeting-summary-diff"
+// endpoint of the "Meeting Service" service.
+func NewGetItxPastMeetingSummaryDiffBadRequestResponseBody(res *meetingservice.BadRequestError) *GetItxPastMeetingSummaryDiffBadRequestResponseBody {
+	body := &GetItxPastMeetingSummaryDiffBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryDiffForbiddenResponseBody builds the HTTP
+// response body from the result of the "get-itx-past-meeting-summary-diff"
+// endpoint of the "Meeting Service" service.
+func NewGetItxPastMeetingSummaryDiffForbiddenResponseBody(res *meetingservice.ForbiddenError) *GetItxPastMeetingSummaryDiffForbiddenResponseBody {
+	body := &GetItxPastMeetingSummaryDiffForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryDiffInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the
+// "get-itx-past-meeting-summary-diff" endpoint of the "Meeting Service"
+// service.
+func NewGetItxPastMeetingSummaryDiffInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody {
+	body := &GetItxPastMeetingSummaryDiffInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryDiffNotFoundResponseBody builds the HTTP response
+// body from the result of the "get-itx-past-meeting-summary-diff" endpoint of
+// the "Meeting Service" service.
+func NewGetItxPastMeetingSummaryDiffNotFoundResponseBody(res *meetingservice.NotFoundError) *GetItxPastMeetingSummaryDiffNotFoundResponseBody {
+	body := &GetItxPastMeetingSummaryDiffNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryDiffServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the
+// "get-itx-past-meeting-summary-diff" endpoint of the "Meeting Service"
+// service.
+func NewGetItxPastMeetingSummaryDiffServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody {
+	body := &GetItxPastMeetingSummaryDiffServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItxPastMeetingSummaryDiffUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "get-itx-past-meeting-summary-diff"
+// endpoint of the "Meeting Service" service.
+func NewGetItxPastMeetingSummaryDiffUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *GetItxPastMeetingSummaryDiffUnauthorizedResponseBody {
+	body := &GetItxPastMeetingSummaryDiffUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItxPastMeetingSummaryBadRequestResponseBody builds the HTTP
+// response body from the result of the "update-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewUpdateItxPastMeetingSummaryBadRequestResponseBody(res *meetingservice.BadRequestError) *UpdateItxPastMeetingSummaryBadRequestResponseBody {
+	body := &UpdateItxPastMeetingSummaryBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItxPastMeetingSummaryForbiddenResponseBody builds the HTTP response
+// body from the result of the "update-itx-past-meeting-summary" endpoint of
+// the "Meeting Service" service.
+func NewUpdateItxPastMeetingSummaryForbiddenResponseBody(res *meetingservice.ForbiddenError) *UpdateItxPastMeetingSummaryForbiddenResponseBody {
+	body := &UpdateItxPastMeetingSummaryForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItxPastMeetingSummaryInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "update-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *UpdateItxPastMeetingSummaryInternalServerErrorResponseBody {
+	body := &UpdateItxPastMeetingSummaryInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItxPastMeetingSummaryNotFoundResponseBody builds the HTTP response
+// body from the result of the "update-itx-past-meeting-summary" endpoint of
+// the "Meeting Service" service.
+func NewUpdateItxPastMeetingSummaryNotFoundResponseBody(res *meetingservice.NotFoundError) *UpdateItxPastMeetingSummaryNotFoundResponseBody {
+	body := &UpdateItxPastMeetingSummaryNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItxPastMeetingSummaryServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *UpdateItxPastMeetingSummaryServiceUnavailableResponseBody {
+	body := &UpdateItxPastMeetingSummaryServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItxPastMeetingSummaryUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "update-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewUpdateItxPastMeetingSummaryUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *UpdateItxPastMeetingSummaryUnauthorizedResponseBody {
+	body := &UpdateItxPastMeetingSummaryUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewApproveItxPastMeetingSummaryBadRequestResponseBody builds the HTTP
+// response body from the result of the "approve-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryBadRequestResponseBody(res *meetingservice.BadRequestError) *ApproveItxPastMeetingSummaryBadRequestResponseBody {
+	body := &ApproveItxPastMeetingSummaryBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewApproveItxPastMeetingSummaryConflictResponseBody builds the HTTP response
+// body from the result of the "approve-itx-past-meeting-summary" endpoint of
+// the "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryConflictResponseBody(res *meetingservice.ConflictError) *ApproveItxPastMeetingSummaryConflictResponseBody {
+	body := &ApproveItxPastMeetingSummaryConflictResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewApproveItxPastMeetingSummaryForbiddenResponseBody builds the HTTP
+// response body from the result of the "approve-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryForbiddenResponseBody(res *meetingservice.ForbiddenError) *ApproveItxPastMeetingSummaryForbiddenResponseBody {
+	body := &ApproveItxPastMeetingSummaryForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewApproveItxPastMeetingSummaryInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "approve-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *ApproveItxPastMeetingSummaryInternalServerErrorResponseBody {
+	body := &ApproveItxPastMeetingSummaryInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxPastMeetingSummaryNotFoundResponseBody builds the HTTP response
-// body from the result of the "get-itx-past-meeting-summary" endpoint of the
-// "Meeting Service" service.
-func NewGetItxPastMeetingSummaryNotFoundResponseBody(res *meetingservice.NotFoundError) *GetItxPastMeetingSummaryNotFoundResponseBody {
-	body := &GetItxPastMeetingSummaryNotFoundResponseBody{
+// NewApproveItxPastMeetingSummaryNotFoundResponseBody builds the HTTP response
+// body from the result of the "approve-itx-past-meeting-summary" endpoint of
+// the "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryNotFoundResponseBody(res *meetingservice.NotFoundError) *ApproveItxPastMeetingSummaryNotFoundResponseBody {
+	body := &ApproveItxPastMeetingSummaryNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxPastMeetingSummaryServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "get-itx-past-meeting-summary" endpoint
-// of the "Meeting Service" service.
-func NewGetItxPastMeetingSummaryServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *GetItxPastMeetingSummaryServiceUnavailableResponseBody {
-	body := &GetItxPastMeetingSummaryServiceUnavailableResponseBody{
+// NewApproveItxPastMeetingSummaryServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the "approve-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *ApproveItxPastMeetingSummaryServiceUnavailableResponseBody {
+	body := &ApproveItxPastMeetingSummaryServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetItxPastMeetingSummaryUnauthorizedResponseBody builds the HTTP response
-// body from the result of the "get-itx-past-meeting-summary" endpoint of the
-// "Meeting Service" service.
-func NewGetItxPastMeetingSummaryUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *GetItxPastMeetingSummaryUnauthorizedResponseBody {
-	body := &GetItxPastMeetingSummaryUnauthorizedResponseBody{
+// NewApproveItxPastMeetingSummaryUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "approve-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+func NewApproveItxPastMeetingSummaryUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *ApproveItxPastMeetingSummaryUnauthorizedResponseBody {
+	body := &ApproveItxPastMeetingSummaryUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateItxPastMeetingSummaryBadRequestResponseBody builds the HTTP
-// response body from the result of the "update-itx-past-meeting-summary"
+// NewRejectItxPastMeetingSummaryBadRequestResponseBody builds the HTTP
+// response body from the result of the "reject-itx-past-meeting-summary"
 // endpoint of the "Meeting Service" service.
-func NewUpdateItxPastMeetingSummaryBadRequestResponseBody(res *meetingservice.BadRequestError) *UpdateItxPastMeetingSummaryBadRequestResponseBody {
-	body := &UpdateItxPastMeetingSummaryBadRequestResponseBody{
+func NewRejectItxPastMeetingSummaryBadRequestResponseBody(res *meetingservice.BadRequestError) *RejectItxPastMeetingSummaryBadRequestResponseBody {
+	body := &RejectItxPastMeetingSummaryBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateItxPastMeetingSummaryForbiddenResponseBody builds the HTTP response
-// body from the result of the "update-itx-past-meeting-summary" endpoint of
+// NewRejectItxPastMeetingSummaryConflictResponseBody builds the HTTP response
+// body from the result of the "reject-itx-past-meeting-summary" endpoint of
 // the "Meeting Service" service.
-func NewUpdateItxPastMeetingSummaryForbiddenResponseBody(res *meetingservice.ForbiddenError) *UpdateItxPastMeetingSummaryForbiddenResponseBody {
-	body := &UpdateItxPastMeetingSummaryForbiddenResponseBody{
+func NewRejectItxPastMeetingSummaryConflictResponseBody(res *meetingservice.ConflictError) *RejectItxPastMeetingSummaryConflictResponseBody {
+	body := &RejectItxPastMeetingSummaryConflictResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateItxPastMeetingSummaryInternalServerErrorResponseBody builds the
-// HTTP response body from the result of the "update-itx-past-meeting-summary"
+// NewRejectItxPastMeetingSummaryForbiddenResponseBody builds the HTTP response
+// body from the result of the "reject-itx-past-meeting-summary" endpoint of
+// the "Meeting Service" service.
+func NewRejectItxPastMeetingSummaryForbiddenResponseBody(res *meetingservice.ForbiddenError) *RejectItxPastMeetingSummaryForbiddenResponseBody {
+	body := &RejectItxPastMeetingSummaryForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRejectItxPastMeetingSummaryInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "reject-itx-past-meeting-summary"
 // endpoint of the "Meeting Service" service.
-func NewUpdateItxPastMeetingSummaryInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *UpdateItxPastMeetingSummaryInternalServerErrorResponseBody {
-	body := &UpdateItxPastMeetingSummaryInternalServerErrorResponseBody{
+func NewRejectItxPastMeetingSummaryInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *RejectItxPastMeetingSummaryInternalServerErrorResponseBody {
+	body := &RejectItxPastMeetingSummaryInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateItxPastMeetingSummaryNotFoundResponseBody builds the HTTP response
-// body from the result of the "update-itx-past-meeting-summary" endpoint of
+// NewRejectItxPastMeetingSummaryNotFoundResponseBody builds the HTTP response
+// body from the result of the "reject-itx-past-meeting-summary" endpoint of
 // the "Meeting Service" service.
-func NewUpdateItxPastMeetingSummaryNotFoundResponseBody(res *meetingservice.NotFoundError) *UpdateItxPastMeetingSummaryNotFoundResponseBody {
-	body := &UpdateItxPastMeetingSummaryNotFoundResponseBody{
+func NewRejectItxPastMeetingSummaryNotFoundResponseBody(res *meetingservice.NotFoundError) *RejectItxPastMeetingSummaryNotFoundResponseBody {
+	body := &RejectItxPastMeetingSummaryNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateItxPastMeetingSummaryServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "update-itx-past-meeting-summary"
+// NewRejectItxPastMeetingSummaryServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "reject-itx-past-meeting-summary"
 // endpoint of the "Meeting Service" service.
-func NewUpdateItxPastMeetingSummaryServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *UpdateItxPastMeetingSummaryServiceUnavailableResponseBody {
-	body := &UpdateItxPastMeetingSummaryServiceUnavailableResponseBody{
+func NewRejectItxPastMeetingSummaryServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *RejectItxPastMeetingSummaryServiceUnavailableResponseBody {
+	body := &RejectItxPastMeetingSummaryServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateItxPastMeetingSummaryUnauthorizedResponseBody builds the HTTP
-// response body from the result of the "update-itx-past-meeting-summary"
+// NewRejectItxPastMeetingSummaryUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "reject-itx-past-meeting-summary"
 // endpoint of the "Meeting Service" service.
-func NewUpdateItxPastMeetingSummaryUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *UpdateItxPastMeetingSummaryUnauthorizedResponseBody {
-	body := &UpdateItxPastMeetingSummaryUnauthorizedResponseBody{
+func NewRejectItxPastMeetingSummaryUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *RejectItxPastMeetingSummaryUnauthorizedResponseBody {
+	body := &RejectItxPastMeetingSummaryUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
@@ -6217,6 +8551,66 @@ func NewUpdateItxPastMeetingParticipantUnauthorizedResponseBody(res *meetingserv
 	return body
 }
 
+// NewBulkUpdateItxPastMeetingParticipantsBadRequestResponseBody builds the
+// HTTP response body from the result of the
+// "bulk-update-itx-past-meeting-participants" endpoint of the "Meeting
+// Service" service.
+func NewBulkUpdateItxPastMeetingParticipantsBadRequestResponseBody(res *meetingservice.BadRequestError) *BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody {
+	body := &BulkUpdateItxPastMeetingParticipantsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkUpdateItxPastMeetingParticipantsForbiddenResponseBody builds the HTTP
+// response body from the result of the
+// "bulk-update-itx-past-meeting-participants" endpoint of the "Meeting
+// Service" service.
+func NewBulkUpdateItxPastMeetingParticipantsForbiddenResponseBody(res *meetingservice.ForbiddenError) *BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody {
+	body := &BulkUpdateItxPastMeetingParticipantsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody
+// builds the HTTP response body from the result of the
+// "bulk-update-itx-past-meeting-participants" endpoint of the "Meeting
+// Service" service.
+func NewBulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody(res *meetingservice.InternalServerError) *BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody {
+	body := &BulkUpdateItxPastMeetingParticipantsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "bulk-update-itx-past-meeting-participants" endpoint of the "Meeting
+// Service" service.
+func NewBulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody(res *meetingservice.ServiceUnavailableError) *BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody {
+	body := &BulkUpdateItxPastMeetingParticipantsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody builds the
+// HTTP response body from the result of the
+// "bulk-update-itx-past-meeting-participants" endpoint of the "Meeting
+// Service" service.
+func NewBulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody(res *meetingservice.UnauthorizedError) *BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody {
+	body := &BulkUpdateItxPastMeetingParticipantsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewDeleteItxPastMeetingParticipantBadRequestResponseBody builds the HTTP
 // response body from the result of the "delete-itx-past-meeting-participant"
 // endpoint of the "Meeting Service" service.
@@ -7099,6 +9493,26 @@ func NewGetItxPastMeetingAttachmentDownloadUnauthorizedResponseBody(res *meeting
 	return body
 }
 
+// NewGetServiceConfigPayload builds a Meeting Service service
+// get-service-config endpoint payload.
+func NewGetServiceConfigPayload(version *string, bearerToken *string) *meetingservice.GetServiceConfigPayload {
+	v := &meetingservice.GetServiceConfigPayload{}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewGetServiceHealthPayload builds a Meeting Service service
+// get-service-health endpoint payload.
+func NewGetServiceHealthPayload(version *string, bearerToken *string) *meetingservice.GetServiceHealthPayload {
+	v := &meetingservice.GetServiceHealthPayload{}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewCreateItxMeetingPayload builds a Meeting Service service
 // create-itx-meeting endpoint payload.
 func NewCreateItxMeetingPayload(body *CreateItxMeetingRequestBody, version *string, bearerToken *string, xSync *bool) *meetingservice.CreateItxMeetingPayload {
@@ -7162,6 +9576,20 @@ func NewDeleteItxMeetingPayload(meetingID string, version *string, bearerToken *
 	return v
 }
 
+// NewCloneItxMeetingPayload builds a Meeting Service service clone-itx-meeting
+// endpoint payload.
+func NewCloneItxMeetingPayload(body *CloneItxMeetingRequestBody, meetingID string, version *string, bearerToken *string) *meetingservice.CloneItxMeetingPayload {
+	v := &meetingservice.CloneItxMeetingPayload{
+		StartTime: *body.StartTime,
+		Title:     body.Title,
+	}
+	v.MeetingID = meetingID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewUpdateItxMeetingPayload builds a Meeting Service service
 // update-itx-meeting endpoint payload.
 func NewUpdateItxMeetingPayload(body *UpdateItxMeetingRequestBody, meetingID string, version *string, bearerToken *string, xSync *bool) *meetingservice.UpdateItxMeetingPayload {
@@ -7216,6 +9644,27 @@ func NewGetItxMeetingCountPayload(version *string, projectUID string, bearerToke
 	return v
 }
 
+// NewPreviewItxMeetingOccurrencesPayload builds a Meeting Service service
+// preview-itx-meeting-occurrences endpoint payload.
+func NewPreviewItxMeetingOccurrencesPayload(body *PreviewItxMeetingOccurrencesRequestBody, version *string, bearerToken *string) *meetingservice.PreviewItxMeetingOccurrencesPayload {
+	v := &meetingservice.PreviewItxMeetingOccurrencesPayload{
+		StartTime: *body.StartTime,
+		Timezone:  *body.Timezone,
+		Duration:  *body.Duration,
+	}
+	if body.Count != nil {
+		v.Count = *body.Count
+	}
+	v.Recurrence = unmarshalRecurrenceRequestBodyToMeetingserviceRecurrence(body.Recurrence)
+	if body.Count == nil {
+		v.Count = 10
+	}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewCreateItxRegistrantPayload builds a Meeting Service service
 // create-itx-registrant endpoint payload.
 func NewCreateItxRegistrantPayload(body *CreateItxRegistrantRequestBody, meetingID string, version *string, bearerToken *string) *meetingservice.CreateItxRegistrantPayload {
@@ -7319,7 +9768,7 @@ func NewDeleteItxRegistrantPayload(meetingID string, registrantID string, versio
 
 // NewGetItxJoinLinkPayload builds a Meeting Service service get-itx-join-link
 // endpoint payload.
-func NewGetItxJoinLinkPayload(meetingID string, version *string, useEmail *bool, userID *string, name *string, email *string, register *bool, bearerToken *string) *meetingservice.GetItxJoinLinkPayload {
+func NewGetItxJoinLinkPayload(meetingID string, version *string, useEmail *bool, userID *string, name *string, email *string, register *bool, occurrenceID *string, bearerToken *string) *meetingservice.GetItxJoinLinkPayload {
 	v := &meetingservice.GetItxJoinLinkPayload{}
 	v.MeetingID = meetingID
 	v.Version = version
@@ -7328,7 +9777,27 @@ func NewGetItxJoinLinkPayload(meetingID string, version *string, useEmail *bool,
 	v.Name = name
 	v.Email = email
 	v.Register = register
+	v.OccurrenceID = occurrenceID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewLaunchItxMeetingPayload builds a Meeting Service service
+// launch-itx-meeting endpoint payload.
+func NewLaunchItxMeetingPayload(meetingID string, version *string, useEmail *bool, userID *string, name *string, email *string, register *bool, occurrenceID *string, client string, bearerToken *string, userAgent *string) *meetingservice.LaunchItxMeetingPayload {
+	v := &meetingservice.LaunchItxMeetingPayload{}
+	v.MeetingID = meetingID
+	v.Version = version
+	v.UseEmail = useEmail
+	v.UserID = userID
+	v.Name = name
+	v.Email = email
+	v.Register = register
+	v.OccurrenceID = occurrenceID
+	v.Client = client
 	v.BearerToken = bearerToken
+	v.UserAgent = userAgent
 
 	return v
 }
@@ -7345,6 +9814,30 @@ func NewGetItxRegistrantIcsPayload(meetingID string, registrantID string, versio
 	return v
 }
 
+// NewAddItxRegistrantHostPayload builds a Meeting Service service
+// add-itx-registrant-host endpoint payload.
+func NewAddItxRegistrantHostPayload(meetingID string, registrantID string, version *string, bearerToken *string) *meetingservice.AddItxRegistrantHostPayload {
+	v := &meetingservice.AddItxRegistrantHostPayload{}
+	v.MeetingID = meetingID
+	v.RegistrantID = registrantID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewRemoveItxRegistrantHostPayload builds a Meeting Service service
+// remove-itx-registrant-host endpoint payload.
+func NewRemoveItxRegistrantHostPayload(meetingID string, registrantID string, version *string, bearerToken *string) *meetingservice.RemoveItxRegistrantHostPayload {
+	v := &meetingservice.RemoveItxRegistrantHostPayload{}
+	v.MeetingID = meetingID
+	v.RegistrantID = registrantID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewResendItxRegistrantInvitationPayload builds a Meeting Service service
 // resend-itx-registrant-invitation endpoint payload.
 func NewResendItxRegistrantInvitationPayload(meetingID string, registrantID string, version *string, bearerToken *string) *meetingservice.ResendItxRegistrantInvitationPayload {
@@ -7385,6 +9878,20 @@ func NewRegisterItxCommitteeMembersPayload(meetingID string, version *string, be
 	return v
 }
 
+// NewListItxMeetingOccurrencesPayload builds a Meeting Service service
+// list-itx-meeting-occurrences endpoint payload.
+func NewListItxMeetingOccurrencesPayload(meetingID string, version *string, tz *string, from *string, to *string, bearerToken *string) *meetingservice.ListItxMeetingOccurrencesPayload {
+	v := &meetingservice.ListItxMeetingOccurrencesPayload{}
+	v.MeetingID = meetingID
+	v.Version = version
+	v.Tz = tz
+	v.From = from
+	v.To = to
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewUpdateItxOccurrencePayload builds a Meeting Service service
 // update-itx-occurrence endpoint payload.
 func NewUpdateItxOccurrencePayload(body *UpdateItxOccurrenceRequestBody, meetingID string, occurrenceID string, version *string, bearerToken *string) *meetingservice.UpdateItxOccurrencePayload {
@@ -7538,6 +10045,18 @@ func NewGetItxPastMeetingSummaryPayload(pastMeetingID string, summaryUID string,
 	return v
 }
 
+// NewGetItxPastMeetingSummaryDiffPayload builds a Meeting Service service
+// get-itx-past-meeting-summary-diff endpoint payload.
+func NewGetItxPastMeetingSummaryDiffPayload(pastMeetingID string, summaryUID string, version *string, bearerToken *string) *meetingservice.GetItxPastMeetingSummaryDiffPayload {
+	v := &meetingservice.GetItxPastMeetingSummaryDiffPayload{}
+	v.PastMeetingID = pastMeetingID
+	v.SummaryUID = summaryUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewUpdateItxPastMeetingSummaryPayload builds a Meeting Service service
 // update-itx-past-meeting-summary endpoint payload.
 func NewUpdateItxPastMeetingSummaryPayload(body *UpdateItxPastMeetingSummaryRequestBody, pastMeetingID string, summaryUID string, version *string, bearerToken *string) *meetingservice.UpdateItxPastMeetingSummaryPayload {
@@ -7553,6 +10072,30 @@ func NewUpdateItxPastMeetingSummaryPayload(body *UpdateItxPastMeetingSummaryRequ
 	return v
 }
 
+// NewApproveItxPastMeetingSummaryPayload builds a Meeting Service service
+// approve-itx-past-meeting-summary endpoint payload.
+func NewApproveItxPastMeetingSummaryPayload(pastMeetingID string, summaryUID string, version *string, bearerToken *string) *meetingservice.ApproveItxPastMeetingSummaryPayload {
+	v := &meetingservice.ApproveItxPastMeetingSummaryPayload{}
+	v.PastMeetingID = pastMeetingID
+	v.SummaryUID = summaryUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewRejectItxPastMeetingSummaryPayload builds a Meeting Service service
+// reject-itx-past-meeting-summary endpoint payload.
+func NewRejectItxPastMeetingSummaryPayload(pastMeetingID string, summaryUID string, version *string, bearerToken *string) *meetingservice.RejectItxPastMeetingSummaryPayload {
+	v := &meetingservice.RejectItxPastMeetingSummaryPayload{}
+	v.PastMeetingID = pastMeetingID
+	v.SummaryUID = summaryUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewCreateItxPastMeetingParticipantPayload builds a Meeting Service service
 // create-itx-past-meeting-participant endpoint payload.
 func NewCreateItxPastMeetingParticipantPayload(body *CreateItxPastMeetingParticipantRequestBody, pastMeetingID string, version *string, bearerToken *string) *meetingservice.CreateItxPastMeetingParticipantPayload {
@@ -7619,6 +10162,25 @@ func NewUpdateItxPastMeetingParticipantPayload(body *UpdateItxPastMeetingPartici
 	return v
 }
 
+// NewBulkUpdateItxPastMeetingParticipantsPayload builds a Meeting Service
+// service bulk-update-itx-past-meeting-participants endpoint payload.
+func NewBulkUpdateItxPastMeetingParticipantsPayload(body *BulkUpdateItxPastMeetingParticipantsRequestBody, pastMeetingID string, version *string, bearerToken *string) *meetingservice.BulkUpdateItxPastMeetingParticipantsPayload {
+	v := &meetingservice.BulkUpdateItxPastMeetingParticipantsPayload{}
+	v.Participants = make([]*meetingservice.ITXPastMeetingParticipantUpdate, len(body.Participants))
+	for i, val := range body.Participants {
+		if val == nil {
+			v.Participants[i] = nil
+			continue
+		}
+		v.Participants[i] = unmarshalITXPastMeetingParticipantUpdateRequestBodyToMeetingserviceITXPastMeetingParticipantUpdate(val)
+	}
+	v.PastMeetingID = pastMeetingID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewDeleteItxPastMeetingParticipantPayload builds a Meeting Service service
 // delete-itx-past-meeting-participant endpoint payload.
 func NewDeleteItxPastMeetingParticipantPayload(pastMeetingID string, participantID string, version *string, bearerToken *string) *meetingservice.DeleteItxPastMeetingParticipantPayload {
@@ -7886,6 +10448,18 @@ func ValidateCreateItxMeetingRequestBody(body *CreateItxMeetingRequestBody) (err
 	return
 }
 
+// ValidateCloneItxMeetingRequestBody runs the validations defined on
+// Clone-Itx-MeetingRequestBody
+func ValidateCloneItxMeetingRequestBody(body *CloneItxMeetingRequestBody) (err error) {
+	if body.StartTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start_time", "body"))
+	}
+	if body.StartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+	}
+	return
+}
+
 // ValidateUpdateItxMeetingRequestBody runs the validations defined on
 // Update-Itx-MeetingRequestBody
 func ValidateUpdateItxMeetingRequestBody(body *UpdateItxMeetingRequestBody) (err error) {
@@ -7970,6 +10544,52 @@ func ValidateUpdateItxMeetingRequestBody(body *UpdateItxMeetingRequestBody) (err
 	return
 }
 
+// ValidatePreviewItxMeetingOccurrencesRequestBody runs the validations defined
+// on Preview-Itx-Meeting-OccurrencesRequestBody
+func ValidatePreviewItxMeetingOccurrencesRequestBody(body *PreviewItxMeetingOccurrencesRequestBody) (err error) {
+	if body.StartTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start_time", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Duration == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duration", "body"))
+	}
+	if body.Recurrence == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("recurrence", "body"))
+	}
+	if body.StartTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start_time", *body.StartTime, goa.FormatDateTime))
+	}
+	if body.Duration != nil {
+		if *body.Duration < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 0, true))
+		}
+	}
+	if body.Duration != nil {
+		if *body.Duration > 600 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 600, false))
+		}
+	}
+	if body.Recurrence != nil {
+		if err2 := ValidateRecurrenceRequestBody(body.Recurrence); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Count != nil {
+		if *body.Count < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.count", *body.Count, 1, true))
+		}
+	}
+	if body.Count != nil {
+		if *body.Count > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.count", *body.Count, 100, false))
+		}
+	}
+	return
+}
+
 // ValidateCreateItxRegistrantRequestBody runs the validations defined on
 // Create-Itx-RegistrantRequestBody
 func ValidateCreateItxRegistrantRequestBody(body *CreateItxRegistrantRequestBody) (err error) {
@@ -8187,6 +10807,28 @@ func ValidateCreateItxPastMeetingParticipantRequestBody(body *CreateItxPastMeeti
 	return
 }
 
+// ValidateBulkUpdateItxPastMeetingParticipantsRequestBody runs the validations
+// defined on Bulk-Update-Itx-Past-Meeting-ParticipantsRequestBody
+func ValidateBulkUpdateItxPastMeetingParticipantsRequestBody(body *BulkUpdateItxPastMeetingParticipantsRequestBody) (err error) {
+	if body.Participants == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("participants", "body"))
+	}
+	if len(body.Participants) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.participants", body.Participants, len(body.Participants), 1, true))
+	}
+	if len(body.Participants) > 200 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.participants", body.Participants, len(body.Participants), 200, false))
+	}
+	for _, e := range body.Participants {
+		if e != nil {
+			if err2 := ValidateITXPastMeetingParticipantUpdateRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // ValidateCreateItxMeetingAttachmentRequestBody runs the validations defined
 // on Create-Itx-Meeting-AttachmentRequestBody
 func ValidateCreateItxMeetingAttachmentRequestBody(body *CreateItxMeetingAttachmentRequestBody) (err error) {
@@ -8359,6 +11001,11 @@ func ValidateRecurrenceRequestBody(body *RecurrenceRequestBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{1, 2, 3}))
 		}
 	}
+	if body.EndTimes != nil {
+		if *body.EndTimes > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.end_times", *body.EndTimes, 1000, false))
+		}
+	}
 	if body.EndDateTime != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.end_date_time", *body.EndDateTime, goa.FormatDateTime))
 	}
@@ -8387,3 +11034,12 @@ func ValidateParticipantSessionRequestBody(body *ParticipantSessionRequestBody)
 	}
 	return
 }
+
+// ValidateITXPastMeetingParticipantUpdateRequestBody runs the validations
+// defined on ITXPastMeetingParticipantUpdateRequestBody
+func ValidateITXPastMeetingParticipantUpdateRequestBody(body *ITXPastMeetingParticipantUpdateRequestBody) (err error) {
+	if body.ParticipantID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("participant_id", "body"))
+	}
+	return
+}
@@ -17,20 +17,28 @@ import (
 type Client struct {
 	ReadyzEndpoint                                goa.Endpoint
 	LivezEndpoint                                 goa.Endpoint
+	GetServiceConfigEndpoint                      goa.Endpoint
+	GetServiceHealthEndpoint                      goa.Endpoint
 	CreateItxMeetingEndpoint                      goa.Endpoint
 	GetItxMeetingEndpoint                         goa.Endpoint
 	DeleteItxMeetingEndpoint                      goa.Endpoint
+	CloneItxMeetingEndpoint                       goa.Endpoint
 	UpdateItxMeetingEndpoint                      goa.Endpoint
 	GetItxMeetingCountEndpoint                    goa.Endpoint
+	PreviewItxMeetingOccurrencesEndpoint          goa.Endpoint
 	CreateItxRegistrantEndpoint                   goa.Endpoint
 	GetItxRegistrantEndpoint                      goa.Endpoint
 	UpdateItxRegistrantEndpoint                   goa.Endpoint
 	DeleteItxRegistrantEndpoint                   goa.Endpoint
 	GetItxJoinLinkEndpoint                        goa.Endpoint
+	LaunchItxMeetingEndpoint                      goa.Endpoint
 	GetItxRegistrantIcsEndpoint                   goa.Endpoint
+	AddItxRegistrantHostEndpoint                  goa.Endpoint
+	RemoveItxRegistrantHostEndpoint               goa.Endpoint
 	ResendItxRegistrantInvitationEndpoint         goa.Endpoint
 	ResendItxMeetingInvitationsEndpoint           goa.Endpoint
 	RegisterItxCommitteeMembersEndpoint           goa.Endpoint
+	ListItxMeetingOccurrencesEndpoint             goa.Endpoint
 	UpdateItxOccurrenceEndpoint                   goa.Endpoint
 	DeleteItxOccurrenceEndpoint                   goa.Endpoint
 	SubmitItxMeetingResponseEndpoint              goa.Endpoint
@@ -39,9 +47,13 @@ type Client struct {
 	DeleteItxPastMeetingEndpoint                  goa.Endpoint
 	UpdateItxPastMeetingEndpoint                  goa.Endpoint
 	GetItxPastMeetingSummaryEndpoint              goa.Endpoint
+	GetItxPastMeetingSummaryDiffEndpoint          goa.Endpoint
 	UpdateItxPastMeetingSummaryEndpoint           goa.Endpoint
+	ApproveItxPastMeetingSummaryEndpoint          goa.Endpoint
+	RejectItxPastMeetingSummaryEndpoint           goa.Endpoint
 	CreateItxPastMeetingParticipantEndpoint       goa.Endpoint
 	UpdateItxPastMeetingParticipantEndpoint       goa.Endpoint
+	BulkUpdateItxPastMeetingParticipantsEndpoint  goa.Endpoint
 	DeleteItxPastMeetingParticipantEndpoint       goa.Endpoint
 	CreateItxMeetingAttachmentEndpoint            goa.Endpoint
 	GetItxMeetingAttachmentEndpoint               goa.Endpoint
@@ -58,24 +70,32 @@ type Client struct {
 }
 
 // NewClient initializes a "Meeting Service" service client given the endpoints.
-func NewClient(readyz, livez, createItxMeeting, getItxMeeting, deleteItxMeeting, updateItxMeeting, getItxMeetingCount, createItxRegistrant, getItxRegistrant, updateItxRegistrant, deleteItxRegistrant, getItxJoinLink, getItxRegistrantIcs, resendItxRegistrantInvitation, resendItxMeetingInvitations, registerItxCommitteeMembers, updateItxOccurrence, deleteItxOccurrence, submitItxMeetingResponse, createItxPastMeeting, getItxPastMeeting, deleteItxPastMeeting, updateItxPastMeeting, getItxPastMeetingSummary, updateItxPastMeetingSummary, createItxPastMeetingParticipant, updateItxPastMeetingParticipant, deleteItxPastMeetingParticipant, createItxMeetingAttachment, getItxMeetingAttachment, updateItxMeetingAttachment, deleteItxMeetingAttachment, createItxMeetingAttachmentPresign, getItxMeetingAttachmentDownload, createItxPastMeetingAttachment, getItxPastMeetingAttachment, updateItxPastMeetingAttachment, deleteItxPastMeetingAttachment, createItxPastMeetingAttachmentPresign, getItxPastMeetingAttachmentDownload goa.Endpoint) *Client {
+func NewClient(readyz, livez, getServiceConfig, getServiceHealth, createItxMeeting, getItxMeeting, deleteItxMeeting, cloneItxMeeting, updateItxMeeting, getItxMeetingCount, previewItxMeetingOccurrences, createItxRegistrant, getItxRegistrant, updateItxRegistrant, deleteItxRegistrant, getItxJoinLink, launchItxMeeting, getItxRegistrantIcs, addItxRegistrantHost, removeItxRegistrantHost, resendItxRegistrantInvitation, resendItxMeetingInvitations, registerItxCommitteeMembers, listItxMeetingOccurrences, updateItxOccurrence, deleteItxOccurrence, submitItxMeetingResponse, createItxPastMeeting, getItxPastMeeting, deleteItxPastMeeting, updateItxPastMeeting, getItxPastMeetingSummary, getItxPastMeetingSummaryDiff, updateItxPastMeetingSummary, approveItxPastMeetingSummary, rejectItxPastMeetingSummary, createItxPastMeetingParticipant, updateItxPastMeetingParticipant, bulkUpdateItxPastMeetingParticipants, deleteItxPastMeetingParticipant, createItxMeetingAttachment, getItxMeetingAttachment, updateItxMeetingAttachment, deleteItxMeetingAttachment, createItxMeetingAttachmentPresign, getItxMeetingAttachmentDownload, createItxPastMeetingAttachment, getItxPastMeetingAttachment, updateItxPastMeetingAttachment, deleteItxPastMeetingAttachment, createItxPastMeetingAttachmentPresign, getItxPastMeetingAttachmentDownload goa.Endpoint) *Client {
 	return &Client{
 		ReadyzEndpoint:                                readyz,
 		LivezEndpoint:                                 livez,
+		GetServiceConfigEndpoint:                      getServiceConfig,
+		GetServiceHealthEndpoint:                      getServiceHealth,
 		CreateItxMeetingEndpoint:                      createItxMeeting,
 		GetItxMeetingEndpoint:                         getItxMeeting,
 		DeleteItxMeetingEndpoint:                      deleteItxMeeting,
+		CloneItxMeetingEndpoint:                       cloneItxMeeting,
 		UpdateItxMeetingEndpoint:                      updateItxMeeting,
 		GetItxMeetingCountEndpoint:                    getItxMeetingCount,
+		PreviewItxMeetingOccurrencesEndpoint:          previewItxMeetingOccurrences,
 		CreateItxRegistrantEndpoint:                   createItxRegistrant,
 		GetItxRegistrantEndpoint:                      getItxRegistrant,
 		UpdateItxRegistrantEndpoint:                   updateItxRegistrant,
 		DeleteItxRegistrantEndpoint:                   deleteItxRegistrant,
 		GetItxJoinLinkEndpoint:                        getItxJoinLink,
+		LaunchItxMeetingEndpoint:                      launchItxMeeting,
 		GetItxRegistrantIcsEndpoint:                   getItxRegistrantIcs,
+		AddItxRegistrantHostEndpoint:                  addItxRegistrantHost,
+		RemoveItxRegistrantHostEndpoint:               removeItxRegistrantHost,
 		ResendItxRegistrantInvitationEndpoint:         resendItxRegistrantInvitation,
 		ResendItxMeetingInvitationsEndpoint:           resendItxMeetingInvitations,
 		RegisterItxCommitteeMembersEndpoint:           registerItxCommitteeMembers,
+		ListItxMeetingOccurrencesEndpoint:             listItxMeetingOccurrences,
 		UpdateItxOccurrenceEndpoint:                   updateItxOccurrence,
 		DeleteItxOccurrenceEndpoint:                   deleteItxOccurrence,
 		SubmitItxMeetingResponseEndpoint:              submitItxMeetingResponse,
@@ -84,9 +104,13 @@ func NewClient(readyz, livez, createItxMeeting, getItxMeeting, deleteItxMeeting,
 		DeleteItxPastMeetingEndpoint:                  deleteItxPastMeeting,
 		UpdateItxPastMeetingEndpoint:                  updateItxPastMeeting,
 		GetItxPastMeetingSummaryEndpoint:              getItxPastMeetingSummary,
+		GetItxPastMeetingSummaryDiffEndpoint:          getItxPastMeetingSummaryDiff,
 		UpdateItxPastMeetingSummaryEndpoint:           updateItxPastMeetingSummary,
+		ApproveItxPastMeetingSummaryEndpoint:          approveItxPastMeetingSummary,
+		RejectItxPastMeetingSummaryEndpoint:           rejectItxPastMeetingSummary,
 		CreateItxPastMeetingParticipantEndpoint:       createItxPastMeetingParticipant,
 		UpdateItxPastMeetingParticipantEndpoint:       updateItxPastMeetingParticipant,
+		BulkUpdateItxPastMeetingParticipantsEndpoint:  bulkUpdateItxPastMeetingParticipants,
 		DeleteItxPastMeetingParticipantEndpoint:       deleteItxPastMeetingParticipant,
 		CreateItxMeetingAttachmentEndpoint:            createItxMeetingAttachment,
 		GetItxMeetingAttachmentEndpoint:               getItxMeetingAttachment,
@@ -126,6 +150,40 @@ func (c *Client) Livez(ctx context.Context) (res []byte, err error) {
 	return ires.([]byte), nil
 }
 
+// GetServiceConfig calls the "get-service-config" endpoint of the "Meeting
+// Service" service.
+// GetServiceConfig may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetServiceConfig(ctx context.Context, p *GetServiceConfigPayload) (res *ServiceConfig, err error) {
+	var ires any
+	ires, err = c.GetServiceConfigEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ServiceConfig), nil
+}
+
+// GetServiceHealth calls the "get-service-health" endpoint of the "Meeting
+// Service" service.
+// GetServiceHealth may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetServiceHealth(ctx context.Context, p *GetServiceHealthPayload) (res *ServiceHealth, err error) {
+	var ires any
+	ires, err = c.GetServiceHealthEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ServiceHealth), nil
+}
+
 // CreateItxMeeting calls the "create-itx-meeting" endpoint of the "Meeting
 // Service" service.
 // CreateItxMeeting may return the following errors:
@@ -179,6 +237,26 @@ func (c *Client) DeleteItxMeeting(ctx context.Context, p *DeleteItxMeetingPayloa
 	return
 }
 
+// CloneItxMeeting calls the "clone-itx-meeting" endpoint of the "Meeting
+// Service" service.
+// CloneItxMeeting may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Meeting not found
+//   - "Conflict" (type *ConflictError): Conflict with existing meeting
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CloneItxMeeting(ctx context.Context, p *CloneItxMeetingPayload) (res *ITXZoomMeetingResponse, err error) {
+	var ires any
+	ires, err = c.CloneItxMeetingEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ITXZoomMeetingResponse), nil
+}
+
 // UpdateItxMeeting calls the "update-itx-meeting" endpoint of the "Meeting
 // Service" service.
 // UpdateItxMeeting may return the following errors:
@@ -214,6 +292,22 @@ func (c *Client) GetItxMeetingCount(ctx context.Context, p *GetItxMeetingCountPa
 	return ires.(*ITXMeetingCountResponse), nil
 }
 
+// PreviewItxMeetingOccurrences calls the "preview-itx-meeting-occurrences"
+// endpoint of the "Meeting Service" service.
+// PreviewItxMeetingOccurrences may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Invalid recurrence definition
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - error: internal error
+func (c *Client) PreviewItxMeetingOccurrences(ctx context.Context, p *PreviewItxMeetingOccurrencesPayload) (res *ITXOccurrencePreviewResponse, err error) {
+	var ires any
+	ires, err = c.PreviewItxMeetingOccurrencesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ITXOccurrencePreviewResponse), nil
+}
+
 // CreateItxRegistrant calls the "create-itx-registrant" endpoint of the
 // "Meeting Service" service.
 // CreateItxRegistrant may return the following errors:
@@ -289,7 +383,8 @@ func (c *Client) DeleteItxRegistrant(ctx context.Context, p *DeleteItxRegistrant
 //   - "BadRequest" (type *BadRequestError): Bad request
 //   - "Unauthorized" (type *UnauthorizedError): Unauthorized
 //   - "Forbidden" (type *ForbiddenError): Forbidden
-//   - "NotFound" (type *NotFoundError): Meeting not found
+//   - "NotFound" (type *NotFoundError): Meeting or occurrence not found
+//   - "Conflict" (type *ConflictError): Occurrence is cancelled
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -302,6 +397,26 @@ func (c *Client) GetItxJoinLink(ctx context.Context, p *GetItxJoinLinkPayload) (
 	return ires.(*ITXZoomMeetingJoinLink), nil
 }
 
+// LaunchItxMeeting calls the "launch-itx-meeting" endpoint of the "Meeting
+// Service" service.
+// LaunchItxMeeting may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Meeting or occurrence not found
+//   - "Conflict" (type *ConflictError): Occurrence is cancelled
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) LaunchItxMeeting(ctx context.Context, p *LaunchItxMeetingPayload) (res *LaunchItxMeetingResult, err error) {
+	var ires any
+	ires, err = c.LaunchItxMeetingEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*LaunchItxMeetingResult), nil
+}
+
 // GetItxRegistrantIcs calls the "get-itx-registrant-ics" endpoint of the
 // "Meeting Service" service.
 // GetItxRegistrantIcs may return the following errors:
@@ -321,6 +436,44 @@ func (c *Client) GetItxRegistrantIcs(ctx context.Context, p *GetItxRegistrantIcs
 	return ires.([]byte), nil
 }
 
+// AddItxRegistrantHost calls the "add-itx-registrant-host" endpoint of the
+// "Meeting Service" service.
+// AddItxRegistrantHost may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Registrant not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) AddItxRegistrantHost(ctx context.Context, p *AddItxRegistrantHostPayload) (res *ITXZoomMeetingRegistrant, err error) {
+	var ires any
+	ires, err = c.AddItxRegistrantHostEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ITXZoomMeetingRegistrant), nil
+}
+
+// RemoveItxRegistrantHost calls the "remove-itx-registrant-host" endpoint of
+// the "Meeting Service" service.
+// RemoveItxRegistrantHost may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Registrant not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RemoveItxRegistrantHost(ctx context.Context, p *RemoveItxRegistrantHostPayload) (res *ITXZoomMeetingRegistrant, err error) {
+	var ires any
+	ires, err = c.RemoveItxRegistrantHostEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ITXZoomMeetingRegistrant), nil
+}
+
 // ResendItxRegistrantInvitation calls the "resend-itx-registrant-invitation"
 // endpoint of the "Meeting Service" service.
 // ResendItxRegistrantInvitation may return the following errors:
@@ -366,6 +519,25 @@ func (c *Client) RegisterItxCommitteeMembers(ctx context.Context, p *RegisterItx
 	return
 }
 
+// ListItxMeetingOccurrences calls the "list-itx-meeting-occurrences" endpoint
+// of the "Meeting Service" service.
+// ListItxMeetingOccurrences may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Meeting not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListItxMeetingOccurrences(ctx context.Context, p *ListItxMeetingOccurrencesPayload) (res *ITXOccurrenceListResponse, err error) {
+	var ires any
+	ires, err = c.ListItxMeetingOccurrencesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ITXOccurrenceListResponse), nil
+}
+
 // UpdateItxOccurrence calls the "update-itx-occurrence" endpoint of the
 // "Meeting Service" service.
 // UpdateItxOccurrence may return the following errors:
@@ -503,6 +675,25 @@ func (c *Client) GetItxPastMeetingSummary(ctx context.Context, p *GetItxPastMeet
 	return ires.(*PastMeetingSummary), nil
 }
 
+// GetItxPastMeetingSummaryDiff calls the "get-itx-past-meeting-summary-diff"
+// endpoint of the "Meeting Service" service.
+// GetItxPastMeetingSummaryDiff may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Summary not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetItxPastMeetingSummaryDiff(ctx context.Context, p *GetItxPastMeetingSummaryDiffPayload) (res *PastMeetingSummaryDiff, err error) {
+	var ires any
+	ires, err = c.GetItxPastMeetingSummaryDiffEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PastMeetingSummaryDiff), nil
+}
+
 // UpdateItxPastMeetingSummary calls the "update-itx-past-meeting-summary"
 // endpoint of the "Meeting Service" service.
 // UpdateItxPastMeetingSummary may return the following errors:
@@ -522,6 +713,46 @@ func (c *Client) UpdateItxPastMeetingSummary(ctx context.Context, p *UpdateItxPa
 	return ires.(*PastMeetingSummary), nil
 }
 
+// ApproveItxPastMeetingSummary calls the "approve-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+// ApproveItxPastMeetingSummary may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Summary not found
+//   - "Conflict" (type *ConflictError): Summary does not require approval
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ApproveItxPastMeetingSummary(ctx context.Context, p *ApproveItxPastMeetingSummaryPayload) (res *PastMeetingSummary, err error) {
+	var ires any
+	ires, err = c.ApproveItxPastMeetingSummaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PastMeetingSummary), nil
+}
+
+// RejectItxPastMeetingSummary calls the "reject-itx-past-meeting-summary"
+// endpoint of the "Meeting Service" service.
+// RejectItxPastMeetingSummary may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Summary not found
+//   - "Conflict" (type *ConflictError): Summary does not require approval
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RejectItxPastMeetingSummary(ctx context.Context, p *RejectItxPastMeetingSummaryPayload) (res *PastMeetingSummary, err error) {
+	var ires any
+	ires, err = c.RejectItxPastMeetingSummaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PastMeetingSummary), nil
+}
+
 // CreateItxPastMeetingParticipant calls the
 // "create-itx-past-meeting-participant" endpoint of the "Meeting Service"
 // service.
@@ -562,6 +793,25 @@ func (c *Client) UpdateItxPastMeetingParticipant(ctx context.Context, p *UpdateI
 	return ires.(*ITXPastMeetingParticipant), nil
 }
 
+// BulkUpdateItxPastMeetingParticipants calls the
+// "bulk-update-itx-past-meeting-participants" endpoint of the "Meeting
+// Service" service.
+// BulkUpdateItxPastMeetingParticipants may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Invalid request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) BulkUpdateItxPastMeetingParticipants(ctx context.Context, p *BulkUpdateItxPastMeetingParticipantsPayload) (res *ITXPastMeetingParticipantBulkUpdateResponse, err error) {
+	var ires any
+	ires, err = c.BulkUpdateItxPastMeetingParticipantsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ITXPastMeetingParticipantBulkUpdateResponse), nil
+}
+
 // DeleteItxPastMeetingParticipant calls the
 // "delete-itx-past-meeting-participant" endpoint of the "Meeting Service"
 // service.
@@ -18,20 +18,28 @@ import (
 type Endpoints struct {
 	Readyz                                goa.Endpoint
 	Livez                                 goa.Endpoint
+	GetServiceConfig                      goa.Endpoint
+	GetServiceHealth                      goa.Endpoint
 	CreateItxMeeting                      goa.Endpoint
 	GetItxMeeting                         goa.Endpoint
 	DeleteItxMeeting                      goa.Endpoint
+	CloneItxMeeting                       goa.Endpoint
 	UpdateItxMeeting                      goa.Endpoint
 	GetItxMeetingCount                    goa.Endpoint
+	PreviewItxMeetingOccurrences          goa.Endpoint
 	CreateItxRegistrant                   goa.Endpoint
 	GetItxRegistrant                      goa.Endpoint
 	UpdateItxRegistrant                   goa.Endpoint
 	DeleteItxRegistrant                   goa.Endpoint
 	GetItxJoinLink                        goa.Endpoint
+	LaunchItxMeeting                      goa.Endpoint
 	GetItxRegistrantIcs                   goa.Endpoint
+	AddItxRegistrantHost                  goa.Endpoint
+	RemoveItxRegistrantHost               goa.Endpoint
 	ResendItxRegistrantInvitation         goa.Endpoint
 	ResendItxMeetingInvitations           goa.Endpoint
 	RegisterItxCommitteeMembers           goa.Endpoint
+	ListItxMeetingOccurrences             goa.Endpoint
 	UpdateItxOccurrence                   goa.Endpoint
 	DeleteItxOccurrence                   goa.Endpoint
 	SubmitItxMeetingResponse              goa.Endpoint
@@ -40,9 +48,13 @@ type Endpoints struct {
 	DeleteItxPastMeeting                  goa.Endpoint
 	UpdateItxPastMeeting                  goa.Endpoint
 	GetItxPastMeetingSummary              goa.Endpoint
+	GetItxPastMeetingSummaryDiff          goa.Endpoint
 	UpdateItxPastMeetingSummary           goa.Endpoint
+	ApproveItxPastMeetingSummary          goa.Endpoint
+	RejectItxPastMeetingSummary           goa.Endpoint
 	CreateItxPastMeetingParticipant       goa.Endpoint
 	UpdateItxPastMeetingParticipant       goa.Endpoint
+	BulkUpdateItxPastMeetingParticipants  goa.Endpoint
 	DeleteItxPastMeetingParticipant       goa.Endpoint
 	CreateItxMeetingAttachment            goa.Endpoint
 	GetItxMeetingAttachment               goa.Endpoint
@@ -66,20 +78,28 @@ func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		Readyz:                                NewReadyzEndpoint(s),
 		Livez:                                 NewLivezEndpoint(s),
+		GetServiceConfig:                      NewGetServiceConfigEndpoint(s, a.JWTAuth),
+		GetServiceHealth:                      NewGetServiceHealthEndpoint(s, a.JWTAuth),
 		CreateItxMeeting:                      NewCreateItxMeetingEndpoint(s, a.JWTAuth),
 		GetItxMeeting:                         NewGetItxMeetingEndpoint(s, a.JWTAuth),
 		DeleteItxMeeting:                      NewDeleteItxMeetingEndpoint(s, a.JWTAuth),
+		CloneItxMeeting:                       NewCloneItxMeetingEndpoint(s, a.JWTAuth),
 		UpdateItxMeeting:                      NewUpdateItxMeetingEndpoint(s, a.JWTAuth),
 		GetItxMeetingCount:                    NewGetItxMeetingCountEndpoint(s, a.JWTAuth),
+		PreviewItxMeetingOccurrences:          NewPreviewItxMeetingOccurrencesEndpoint(s, a.JWTAuth),
 		CreateItxRegistrant:                   NewCreateItxRegistrantEndpoint(s, a.JWTAuth),
 		GetItxRegistrant:                      NewGetItxRegistrantEndpoint(s, a.JWTAuth),
 		UpdateItxRegistrant:                   NewUpdateItxRegistrantEndpoint(s, a.JWTAuth),
 		DeleteItxRegistrant:                   NewDeleteItxRegistrantEndpoint(s, a.JWTAuth),
 		GetItxJoinLink:                        NewGetItxJoinLinkEndpoint(s, a.JWTAuth),
+		LaunchItxMeeting:                      NewLaunchItxMeetingEndpoint(s, a.JWTAuth),
 		GetItxRegistrantIcs:                   NewGetItxRegistrantIcsEndpoint(s, a.JWTAuth),
+		AddItxRegistrantHost:                  NewAddItxRegistrantHostEndpoint(s, a.JWTAuth),
+		RemoveItxRegistrantHost:               NewRemoveItxRegistrantHostEndpoint(s, a.JWTAuth),
 		ResendItxRegistrantInvitation:         NewResendItxRegistrantInvitationEndpoint(s, a.JWTAuth),
 		ResendItxMeetingInvitations:           NewResendItxMeetingInvitationsEndpoint(s, a.JWTAuth),
 		RegisterItxCommitteeMembers:           NewRegisterItxCommitteeMembersEndpoint(s, a.JWTAuth),
+		ListItxMeetingOccurrences:             NewListItxMeetingOccurrencesEndpoint(s, a.JWTAuth),
 		UpdateItxOccurrence:                   NewUpdateItxOccurrenceEndpoint(s, a.JWTAuth),
 		DeleteItxOccurrence:                   NewDeleteItxOccurrenceEndpoint(s, a.JWTAuth),
 		SubmitItxMeetingResponse:              NewSubmitItxMeetingResponseEndpoint(s, a.JWTAuth),
@@ -88,9 +108,13 @@ func NewEndpoints(s Service) *Endpoints {
 		DeleteItxPastMeeting:                  NewDeleteItxPastMeetingEndpoint(s, a.JWTAuth),
 		UpdateItxPastMeeting:                  NewUpdateItxPastMeetingEndpoint(s, a.JWTAuth),
 		GetItxPastMeetingSummary:              NewGetItxPastMeetingSummaryEndpoint(s, a.JWTAuth),
+		GetItxPastMeetingSummaryDiff:          NewGetItxPastMeetingSummaryDiffEndpoint(s, a.JWTAuth),
 		UpdateItxPastMeetingSummary:           NewUpdateItxPastMeetingSummaryEndpoint(s, a.JWTAuth),
+		ApproveItxPastMeetingSummary:          NewApproveItxPastMeetingSummaryEndpoint(s, a.JWTAuth),
+		RejectItxPastMeetingSummary:           NewRejectItxPastMeetingSummaryEndpoint(s, a.JWTAuth),
 		CreateItxPastMeetingParticipant:       NewCreateItxPastMeetingParticipantEndpoint(s, a.JWTAuth),
 		UpdateItxPastMeetingParticipant:       NewUpdateItxPastMeetingParticipantEndpoint(s, a.JWTAuth),
+		BulkUpdateItxPastMeetingParticipants:  NewBulkUpdateItxPastMeetingParticipantsEndpoint(s, a.JWTAuth),
 		DeleteItxPastMeetingParticipant:       NewDeleteItxPastMeetingParticipantEndpoint(s, a.JWTAuth),
 		CreateItxMeetingAttachment:            NewCreateItxMeetingAttachmentEndpoint(s, a.JWTAuth),
 		GetItxMeetingAttachment:               NewGetItxMeetingAttachmentEndpoint(s, a.JWTAuth),
@@ -112,20 +136,28 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Readyz = m(e.Readyz)
 	e.Livez = m(e.Livez)
+	e.GetServiceConfig = m(e.GetServiceConfig)
+	e.GetServiceHealth = m(e.GetServiceHealth)
 	e.CreateItxMeeting = m(e.CreateItxMeeting)
 	e.GetItxMeeting = m(e.GetItxMeeting)
 	e.DeleteItxMeeting = m(e.DeleteItxMeeting)
+	e.CloneItxMeeting = m(e.CloneItxMeeting)
 	e.UpdateItxMeeting = m(e.UpdateItxMeeting)
 	e.GetItxMeetingCount = m(e.GetItxMeetingCount)
+	e.PreviewItxMeetingOccurrences = m(e.PreviewItxMeetingOccurrences)
 	e.CreateItxRegistrant = m(e.CreateItxRegistrant)
 	e.GetItxRegistrant = m(e.GetItxRegistrant)
 	e.UpdateItxRegistrant = m(e.UpdateItxRegistrant)
 	e.DeleteItxRegistrant = m(e.DeleteItxRegistrant)
 	e.GetItxJoinLink = m(e.GetItxJoinLink)
+	e.LaunchItxMeeting = m(e.LaunchItxMeeting)
 	e.GetItxRegistrantIcs = m(e.GetItxRegistrantIcs)
+	e.AddItxRegistrantHost = m(e.AddItxRegistrantHost)
+	e.RemoveItxRegistrantHost = m(e.RemoveItxRegistrantHost)
 	e.ResendItxRegistrantInvitation = m(e.ResendItxRegistrantInvitation)
 	e.ResendItxMeetingInvitations = m(e.ResendItxMeetingInvitations)
 	e.RegisterItxCommitteeMembers = m(e.RegisterItxCommitteeMembers)
+	e.ListItxMeetingOccurrences = m(e.ListItxMeetingOccurrences)
 	e.UpdateItxOccurrence = m(e.UpdateItxOccurrence)
 	e.DeleteItxOccurrence = m(e.DeleteItxOccurrence)
 	e.SubmitItxMeetingResponse = m(e.SubmitItxMeetingResponse)
@@ -134,9 +166,13 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.DeleteItxPastMeeting = m(e.DeleteItxPastMeeting)
 	e.UpdateItxPastMeeting = m(e.UpdateItxPastMeeting)
 	e.GetItxPastMeetingSummary = m(e.GetItxPastMeetingSummary)
+	e.GetItxPastMeetingSummaryDiff = m(e.GetItxPastMeetingSummaryDiff)
 	e.UpdateItxPastMeetingSummary = m(e.UpdateItxPastMeetingSummary)
+	e.ApproveItxPastMeetingSummary = m(e.ApproveItxPastMeetingSummary)
+	e.RejectItxPastMeetingSummary = m(e.RejectItxPastMeetingSummary)
 	e.CreateItxPastMeetingParticipant = m(e.CreateItxPastMeetingParticipant)
 	e.UpdateItxPastMeetingParticipant = m(e.UpdateItxPastMeetingParticipant)
+	e.BulkUpdateItxPastMeetingParticipants = m(e.BulkUpdateItxPastMeetingParticipants)
 	e.DeleteItxPastMeetingParticipant = m(e.DeleteItxPastMeetingParticipant)
 	e.CreateItxMeetingAttachment = m(e.CreateItxMeetingAttachment)
 	e.GetItxMeetingAttachment = m(e.GetItxMeetingAttachment)
@@ -168,6 +204,52 @@ func NewLivezEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetServiceConfigEndpoint returns an endpoint function that calls the
+// method "get-service-config" of service "Meeting Service".
+func NewGetServiceConfigEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetServiceConfigPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetServiceConfig(ctx, p)
+	}
+}
+
+// NewGetServiceHealthEndpoint returns an endpoint function that calls the
+// method "get-service-health" of service "Meeting Service".
+func NewGetServiceHealthEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetServiceHealthPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetServiceHealth(ctx, p)
+	}
+}
+
 // NewCreateItxMeetingEndpoint returns an endpoint function that calls the
 // method "create-itx-meeting" of service "Meeting Service".
 func NewCreateItxMeetingEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -237,6 +319,29 @@ func NewDeleteItxMeetingEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.
 	}
 }
 
+// NewCloneItxMeetingEndpoint returns an endpoint function that calls the
+// method "clone-itx-meeting" of service "Meeting Service".
+func NewCloneItxMeetingEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CloneItxMeetingPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CloneItxMeeting(ctx, p)
+	}
+}
+
 // NewUpdateItxMeetingEndpoint returns an endpoint function that calls the
 // method "update-itx-meeting" of service "Meeting Service".
 func NewUpdateItxMeetingEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -283,6 +388,30 @@ func NewGetItxMeetingCountEndpoint(s Service, authJWTFn security.AuthJWTFunc) go
 	}
 }
 
+// NewPreviewItxMeetingOccurrencesEndpoint returns an endpoint function that
+// calls the method "preview-itx-meeting-occurrences" of service "Meeting
+// Service".
+func NewPreviewItxMeetingOccurrencesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PreviewItxMeetingOccurrencesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.PreviewItxMeetingOccurrences(ctx, p)
+	}
+}
+
 // NewCreateItxRegistrantEndpoint returns an endpoint function that calls the
 // method "create-itx-registrant" of service "Meeting Service".
 func NewCreateItxRegistrantEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -398,6 +527,29 @@ func NewGetItxJoinLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.En
 	}
 }
 
+// NewLaunchItxMeetingEndpoint returns an endpoint function that calls the
+// method "launch-itx-meeting" of service "Meeting Service".
+func NewLaunchItxMeetingEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*LaunchItxMeetingPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.LaunchItxMeeting(ctx, p)
+	}
+}
+
 // NewGetItxRegistrantIcsEndpoint returns an endpoint function that calls the
 // method "get-itx-registrant-ics" of service "Meeting Service".
 func NewGetItxRegistrantIcsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -421,6 +573,52 @@ func NewGetItxRegistrantIcsEndpoint(s Service, authJWTFn security.AuthJWTFunc) g
 	}
 }
 
+// NewAddItxRegistrantHostEndpoint returns an endpoint function that calls the
+// method "add-itx-registrant-host" of service "Meeting Service".
+func NewAddItxRegistrantHostEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddItxRegistrantHostPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.AddItxRegistrantHost(ctx, p)
+	}
+}
+
+// NewRemoveItxRegistrantHostEndpoint returns an endpoint function that calls
+// the method "remove-itx-registrant-host" of service "Meeting Service".
+func NewRemoveItxRegistrantHostEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RemoveItxRegistrantHostPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RemoveItxRegistrantHost(ctx, p)
+	}
+}
+
 // NewResendItxRegistrantInvitationEndpoint returns an endpoint function that
 // calls the method "resend-itx-registrant-invitation" of service "Meeting
 // Service".
@@ -493,6 +691,29 @@ func NewRegisterItxCommitteeMembersEndpoint(s Service, authJWTFn security.AuthJW
 	}
 }
 
+// NewListItxMeetingOccurrencesEndpoint returns an endpoint function that calls
+// the method "list-itx-meeting-occurrences" of service "Meeting Service".
+func NewListItxMeetingOccurrencesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListItxMeetingOccurrencesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListItxMeetingOccurrences(ctx, p)
+	}
+}
+
 // NewUpdateItxOccurrenceEndpoint returns an endpoint function that calls the
 // method "update-itx-occurrence" of service "Meeting Service".
 func NewUpdateItxOccurrenceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -677,6 +898,30 @@ func NewGetItxPastMeetingSummaryEndpoint(s Service, authJWTFn security.AuthJWTFu
 	}
 }
 
+// NewGetItxPastMeetingSummaryDiffEndpoint returns an endpoint function that
+// calls the method "get-itx-past-meeting-summary-diff" of service "Meeting
+// Service".
+func NewGetItxPastMeetingSummaryDiffEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetItxPastMeetingSummaryDiffPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetItxPastMeetingSummaryDiff(ctx, p)
+	}
+}
+
 // NewUpdateItxPastMeetingSummaryEndpoint returns an endpoint function that
 // calls the method "update-itx-past-meeting-summary" of service "Meeting
 // Service".
@@ -701,6 +946,54 @@ func NewUpdateItxPastMeetingSummaryEndpoint(s Service, authJWTFn security.AuthJW
 	}
 }
 
+// NewApproveItxPastMeetingSummaryEndpoint returns an endpoint function that
+// calls the method "approve-itx-past-meeting-summary" of service "Meeting
+// Service".
+func NewApproveItxPastMeetingSummaryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ApproveItxPastMeetingSummaryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ApproveItxPastMeetingSummary(ctx, p)
+	}
+}
+
+// NewRejectItxPastMeetingSummaryEndpoint returns an endpoint function that
+// calls the method "reject-itx-past-meeting-summary" of service "Meeting
+// Service".
+func NewRejectItxPastMeetingSummaryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RejectItxPastMeetingSummaryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RejectItxPastMeetingSummary(ctx, p)
+	}
+}
+
 // NewCreateItxPastMeetingParticipantEndpoint returns an endpoint function that
 // calls the method "create-itx-past-meeting-participant" of service "Meeting
 // Service".
@@ -749,6 +1042,30 @@ func NewUpdateItxPastMeetingParticipantEndpoint(s Service, authJWTFn security.Au
 	}
 }
 
+// NewBulkUpdateItxPastMeetingParticipantsEndpoint returns an endpoint function
+// that calls the method "bulk-update-itx-past-meeting-participants" of service
+// "Meeting Service".
+func NewBulkUpdateItxPastMeetingParticipantsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BulkUpdateItxPastMeetingParticipantsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.BulkUpdateItxPastMeetingParticipants(ctx, p)
+	}
+}
+
 // NewDeleteItxPastMeetingParticipantEndpoint returns an endpoint function that
 // calls the method "delete-itx-past-meeting-participant" of service "Meeting
 // Service".
@@ -20,16 +20,28 @@ type Service interface {
 	Readyz(context.Context) (res []byte, err error)
 	// Check if the service is alive.
 	Livez(context.Context) (res []byte, err error)
+	// Get the sanitized effective service configuration for diagnostics
+	GetServiceConfig(context.Context, *GetServiceConfigPayload) (res *ServiceConfig, err error)
+	// Check each dependency of the service (ITX auth, NATS connections, KV
+	// buckets) and report its status and latency for diagnostics
+	GetServiceHealth(context.Context, *GetServiceHealthPayload) (res *ServiceHealth, err error)
 	// Create a Zoom meeting through ITX API proxy
 	CreateItxMeeting(context.Context, *CreateItxMeetingPayload) (res *ITXZoomMeetingResponse, err error)
 	// Get a Zoom meeting through ITX API proxy
 	GetItxMeeting(context.Context, *GetItxMeetingPayload) (res *ITXZoomMeetingResponse, err error)
 	// Delete a Zoom meeting through ITX API proxy
 	DeleteItxMeeting(context.Context, *DeleteItxMeetingPayload) (err error)
+	// Create a new Zoom meeting with the settings of an existing meeting at a new
+	// start time through ITX API proxy. Registrants and attachments are not copied.
+	CloneItxMeeting(context.Context, *CloneItxMeetingPayload) (res *ITXZoomMeetingResponse, err error)
 	// Update a Zoom meeting through ITX API proxy
 	UpdateItxMeeting(context.Context, *UpdateItxMeetingPayload) (err error)
 	// Get the count of Zoom meetings for a project through ITX API proxy
 	GetItxMeetingCount(context.Context, *GetItxMeetingCountPayload) (res *ITXMeetingCountResponse, err error)
+	// Preview the occurrences a recurrence definition will generate, without
+	// creating a meeting. Occurrences are computed with the same algorithm used to
+	// index ITX meetings.
+	PreviewItxMeetingOccurrences(context.Context, *PreviewItxMeetingOccurrencesPayload) (res *ITXOccurrencePreviewResponse, err error)
 	// Create a meeting registrant through ITX API proxy
 	CreateItxRegistrant(context.Context, *CreateItxRegistrantPayload) (res *ITXZoomMeetingRegistrant, err error)
 	// Get a meeting registrant through ITX API proxy
@@ -40,14 +52,25 @@ type Service interface {
 	DeleteItxRegistrant(context.Context, *DeleteItxRegistrantPayload) (err error)
 	// Get join link for a meeting through ITX API proxy
 	GetItxJoinLink(context.Context, *GetItxJoinLinkPayload) (res *ITXZoomMeetingJoinLink, err error)
+	// Redirect to a meeting's join link through ITX API proxy, as a zoommtg://
+	// deep link for desktop clients or the web link otherwise
+	LaunchItxMeeting(context.Context, *LaunchItxMeetingPayload) (res *LaunchItxMeetingResult, err error)
 	// Get ICS calendar file for a meeting registrant through ITX API proxy
 	GetItxRegistrantIcs(context.Context, *GetItxRegistrantIcsPayload) (res []byte, err error)
+	// Grant a registrant host access (the meeting host key) through ITX API proxy.
+	// Idempotent.
+	AddItxRegistrantHost(context.Context, *AddItxRegistrantHostPayload) (res *ITXZoomMeetingRegistrant, err error)
+	// Revoke a registrant's host access through ITX API proxy. Idempotent.
+	RemoveItxRegistrantHost(context.Context, *RemoveItxRegistrantHostPayload) (res *ITXZoomMeetingRegistrant, err error)
 	// Resend meeting invitation to a registrant through ITX API proxy
 	ResendItxRegistrantInvitation(context.Context, *ResendItxRegistrantInvitationPayload) (err error)
 	// Resend meeting invitations to all registrants through ITX API proxy
 	ResendItxMeetingInvitations(context.Context, *ResendItxMeetingInvitationsPayload) (err error)
 	// Register committee members to a meeting asynchronously through ITX API proxy
 	RegisterItxCommitteeMembers(context.Context, *RegisterItxCommitteeMembersPayload) (err error)
+	// List a meeting's occurrences through ITX API proxy, with start and end times
+	// converted to a requested timezone
+	ListItxMeetingOccurrences(context.Context, *ListItxMeetingOccurrencesPayload) (res *ITXOccurrenceListResponse, err error)
 	// Update a specific occurrence of a recurring meeting through ITX API proxy
 	UpdateItxOccurrence(context.Context, *UpdateItxOccurrencePayload) (err error)
 	// Delete a specific occurrence of a recurring meeting through ITX API proxy
@@ -65,14 +88,26 @@ type Service interface {
 	UpdateItxPastMeeting(context.Context, *UpdateItxPastMeetingPayload) (err error)
 	// Get a specific past meeting summary through ITX API proxy
 	GetItxPastMeetingSummary(context.Context, *GetItxPastMeetingSummaryPayload) (res *PastMeetingSummary, err error)
+	// Get a structured diff between the AI-generated and edited content of a past
+	// meeting summary through ITX API proxy
+	GetItxPastMeetingSummaryDiff(context.Context, *GetItxPastMeetingSummaryDiffPayload) (res *PastMeetingSummaryDiff, err error)
 	// Update a past meeting summary through ITX API proxy
 	UpdateItxPastMeetingSummary(context.Context, *UpdateItxPastMeetingSummaryPayload) (res *PastMeetingSummary, err error)
+	// Approve a past meeting summary that requires approval through ITX API proxy
+	ApproveItxPastMeetingSummary(context.Context, *ApproveItxPastMeetingSummaryPayload) (res *PastMeetingSummary, err error)
+	// Reject (un-approve) a past meeting summary that requires approval through
+	// ITX API proxy
+	RejectItxPastMeetingSummary(context.Context, *RejectItxPastMeetingSummaryPayload) (res *PastMeetingSummary, err error)
 	// Create a past meeting participant through ITX API proxy - routes to invitee
 	// and/or attendee endpoints based on flags
 	CreateItxPastMeetingParticipant(context.Context, *CreateItxPastMeetingParticipantPayload) (res *ITXPastMeetingParticipant, err error)
 	// Update a past meeting participant through ITX API proxy - updates invitee
 	// and/or attendee records as needed
 	UpdateItxPastMeetingParticipant(context.Context, *UpdateItxPastMeetingParticipantPayload) (res *ITXPastMeetingParticipant, err error)
+	// Apply corrections to many past meeting participants in one request through
+	// ITX API proxy. Each correction is applied like
+	// update-itx-past-meeting-participant, in order; the request is not atomic.
+	BulkUpdateItxPastMeetingParticipants(context.Context, *BulkUpdateItxPastMeetingParticipantsPayload) (res *ITXPastMeetingParticipantBulkUpdateResponse, err error)
 	// Delete a past meeting participant through ITX API proxy - deletes invitee
 	// and/or attendee records as needed
 	DeleteItxPastMeetingParticipant(context.Context, *DeleteItxPastMeetingParticipantPayload) (err error)
@@ -124,11 +159,37 @@ const ServiceName = "Meeting Service"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [40]string{"readyz", "livez", "create-itx-meeting", "get-itx-meeting", "delete-itx-meeting", "update-itx-meeting", "get-itx-meeting-count", "create-itx-registrant", "get-itx-registrant", "update-itx-registrant", "delete-itx-registrant", "get-itx-join-link", "get-itx-registrant-ics", "resend-itx-registrant-invitation", "resend-itx-meeting-invitations", "register-itx-committee-members", "update-itx-occurrence", "delete-itx-occurrence", "submit-itx-meeting-response", "create-itx-past-meeting", "get-itx-past-meeting", "delete-itx-past-meeting", "update-itx-past-meeting", "get-itx-past-meeting-summary", "update-itx-past-meeting-summary", "create-itx-past-meeting-participant", "update-itx-past-meeting-participant", "delete-itx-past-meeting-participant", "create-itx-meeting-attachment", "get-itx-meeting-attachment", "update-itx-meeting-attachment", "delete-itx-meeting-attachment", "create-itx-meeting-attachment-presign", "get-itx-meeting-attachment-download", "create-itx-past-meeting-attachment", "get-itx-past-meeting-attachment", "update-itx-past-meeting-attachment", "delete-itx-past-meeting-attachment", "create-itx-past-meeting-attachment-presign", "get-itx-past-meeting-attachment-download"}
+var MethodNames = [52]string{"readyz", "livez", "get-service-config", "get-service-health", "create-itx-meeting", "get-itx-meeting", "delete-itx-meeting", "clone-itx-meeting", "update-itx-meeting", "get-itx-meeting-count", "preview-itx-meeting-occurrences", "create-itx-registrant", "get-itx-registrant", "update-itx-registrant", "delete-itx-registrant", "get-itx-join-link", "launch-itx-meeting", "get-itx-registrant-ics", "add-itx-registrant-host", "remove-itx-registrant-host", "resend-itx-registrant-invitation", "resend-itx-meeting-invitations", "register-itx-committee-members", "list-itx-meeting-occurrences", "update-itx-occurrence", "delete-itx-occurrence", "submit-itx-meeting-response", "create-itx-past-meeting", "get-itx-past-meeting", "delete-itx-past-meeting", "update-itx-past-meeting", "get-itx-past-meeting-summary", "get-itx-past-meeting-summary-diff", "update-itx-past-meeting-summary", "approve-itx-past-meeting-summary", "reject-itx-past-meeting-summary", "create-itx-past-meeting-participant", "update-itx-past-meeting-participant", "bulk-update-itx-past-meeting-participants", "delete-itx-past-meeting-participant", "create-itx-meeting-attachment", "get-itx-meeting-attachment", "update-itx-meeting-attachment", "delete-itx-meeting-attachment", "create-itx-meeting-attachment-presign", "get-itx-meeting-attachment-download", "create-itx-past-meeting-attachment", "get-itx-past-meeting-attachment", "update-itx-past-meeting-attachment", "delete-itx-past-meeting-attachment", "create-itx-past-meeting-attachment-presign", "get-itx-past-meeting-attachment-download"}
+
+// AddItxRegistrantHostPayload is the payload type of the Meeting Service
+// service add-itx-registrant-host method.
+type AddItxRegistrantHostPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The ID of the meeting
+	MeetingID string
+	// The ID of the registrant
+	RegistrantID string
+}
 
 // Voting status filter for committee members
 type AllowedVotingStatus string
 
+// ApproveItxPastMeetingSummaryPayload is the payload type of the Meeting
+// Service service approve-itx-past-meeting-summary method.
+type ApproveItxPastMeetingSummaryPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Past meeting ID (meeting_id-occurrence_id)
+	PastMeetingID string
+	// Summary UID
+	SummaryUID string
+}
+
 type BadRequestError struct {
 	// HTTP status code
 	Code string
@@ -136,6 +197,34 @@ type BadRequestError struct {
 	Message string
 }
 
+// BulkUpdateItxPastMeetingParticipantsPayload is the payload type of the
+// Meeting Service service bulk-update-itx-past-meeting-participants method.
+type BulkUpdateItxPastMeetingParticipantsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Past meeting ID (meeting_id-occurrence_id format)
+	PastMeetingID string
+	// Participant corrections
+	Participants []*ITXPastMeetingParticipantUpdate
+}
+
+// CloneItxMeetingPayload is the payload type of the Meeting Service service
+// clone-itx-meeting method.
+type CloneItxMeetingPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The Zoom meeting ID to clone
+	MeetingID string
+	// The start time of the meeting in RFC3339 format
+	StartTime string
+	// Title of the new meeting; defaults to the source meeting title
+	Title *string
+}
+
 // A committee associated with a meeting
 type Committee struct {
 	// Committee UID
@@ -514,6 +603,18 @@ type DeleteItxRegistrantPayload struct {
 	RegistrantID string
 }
 
+// Health of a single dependency
+type DependencyHealth struct {
+	// Dependency name
+	Name string
+	// Check outcome
+	Status string
+	// How long the check took, in milliseconds
+	LatencyMs int64
+	// Why the check failed (only when status is error)
+	Error *string
+}
+
 type ForbiddenError struct {
 	// HTTP status code
 	Code string
@@ -540,6 +641,9 @@ type GetItxJoinLinkPayload struct {
 	Email *string
 	// Register user as guest if not already registered
 	Register *bool
+	// Occurrence (Unix timestamp) to check and return the schedule of; the link
+	// itself is the same for every occurrence
+	OccurrenceID *string
 }
 
 // GetItxMeetingAttachmentDownloadPayload is the payload type of the Meeting
@@ -627,6 +731,19 @@ type GetItxPastMeetingPayload struct {
 	PastMeetingID string
 }
 
+// GetItxPastMeetingSummaryDiffPayload is the payload type of the Meeting
+// Service service get-itx-past-meeting-summary-diff method.
+type GetItxPastMeetingSummaryDiffPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Past meeting ID (meeting_id-occurrence_id)
+	PastMeetingID string
+	// Summary UID
+	SummaryUID string
+}
+
 // GetItxPastMeetingSummaryPayload is the payload type of the Meeting Service
 // service get-itx-past-meeting-summary method.
 type GetItxPastMeetingSummaryPayload struct {
@@ -666,6 +783,24 @@ type GetItxRegistrantPayload struct {
 	RegistrantID string
 }
 
+// GetServiceConfigPayload is the payload type of the Meeting Service service
+// get-service-config method.
+type GetServiceConfigPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+}
+
+// GetServiceHealthPayload is the payload type of the Meeting Service service
+// get-service-health method.
+type GetServiceHealthPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+}
+
 // ITXAttachmentDownloadResponse is the result type of the Meeting Service
 // service get-itx-meeting-attachment-download method.
 type ITXAttachmentDownloadResponse struct {
@@ -673,6 +808,22 @@ type ITXAttachmentDownloadResponse struct {
 	DownloadURL string
 }
 
+// Meeting occurrence with start and end times in the list's timezone
+type ITXLocalOccurrence struct {
+	// Unix timestamp
+	OccurrenceID string
+	// RFC3339 start time in UTC
+	StartTime string
+	// RFC3339 start time in the list's timezone
+	LocalStartTime string
+	// RFC3339 end time in the list's timezone
+	LocalEndTime string
+	// Duration in minutes
+	Duration int
+	// available or cancel
+	Status string
+}
+
 // ITXMeetingAttachment is the result type of the Meeting Service service
 // create-itx-meeting-attachment method.
 type ITXMeetingAttachment struct {
@@ -799,6 +950,22 @@ type ITXOccurrence struct {
 	RegistrantCount *int
 }
 
+// ITXOccurrenceListResponse is the result type of the Meeting Service service
+// list-itx-meeting-occurrences method.
+type ITXOccurrenceListResponse struct {
+	// IANA timezone of the local times
+	Timezone string
+	// Occurrences
+	Occurrences []*ITXLocalOccurrence
+}
+
+// ITXOccurrencePreviewResponse is the result type of the Meeting Service
+// service preview-itx-meeting-occurrences method.
+type ITXOccurrencePreviewResponse struct {
+	// Computed occurrences
+	Occurrences []*ITXOccurrence
+}
+
 // ITXPastMeetingAttachment is the result type of the Meeting Service service
 // create-itx-past-meeting-attachment method.
 type ITXPastMeetingAttachment struct {
@@ -956,6 +1123,47 @@ type ITXPastMeetingParticipant struct {
 	ModifiedBy *ITXUser
 }
 
+// ITXPastMeetingParticipantBulkUpdateResponse is the result type of the
+// Meeting Service service bulk-update-itx-past-meeting-participants method.
+type ITXPastMeetingParticipantBulkUpdateResponse struct {
+	// Updated participants
+	Participants []*ITXPastMeetingParticipant
+}
+
+// Correction for one past meeting participant
+type ITXPastMeetingParticipantUpdate struct {
+	// Participant ID (invitee_id or attendee_id)
+	ParticipantID string
+	// Optional invitee ID to use directly (avoids ID mapping lookup)
+	InviteeID *string
+	// Optional attendee ID to use directly (avoids ID mapping lookup)
+	AttendeeID *string
+	// Whether the participant is invited (if false, invitee record will be deleted)
+	IsInvited *bool
+	// Whether the participant attended (if false, attendee record will be deleted)
+	IsAttended *bool
+	// Email address (used for creation)
+	Email *string
+	// LF SSO username (used for creation)
+	Username *string
+	// LF user ID (used for creation)
+	LfUserID *string
+	// First name (required for invitee updates)
+	FirstName *string
+	// Last name (required for invitee updates)
+	LastName *string
+	// Organization name
+	OrgName *string
+	// Job title
+	JobTitle *string
+	// Role within committee
+	CommitteeRole *string
+	// Voting status in committee
+	CommitteeVotingStatus *string
+	// Whether the attendee has been verified (attendee only)
+	IsVerified *bool
+}
+
 // ITXPastZoomMeeting is the result type of the Meeting Service service
 // create-itx-past-meeting method.
 type ITXPastZoomMeeting struct {
@@ -1014,6 +1222,12 @@ type ITXUser struct {
 type ITXZoomMeetingJoinLink struct {
 	// Zoom meeting join URL
 	Link string
+	// Occurrence the link was requested for (Unix timestamp)
+	OccurrenceID *string
+	// Start time of the occurrence in RFC3339 format
+	OccurrenceStartTime *string
+	// Duration of the occurrence in minutes
+	OccurrenceDuration *int
 }
 
 // ITXZoomMeetingRegistrant is the result type of the Meeting Service service
@@ -1159,6 +1373,58 @@ type InternalServerError struct {
 	Message string
 }
 
+// LaunchItxMeetingPayload is the payload type of the Meeting Service service
+// launch-itx-meeting method.
+type LaunchItxMeetingPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The ID of the meeting
+	MeetingID string
+	// Use email for identification instead of user_id
+	UseEmail *bool
+	// LF user ID
+	UserID *string
+	// User's full name
+	Name *string
+	// User's email address
+	Email *string
+	// Register user as guest if not already registered
+	Register *bool
+	// Occurrence (Unix timestamp) to check and return the schedule of; the link
+	// itself is the same for every occurrence
+	OccurrenceID *string
+	// Which Zoom client to launch; auto picks based on the User-Agent
+	Client string
+	// User-Agent of the requesting browser
+	UserAgent *string
+}
+
+// LaunchItxMeetingResult is the result type of the Meeting Service service
+// launch-itx-meeting method.
+type LaunchItxMeetingResult struct {
+	// Join link to redirect to
+	Location string
+}
+
+// ListItxMeetingOccurrencesPayload is the payload type of the Meeting Service
+// service list-itx-meeting-occurrences method.
+type ListItxMeetingOccurrencesPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The Zoom meeting ID
+	MeetingID string
+	// IANA timezone for local times; defaults to the meeting's timezone
+	Tz *string
+	// Only include occurrences starting at or after this time (RFC3339)
+	From *string
+	// Only include occurrences starting before this time (RFC3339)
+	To *string
+}
+
 type NotFoundError struct {
 	// HTTP status code
 	Code string
@@ -1207,6 +1473,28 @@ type PastMeetingSummary struct {
 	UpdatedAt string
 }
 
+// PastMeetingSummaryDiff is the result type of the Meeting Service service
+// get-itx-past-meeting-summary-diff method.
+type PastMeetingSummaryDiff struct {
+	// The unique identifier of the summary
+	UID string
+	// The past meeting identifier (meeting_id-occurrence_id)
+	PastMeetingID string
+	// Whether the summary has edited content that differs from the AI-generated
+	// content
+	HasEdits bool
+	// Number of lines added in the edited content
+	Additions int
+	// Number of lines removed from the AI-generated content
+	Deletions int
+	// Whether the changed region was too large to diff line by line, in which case
+	// its AI-generated lines are reported as deleted and its edited lines as
+	// inserted
+	Approximate bool
+	// Diff lines in document order
+	Lines []*SummaryDiffLine
+}
+
 // Zoom-specific configuration for a past meeting summary
 type PastMeetingSummaryZoomConfig struct {
 	// Zoom meeting ID
@@ -1215,6 +1503,25 @@ type PastMeetingSummaryZoomConfig struct {
 	MeetingUUID *string
 }
 
+// PreviewItxMeetingOccurrencesPayload is the payload type of the Meeting
+// Service service preview-itx-meeting-occurrences method.
+type PreviewItxMeetingOccurrencesPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The start time of the meeting in RFC3339 format
+	StartTime string
+	// The timezone of the meeting (e.g. 'America/New_York')
+	Timezone string
+	// The duration of the meeting in minutes
+	Duration int
+	// The recurrence of the meeting
+	Recurrence *Recurrence
+	// Maximum number of occurrences to return
+	Count int
+}
+
 // Meeting recurrence settings
 type Recurrence struct {
 	// Recurrence type: 1=Daily, 2=Weekly, 3=Monthly
@@ -1231,8 +1538,14 @@ type Recurrence struct {
 	MonthlyWeekDay *int
 	// Number of occurrences
 	EndTimes *int
-	// End date/time in RFC3339
+	// End date/time in RFC3339. Occurrence previews reject end dates more than 10
+	// years after the start time
 	EndDateTime *string
+	// RFC 5545 RRULE equivalent of the recurrence. Set on responses unless the
+	// recurrence ITX returns has an invalid type or end_date_time. On requests it
+	// may be sent instead of type and the other fields above, for rules Zoom can
+	// represent
+	Rrule *string
 }
 
 // RegisterItxCommitteeMembersPayload is the payload type of the Meeting
@@ -1246,6 +1559,32 @@ type RegisterItxCommitteeMembersPayload struct {
 	MeetingID string
 }
 
+// RejectItxPastMeetingSummaryPayload is the payload type of the Meeting
+// Service service reject-itx-past-meeting-summary method.
+type RejectItxPastMeetingSummaryPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Past meeting ID (meeting_id-occurrence_id)
+	PastMeetingID string
+	// Summary UID
+	SummaryUID string
+}
+
+// RemoveItxRegistrantHostPayload is the payload type of the Meeting Service
+// service remove-itx-registrant-host method.
+type RemoveItxRegistrantHostPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The ID of the meeting
+	MeetingID string
+	// The ID of the registrant
+	RegistrantID string
+}
+
 // ResendItxMeetingInvitationsPayload is the payload type of the Meeting
 // Service service resend-itx-meeting-invitations method.
 type ResendItxMeetingInvitationsPayload struct {
@@ -1272,6 +1611,110 @@ type ResendItxRegistrantInvitationPayload struct {
 	RegistrantID string
 }
 
+// Effective attachment upload limits
+type ServiceAttachmentsConfig struct {
+	// Maximum attachment size in bytes (0 means no limit)
+	MaxFileSize int64
+	// Accepted attachment content types (empty means any)
+	AllowedContentTypes []string
+}
+
+// ServiceConfig is the result type of the Meeting Service service
+// get-service-config method.
+type ServiceConfig struct {
+	// Service build version
+	Version string
+	// Normalized LFX environment
+	LfxEnvironment string
+	// ITX API base URL
+	ItxBaseURL string
+	// Auth0 domain used for ITX M2M tokens
+	ItxAuth0Domain string
+	// Audience of the ITX M2M tokens
+	ItxAudience string
+	// v1 user-service (API gateway) base URL
+	UserServiceBaseURL string
+	// Whether v1/v2 ID mapping through NATS is enabled
+	IDMappingEnabled bool
+	// Event processing configuration
+	EventProcessing *ServiceEventProcessingConfig
+	// LFID invite feature configuration
+	Invites *ServiceInvitesConfig
+	// ITX client timeout, retry and circuit breaker configuration
+	ItxResilience *ServiceITXResilienceConfig
+	// Attachment upload limits
+	Attachments *ServiceAttachmentsConfig
+	// How long ITX join links are cached, as a Go duration (0s when caching is
+	// disabled)
+	JoinLinkCacheTTL string
+	// Global log level (LOG_LEVEL)
+	LogLevel string
+	// Effective per-subsystem log level overrides
+	SubsystemLogLevels map[string]string
+}
+
+// Effective event processing configuration
+type ServiceEventProcessingConfig struct {
+	// Whether the v1-objects KV consumer is started
+	Enabled bool
+	// JetStream consumer name
+	ConsumerName string
+	// JetStream stream name
+	StreamName string
+	// KV subjects the consumer is filtered to
+	FilterSubjects []string
+	// Maximum delivery attempts per message
+	MaxDeliver int
+	// Ack wait timeout as a Go duration
+	AckWait string
+	// Maximum unacknowledged messages
+	MaxAckPending int
+	// KV bucket used for v1 mappings
+	V1MappingsBucket string
+	// Consecutive failures that open an object type's circuit breaker (0 disables)
+	BreakerThreshold int
+	// Circuit breaker cooldown as a Go duration
+	BreakerCooldown string
+}
+
+// ServiceHealth is the result type of the Meeting Service service
+// get-service-health method.
+type ServiceHealth struct {
+	// ok when every dependency check passed, degraded otherwise
+	Status string
+	// Result of each dependency check
+	Checks []*DependencyHealth
+}
+
+// Effective ITX client timeout, retry and circuit breaker configuration
+type ServiceITXResilienceConfig struct {
+	// Retries of an idempotent request after a transient failure (0 disables
+	// retries)
+	MaxRetries int
+	// Base retry backoff as a Go duration
+	RetryBaseDelay string
+	// Maximum retry backoff as a Go duration
+	RetryMaxDelay string
+	// Timeout of a single attempt of an idempotent request as a Go duration (0s
+	// means no per-attempt bound)
+	AttemptTimeout string
+	// attempt_timeout overrides by endpoint group (ics, attachments) as Go
+	// durations
+	EndpointAttemptTimeouts map[string]string
+	// Consecutive failures that open the ITX circuit breaker (0 disables)
+	BreakerThreshold int
+	// Circuit breaker cooldown as a Go duration
+	BreakerCooldown string
+}
+
+// Effective LFID invite feature configuration
+type ServiceInvitesConfig struct {
+	// Whether outbound LFID invites are enabled
+	Enabled bool
+	// LFX self-serve base URL used in invite links (empty when invalid)
+	SelfServeBaseURL string
+}
+
 type ServiceUnavailableError struct {
 	// HTTP status code
 	Code string
@@ -1315,6 +1758,19 @@ type SummaryData struct {
 	EditedContent *string
 }
 
+// A single line of a line-oriented diff between AI-generated and edited
+// summary content
+type SummaryDiffLine struct {
+	// How the line changed from the AI-generated content to the edited content
+	Operation string
+	// The line text
+	Text string
+	// 1-based line number in the AI-generated content (omitted for inserted lines)
+	OriginalLine *int
+	// 1-based line number in the edited content (omitted for deleted lines)
+	EditedLine *int
+}
+
 type UnauthorizedError struct {
 	// HTTP status code
 	Code string
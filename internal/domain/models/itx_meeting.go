@@ -3,7 +3,11 @@
 
 package models
 
-import itx "github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+import (
+	"time"
+
+	itx "github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
 
 // Committee represents a committee associated with a meeting
 type Committee struct {
@@ -40,10 +44,42 @@ type CreateITXMeetingRequest struct {
 	AISummaryEnabled         bool
 	RequireAISummaryApproval bool
 	ArtifactVisibility       itx.ArtifactAccess
+	RecordingAccess          itx.ArtifactAccess // Per-artifact override of ArtifactVisibility (set by clone)
+	TranscriptAccess         itx.ArtifactAccess // Per-artifact override of ArtifactVisibility (set by clone)
+	AISummaryAccess          itx.ArtifactAccess // Per-artifact override of ArtifactVisibility (set by clone)
 	Recurrence               *ITXRecurrence
 	UpdateNote               string
 }
 
+// CloneITXMeetingRequest is the domain request for duplicating an existing meeting's settings
+type CloneITXMeetingRequest struct {
+	StartTime string // RFC3339 start time of the new meeting (required)
+	Title     string // Optional title override; the source title is kept when empty
+}
+
+// ListITXOccurrencesRequest is the domain request for listing a meeting's occurrences
+type ListITXOccurrencesRequest struct {
+	MeetingID string
+	Timezone  string    // IANA timezone for local times; the meeting's timezone when empty
+	From      time.Time // Inclusive lower bound on start time; zero for no bound
+	To        time.Time // Exclusive upper bound on start time; zero for no bound
+}
+
+// ITXOccurrenceList is a meeting's occurrences with start and end times in one timezone
+type ITXOccurrenceList struct {
+	Timezone    string
+	Occurrences []ITXLocalOccurrence
+}
+
+// ITXLocalOccurrence is a meeting occurrence whose times are in the list's timezone
+type ITXLocalOccurrence struct {
+	OccurrenceID string
+	StartTime    time.Time
+	EndTime      time.Time
+	Duration     int
+	Status       itx.OccurrenceStatus
+}
+
 // ITXRecurrence represents recurrence for ITX requests (with string EndDateTime)
 type ITXRecurrence struct {
 	Type           itx.RecurrenceType
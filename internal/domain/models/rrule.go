@@ -0,0 +1,261 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdaysABBRV and typeName are indexed by the Zoom weekday (1 = Sunday) and recurrence
+// type (1 = daily) minus one.
+var weekdaysABBRV = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+var typeName = []string{"Daily", "Weekly", "Monthly"}
+
+// RecurrenceToRRule returns the RFC 5545 RRULE equivalent of a Zoom-shaped recurrence
+// (e.g. "FREQ=WEEKLY;WKST=SU;INTERVAL=1;BYDAY=TU;COUNT=4"). It is the rule the occurrence
+// calculator expands, so monthly days past the 28th are expressed with BYSETPOS=-1, which
+// falls back to the last day of shorter months the same way Zoom schedules them.
+func RecurrenceToRRule(recurrence *ZoomMeetingRecurrence) (string, error) {
+	rule, _, err := FormatRRule(recurrence, nil)
+	return rule, err
+}
+
+// FormatRRule formats a meeting recurrence as a recurrence rule and reports whether the
+// rule has a terminal condition (COUNT or UNTIL).
+func FormatRRule(reccurrence *ZoomMeetingRecurrence, endTime *time.Time) (string, bool, error) {
+	var rrule strings.Builder
+
+	if reccurrence.Type < 1 || reccurrence.Type > 3 {
+		return "", false, fmt.Errorf("invalid recurrence type: %d", reccurrence.Type)
+	}
+
+	fmt.Fprintf(&rrule, "FREQ=%s;", strings.ToUpper(typeName[reccurrence.Type-1]))
+	rrule.WriteString("WKST=SU;")
+
+	if reccurrence.RepeatInterval != 0 {
+		fmt.Fprintf(&rrule, "INTERVAL=%d;", reccurrence.RepeatInterval)
+	}
+
+	if reccurrence.WeeklyDays != "" {
+		s, err := parseByDay(reccurrence.WeeklyDays)
+		if err != nil {
+			return "", false, err
+		}
+		fmt.Fprintf(&rrule, "BYDAY=%s;", s)
+	} else if reccurrence.MonthlyWeek != 0 && reccurrence.MonthlyWeekDay != 0 {
+		fmt.Fprintf(&rrule, "BYDAY=%d%s;", reccurrence.MonthlyWeek, weekdaysABBRV[reccurrence.MonthlyWeekDay-1])
+	}
+
+	if reccurrence.MonthlyDay != 0 {
+		switch reccurrence.MonthlyDay {
+		case 29:
+			rrule.WriteString("BYMONTHDAY=28,29;BYSETPOS=-1;") // fall back to the 28th on months with 28 days if recurrence set to every 29th
+		case 30:
+			rrule.WriteString("BYMONTHDAY=28,29,30;BYSETPOS=-1;")
+		case 31:
+			rrule.WriteString("BYMONTHDAY=28,29,30,31;BYSETPOS=-1;")
+		default:
+			fmt.Fprintf(&rrule, "BYMONTHDAY=%d;", reccurrence.MonthlyDay)
+		}
+	}
+
+	bounded := true
+	if endTime != nil {
+		fmt.Fprintf(&rrule, "UNTIL=%s;", endTime.Format("20060102T150405Z"))
+	} else {
+		// Use a local copy to avoid mutating the caller's recurrence object
+		endTimes := reccurrence.EndTimes
+		if reccurrence.EndDateTime != "" {
+			endTimes = 0
+			t, err := time.Parse(time.RFC3339, reccurrence.EndDateTime)
+			if err != nil {
+				return "", false, fmt.Errorf("failed to parse recurrence end_date_time %s: %w", reccurrence.EndDateTime, err)
+			}
+			fmt.Fprintf(&rrule, "UNTIL=%s;", t.UTC().Format("20060102T150405Z"))
+		}
+
+		if endTimes != 0 {
+			fmt.Fprintf(&rrule, "COUNT=%d;", endTimes)
+		} else if reccurrence.EndDateTime == "" {
+			bounded = false
+		}
+	}
+
+	return strings.TrimSuffix(rrule.String(), ";"), bounded, nil
+}
+
+// parseByDay takes a list of weekdays as a string and returns the list of
+// abbreviations as a string where 1 is Sunday and 7 is Saturday
+// (e.g. "2,3,6" -> "MO,TU,FR")
+func parseByDay(days string) (string, error) {
+	stringSlice := strings.Split(days, ",")
+	var weekdays strings.Builder
+	var hasWritten bool
+	for _, item := range stringSlice {
+		weekdayNum, err := strconv.Atoi(item)
+		if err != nil {
+			return "", err
+		}
+		// A weekday can only be 1-7. Skip numbers that are not in this range.
+		if weekdayNum < 1 || weekdayNum > 7 {
+			continue
+		}
+		if hasWritten {
+			weekdays.WriteString(",")
+		}
+		weekdays.WriteString(weekdaysABBRV[weekdayNum-1])
+		hasWritten = true
+	}
+	return weekdays.String(), nil
+}
+
+// RecurrenceFromRRule converts an RFC 5545 RRULE into the Zoom recurrence shape ITX accepts.
+// Only rules Zoom can represent are supported: DAILY, WEEKLY or MONTHLY frequency, INTERVAL,
+// BYDAY (weekdays for WEEKLY, a single ordinal weekday such as 3TH or -1FR for MONTHLY),
+// BYMONTHDAY (a single day, or the BYSETPOS=-1 month-end form RecurrenceToRRule emits), and
+// at most one of COUNT and UNTIL. An optional "RRULE:" prefix is accepted.
+func RecurrenceFromRRule(rule string) (*ZoomMeetingRecurrence, error) {
+	parts := make(map[string]string)
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rrule part %q", part)
+		}
+		name = strings.ToUpper(name)
+		if _, dup := parts[name]; dup {
+			return nil, fmt.Errorf("duplicate rrule part %s", name)
+		}
+		parts[name] = strings.ToUpper(value)
+	}
+
+	recurrence := &ZoomMeetingRecurrence{RepeatInterval: 1}
+	switch parts["FREQ"] {
+	case "DAILY":
+		recurrence.Type = 1
+	case "WEEKLY":
+		recurrence.Type = 2
+	case "MONTHLY":
+		recurrence.Type = 3
+	case "":
+		return nil, fmt.Errorf("rrule FREQ is required")
+	default:
+		return nil, fmt.Errorf("unsupported rrule FREQ %s: only DAILY, WEEKLY and MONTHLY are supported", parts["FREQ"])
+	}
+
+	for name, value := range parts {
+		var err error
+		switch name {
+		case "FREQ", "WKST":
+			// FREQ is handled above; Zoom weeks always start on Sunday.
+		case "INTERVAL":
+			recurrence.RepeatInterval, err = parsePositiveInt(name, value)
+		case "BYDAY":
+			err = applyRRuleByDay(recurrence, value)
+		case "BYMONTHDAY":
+			err = applyRRuleByMonthDay(recurrence, value, parts["BYSETPOS"])
+		case "BYSETPOS":
+			if _, ok := parts["BYMONTHDAY"]; !ok || value != "-1" {
+				err = fmt.Errorf("rrule BYSETPOS is only supported as BYSETPOS=-1 with BYMONTHDAY")
+			}
+		case "COUNT":
+			recurrence.EndTimes, err = parsePositiveInt(name, value)
+		case "UNTIL":
+			recurrence.EndDateTime, err = parseRRuleUntil(value)
+		default:
+			err = fmt.Errorf("unsupported rrule part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if recurrence.EndTimes != 0 && recurrence.EndDateTime != "" {
+		return nil, fmt.Errorf("rrule COUNT and UNTIL cannot both be set")
+	}
+	if recurrence.Type == 3 && recurrence.MonthlyDay == 0 && recurrence.MonthlyWeek == 0 {
+		return nil, fmt.Errorf("monthly rrule requires BYMONTHDAY or an ordinal BYDAY")
+	}
+	if recurrence.MonthlyDay != 0 && recurrence.MonthlyWeek != 0 {
+		return nil, fmt.Errorf("rrule BYMONTHDAY and BYDAY cannot both be set")
+	}
+
+	return recurrence, nil
+}
+
+// applyRRuleByDay maps BYDAY to weekly_days for weekly rules and to
+// monthly_week/monthly_week_day for monthly rules.
+func applyRRuleByDay(recurrence *ZoomMeetingRecurrence, value string) error {
+	switch recurrence.Type {
+	case 2:
+		days := make([]string, 0, 7)
+		for _, day := range strings.Split(value, ",") {
+			index := slices.Index(weekdaysABBRV, day)
+			if index < 0 {
+				return fmt.Errorf("invalid rrule BYDAY weekday %q for a weekly rule", day)
+			}
+			days = append(days, strconv.Itoa(index+1))
+		}
+		recurrence.WeeklyDays = strings.Join(days, ",")
+		return nil
+	case 3:
+		if len(value) < 3 {
+			return fmt.Errorf("invalid rrule BYDAY %q for a monthly rule: expected an ordinal weekday such as 3TH", value)
+		}
+		week, err := strconv.Atoi(value[:len(value)-2])
+		index := slices.Index(weekdaysABBRV, value[len(value)-2:])
+		if err != nil || index < 0 || week == 0 || week < -1 || week > 4 {
+			return fmt.Errorf("invalid rrule BYDAY %q for a monthly rule: the week must be 1-4 or -1 (last)", value)
+		}
+		recurrence.MonthlyWeek = week
+		recurrence.MonthlyWeekDay = index + 1
+		return nil
+	default:
+		return fmt.Errorf("rrule BYDAY is not supported for daily rules")
+	}
+}
+
+// applyRRuleByMonthDay maps BYMONTHDAY to monthly_day, accepting the month-end fallback
+// lists (e.g. 28,29,30,31 with BYSETPOS=-1 for the 31st) that RecurrenceToRRule emits.
+func applyRRuleByMonthDay(recurrence *ZoomMeetingRecurrence, value, bySetPos string) error {
+	if recurrence.Type != 3 {
+		return fmt.Errorf("rrule BYMONTHDAY is only supported for monthly rules")
+	}
+	switch {
+	case bySetPos == "-1" && slices.Contains([]string{"28,29", "28,29,30", "28,29,30,31"}, value):
+		value = value[strings.LastIndex(value, ",")+1:]
+	case bySetPos != "":
+		return fmt.Errorf("rrule BYSETPOS=-1 is only supported with a month-end BYMONTHDAY list")
+	}
+	day, err := strconv.Atoi(value)
+	if err != nil || day < 1 || day > 31 {
+		return fmt.Errorf("invalid rrule BYMONTHDAY %q: expected a single day of the month (1-31)", value)
+	}
+	recurrence.MonthlyDay = day
+	return nil
+}
+
+// parseRRuleUntil converts an UNTIL value (a UTC date-time or a date) to RFC3339
+func parseRRuleUntil(value string) (string, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC().Format(time.RFC3339), nil
+		}
+	}
+	return "", fmt.Errorf("invalid rrule UNTIL %q: expected a UTC date-time (20060102T150405Z) or a date (20060102)", value)
+}
+
+func parsePositiveInt(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid rrule %s %q: expected a positive integer", name, value)
+	}
+	return n, nil
+}
@@ -0,0 +1,114 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrenceRRuleRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name       string
+		recurrence ZoomMeetingRecurrence
+		rule       string
+	}{
+		{
+			name:       "daily without end",
+			recurrence: ZoomMeetingRecurrence{Type: 1, RepeatInterval: 2},
+			rule:       "FREQ=DAILY;WKST=SU;INTERVAL=2",
+		},
+		{
+			name:       "weekly on days with count",
+			recurrence: ZoomMeetingRecurrence{Type: 2, RepeatInterval: 1, WeeklyDays: "2,4", EndTimes: 10},
+			rule:       "FREQ=WEEKLY;WKST=SU;INTERVAL=1;BYDAY=MO,WE;COUNT=10",
+		},
+		{
+			name:       "monthly third thursday until",
+			recurrence: ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyWeek: 3, MonthlyWeekDay: 5, EndDateTime: "2025-12-31T23:59:59Z"},
+			rule:       "FREQ=MONTHLY;WKST=SU;INTERVAL=1;BYDAY=3TH;UNTIL=20251231T235959Z",
+		},
+		{
+			name:       "monthly last friday",
+			recurrence: ZoomMeetingRecurrence{Type: 3, RepeatInterval: 3, MonthlyWeek: -1, MonthlyWeekDay: 6},
+			rule:       "FREQ=MONTHLY;WKST=SU;INTERVAL=3;BYDAY=-1FR",
+		},
+		{
+			name:       "monthly on the 31st",
+			recurrence: ZoomMeetingRecurrence{Type: 3, RepeatInterval: 1, MonthlyDay: 31},
+			rule:       "FREQ=MONTHLY;WKST=SU;INTERVAL=1;BYMONTHDAY=28,29,30,31;BYSETPOS=-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := RecurrenceToRRule(&tc.recurrence)
+			require.NoError(t, err)
+			assert.Equal(t, tc.rule, rule)
+
+			parsed, err := RecurrenceFromRRule(rule)
+			require.NoError(t, err)
+			assert.Equal(t, tc.recurrence, *parsed)
+		})
+	}
+}
+
+func TestRecurrenceFromRRule(t *testing.T) {
+	t.Run("defaults and prefix", func(t *testing.T) {
+		parsed, err := RecurrenceFromRRule("RRULE:FREQ=WEEKLY;BYDAY=TU;UNTIL=20250301")
+		require.NoError(t, err)
+		assert.Equal(t, ZoomMeetingRecurrence{
+			Type:           2,
+			RepeatInterval: 1,
+			WeeklyDays:     "3",
+			EndDateTime:    "2025-03-01T00:00:00Z",
+		}, *parsed)
+	})
+
+	invalid := map[string]string{
+		"missing freq":                "INTERVAL=2",
+		"yearly":                      "FREQ=YEARLY",
+		"count and until":             "FREQ=DAILY;COUNT=3;UNTIL=20250301T000000Z",
+		"unsupported part":            "FREQ=WEEKLY;BYHOUR=10",
+		"daily byday":                 "FREQ=DAILY;BYDAY=MO",
+		"monthly multiple weekdays":   "FREQ=MONTHLY;BYDAY=MO,TU",
+		"monthly fifth week":          "FREQ=MONTHLY;BYDAY=5MO",
+		"monthly without day":         "FREQ=MONTHLY;INTERVAL=1",
+		"monthly multiple days":       "FREQ=MONTHLY;BYMONTHDAY=1,15",
+		"bysetpos without monthday":   "FREQ=MONTHLY;BYDAY=1MO;BYSETPOS=-1",
+		"zero interval":               "FREQ=DAILY;INTERVAL=0",
+		"until with local time":       "FREQ=DAILY;UNTIL=20250301T000000",
+		"duplicate part":              "FREQ=DAILY;FREQ=WEEKLY",
+		"byday and monthday":          "FREQ=MONTHLY;BYDAY=1MO;BYMONTHDAY=3",
+		"malformed part":              "FREQ=DAILY;COUNT",
+		"weekly with ordinal weekday": "FREQ=WEEKLY;BYDAY=1MO",
+	}
+	for name, rule := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := RecurrenceFromRRule(rule)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseByDay(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1", "SU"},
+		{"2", "MO"},
+		{"1,3,5", "SU,TU,TH"},
+		{"2,3,4,5,6", "MO,TU,WE,TH,FR"},
+		{"1,7", "SU,SA"},
+	}
+
+	for _, tt := range tests {
+		result, err := parseByDay(tt.input)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, result, "Input: %s", tt.input)
+	}
+}
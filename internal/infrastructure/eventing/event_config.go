@@ -30,4 +30,11 @@ type Config struct {
 
 	// V1MappingsBucketName is the name of the KV bucket for storing v1 mappings
 	V1MappingsBucketName string
+
+	// BreakerThreshold is the number of consecutive retryable failures of one object type
+	// after which its messages are deferred without being handled (0 disables)
+	BreakerThreshold int
+
+	// BreakerCooldown is how long an object type's breaker stays open before a probe
+	BreakerCooldown time.Duration
 }
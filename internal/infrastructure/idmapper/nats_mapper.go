@@ -63,6 +63,11 @@ func (m *NATSMapper) Close() {
 	}
 }
 
+// Ping round-trips to the NATS server used for ID mapping
+func (m *NATSMapper) Ping(ctx context.Context) error {
+	return m.conn.FlushWithContext(ctx)
+}
+
 // MapProjectV2ToV1 maps a v2 project UID to v1 project SFID
 func (m *NATSMapper) MapProjectV2ToV1(ctx context.Context, v2UID string) (string, error) {
 	if v2UID == "" {
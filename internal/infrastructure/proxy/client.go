@@ -36,12 +36,16 @@ type Config struct {
 	Auth0Domain string
 	Audience    string
 	Timeout     time.Duration
+	// Resilience configures retries and the circuit breaker; the zero value disables both.
+	Resilience ResilienceConfig
 }
 
 // Client implements domain.ITXProxyClient
 type Client struct {
-	httpClient *http.Client
-	config     Config
+	httpClient  *http.Client
+	tokenSource oauth2.TokenSource
+	config      Config
+	logger      *slog.Logger
 }
 
 // auth0TokenSource implements oauth2.TokenSource using Auth0 SDK with private key
@@ -123,14 +127,41 @@ func NewClient(config Config) *Client {
 	reuseTokenSource := oauth2.ReuseTokenSource(nil, tokenSource)
 
 	// Create HTTP client that automatically handles token management.
-	// Wrap the oauth2 transport with otelhttp so ITX API calls appear in traces.
+	// Wrap the oauth2 transport with otelhttp so ITX API calls appear in traces, and that
+	// with the retry/circuit breaker transport so each attempt is traced separately.
 	httpClient := oauth2.NewClient(ctx, reuseTokenSource)
-	httpClient.Transport = otelhttp.NewTransport(httpClient.Transport)
+	httpClient.Transport = newResilientTransport(otelhttp.NewTransport(httpClient.Transport), config.Resilience)
 	httpClient.Timeout = config.Timeout
 
 	return &Client{
-		httpClient: httpClient,
-		config:     config,
+		httpClient:  httpClient,
+		tokenSource: reuseTokenSource,
+		config:      config,
+		logger:      logging.Subsystem(logging.SubsystemITX),
+	}
+}
+
+// CheckAuth reports whether the client holds, or can obtain from Auth0, a valid ITX M2M token.
+// oauth2.TokenSource takes no context, so the token is fetched in a goroutine and CheckAuth
+// returns when ctx is done; an abandoned fetch still ends within the Auth0 client's timeout.
+// The returned error is generic and the cause is logged, since it may include Auth0 responses.
+func (c *Client) CheckAuth(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := c.tokenSource.Token()
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			c.logger.With(logging.ErrKey, err).WarnContext(ctx, "ITX auth check failed to obtain an access token")
+			return domain.NewUnavailableError("failed to obtain ITX access token")
+		}
+		return nil
+	case <-ctx.Done():
+		c.logger.With(logging.ErrKey, ctx.Err()).WarnContext(ctx, "ITX auth check timed out waiting for an access token")
+		return domain.NewUnavailableError("timed out obtaining ITX access token")
 	}
 }
 
@@ -1439,7 +1470,7 @@ func (c *Client) CreateMeetingAttachmentPresignURL(ctx context.Context, meetingI
 
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/meetings/%s/attachments/presign", c.config.BaseURL, meetingID)
-	slog.DebugContext(ctx, "ITX CreateMeetingAttachmentPresignURL request",
+	c.logger.DebugContext(ctx, "ITX CreateMeetingAttachmentPresignURL request",
 		"method", http.MethodPost,
 		"url", url,
 		"meetingID", meetingID,
@@ -1458,7 +1489,7 @@ func (c *Client) CreateMeetingAttachmentPresignURL(ctx context.Context, meetingI
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX CreateMeetingAttachmentPresignURL request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX CreateMeetingAttachmentPresignURL request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1471,7 +1502,7 @@ func (c *Client) CreateMeetingAttachmentPresignURL(ctx context.Context, meetingI
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX CreateMeetingAttachmentPresignURL response",
+	c.logger.DebugContext(ctx, "ITX CreateMeetingAttachmentPresignURL response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1493,7 +1524,7 @@ func (c *Client) CreateMeetingAttachmentPresignURL(ctx context.Context, meetingI
 func (c *Client) GetMeetingAttachmentDownloadURL(ctx context.Context, meetingID, attachmentID string) (*itx.AttachmentDownloadResponse, error) {
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/meetings/%s/attachments/%s/download", c.config.BaseURL, meetingID, attachmentID)
-	slog.DebugContext(ctx, "ITX GetMeetingAttachmentDownloadURL request",
+	c.logger.DebugContext(ctx, "ITX GetMeetingAttachmentDownloadURL request",
 		"method", http.MethodGet,
 		"url", url,
 		"meetingID", meetingID,
@@ -1511,7 +1542,7 @@ func (c *Client) GetMeetingAttachmentDownloadURL(ctx context.Context, meetingID,
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX GetMeetingAttachmentDownloadURL request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX GetMeetingAttachmentDownloadURL request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1524,7 +1555,7 @@ func (c *Client) GetMeetingAttachmentDownloadURL(ctx context.Context, meetingID,
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX GetMeetingAttachmentDownloadURL response",
+	c.logger.DebugContext(ctx, "ITX GetMeetingAttachmentDownloadURL response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1552,7 +1583,7 @@ func (c *Client) CreatePastMeetingAttachmentPresignURL(ctx context.Context, meet
 
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/past_meetings/%s/attachments/presign", c.config.BaseURL, meetingAndOccurrenceID)
-	slog.DebugContext(ctx, "ITX CreatePastMeetingAttachmentPresignURL request",
+	c.logger.DebugContext(ctx, "ITX CreatePastMeetingAttachmentPresignURL request",
 		"method", http.MethodPost,
 		"url", url,
 		"meetingAndOccurrenceID", meetingAndOccurrenceID,
@@ -1571,7 +1602,7 @@ func (c *Client) CreatePastMeetingAttachmentPresignURL(ctx context.Context, meet
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX CreatePastMeetingAttachmentPresignURL request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX CreatePastMeetingAttachmentPresignURL request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1584,7 +1615,7 @@ func (c *Client) CreatePastMeetingAttachmentPresignURL(ctx context.Context, meet
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX CreatePastMeetingAttachmentPresignURL response",
+	c.logger.DebugContext(ctx, "ITX CreatePastMeetingAttachmentPresignURL response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1606,7 +1637,7 @@ func (c *Client) CreatePastMeetingAttachmentPresignURL(ctx context.Context, meet
 func (c *Client) GetPastMeetingAttachmentDownloadURL(ctx context.Context, meetingAndOccurrenceID, attachmentID string) (*itx.AttachmentDownloadResponse, error) {
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/past_meetings/%s/attachments/%s/download", c.config.BaseURL, meetingAndOccurrenceID, attachmentID)
-	slog.DebugContext(ctx, "ITX GetPastMeetingAttachmentDownloadURL request",
+	c.logger.DebugContext(ctx, "ITX GetPastMeetingAttachmentDownloadURL request",
 		"method", http.MethodGet,
 		"url", url,
 		"meetingAndOccurrenceID", meetingAndOccurrenceID,
@@ -1624,7 +1655,7 @@ func (c *Client) GetPastMeetingAttachmentDownloadURL(ctx context.Context, meetin
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX GetPastMeetingAttachmentDownloadURL request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX GetPastMeetingAttachmentDownloadURL request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1637,7 +1668,7 @@ func (c *Client) GetPastMeetingAttachmentDownloadURL(ctx context.Context, meetin
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX GetPastMeetingAttachmentDownloadURL response",
+	c.logger.DebugContext(ctx, "ITX GetPastMeetingAttachmentDownloadURL response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1665,7 +1696,7 @@ func (c *Client) CreateMeetingAttachment(ctx context.Context, meetingID string,
 
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/meetings/%s/attachments", c.config.BaseURL, meetingID)
-	slog.DebugContext(ctx, "ITX CreateMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX CreateMeetingAttachment request",
 		"method", http.MethodPost,
 		"url", url,
 		"meetingID", meetingID,
@@ -1684,7 +1715,7 @@ func (c *Client) CreateMeetingAttachment(ctx context.Context, meetingID string,
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX CreateMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX CreateMeetingAttachment request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1697,7 +1728,7 @@ func (c *Client) CreateMeetingAttachment(ctx context.Context, meetingID string,
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX CreateMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX CreateMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1719,7 +1750,7 @@ func (c *Client) CreateMeetingAttachment(ctx context.Context, meetingID string,
 func (c *Client) GetMeetingAttachment(ctx context.Context, meetingID, attachmentID string) (*itx.MeetingAttachment, error) {
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/meetings/%s/attachments/%s", c.config.BaseURL, meetingID, attachmentID)
-	slog.DebugContext(ctx, "ITX GetMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX GetMeetingAttachment request",
 		"method", http.MethodGet,
 		"url", url,
 		"meetingID", meetingID,
@@ -1737,7 +1768,7 @@ func (c *Client) GetMeetingAttachment(ctx context.Context, meetingID, attachment
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX GetMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX GetMeetingAttachment request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1750,7 +1781,7 @@ func (c *Client) GetMeetingAttachment(ctx context.Context, meetingID, attachment
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX GetMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX GetMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1778,7 +1809,7 @@ func (c *Client) UpdateMeetingAttachment(ctx context.Context, meetingID, attachm
 
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/meetings/%s/attachments/%s", c.config.BaseURL, meetingID, attachmentID)
-	slog.DebugContext(ctx, "ITX UpdateMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX UpdateMeetingAttachment request",
 		"method", http.MethodPut,
 		"url", url,
 		"meetingID", meetingID,
@@ -1798,7 +1829,7 @@ func (c *Client) UpdateMeetingAttachment(ctx context.Context, meetingID, attachm
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX UpdateMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX UpdateMeetingAttachment request failed", logging.ErrKey, err)
 		return domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1811,7 +1842,7 @@ func (c *Client) UpdateMeetingAttachment(ctx context.Context, meetingID, attachm
 		return domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX UpdateMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX UpdateMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1828,7 +1859,7 @@ func (c *Client) UpdateMeetingAttachment(ctx context.Context, meetingID, attachm
 func (c *Client) DeleteMeetingAttachment(ctx context.Context, meetingID, attachmentID string) error {
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/meetings/%s/attachments/%s", c.config.BaseURL, meetingID, attachmentID)
-	slog.DebugContext(ctx, "ITX DeleteMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX DeleteMeetingAttachment request",
 		"method", http.MethodDelete,
 		"url", url,
 		"meetingID", meetingID,
@@ -1845,7 +1876,7 @@ func (c *Client) DeleteMeetingAttachment(ctx context.Context, meetingID, attachm
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX DeleteMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX DeleteMeetingAttachment request failed", logging.ErrKey, err)
 		return domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1858,7 +1889,7 @@ func (c *Client) DeleteMeetingAttachment(ctx context.Context, meetingID, attachm
 		return domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX DeleteMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX DeleteMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1880,7 +1911,7 @@ func (c *Client) CreatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/past_meetings/%s/attachments", c.config.BaseURL, meetingAndOccurrenceID)
-	slog.DebugContext(ctx, "ITX CreatePastMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX CreatePastMeetingAttachment request",
 		"method", http.MethodPost,
 		"url", url,
 		"meetingAndOccurrenceID", meetingAndOccurrenceID,
@@ -1899,7 +1930,7 @@ func (c *Client) CreatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX CreatePastMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX CreatePastMeetingAttachment request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1912,7 +1943,7 @@ func (c *Client) CreatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX CreatePastMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX CreatePastMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1934,7 +1965,7 @@ func (c *Client) CreatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 func (c *Client) GetPastMeetingAttachment(ctx context.Context, meetingAndOccurrenceID, attachmentID string) (*itx.PastMeetingAttachment, error) {
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/past_meetings/%s/attachments/%s", c.config.BaseURL, meetingAndOccurrenceID, attachmentID)
-	slog.DebugContext(ctx, "ITX GetPastMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX GetPastMeetingAttachment request",
 		"method", http.MethodGet,
 		"url", url,
 		"meetingAndOccurrenceID", meetingAndOccurrenceID,
@@ -1952,7 +1983,7 @@ func (c *Client) GetPastMeetingAttachment(ctx context.Context, meetingAndOccurre
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX GetPastMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX GetPastMeetingAttachment request failed", logging.ErrKey, err)
 		return nil, domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -1965,7 +1996,7 @@ func (c *Client) GetPastMeetingAttachment(ctx context.Context, meetingAndOccurre
 		return nil, domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX GetPastMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX GetPastMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -1993,7 +2024,7 @@ func (c *Client) UpdatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/past_meetings/%s/attachments/%s", c.config.BaseURL, meetingAndOccurrenceID, attachmentID)
-	slog.DebugContext(ctx, "ITX UpdatePastMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX UpdatePastMeetingAttachment request",
 		"method", http.MethodPut,
 		"url", url,
 		"meetingAndOccurrenceID", meetingAndOccurrenceID,
@@ -2013,7 +2044,7 @@ func (c *Client) UpdatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX UpdatePastMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX UpdatePastMeetingAttachment request failed", logging.ErrKey, err)
 		return domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -2026,7 +2057,7 @@ func (c *Client) UpdatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 		return domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX UpdatePastMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX UpdatePastMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -2043,7 +2074,7 @@ func (c *Client) UpdatePastMeetingAttachment(ctx context.Context, meetingAndOccu
 func (c *Client) DeletePastMeetingAttachment(ctx context.Context, meetingAndOccurrenceID, attachmentID string) error {
 	// Create HTTP request
 	url := fmt.Sprintf("%s/v2/zoom/past_meetings/%s/attachments/%s", c.config.BaseURL, meetingAndOccurrenceID, attachmentID)
-	slog.DebugContext(ctx, "ITX DeletePastMeetingAttachment request",
+	c.logger.DebugContext(ctx, "ITX DeletePastMeetingAttachment request",
 		"method", http.MethodDelete,
 		"url", url,
 		"meetingAndOccurrenceID", meetingAndOccurrenceID,
@@ -2060,7 +2091,7 @@ func (c *Client) DeletePastMeetingAttachment(ctx context.Context, meetingAndOccu
 	// Execute request
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		slog.DebugContext(ctx, "ITX DeletePastMeetingAttachment request failed", logging.ErrKey, err)
+		c.logger.DebugContext(ctx, "ITX DeletePastMeetingAttachment request failed", logging.ErrKey, err)
 		return domain.NewUnavailableError("ITX service request failed", err)
 	}
 	defer func() {
@@ -2073,7 +2104,7 @@ func (c *Client) DeletePastMeetingAttachment(ctx context.Context, meetingAndOccu
 		return domain.NewInternalError("failed to read response", err)
 	}
 
-	slog.DebugContext(ctx, "ITX DeletePastMeetingAttachment response",
+	c.logger.DebugContext(ctx, "ITX DeletePastMeetingAttachment response",
 		"statusCode", resp.StatusCode,
 		"response", string(respBody))
 
@@ -2101,7 +2132,7 @@ func (c *Client) AcceptInvite(ctx context.Context, email, username string) error
 		return domain.NewInternalError("failed to marshal accept-invite request", err)
 	}
 
-	slog.InfoContext(ctx, "ITX AcceptInvite request", "email", redaction.RedactEmail(email), "username", redaction.Redact(username))
+	c.logger.InfoContext(ctx, "ITX AcceptInvite request", "email", redaction.RedactEmail(email), "username", redaction.Redact(username))
 
 	reqURL := fmt.Sprintf("%s/v2/zoom/meetings/invite_accepted", c.config.BaseURL)
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewReader(body))
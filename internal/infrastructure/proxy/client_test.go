@@ -0,0 +1,58 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// stubTokenSource returns err after waiting for release, if set
+type stubTokenSource struct {
+	release chan struct{}
+	err     error
+}
+
+func (s stubTokenSource) Token() (*oauth2.Token, error) {
+	if s.release != nil {
+		<-s.release
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &oauth2.Token{AccessToken: "token"}, nil
+}
+
+func TestCheckAuth(t *testing.T) {
+	t.Run("token available", func(t *testing.T) {
+		c := &Client{tokenSource: stubTokenSource{}, logger: slog.Default()}
+		assert.NoError(t, c.CheckAuth(context.Background()))
+	})
+
+	t.Run("token error is not exposed", func(t *testing.T) {
+		c := &Client{tokenSource: stubTokenSource{err: errors.New("401 access_denied: invalid client assertion")}, logger: slog.Default()}
+		err := c.CheckAuth(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, "failed to obtain ITX access token", err.Error())
+	})
+
+	t.Run("returns when the context is done", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		c := &Client{tokenSource: stubTokenSource{release: release}, logger: slog.Default()}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := c.CheckAuth(ctx)
+		require.Error(t, err)
+		assert.Equal(t, "timed out obtaining ITX access token", err.Error())
+	})
+}
@@ -0,0 +1,327 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Breaker states reported on the itx.circuit_breaker.state gauge.
+const (
+	breakerClosed   int64 = 0
+	breakerOpen     int64 = 1
+	breakerHalfOpen int64 = 2
+)
+
+// ErrCircuitOpen is returned for ITX requests rejected without being sent because the
+// circuit breaker is open.
+var ErrCircuitOpen = errors.New("ITX circuit breaker is open")
+
+// Endpoint groups that can be given their own attempt timeout in ResilienceConfig.
+const (
+	// EndpointICS is the registrant calendar (ICS) endpoint.
+	EndpointICS = "ics"
+	// EndpointAttachments is every meeting and past meeting attachment endpoint.
+	EndpointAttachments = "attachments"
+)
+
+// ResilienceConfig configures retries and the circuit breaker of the ITX client.
+type ResilienceConfig struct {
+	// MaxRetries is how many times an idempotent (GET or DELETE) request is retried after a
+	// transport error or a 502/503/504 response; 0 disables retries.
+	MaxRetries int
+	// RetryBaseDelay and RetryMaxDelay bound the jittered exponential backoff between retries.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// AttemptTimeout bounds a single attempt of an idempotent request, so a hung attempt
+	// leaves time for a retry within the client's overall Timeout; 0 means no per-attempt bound.
+	AttemptTimeout time.Duration
+	// EndpointAttemptTimeouts overrides AttemptTimeout for the endpoint groups named by the
+	// Endpoint constants, whose responses ITX renders or signs on demand and routinely take
+	// longer than other calls.
+	EndpointAttemptTimeouts map[string]time.Duration
+	// BreakerThreshold is the number of consecutive failed requests that opens the breaker;
+	// 0 disables the breaker.
+	BreakerThreshold int
+	// BreakerCooldown is how long the breaker stays open before a single probe request is sent.
+	BreakerCooldown time.Duration
+}
+
+// DefaultResilienceConfig returns the retry and breaker settings used when none are configured.
+func DefaultResilienceConfig() ResilienceConfig {
+	return ResilienceConfig{
+		MaxRetries:     2,
+		RetryBaseDelay: 100 * time.Millisecond,
+		RetryMaxDelay:  2 * time.Second,
+		AttemptTimeout: 10 * time.Second,
+		EndpointAttemptTimeouts: map[string]time.Duration{
+			EndpointICS:         25 * time.Second,
+			EndpointAttachments: 25 * time.Second,
+		},
+		BreakerThreshold: 5,
+		BreakerCooldown:  30 * time.Second,
+	}
+}
+
+// resilientTransport retries idempotent ITX requests with jittered backoff and fails fast
+// through a circuit breaker while ITX keeps failing, so a flaky or down ITX does not tie up
+// every inbound request for the full client timeout.
+type resilientTransport struct {
+	next    http.RoundTripper
+	config  ResilienceConfig
+	breaker *circuitBreaker
+	sleep   func(ctx context.Context, d time.Duration) error
+	retries metric.Int64Counter
+}
+
+func newResilientTransport(next http.RoundTripper, config ResilienceConfig) *resilientTransport {
+	t := &resilientTransport{
+		next:    next,
+		config:  config,
+		breaker: newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
+		sleep:   sleepContext,
+	}
+
+	meter := otel.Meter("github.com/linuxfoundation/lfx-v2-meeting-service/internal/infrastructure/proxy")
+	// Failed instrument registrations fall back to no-op instruments, so the errors are ignored.
+	t.retries, _ = meter.Int64Counter("itx.request.retries",
+		metric.WithDescription("ITX requests retried after a transient failure"))
+	_, _ = meter.Int64ObservableGauge("itx.circuit_breaker.state",
+		metric.WithDescription("State of the ITX circuit breaker: 0 closed, 1 open, 2 half-open"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(t.breaker.state())
+			return nil
+		}))
+	return t
+}
+
+// RoundTrip sends the request through the circuit breaker, retrying idempotent requests
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	allowed, probe := t.breaker.allow()
+	if !allowed {
+		return nil, ErrCircuitOpen
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodDelete
+	attempts := 1
+	if idempotent && req.Body == nil {
+		attempts += max(t.config.MaxRetries, 0)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.attempt(req, idempotent)
+		failed := isTransientFailure(resp, err)
+		if !failed || attempt == attempts || req.Context().Err() != nil {
+			t.record(req.Context(), probe, failed)
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		t.retries.Add(req.Context(), 1, metric.WithAttributes(attribute.String("method", req.Method)))
+		if err := t.sleep(req.Context(), t.backoff(attempt)); err != nil {
+			t.record(req.Context(), probe, true)
+			return nil, err
+		}
+	}
+}
+
+// attempt sends one attempt, bounding idempotent attempts by the endpoint's attempt timeout.
+// The attempt's context is released when the response body is closed, not when RoundTrip returns.
+func (t *resilientTransport) attempt(req *http.Request, idempotent bool) (*http.Response, error) {
+	timeout := t.attemptTimeout(req.URL.Path)
+	if !idempotent || timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// attemptTimeout returns the attempt timeout for a request path: the override of its
+// endpoint group if one is configured, AttemptTimeout otherwise
+func (t *resilientTransport) attemptTimeout(path string) time.Duration {
+	var endpoint string
+	switch {
+	case strings.HasSuffix(path, "/ics"):
+		endpoint = EndpointICS
+	case strings.Contains(path, "/attachments"):
+		endpoint = EndpointAttachments
+	}
+	if timeout, ok := t.config.EndpointAttemptTimeouts[endpoint]; endpoint != "" && ok {
+		return timeout
+	}
+	return t.config.AttemptTimeout
+}
+
+// record reports the request outcome to the breaker. A request canceled by its caller says
+// nothing about ITX, so it only releases a half-open probe; a request that ran out its
+// deadline counts as a failure.
+func (t *resilientTransport) record(ctx context.Context, probe, failed bool) {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		t.breaker.release(probe)
+		return
+	}
+	t.breaker.record(probe, failed)
+}
+
+// backoff returns the delay before the given retry: a random duration up to the
+// exponentially growing cap ("full jitter"), so retries from many requests spread out.
+func (t *resilientTransport) backoff(attempt int) time.Duration {
+	ceiling := t.config.RetryBaseDelay << (attempt - 1)
+	if ceiling <= 0 || ceiling > t.config.RetryMaxDelay {
+		ceiling = t.config.RetryMaxDelay
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling) + 1
+}
+
+// isTransientFailure reports whether an attempt failed in a way worth retrying and counting
+// against the breaker: a transport error or a gateway/unavailable response.
+func isTransientFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+// circuitBreaker opens after threshold consecutive failed ITX requests. While open, requests
+// fail fast with ErrCircuitOpen; after the cooldown a single probe request is let through,
+// and its outcome closes the breaker or re-opens it for another cooldown.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	threshold           int
+	cooldown            time.Duration
+	now                 func() time.Time
+	consecutiveFailures int
+	openUntil           time.Time
+	probing             bool
+}
+
+// newCircuitBreaker creates a breaker; a threshold of zero or less disables it.
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// allow reports whether a request may be sent now and whether it is the half-open probe,
+// whose outcome must be passed back to record or release
+func (b *circuitBreaker) allow() (allowed, probe bool) {
+	if b.threshold <= 0 {
+		return true, false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.consecutiveFailures < b.threshold {
+		return true, false
+	}
+	if b.now().Before(b.openUntil) || b.probing {
+		return false, false
+	}
+	b.probing = true
+	return true, true
+}
+
+// record stores the outcome of an allowed request. probe is the value allow returned for it;
+// requests that were already in flight when the breaker opened neither end the probe nor
+// close the breaker.
+func (b *circuitBreaker) record(probe, failed bool) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+	if !failed {
+		if probe || b.consecutiveFailures < b.threshold {
+			b.consecutiveFailures = 0
+			b.openUntil = time.Time{}
+		}
+		return
+	}
+
+	b.consecutiveFailures++
+	if probe || b.consecutiveFailures == b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+	}
+}
+
+// release ends a request without an outcome, letting another probe through if it was one
+func (b *circuitBreaker) release(probe bool) {
+	if !probe {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// state returns the breaker state for the state gauge
+func (b *circuitBreaker) state() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.threshold <= 0 || b.consecutiveFailures < b.threshold:
+		return breakerClosed
+	case b.probing || !b.now().Before(b.openUntil):
+		return breakerHalfOpen
+	default:
+		return breakerOpen
+	}
+}
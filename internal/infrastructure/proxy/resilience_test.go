@@ -0,0 +1,239 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedTransport answers each attempt with the next status code in statuses (0 means a
+// transport error) and counts the attempts it received.
+type scriptedTransport struct {
+	statuses []int
+	calls    int
+}
+
+func (s *scriptedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := s.statuses[min(s.calls, len(s.statuses)-1)]
+	s.calls++
+	if status == 0 {
+		return nil, errors.New("connection reset by peer")
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+}
+
+func newTestTransport(next http.RoundTripper, config ResilienceConfig) (*resilientTransport, *time.Time) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	t := newResilientTransport(next, config)
+	t.sleep = func(context.Context, time.Duration) error { return nil }
+	t.breaker.now = func() time.Time { return now }
+	return t, &now
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, ctx context.Context, method string) (*http.Response, error) {
+	t.Helper()
+	var body io.Reader
+	if method == http.MethodPost {
+		body = strings.NewReader("{}")
+	}
+	req, err := http.NewRequestWithContext(ctx, method, "https://itx.example.com/v2/zoom/meetings/123", body)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestResilientTransport_RetriesIdempotentRequests(t *testing.T) {
+	next := &scriptedTransport{statuses: []int{503, 0, 200}}
+	rt, _ := newTestTransport(next, ResilienceConfig{MaxRetries: 2})
+
+	resp, err := doRequest(t, rt, context.Background(), http.MethodGet)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, next.calls)
+}
+
+func TestResilientTransport_GivesUpAfterMaxRetries(t *testing.T) {
+	next := &scriptedTransport{statuses: []int{502}}
+	rt, _ := newTestTransport(next, ResilienceConfig{MaxRetries: 2})
+
+	resp, err := doRequest(t, rt, context.Background(), http.MethodDelete)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode, "the last response is returned to the client")
+	assert.Equal(t, 3, next.calls)
+}
+
+func TestResilientTransport_DoesNotRetryWrites(t *testing.T) {
+	next := &scriptedTransport{statuses: []int{503, 200}}
+	rt, _ := newTestTransport(next, ResilienceConfig{MaxRetries: 2})
+
+	resp, err := doRequest(t, rt, context.Background(), http.MethodPost)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestResilientTransport_DoesNotRetryClientErrors(t *testing.T) {
+	next := &scriptedTransport{statuses: []int{404, 200}}
+	rt, _ := newTestTransport(next, ResilienceConfig{MaxRetries: 2, BreakerThreshold: 1, BreakerCooldown: time.Minute})
+
+	resp, err := doRequest(t, rt, context.Background(), http.MethodGet)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, breakerClosed, rt.breaker.state(), "a 4xx means ITX is up")
+}
+
+func TestResilientTransport_CircuitBreaker(t *testing.T) {
+	next := &scriptedTransport{statuses: []int{503}}
+	rt, now := newTestTransport(next, ResilienceConfig{BreakerThreshold: 2, BreakerCooldown: time.Minute})
+
+	for range 2 {
+		_, err := doRequest(t, rt, context.Background(), http.MethodPost)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, breakerOpen, rt.breaker.state())
+
+	_, err := doRequest(t, rt, context.Background(), http.MethodGet)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 2, next.calls, "requests are not sent while the breaker is open")
+
+	t.Run("failed probe re-opens", func(t *testing.T) {
+		*now = now.Add(time.Minute)
+		assert.Equal(t, breakerHalfOpen, rt.breaker.state())
+		_, err := doRequest(t, rt, context.Background(), http.MethodGet)
+		require.NoError(t, err)
+		assert.Equal(t, 3, next.calls)
+		assert.Equal(t, breakerOpen, rt.breaker.state())
+	})
+
+	t.Run("successful probe closes", func(t *testing.T) {
+		*now = now.Add(time.Minute)
+		next.statuses = []int{200}
+		_, err := doRequest(t, rt, context.Background(), http.MethodGet)
+		require.NoError(t, err)
+		assert.Equal(t, breakerClosed, rt.breaker.state())
+	})
+}
+
+func TestResilientTransport_InFlightRequestDoesNotEndProbe(t *testing.T) {
+	started := make(chan string)
+	release := map[string]chan int{"slow": make(chan int), "probe": make(chan int)}
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusServiceUnavailable
+		if name := req.Header.Get("X-Test-Request"); name != "" {
+			started <- name
+			status = <-release[name]
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+	})
+	rt, now := newTestTransport(next, ResilienceConfig{BreakerThreshold: 1, BreakerCooldown: time.Minute})
+
+	send := func(name string) <-chan struct{} {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			req, _ := http.NewRequest(http.MethodGet, "https://itx.example.com/v2/zoom/meetings/123", nil)
+			req.Header.Set("X-Test-Request", name)
+			if resp, err := rt.RoundTrip(req); err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+		require.Equal(t, name, <-started)
+		return done
+	}
+
+	slowDone := send("slow")
+	_, err := doRequest(t, rt, context.Background(), http.MethodGet)
+	require.NoError(t, err)
+	require.Equal(t, breakerOpen, rt.breaker.state())
+
+	*now = now.Add(time.Minute)
+	probeDone := send("probe")
+
+	release["slow"] <- http.StatusOK
+	<-slowDone
+	assert.Equal(t, breakerHalfOpen, rt.breaker.state(), "a request sent before the breaker opened does not close it")
+	_, err = doRequest(t, rt, context.Background(), http.MethodGet)
+	assert.ErrorIs(t, err, ErrCircuitOpen, "the probe is still in flight")
+
+	release["probe"] <- http.StatusServiceUnavailable
+	<-probeDone
+	assert.Equal(t, breakerOpen, rt.breaker.state())
+}
+
+func TestResilientTransport_CanceledRequestDoesNotCount(t *testing.T) {
+	next := &scriptedTransport{statuses: []int{0}}
+	rt, _ := newTestTransport(next, ResilienceConfig{MaxRetries: 2, BreakerThreshold: 1, BreakerCooldown: time.Minute})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := doRequest(t, rt, ctx, http.MethodGet)
+
+	require.Error(t, err)
+	assert.Equal(t, 1, next.calls, "a canceled request is not retried")
+	assert.Equal(t, breakerClosed, rt.breaker.state())
+}
+
+func TestResilientTransport_AttemptTimeoutReleasedOnClose(t *testing.T) {
+	var attemptCtx context.Context
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attemptCtx = req.Context()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})
+	rt, _ := newTestTransport(next, ResilienceConfig{AttemptTimeout: time.Minute})
+
+	req, err := http.NewRequest(http.MethodGet, "https://itx.example.com/v2/zoom/meetings/123", nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	_, deadlineSet := attemptCtx.Deadline()
+	assert.True(t, deadlineSet)
+	assert.NoError(t, attemptCtx.Err(), "the attempt context stays live until the body is closed")
+	require.NoError(t, resp.Body.Close())
+	assert.ErrorIs(t, attemptCtx.Err(), context.Canceled)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestResilientTransport_Backoff(t *testing.T) {
+	rt, _ := newTestTransport(nil, ResilienceConfig{RetryBaseDelay: 100 * time.Millisecond, RetryMaxDelay: 300 * time.Millisecond})
+
+	for range 50 {
+		assert.LessOrEqual(t, rt.backoff(1), 100*time.Millisecond)
+		assert.LessOrEqual(t, rt.backoff(2), 200*time.Millisecond)
+		assert.LessOrEqual(t, rt.backoff(10), 300*time.Millisecond)
+		assert.LessOrEqual(t, rt.backoff(100), 300*time.Millisecond, "large attempts do not overflow the cap")
+		assert.Positive(t, rt.backoff(1))
+	}
+}
+
+func TestResilientTransport_EndpointAttemptTimeouts(t *testing.T) {
+	rt, _ := newTestTransport(&scriptedTransport{statuses: []int{http.StatusOK}}, ResilienceConfig{
+		AttemptTimeout:          10 * time.Second,
+		EndpointAttemptTimeouts: map[string]time.Duration{EndpointICS: 25 * time.Second},
+	})
+
+	assert.Equal(t, 25*time.Second, rt.attemptTimeout("/v2/zoom/meetings/123/registrants/r1/ics"))
+	assert.Equal(t, 10*time.Second, rt.attemptTimeout("/v2/zoom/meetings/123/attachments/a1"), "groups without an override use AttemptTimeout")
+	assert.Equal(t, 10*time.Second, rt.attemptTimeout("/v2/zoom/meetings/123"))
+}
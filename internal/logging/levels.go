@@ -0,0 +1,253 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+)
+
+// Subsystem names accepted in LOG_LEVEL_SUBSYSTEMS.
+const (
+	SubsystemEventing       = "eventing"
+	SubsystemITX            = "itx"
+	SubsystemInvites        = "invites"
+	SubsystemPreferredEmail = "preferred_email"
+	SubsystemUserMetadata   = "user_metadata"
+)
+
+// knownSubsystems lists the subsystem names above; overrides for other names are applied but
+// warned about, since they are most likely typos.
+var knownSubsystems = []string{
+	SubsystemEventing, SubsystemITX, SubsystemInvites, SubsystemPreferredEmail, SubsystemUserMetadata,
+}
+
+var (
+	// globalLevel is the LOG_LEVEL threshold applied to loggers without a subsystem override.
+	globalLevel slog.LevelVar
+
+	// subsystemLevels holds the current per-subsystem overrides. It is swapped as a whole
+	// on reload so readers never observe a partially applied configuration.
+	subsystemLevels atomic.Pointer[map[string]slog.Level]
+
+	// envSubsystemLevels and fileSubsystemLevels are the overrides from LOG_LEVEL_SUBSYSTEMS
+	// and LOG_LEVEL_SUBSYSTEMS_FILE that subsystemLevels is merged from.
+	levelsMu            sync.Mutex
+	envSubsystemLevels  map[string]slog.Level
+	fileSubsystemLevels map[string]slog.Level
+
+	// rootHandler is the handler chain (context + otel + JSON) shared by all loggers.
+	rootHandler slog.Handler
+)
+
+// levelHandler filters records against the global level or, for subsystem loggers, the
+// subsystem's override. The wrapped handler is configured to accept every level so that
+// an override can be more verbose than LOG_LEVEL.
+type levelHandler struct {
+	next      slog.Handler
+	subsystem string
+}
+
+// Enabled reports whether the record level meets the effective level for the subsystem
+func (h levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= effectiveLevel(h.subsystem) && h.next.Enabled(ctx, level)
+}
+
+// Handle passes the record to the wrapped handler
+func (h levelHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a levelHandler for the same subsystem wrapping the derived handler
+func (h levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return levelHandler{next: h.next.WithAttrs(attrs), subsystem: h.subsystem}
+}
+
+// WithGroup returns a levelHandler for the same subsystem wrapping the derived handler
+func (h levelHandler) WithGroup(name string) slog.Handler {
+	return levelHandler{next: h.next.WithGroup(name), subsystem: h.subsystem}
+}
+
+func effectiveLevel(subsystem string) slog.Level {
+	if subsystem != "" {
+		if levels := subsystemLevels.Load(); levels != nil {
+			if level, ok := (*levels)[subsystem]; ok {
+				return level
+			}
+		}
+	}
+	return globalLevel.Level()
+}
+
+// Subsystem returns a logger tagged with the given subsystem name whose level can be
+// overridden independently of LOG_LEVEL via LOG_LEVEL_SUBSYSTEMS.
+func Subsystem(name string) *slog.Logger {
+	next := rootHandler
+	if next == nil {
+		// Logging has not been initialized (e.g. in tests); fall back to the default handler.
+		next = slog.Default().Handler()
+	}
+	return slog.New(levelHandler{next: next, subsystem: name}).With("subsystem", name)
+}
+
+// Level returns the global LOG_LEVEL threshold
+func Level() slog.Level {
+	return globalLevel.Level()
+}
+
+// SubsystemLevels returns a copy of the current per-subsystem level overrides
+func SubsystemLevels() map[string]slog.Level {
+	levels := make(map[string]slog.Level)
+	if current := subsystemLevels.Load(); current != nil {
+		maps.Copy(levels, *current)
+	}
+	return levels
+}
+
+// SetSubsystemLevels replaces the LOG_LEVEL_SUBSYSTEMS overrides with the given spec, a
+// comma- or newline-separated list of subsystem=level pairs (e.g. "eventing=debug,itx=warn").
+// An empty spec clears them. Overrides loaded from LOG_LEVEL_SUBSYSTEMS_FILE take precedence
+// for the subsystems they name. The current overrides are kept if the spec is invalid.
+func SetSubsystemLevels(spec string) error {
+	levels, err := parseSubsystemLevels(spec)
+	if err != nil {
+		return err
+	}
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	envSubsystemLevels = levels
+	storeSubsystemLevels()
+	return nil
+}
+
+// setFileSubsystemLevels replaces the LOG_LEVEL_SUBSYSTEMS_FILE overrides with the given spec
+func setFileSubsystemLevels(spec string) error {
+	levels, err := parseSubsystemLevels(spec)
+	if err != nil {
+		return err
+	}
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	fileSubsystemLevels = levels
+	storeSubsystemLevels()
+	return nil
+}
+
+// storeSubsystemLevels publishes the env overrides merged with the file overrides, the file
+// winning for subsystems named in both. Callers must hold levelsMu.
+func storeSubsystemLevels() {
+	levels := make(map[string]slog.Level, len(envSubsystemLevels)+len(fileSubsystemLevels))
+	maps.Copy(levels, envSubsystemLevels)
+	maps.Copy(levels, fileSubsystemLevels)
+	subsystemLevels.Store(&levels)
+}
+
+// parseSubsystemLevels parses a subsystem=level spec
+func parseSubsystemLevels(spec string) (map[string]slog.Level, error) {
+	levels := make(map[string]slog.Level)
+	for _, entry := range strings.FieldsFunc(spec, func(r rune) bool { return r == ',' || r == '\n' }) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid subsystem log level %q: expected subsystem=level", entry)
+		}
+		level, ok := parseLevel(strings.TrimSpace(value))
+		if !ok {
+			return nil, fmt.Errorf("invalid log level %q for subsystem %q", strings.TrimSpace(value), strings.TrimSpace(name))
+		}
+		levels[strings.TrimSpace(name)] = level
+	}
+	return levels, nil
+}
+
+// unknownSubsystems returns the subsystem names in spec that no logger uses, or nil if the
+// spec is invalid
+func unknownSubsystems(spec string) []string {
+	levels, err := parseSubsystemLevels(spec)
+	if err != nil {
+		return nil
+	}
+	var unknown []string
+	for name := range levels {
+		if !slices.Contains(knownSubsystems, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	return unknown
+}
+
+// WatchSubsystemLevels loads per-subsystem level overrides from the file named by
+// LOG_LEVEL_SUBSYSTEMS_FILE and reloads it whenever the process receives SIGHUP, so operators
+// can turn on debug logging for a single subsystem without a restart. The file uses the same
+// format as LOG_LEVEL_SUBSYSTEMS and is merged with it: a subsystem named in the file uses the
+// file's level, and one named only in LOG_LEVEL_SUBSYSTEMS keeps its environment level, which
+// it returns to when removed from the file. It is a no-op when the variable is not set, and
+// stops when ctx is done.
+func WatchSubsystemLevels(ctx context.Context) {
+	path := os.Getenv("LOG_LEVEL_SUBSYSTEMS_FILE")
+	if path == "" {
+		return
+	}
+	reloadSubsystemLevels(ctx, path)
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				reloadSubsystemLevels(ctx, path)
+			}
+		}
+	}()
+}
+
+func reloadSubsystemLevels(ctx context.Context, path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		slog.With(ErrKey, err).WarnContext(ctx, "failed to read subsystem log levels file", "path", path)
+		return
+	}
+	if err := setFileSubsystemLevels(string(data)); err != nil {
+		slog.With(ErrKey, err).WarnContext(ctx, "invalid subsystem log levels file; keeping current levels", "path", path)
+		return
+	}
+	if unknown := unknownSubsystems(string(data)); len(unknown) > 0 {
+		slog.WarnContext(ctx, "subsystem log levels file names unknown subsystems", "path", path,
+			"unknown", unknown, "known", knownSubsystems)
+	}
+	slog.InfoContext(ctx, "subsystem log levels reloaded", "path", path, "levels", strings.TrimSpace(string(data)))
+}
+
+// parseLevel maps a LOG_LEVEL style name to its slog level
+func parseLevel(name string) (slog.Level, bool) {
+	switch name {
+	case debug:
+		return slog.LevelDebug, true
+	case warn:
+		return slog.LevelWarn, true
+	case err:
+		return slog.LevelError, true
+	case info:
+		return slog.LevelInfo, true
+	default:
+		return 0, false
+	}
+}
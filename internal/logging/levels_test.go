@@ -0,0 +1,151 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// withTestRootHandler routes subsystem loggers into buf at the given global level and
+// restores the package state when the test finishes.
+func withTestRootHandler(t *testing.T, buf *bytes.Buffer, level slog.Level) {
+	t.Helper()
+	prevRoot, prevLevel, prevOverrides := rootHandler, globalLevel.Level(), subsystemLevels.Load()
+	prevEnv, prevFile := envSubsystemLevels, fileSubsystemLevels
+	t.Cleanup(func() {
+		rootHandler = prevRoot
+		globalLevel.Set(prevLevel)
+		subsystemLevels.Store(prevOverrides)
+		envSubsystemLevels, fileSubsystemLevels = prevEnv, prevFile
+	})
+
+	rootHandler = slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	globalLevel.Set(level)
+	subsystemLevels.Store(nil)
+	envSubsystemLevels, fileSubsystemLevels = nil, nil
+}
+
+func TestSubsystem_LevelOverrides(t *testing.T) {
+	var buf bytes.Buffer
+	withTestRootHandler(t, &buf, slog.LevelInfo)
+
+	if err := SetSubsystemLevels("eventing=debug, itx=error"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	Subsystem(SubsystemEventing).DebugContext(ctx, "eventing debug")
+	Subsystem(SubsystemITX).WarnContext(ctx, "itx warn")
+	Subsystem(SubsystemInvites).DebugContext(ctx, "invites debug")
+	Subsystem(SubsystemInvites).InfoContext(ctx, "invites info")
+
+	out := buf.String()
+	if !strings.Contains(out, "eventing debug") || !strings.Contains(out, "subsystem=eventing") {
+		t.Errorf("expected eventing debug record with subsystem attribute, got %q", out)
+	}
+	if strings.Contains(out, "itx warn") {
+		t.Errorf("expected itx warn to be filtered by the error override, got %q", out)
+	}
+	if strings.Contains(out, "invites debug") {
+		t.Errorf("expected invites debug to be filtered by the global level, got %q", out)
+	}
+	if !strings.Contains(out, "invites info") {
+		t.Errorf("expected invites info record, got %q", out)
+	}
+}
+
+func TestSubsystem_OverrideAppliesToDerivedLoggers(t *testing.T) {
+	var buf bytes.Buffer
+	withTestRootHandler(t, &buf, slog.LevelError)
+
+	logger := Subsystem(SubsystemEventing).With("handler", "meetings")
+	if err := SetSubsystemLevels("eventing=debug"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	logger.Debug("after reload")
+
+	if !strings.Contains(buf.String(), "after reload") {
+		t.Errorf("expected override set after logger creation to apply, got %q", buf.String())
+	}
+}
+
+func TestSetSubsystemLevels(t *testing.T) {
+	var buf bytes.Buffer
+	withTestRootHandler(t, &buf, slog.LevelInfo)
+
+	testCases := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"empty", "", false},
+		{"single", "eventing=debug", false},
+		{"newline separated with comments", "# incident 123\neventing=debug\nitx=warn\n", false},
+		{"missing separator", "eventing", true},
+		{"unknown level", "eventing=verbose", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := SetSubsystemLevels(tc.spec)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("SetSubsystemLevels(%q) error = %v, wantErr %v", tc.spec, err, tc.wantErr)
+			}
+		})
+	}
+
+	// An invalid spec must leave the previous overrides in place.
+	if err := SetSubsystemLevels("eventing=debug"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = SetSubsystemLevels("eventing=bogus")
+	if got := effectiveLevel(SubsystemEventing); got != slog.LevelDebug {
+		t.Errorf("expected eventing level to remain debug after invalid spec, got %v", got)
+	}
+}
+
+func TestSubsystemLevels_FileMergesOverEnv(t *testing.T) {
+	var buf bytes.Buffer
+	withTestRootHandler(t, &buf, slog.LevelInfo)
+
+	if err := SetSubsystemLevels("eventing=debug,itx=warn"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := setFileSubsystemLevels("itx=debug\ninvites=error"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]slog.Level{
+		SubsystemEventing: slog.LevelDebug, // only in the environment
+		SubsystemITX:      slog.LevelDebug, // the file wins
+		SubsystemInvites:  slog.LevelError, // only in the file
+	}
+	for subsystem, level := range want {
+		if got := effectiveLevel(subsystem); got != level {
+			t.Errorf("effectiveLevel(%q) = %v, want %v", subsystem, got, level)
+		}
+	}
+
+	// Removing an entry from the file reverts the subsystem to its environment level.
+	if err := setFileSubsystemLevels(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := effectiveLevel(SubsystemITX); got != slog.LevelWarn {
+		t.Errorf("expected itx to return to its environment level, got %v", got)
+	}
+}
+
+func TestUnknownSubsystems(t *testing.T) {
+	got := unknownSubsystems("eventing=debug,evnting=debug,zoom=warn")
+	if strings.Join(got, ",") != "evnting,zoom" {
+		t.Errorf("unknownSubsystems = %v, want [evnting zoom]", got)
+	}
+	if got := unknownSubsystems("eventing=debug,itx=warn"); len(got) != 0 {
+		t.Errorf("expected no unknown subsystems, got %v", got)
+	}
+}
@@ -72,25 +72,21 @@ func AppendCtx(parent context.Context, attr slog.Attr) context.Context {
 	return context.WithValue(parent, slogFields, v)
 }
 
-// InitStructureLogConfig sets the structured log behavior
+// InitStructureLogConfig sets the structured log behavior and returns the default logger's
+// handler, which applies LOG_LEVEL and the per-subsystem overrides
 func InitStructureLogConfig() slog.Handler {
 	logOptions := &slog.HandlerOptions{}
 	var h slog.Handler
 
-	// Configure log level
-	logLevel := os.Getenv("LOG_LEVEL")
-	switch logLevel {
-	case debug:
-		logOptions.Level = slog.LevelDebug
-	case warn:
-		logOptions.Level = slog.LevelWarn
-	case err:
-		logOptions.Level = slog.LevelError
-	case info:
-		logOptions.Level = slog.LevelInfo
-	default:
-		logOptions.Level = logLevelDefault
+	// Configure log level. The JSON handler accepts every level; LOG_LEVEL and any
+	// per-subsystem overrides are applied by levelHandler.
+	logLevel, ok := parseLevel(os.Getenv("LOG_LEVEL"))
+	if !ok {
+		logLevel = logLevelDefault
 	}
+	globalLevel.Set(logLevel)
+	logOptions.Level = slog.LevelDebug
+	subsystemLevelsErr := SetSubsystemLevels(os.Getenv("LOG_LEVEL_SUBSYSTEMS"))
 
 	// Configure source information
 	addSource := os.Getenv("LOG_ADD_SOURCE")
@@ -103,15 +99,21 @@ func InitStructureLogConfig() slog.Handler {
 	otelHandler := slogotel.OtelHandler{Next: h}
 
 	// Wrap with contextHandler to support context-based attributes
-	logger := contextHandler{otelHandler}
-	slog.SetDefault(slog.New(logger))
+	rootHandler = contextHandler{otelHandler}
+	slog.SetDefault(slog.New(levelHandler{next: rootHandler}))
 
 	slog.Info("log config",
-		"logLevel", logOptions.Level,
+		"logLevel", logLevel,
+		"subsystemLevels", os.Getenv("LOG_LEVEL_SUBSYSTEMS"),
 		"addSource", logOptions.AddSource,
 	)
+	if subsystemLevelsErr != nil {
+		slog.With(ErrKey, subsystemLevelsErr).Warn("ignoring invalid LOG_LEVEL_SUBSYSTEMS")
+	} else if unknown := unknownSubsystems(os.Getenv("LOG_LEVEL_SUBSYSTEMS")); len(unknown) > 0 {
+		slog.Warn("LOG_LEVEL_SUBSYSTEMS names unknown subsystems", "unknown", unknown, "known", knownSubsystems)
+	}
 
-	return h
+	return slog.Default().Handler()
 }
 
 // Priority creates a slog.Attr for error priority classification
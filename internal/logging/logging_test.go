@@ -212,6 +212,9 @@ func TestInitStructureLogConfig_WithLogLevel(t *testing.T) {
 			if handler == nil {
 				t.Error("expected non-nil handler")
 			}
+			if tc.logLevel == "info" && handler.Enabled(context.Background(), slog.LevelDebug) {
+				t.Error("expected the returned handler to apply LOG_LEVEL")
+			}
 		})
 	}
 }
@@ -0,0 +1,156 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+// Attachment rejection reasons recorded on the attachment.upload.rejected metric.
+const (
+	AttachmentRejectTooLarge    = "too_large"
+	AttachmentRejectContentType = "content_type_not_allowed"
+	AttachmentRejectExtension   = "extension_not_allowed"
+)
+
+// AttachmentPolicy limits the file uploads the service will issue presigned URLs for.
+// File bytes go straight from the client to S3 and never pass through the service, so the
+// policy is checked against the size and MIME type declared in the presign request, with
+// the file name's extension checked as well rather than trusting the declared type alone.
+//
+// The declared file_size and file_type are forwarded to ITX in the presign request, but the
+// ITX presign contract does not say whether they are signed into the URL as Content-Length
+// and Content-Type. Until ITX confirms it does, a client can upload a different size or type
+// than it declared, so the policy screens honest mistakes rather than enforcing a limit.
+type AttachmentPolicy struct {
+	// MaxFileSize is the largest accepted file size in bytes; zero or less means no limit.
+	MaxFileSize int64
+	// AllowedContentTypes is the MIME type allowlist (e.g. "application/pdf", or "image/*"
+	// for a whole type); empty allows any type.
+	AllowedContentTypes []string
+
+	rejections metric.Int64Counter
+}
+
+// NewAttachmentPolicy creates an attachment policy with the given limits
+func NewAttachmentPolicy(maxFileSize int64, allowedContentTypes []string) *AttachmentPolicy {
+	p := &AttachmentPolicy{MaxFileSize: maxFileSize}
+	for _, contentType := range allowedContentTypes {
+		if contentType = strings.ToLower(strings.TrimSpace(contentType)); contentType != "" {
+			p.AllowedContentTypes = append(p.AllowedContentTypes, contentType)
+		}
+	}
+	// A failed instrument registration falls back to a no-op counter, so the error is ignored.
+	p.rejections, _ = otel.Meter("github.com/linuxfoundation/lfx-v2-meeting-service/internal/service/itx").Int64Counter(
+		"attachment.upload.rejected",
+		metric.WithDescription("Attachment uploads rejected by the attachment policy"),
+	)
+	return p
+}
+
+// Validate checks a presign request against the policy, returning a validation error that
+// names the violated limit. A nil policy accepts every request.
+func (p *AttachmentPolicy) Validate(ctx context.Context, req *itx.CreateAttachmentPresignRequest) error {
+	if p == nil {
+		return nil
+	}
+
+	if p.MaxFileSize > 0 && req.FileSize > p.MaxFileSize {
+		p.reject(ctx, AttachmentRejectTooLarge)
+		return domain.NewValidationError(fmt.Sprintf("file_size %d exceeds the maximum attachment size of %d bytes", req.FileSize, p.MaxFileSize))
+	}
+
+	if len(p.AllowedContentTypes) == 0 {
+		return nil
+	}
+	declared, _, err := mime.ParseMediaType(req.FileType)
+	if err != nil || !p.allows(declared) {
+		p.reject(ctx, AttachmentRejectContentType)
+		return domain.NewValidationError(fmt.Sprintf("file_type %q is not allowed; allowed types: %s", req.FileType, strings.Join(p.AllowedContentTypes, ", ")))
+	}
+
+	// The declared type is client-supplied, so the type implied by the file name must be allowed
+	// too; this catches e.g. a .html file declared as application/pdf. Extensions not in
+	// extensionTypes are judged by the declared type alone.
+	if implied, ok := extensionTypes[strings.ToLower(filepath.Ext(req.Name))]; ok && !p.allows(implied) {
+		p.reject(ctx, AttachmentRejectExtension)
+		return domain.NewValidationError(fmt.Sprintf("file name %q implies type %q, which is not allowed", req.Name, implied))
+	}
+
+	return nil
+}
+
+// extensionTypes maps file extensions to the media type they imply. It is fixed rather than
+// read from mime.TypeByExtension, which also loads the host's MIME tables (/etc/mime.types
+// and the like), so that the policy does not change with the container image.
+var extensionTypes = map[string]string{
+	".7z":    "application/x-7z-compressed",
+	".bmp":   "image/bmp",
+	".css":   "text/css",
+	".csv":   "text/csv",
+	".doc":   "application/msword",
+	".docx":  "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".exe":   "application/vnd.microsoft.portable-executable",
+	".gif":   "image/gif",
+	".gz":    "application/gzip",
+	".htm":   "text/html",
+	".html":  "text/html",
+	".ics":   "text/calendar",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript",
+	".json":  "application/json",
+	".key":   "application/vnd.apple.keynote",
+	".m4a":   "audio/mp4",
+	".md":    "text/markdown",
+	".mov":   "video/quicktime",
+	".mp3":   "audio/mpeg",
+	".mp4":   "video/mp4",
+	".odp":   "application/vnd.oasis.opendocument.presentation",
+	".ods":   "application/vnd.oasis.opendocument.spreadsheet",
+	".odt":   "application/vnd.oasis.opendocument.text",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".ppt":   "application/vnd.ms-powerpoint",
+	".pptx":  "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".rtf":   "application/rtf",
+	".sh":    "application/x-sh",
+	".svg":   "image/svg+xml",
+	".tar":   "application/x-tar",
+	".tif":   "image/tiff",
+	".tiff":  "image/tiff",
+	".txt":   "text/plain",
+	".wav":   "audio/wav",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".xhtml": "application/xhtml+xml",
+	".xls":   "application/vnd.ms-excel",
+	".xlsx":  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".xml":   "application/xml",
+	".zip":   "application/zip",
+}
+
+// allows reports whether a media type matches the allowlist, exactly or by a "type/*" entry
+func (p *AttachmentPolicy) allows(mediaType string) bool {
+	major, _, _ := strings.Cut(mediaType, "/")
+	return slices.Contains(p.AllowedContentTypes, mediaType) || slices.Contains(p.AllowedContentTypes, major+"/*")
+}
+
+func (p *AttachmentPolicy) reject(ctx context.Context, reason string) {
+	if p.rejections != nil {
+		p.rejections.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+func TestAttachmentPolicy_Validate(t *testing.T) {
+	policy := NewAttachmentPolicy(10<<20, []string{"application/pdf", " Image/* ", ""})
+
+	tests := []struct {
+		name    string
+		req     itx.CreateAttachmentPresignRequest
+		wantErr string
+	}{
+		{
+			name: "allowed type within size limit",
+			req:  itx.CreateAttachmentPresignRequest{Name: "minutes.pdf", FileSize: 1024, FileType: "application/pdf"},
+		},
+		{
+			name: "wildcard type with parameters",
+			req:  itx.CreateAttachmentPresignRequest{Name: "slide.png", FileSize: 1024, FileType: "image/png; charset=binary"},
+		},
+		{
+			name: "unknown extension judged by declared type",
+			req:  itx.CreateAttachmentPresignRequest{Name: "minutes.final", FileSize: 1024, FileType: "application/pdf"},
+		},
+		{
+			name:    "too large",
+			req:     itx.CreateAttachmentPresignRequest{Name: "minutes.pdf", FileSize: 10<<20 + 1, FileType: "application/pdf"},
+			wantErr: "exceeds the maximum attachment size",
+		},
+		{
+			name:    "type not in allowlist",
+			req:     itx.CreateAttachmentPresignRequest{Name: "notes.txt", FileSize: 1024, FileType: "text/plain"},
+			wantErr: `file_type "text/plain" is not allowed`,
+		},
+		{
+			name:    "invalid type",
+			req:     itx.CreateAttachmentPresignRequest{Name: "minutes.pdf", FileSize: 1024, FileType: "pdf"},
+			wantErr: `file_type "pdf" is not allowed`,
+		},
+		{
+			name:    "declared type contradicted by extension",
+			req:     itx.CreateAttachmentPresignRequest{Name: "page.html", FileSize: 1024, FileType: "application/pdf"},
+			wantErr: `implies type "text/html"`,
+		},
+		{
+			name:    "extension matched case-insensitively",
+			req:     itx.CreateAttachmentPresignRequest{Name: "Minutes.DOCX", FileSize: 1024, FileType: "application/pdf"},
+			wantErr: `implies type "application/vnd.openxmlformats-officedocument.wordprocessingml.document"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(context.Background(), &tt.req)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestAttachmentPolicy_NoLimits(t *testing.T) {
+	req := &itx.CreateAttachmentPresignRequest{Name: "archive.bin", FileSize: 1 << 40, FileType: "whatever"}
+
+	assert.NoError(t, NewAttachmentPolicy(0, nil).Validate(context.Background(), req))
+
+	var nilPolicy *AttachmentPolicy
+	assert.NoError(t, nilPolicy.Validate(context.Background(), req))
+}
@@ -0,0 +1,175 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+// joinLinkCacheKey identifies a join link by every request field forwarded to ITX. The
+// occurrence ID is resolved by the proxy after the lookup, so it is not part of the key.
+type joinLinkCacheKey struct {
+	meetingID string
+	useEmail  bool
+	userID    string
+	name      string
+	email     string
+	register  bool
+}
+
+type joinLinkCacheEntry struct {
+	link      itx.ZoomMeetingJoinLink
+	expiresAt time.Time
+}
+
+type occurrencesCacheEntry struct {
+	occurrences []itx.Occurrence
+	expiresAt   time.Time
+}
+
+// JoinLinkCache is a short-TTL, per-replica cache of ITX join links keyed by meeting and
+// user, so repeated join-link and launch requests (clients retry and poll around start
+// time) do not each round-trip to ITX. It also holds each meeting's occurrences, which
+// occurrence-scoped join links are checked against. It is shared by MeetingService and
+// RegistrantService: entries for a meeting are dropped when the meeting, one of its
+// occurrences or one of its registrants is changed through this service; the TTL bounds
+// staleness for changes made elsewhere.
+type JoinLinkCache struct {
+	mu          sync.Mutex
+	ttl         time.Duration
+	now         func() time.Time
+	entries     map[joinLinkCacheKey]joinLinkCacheEntry
+	occurrences map[string]occurrencesCacheEntry
+}
+
+// NewJoinLinkCache creates a join link cache with the given TTL. A TTL of zero or less
+// returns nil, which leaves caching off.
+func NewJoinLinkCache(ttl time.Duration) *JoinLinkCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &JoinLinkCache{
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     make(map[joinLinkCacheKey]joinLinkCacheEntry),
+		occurrences: make(map[string]occurrencesCacheEntry),
+	}
+}
+
+func joinLinkKey(req *itx.GetJoinLinkRequest) joinLinkCacheKey {
+	return joinLinkCacheKey{
+		meetingID: req.MeetingID,
+		useEmail:  req.UseEmail,
+		userID:    req.UserID,
+		name:      req.Name,
+		email:     req.Email,
+		register:  req.Register,
+	}
+}
+
+// get returns a copy of the cached join link for the request, if it has not expired
+func (c *JoinLinkCache) get(req *itx.GetJoinLinkRequest) (*itx.ZoomMeetingJoinLink, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := joinLinkKey(req)
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	link := entry.link
+	return &link, true
+}
+
+// put caches a copy of the join link returned by ITX for the request
+func (c *JoinLinkCache) put(req *itx.GetJoinLinkRequest, link *itx.ZoomMeetingJoinLink) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.sweep(now)
+	c.entries[joinLinkKey(req)] = joinLinkCacheEntry{link: *link, expiresAt: now.Add(c.ttl)}
+}
+
+// getOccurrences returns the cached occurrences of the meeting, if they have not expired
+func (c *JoinLinkCache) getOccurrences(meetingID string) ([]itx.Occurrence, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.occurrences[meetingID]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.occurrences, meetingID)
+		return nil, false
+	}
+	return slices.Clone(entry.occurrences), true
+}
+
+// putOccurrences caches a copy of the meeting's occurrences
+func (c *JoinLinkCache) putOccurrences(meetingID string, occurrences []itx.Occurrence) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.sweep(now)
+	c.occurrences[meetingID] = occurrencesCacheEntry{occurrences: slices.Clone(occurrences), expiresAt: now.Add(c.ttl)}
+}
+
+// sweep drops expired entries as new ones are added so the maps do not grow without bound.
+// The caller must hold mu.
+func (c *JoinLinkCache) sweep(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	for meetingID, entry := range c.occurrences {
+		if !now.Before(entry.expiresAt) {
+			delete(c.occurrences, meetingID)
+		}
+	}
+}
+
+// invalidate drops every cached join link and the cached occurrences of the meeting
+func (c *JoinLinkCache) invalidate(meetingID string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.meetingID == meetingID {
+			delete(c.entries, key)
+		}
+	}
+	delete(c.occurrences, meetingID)
+}
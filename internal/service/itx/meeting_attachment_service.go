@@ -13,12 +13,14 @@ import (
 // MeetingAttachmentService handles ITX meeting attachment operations
 type MeetingAttachmentService struct {
 	attachmentClient domain.ITXMeetingAttachmentClient
+	policy           *AttachmentPolicy
 }
 
-// NewMeetingAttachmentService creates a new ITX meeting attachment service
-func NewMeetingAttachmentService(attachmentClient domain.ITXMeetingAttachmentClient) *MeetingAttachmentService {
+// NewMeetingAttachmentService creates a new ITX meeting attachment service; a nil policy places no limits on uploads
+func NewMeetingAttachmentService(attachmentClient domain.ITXMeetingAttachmentClient, policy *AttachmentPolicy) *MeetingAttachmentService {
 	return &MeetingAttachmentService{
 		attachmentClient: attachmentClient,
+		policy:           policy,
 	}
 }
 
@@ -42,8 +44,11 @@ func (s *MeetingAttachmentService) DeleteMeetingAttachment(ctx context.Context,
 	return s.attachmentClient.DeleteMeetingAttachment(ctx, meetingID, attachmentID)
 }
 
-// CreateMeetingAttachmentPresignURL generates a presigned URL for meeting attachment upload via ITX proxy
+// CreateMeetingAttachmentPresignURL checks the upload against the attachment policy and generates a presigned URL for it via ITX proxy
 func (s *MeetingAttachmentService) CreateMeetingAttachmentPresignURL(ctx context.Context, meetingID string, req *itx.CreateAttachmentPresignRequest) (*itx.MeetingAttachmentPresignResponse, error) {
+	if err := s.policy.Validate(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.attachmentClient.CreateMeetingAttachmentPresignURL(ctx, meetingID, req)
 }
 
@@ -0,0 +1,86 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+// Launch client choices accepted by GetMeetingLaunchLink
+const (
+	LaunchClientAuto   = "auto"
+	LaunchClientNative = "native"
+	LaunchClientWeb    = "web"
+)
+
+// GetMeetingLaunchLink resolves the user's join link like GetMeetingJoinLink and returns the
+// URL to redirect them to: a zoommtg:// deep link when the native client is requested (or, for
+// "auto", when the User-Agent is a desktop browser), otherwise the web join link. Every launch
+// is logged so joins through the native client are still visible.
+func (s *MeetingService) GetMeetingLaunchLink(ctx context.Context, req *itx.GetJoinLinkRequest, client, userAgent string) (string, error) {
+	resp, err := s.GetMeetingJoinLink(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	location, chosen := resp.Link, LaunchClientWeb
+	if client == LaunchClientNative || (client != LaunchClientWeb && isDesktopUserAgent(userAgent)) {
+		if deepLink, ok := zoomDeepLink(resp.Link); ok {
+			location, chosen = deepLink, LaunchClientNative
+		}
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	s.logger.InfoContext(ctx, "meeting launch",
+		"meeting_id", req.MeetingID,
+		"occurrence_id", req.OccurrenceID,
+		"username", principal,
+		"client", chosen,
+	)
+
+	return location, nil
+}
+
+// zoomDeepLink converts a Zoom web join link (https://<host>/j/<id> or /w/<id>) into the
+// equivalent zoommtg:// link, keeping query parameters such as pwd and the registrant tk.
+func zoomDeepLink(webLink string) (string, bool) {
+	parsed, err := url.Parse(webLink)
+	if err != nil || parsed.Host == "" {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) != 2 || (segments[0] != "j" && segments[0] != "w") || segments[1] == "" {
+		return "", false
+	}
+
+	query := parsed.Query()
+	query.Set("action", "join")
+	query.Set("confno", segments[1])
+
+	deepLink := url.URL{Scheme: "zoommtg", Host: parsed.Host, Path: "/join", RawQuery: query.Encode()}
+	return deepLink.String(), true
+}
+
+// isDesktopUserAgent reports whether the User-Agent looks like a desktop browser, where the
+// native Zoom client handles zoommtg:// links. Mobile browsers get the web link, which the
+// Zoom mobile app intercepts on its own.
+func isDesktopUserAgent(userAgent string) bool {
+	for _, mobile := range []string{"Android", "iPhone", "iPad", "Mobile"} {
+		if strings.Contains(userAgent, mobile) {
+			return false
+		}
+	}
+	for _, desktop := range []string{"Windows", "Macintosh", "X11", "CrOS"} {
+		if strings.Contains(userAgent, desktop) {
+			return true
+		}
+	}
+	return false
+}
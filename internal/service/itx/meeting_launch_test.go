@@ -0,0 +1,65 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+const (
+	desktopUA = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36"
+	mobileUA  = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148"
+)
+
+func TestZoomDeepLink(t *testing.T) {
+	testCases := []struct {
+		name    string
+		webLink string
+		want    string
+		wantOK  bool
+	}{
+		{"join link with passcode", "https://zoom.us/j/123456789?pwd=abc", "zoommtg://zoom.us/join?action=join&confno=123456789&pwd=abc", true},
+		{"registrant link keeps token", "https://lf.zoom.us/w/123456789?tk=tok&pwd=abc", "zoommtg://lf.zoom.us/join?action=join&confno=123456789&pwd=abc&tk=tok", true},
+		{"unexpected path", "https://zoom.us/meeting/register/abc", "", false},
+		{"not a URL", "::", "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := zoomDeepLink(tc.webLink)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestMeetingService_GetMeetingLaunchLink(t *testing.T) {
+	svc := NewMeetingService(&fakeMeetingClient{}, noOpIDMapper{}, nil)
+	req := &itx.GetJoinLinkRequest{MeetingID: "123"}
+
+	testCases := []struct {
+		name      string
+		client    string
+		userAgent string
+		want      string
+	}{
+		{"auto on desktop launches native client", LaunchClientAuto, desktopUA, "zoommtg://zoom.us/join?action=join&confno=123"},
+		{"auto on mobile uses web link", LaunchClientAuto, mobileUA, "https://zoom.us/j/123"},
+		{"auto without user agent uses web link", LaunchClientAuto, "", "https://zoom.us/j/123"},
+		{"web forced on desktop", LaunchClientWeb, desktopUA, "https://zoom.us/j/123"},
+		{"native forced on mobile", LaunchClientNative, mobileUA, "zoommtg://zoom.us/join?action=join&confno=123"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := svc.GetMeetingLaunchLink(context.Background(), req, tc.client, tc.userAgent)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -5,12 +5,17 @@ package itx
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/logging"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/utils"
 )
 
 // MeetingService handles ITX Zoom meeting operations
@@ -18,23 +23,40 @@ type MeetingService struct {
 	meetingClient domain.ITXMeetingClient
 	idMapper      domain.IDMapper
 	userMetadata  domain.UserMetadataReader
+	joinLinks     *JoinLinkCache
+	logger        *slog.Logger
 }
 
 // NewMeetingService creates a new ITX meeting service. userMetadata may be nil (e.g. when
 // NATS is disabled), in which case created_by on newly created meetings is limited to the
 // JWT-derived username/email (profile enrichment such as name/avatar is skipped) rather
 // than blocking creation.
-func NewMeetingService(meetingClient domain.ITXMeetingClient, idMapper domain.IDMapper, userMetadata domain.UserMetadataReader) *MeetingService {
-	return &MeetingService{
+func NewMeetingService(meetingClient domain.ITXMeetingClient, idMapper domain.IDMapper, userMetadata domain.UserMetadataReader, opts ...MeetingServiceOption) *MeetingService {
+	s := &MeetingService{
 		meetingClient: meetingClient,
 		idMapper:      idMapper,
 		userMetadata:  userMetadata,
+		logger:        logging.Subsystem(logging.SubsystemITX),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// MeetingServiceOption is a functional option for MeetingService.
+type MeetingServiceOption func(*MeetingService)
+
+// WithJoinLinkCache caches join links returned by ITX in cache. A nil cache leaves caching off.
+func WithJoinLinkCache(cache *JoinLinkCache) MeetingServiceOption {
+	return func(s *MeetingService) {
+		s.joinLinks = cache
 	}
 }
 
 // CreateMeeting creates a meeting via ITX proxy
 func (s *MeetingService) CreateMeeting(ctx context.Context, req *models.CreateITXMeetingRequest) (*itx.ZoomMeetingResponse, error) {
-	if err := validateMeetingRequest(req); err != nil {
+	if err := validateCreateMeetingRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -93,6 +115,7 @@ func (s *MeetingService) UpdateMeeting(ctx context.Context, meetingID string, re
 	if err != nil {
 		return err
 	}
+	s.joinLinks.invalidate(meetingID)
 
 	return nil
 }
@@ -103,10 +126,97 @@ func (s *MeetingService) DeleteMeeting(ctx context.Context, meetingID string) er
 	if err != nil {
 		return err
 	}
+	s.joinLinks.invalidate(meetingID)
 
 	return nil
 }
 
+// CloneMeeting creates a new meeting with the settings of an existing one at a new start time.
+// Registrants and attachments are not copied: ITX exposes no way to list them for a meeting.
+func (s *MeetingService) CloneMeeting(ctx context.Context, meetingID string, req *models.CloneITXMeetingRequest) (*itx.ZoomMeetingResponse, error) {
+	source, err := s.GetMeeting(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq, err := cloneMeetingRequest(source, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CreateMeeting(ctx, createReq)
+}
+
+// cloneMeetingRequest builds a create request from a (v2-mapped) source meeting. A recurrence
+// end date is shifted by the same offset as the start time so the clone keeps the series length.
+func cloneMeetingRequest(source *itx.ZoomMeetingResponse, req *models.CloneITXMeetingRequest) (*models.CreateITXMeetingRequest, error) {
+	newStart, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, domain.NewValidationError("start_time must be in RFC3339 format", err)
+	}
+
+	title := source.Topic
+	if req.Title != "" {
+		title = req.Title
+	}
+
+	createReq := &models.CreateITXMeetingRequest{
+		ProjectUID:               source.Project,
+		Title:                    title,
+		StartTime:                newStart.UTC().Format(time.RFC3339),
+		Duration:                 source.Duration,
+		Timezone:                 source.Timezone,
+		Visibility:               source.Visibility,
+		Description:              source.Agenda,
+		Restricted:               source.Restricted,
+		MeetingType:              source.MeetingType,
+		EarlyJoinTimeMinutes:     source.EarlyJoinTime,
+		RecordingEnabled:         source.RecordingEnabled,
+		TranscriptEnabled:        source.TranscriptEnabled,
+		YoutubeUploadEnabled:     source.YoutubeUploadEnabled,
+		AISummaryEnabled:         source.ZoomAIEnabled,
+		RequireAISummaryApproval: source.RequireAISummaryApproval,
+		ArtifactVisibility:       utils.Coalesce(source.RecordingAccess, source.TranscriptAccess, source.AISummaryAccess),
+		RecordingAccess:          source.RecordingAccess,
+		TranscriptAccess:         source.TranscriptAccess,
+		AISummaryAccess:          source.AISummaryAccess,
+	}
+
+	for _, c := range source.Committees {
+		// GetMeeting blanks committees whose v2 UID could not be resolved; cloning without
+		// them would silently change who the meeting is for
+		if c.ID == "" {
+			return nil, domain.NewInternalError("source meeting has a committee that could not be mapped to a v2 UID")
+		}
+		createReq.Committees = append(createReq.Committees, models.Committee{
+			UID:                   c.ID,
+			AllowedVotingStatuses: c.Filters,
+		})
+	}
+
+	if r := source.Recurrence; r != nil {
+		createReq.Recurrence = &models.ITXRecurrence{
+			Type:           r.Type,
+			RepeatInterval: r.RepeatInterval,
+			WeeklyDays:     r.WeeklyDays,
+			MonthlyDay:     r.MonthlyDay,
+			MonthlyWeek:    r.MonthlyWeek,
+			MonthlyWeekDay: r.MonthlyWeekDay,
+			EndTimes:       r.EndTimes,
+		}
+		if r.EndDateTime != "" {
+			oldStart, startErr := time.Parse(time.RFC3339, source.StartTime)
+			oldEnd, endErr := time.Parse(time.RFC3339, r.EndDateTime)
+			if startErr != nil || endErr != nil {
+				return nil, domain.NewInternalError("source meeting has an unparseable start time or recurrence end date")
+			}
+			createReq.Recurrence.EndDateTime = oldEnd.Add(newStart.Sub(oldStart)).UTC().Format(time.RFC3339)
+		}
+	}
+
+	return createReq, nil
+}
+
 // GetMeetingCount retrieves the count of meetings for a project via ITX proxy
 func (s *MeetingService) GetMeetingCount(ctx context.Context, projectID string) (*itx.MeetingCountResponse, error) {
 	// Map v2 project UID to v1 SFID
@@ -123,9 +233,118 @@ func (s *MeetingService) GetMeetingCount(ctx context.Context, projectID string)
 	return resp, nil
 }
 
-// GetMeetingJoinLink retrieves a join link for a meeting via ITX proxy
+// GetMeetingJoinLink retrieves a join link for a meeting via ITX proxy, from the join link
+// cache when one is configured. When an occurrence ID is given, the occurrence is checked to
+// exist and not be cancelled, and its schedule is returned alongside the link. The link itself
+// is the same for every occurrence: Zoom join URLs identify the meeting (and registrant), not
+// an occurrence.
 func (s *MeetingService) GetMeetingJoinLink(ctx context.Context, req *itx.GetJoinLinkRequest) (*itx.ZoomMeetingJoinLink, error) {
-	return s.meetingClient.GetMeetingJoinLink(ctx, req)
+	var occurrence *itx.Occurrence
+	if req.OccurrenceID != "" {
+		occurrences, ok := s.joinLinks.getOccurrences(req.MeetingID)
+		if !ok {
+			meeting, err := s.meetingClient.GetZoomMeeting(ctx, req.MeetingID)
+			if err != nil {
+				return nil, err
+			}
+			occurrences = meeting.Occurrences
+			s.joinLinks.putOccurrences(req.MeetingID, occurrences)
+		}
+		var err error
+		occurrence, err = findJoinableOccurrence(occurrences, req.OccurrenceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, ok := s.joinLinks.get(req)
+	if !ok {
+		var err error
+		resp, err = s.meetingClient.GetMeetingJoinLink(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		s.joinLinks.put(req, resp)
+	}
+
+	if occurrence != nil {
+		resp.OccurrenceID = occurrence.OccurrenceID
+		resp.OccurrenceStartTime = occurrence.StartTime
+		resp.OccurrenceDuration = occurrence.Duration
+	}
+
+	return resp, nil
+}
+
+// findJoinableOccurrence returns the occurrence with the given ID, rejecting cancelled ones.
+func findJoinableOccurrence(occurrences []itx.Occurrence, occurrenceID string) (*itx.Occurrence, error) {
+	for i := range occurrences {
+		occurrence := &occurrences[i]
+		if occurrence.OccurrenceID != occurrenceID {
+			continue
+		}
+		if occurrence.Status == itx.OccurrenceStatusCancel {
+			return nil, domain.NewConflictError(fmt.Sprintf("occurrence %s is cancelled", occurrenceID))
+		}
+		return occurrence, nil
+	}
+	return nil, domain.NewNotFoundError(fmt.Sprintf("occurrence %s not found", occurrenceID))
+}
+
+// ListOccurrences returns a meeting's occurrences that start within the requested range, with
+// start and end times converted to the requested timezone (or the meeting's own timezone).
+// Occurrences, including cancelled ones, are taken from the ITX meeting record so the list
+// matches what ITX will schedule.
+func (s *MeetingService) ListOccurrences(ctx context.Context, req *models.ListITXOccurrencesRequest) (*models.ITXOccurrenceList, error) {
+	if !req.From.IsZero() && !req.To.IsZero() && !req.From.Before(req.To) {
+		return nil, domain.NewValidationError("from must be before to")
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return nil, domain.NewValidationError(fmt.Sprintf("invalid timezone %q", req.Timezone))
+		}
+	}
+
+	meeting, err := s.meetingClient.GetZoomMeeting(ctx, req.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = meeting.Timezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, domain.NewInternalError(fmt.Sprintf("meeting %s has an invalid timezone %q", req.MeetingID, timezone), err)
+	}
+
+	list := &models.ITXOccurrenceList{
+		Timezone:    loc.String(),
+		Occurrences: make([]models.ITXLocalOccurrence, 0, len(meeting.Occurrences)),
+	}
+	for _, occurrence := range meeting.Occurrences {
+		start, err := time.Parse(time.RFC3339, occurrence.StartTime)
+		if err != nil {
+			return nil, domain.NewInternalError(fmt.Sprintf("occurrence %s has an invalid start time", occurrence.OccurrenceID), err)
+		}
+		if (!req.From.IsZero() && start.Before(req.From)) || (!req.To.IsZero() && !start.Before(req.To)) {
+			continue
+		}
+		start = start.In(loc)
+		list.Occurrences = append(list.Occurrences, models.ITXLocalOccurrence{
+			OccurrenceID: occurrence.OccurrenceID,
+			StartTime:    start,
+			EndTime:      start.Add(time.Duration(occurrence.Duration) * time.Minute),
+			Duration:     occurrence.Duration,
+			Status:       occurrence.Status,
+		})
+	}
+	slices.SortFunc(list.Occurrences, func(a, b models.ITXLocalOccurrence) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	return list, nil
 }
 
 // ResendMeetingInvitations resends meeting invitations to all registrants via ITX proxy
@@ -140,12 +359,20 @@ func (s *MeetingService) RegisterCommitteeMembers(ctx context.Context, meetingID
 
 // UpdateOccurrence updates a specific occurrence of a recurring meeting via ITX proxy
 func (s *MeetingService) UpdateOccurrence(ctx context.Context, meetingID, occurrenceID string, req *itx.UpdateOccurrenceRequest) error {
-	return s.meetingClient.UpdateOccurrence(ctx, meetingID, occurrenceID, req)
+	if err := s.meetingClient.UpdateOccurrence(ctx, meetingID, occurrenceID, req); err != nil {
+		return err
+	}
+	s.joinLinks.invalidate(meetingID)
+	return nil
 }
 
 // DeleteOccurrence deletes a specific occurrence of a recurring meeting via ITX proxy
 func (s *MeetingService) DeleteOccurrence(ctx context.Context, meetingID, occurrenceID string) error {
-	return s.meetingClient.DeleteOccurrence(ctx, meetingID, occurrenceID)
+	if err := s.meetingClient.DeleteOccurrence(ctx, meetingID, occurrenceID); err != nil {
+		return err
+	}
+	s.joinLinks.invalidate(meetingID)
+	return nil
 }
 
 // SubmitMeetingResponse submits a meeting response for a meeting or occurrence via ITX proxy
@@ -153,8 +380,21 @@ func (s *MeetingService) SubmitMeetingResponse(ctx context.Context, meetingAndOc
 	return s.meetingClient.SubmitMeetingResponse(ctx, meetingAndOccurrenceID, req)
 }
 
-// validateMeetingRequest validates a meeting create/update request before sending to ITX
+// validateCreateMeetingRequest validates a meeting create request, including the required
+// settings of its meeting type profile
+func validateCreateMeetingRequest(req *models.CreateITXMeetingRequest) error {
+	if err := validateMeetingRequest(req); err != nil {
+		return err
+	}
+	return validateMeetingTypeProfile(req)
+}
+
+// validateMeetingRequest applies meeting type defaults to a meeting create/update request
+// and validates it before sending to ITX. Meeting type profile requirements are only
+// enforced on create, so meetings created before the profiles existed can still be edited.
 func validateMeetingRequest(req *models.CreateITXMeetingRequest) error {
+	applyMeetingTypeDefaults(req)
+
 	anyFeatureEnabled := req.RecordingEnabled || req.TranscriptEnabled || req.AISummaryEnabled
 	if anyFeatureEnabled && req.ArtifactVisibility == "" {
 		return domain.NewValidationError("artifact_visibility is required when recording, transcript, or ai_summary is enabled")
@@ -182,7 +422,7 @@ func (s *MeetingService) buildRequestingUser(ctx context.Context) *itx.User {
 
 	profile, err := s.userMetadata.ResolveProfile(ctx, principal)
 	if err != nil {
-		slog.WarnContext(ctx, "failed to resolve user profile for meeting created_by/updated_by; stamping username/email only",
+		s.logger.WarnContext(ctx, "failed to resolve user profile for meeting created_by/updated_by; stamping username/email only",
 			"username", principal, "err", err)
 		return &itx.User{Username: principal, Email: email}
 	}
@@ -221,17 +461,16 @@ func (s *MeetingService) transformToITXRequest(req *models.CreateITXMeetingReque
 		Note:                     req.UpdateNote,
 	}
 
-	// Map artifact visibility to access controls only when the respective feature is enabled
-	if req.ArtifactVisibility != "" {
-		if req.RecordingEnabled {
-			itxReq.RecordingAccess = req.ArtifactVisibility
-		}
-		if req.TranscriptEnabled {
-			itxReq.TranscriptAccess = req.ArtifactVisibility
-		}
-		if req.AISummaryEnabled {
-			itxReq.AISummaryAccess = req.ArtifactVisibility
-		}
+	// Map artifact visibility to access controls only when the respective feature is enabled;
+	// a per-artifact access level takes precedence
+	if req.RecordingEnabled {
+		itxReq.RecordingAccess = utils.Coalesce(req.RecordingAccess, req.ArtifactVisibility)
+	}
+	if req.TranscriptEnabled {
+		itxReq.TranscriptAccess = utils.Coalesce(req.TranscriptAccess, req.ArtifactVisibility)
+	}
+	if req.AISummaryEnabled {
+		itxReq.AISummaryAccess = utils.Coalesce(req.AISummaryAccess, req.ArtifactVisibility)
 	}
 
 	// Map committees
@@ -304,7 +543,7 @@ func (s *MeetingService) mapResponseV1ToV2(ctx context.Context, resp *itx.ZoomMe
 		if resp.Committees[i].ID != "" {
 			v2UID, err := s.idMapper.MapCommitteeV1ToV2(ctx, resp.Committees[i].ID)
 			if err != nil {
-				slog.WarnContext(ctx, "failed to map committee ID in meeting response; returning empty committee UID",
+				s.logger.WarnContext(ctx, "failed to map committee ID in meeting response; returning empty committee UID",
 					"v1_id", resp.Committees[i].ID, "err", err)
 				resp.Committees[i].ID = ""
 				continue
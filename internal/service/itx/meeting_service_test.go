@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +26,9 @@ type fakeMeetingClient struct {
 	lastUpdateReq *itx.CreateZoomMeetingRequest
 	createResp    *itx.ZoomMeetingResponse
 	createErr     error
+	getResp       *itx.ZoomMeetingResponse
+	getCalls      int
+	joinLinkCalls int
 }
 
 func (f *fakeMeetingClient) CreateZoomMeeting(_ context.Context, req *itx.CreateZoomMeetingRequest) (*itx.ZoomMeetingResponse, error) {
@@ -38,6 +42,23 @@ func (f *fakeMeetingClient) CreateZoomMeeting(_ context.Context, req *itx.Create
 	return &itx.ZoomMeetingResponse{}, nil
 }
 
+func (f *fakeMeetingClient) GetZoomMeeting(_ context.Context, _ string) (*itx.ZoomMeetingResponse, error) {
+	f.getCalls++
+	if f.getResp != nil {
+		return f.getResp, nil
+	}
+	return &itx.ZoomMeetingResponse{}, nil
+}
+
+func (f *fakeMeetingClient) GetMeetingJoinLink(_ context.Context, _ *itx.GetJoinLinkRequest) (*itx.ZoomMeetingJoinLink, error) {
+	f.joinLinkCalls++
+	return &itx.ZoomMeetingJoinLink{Link: "https://zoom.us/j/123"}, nil
+}
+
+func (f *fakeMeetingClient) DeleteOccurrence(_ context.Context, _, _ string) error {
+	return nil
+}
+
 func (f *fakeMeetingClient) UpdateZoomMeeting(_ context.Context, _ string, req *itx.CreateZoomMeetingRequest) error {
 	f.lastUpdateReq = req
 	return nil
@@ -53,6 +74,13 @@ func (noOpIDMapper) MapProjectV1ToV2(_ context.Context, v1SFID string) (string,
 	return v1SFID, nil
 }
 
+// unmappedCommitteeIDMapper passes project IDs through and fails every committee mapping.
+type unmappedCommitteeIDMapper struct{ noOpIDMapper }
+
+func (unmappedCommitteeIDMapper) MapCommitteeV1ToV2(_ context.Context, _ string) (string, error) {
+	return "", domain.NewNotFoundError("committee mapping not found")
+}
+
 // fakeUserMetadataReader returns a canned profile or error for ResolveProfile.
 type fakeUserMetadataReader struct {
 	profile *domain.UserProfile
@@ -155,6 +183,136 @@ func TestMeetingService_CreateMeeting_CreatedBy(t *testing.T) {
 	})
 }
 
+func TestMeetingService_GetMeetingJoinLink_Cache(t *testing.T) {
+	meeting := &itx.ZoomMeetingResponse{
+		Occurrences: []itx.Occurrence{
+			{OccurrenceID: "1640995200", StartTime: "2022-01-01T00:00:00Z", Duration: 60, Status: itx.OccurrenceStatusAvailable},
+		},
+	}
+	alice := &itx.GetJoinLinkRequest{MeetingID: "123", UserID: "alice", Email: "alice@example.com"}
+	bob := &itx.GetJoinLinkRequest{MeetingID: "123", UserID: "bob", Email: "bob@example.com"}
+
+	newCachedService := func(client *fakeMeetingClient) (*MeetingService, *time.Time) {
+		svc := NewMeetingService(client, noOpIDMapper{}, nil, WithJoinLinkCache(NewJoinLinkCache(time.Minute)))
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		svc.joinLinks.now = func() time.Time { return now }
+		return svc, &now
+	}
+
+	t.Run("repeat lookups for a user are served from the cache", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc, _ := newCachedService(client)
+
+		for range 3 {
+			resp, err := svc.GetMeetingJoinLink(context.Background(), alice)
+			require.NoError(t, err)
+			assert.Equal(t, "https://zoom.us/j/123", resp.Link)
+		}
+		assert.Equal(t, 1, client.joinLinkCalls)
+
+		_, err := svc.GetMeetingJoinLink(context.Background(), bob)
+		require.NoError(t, err)
+		assert.Equal(t, 2, client.joinLinkCalls, "links are cached per user")
+	})
+
+	t.Run("registrant changes drop the meeting's cached links", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc, _ := newCachedService(client)
+		registrants := NewRegistrantService(&fakeRegistrantClient{getResp: &itx.ZoomMeetingRegistrant{ID: "reg-1", Host: true}},
+			noOpIDMapper{}, WithRegistrantJoinLinkCache(svc.joinLinks))
+
+		_, err := svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+
+		_, err = registrants.SetRegistrantHost(context.Background(), "123", "reg-1", false)
+		require.NoError(t, err)
+		_, err = svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+		assert.Equal(t, 2, client.joinLinkCalls, "revoking host access drops the cached link")
+
+		require.NoError(t, registrants.DeleteRegistrant(context.Background(), "123", "reg-1"))
+		_, err = svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+		assert.Equal(t, 3, client.joinLinkCalls, "deleting a registrant drops the cached link")
+	})
+
+	t.Run("occurrence metadata does not leak into the cached link", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc, _ := newCachedService(client)
+
+		withOccurrence := *alice
+		withOccurrence.OccurrenceID = "1640995200"
+		resp, err := svc.GetMeetingJoinLink(context.Background(), &withOccurrence)
+		require.NoError(t, err)
+		assert.Equal(t, "1640995200", resp.OccurrenceID)
+
+		resp, err = svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+		assert.Empty(t, resp.OccurrenceID)
+		assert.Equal(t, 1, client.joinLinkCalls)
+	})
+
+	t.Run("occurrence lookups are cached with the meeting's links", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc, _ := newCachedService(client)
+
+		withOccurrence := *alice
+		withOccurrence.OccurrenceID = "1640995200"
+		for range 3 {
+			_, err := svc.GetMeetingJoinLink(context.Background(), &withOccurrence)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, client.getCalls)
+
+		require.NoError(t, svc.DeleteOccurrence(context.Background(), "123", "1640995200"))
+		_, err := svc.GetMeetingJoinLink(context.Background(), &withOccurrence)
+		require.NoError(t, err)
+		assert.Equal(t, 2, client.getCalls, "occurrence changes invalidate the cached occurrences")
+	})
+
+	t.Run("entries expire after the TTL", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc, now := newCachedService(client)
+
+		_, err := svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+		*now = now.Add(time.Minute)
+		_, err = svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+		assert.Equal(t, 2, client.joinLinkCalls)
+	})
+
+	t.Run("meeting changes invalidate the meeting's links", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc, _ := newCachedService(client)
+		other := &itx.GetJoinLinkRequest{MeetingID: "456", UserID: "alice"}
+
+		_, err := svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+		_, err = svc.GetMeetingJoinLink(context.Background(), other)
+		require.NoError(t, err)
+
+		require.NoError(t, svc.DeleteOccurrence(context.Background(), "123", "1640995200"))
+
+		_, err = svc.GetMeetingJoinLink(context.Background(), alice)
+		require.NoError(t, err)
+		_, err = svc.GetMeetingJoinLink(context.Background(), other)
+		require.NoError(t, err)
+		assert.Equal(t, 3, client.joinLinkCalls, "only meeting 123 is fetched again")
+	})
+
+	t.Run("no cache without the option", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		for range 2 {
+			_, err := svc.GetMeetingJoinLink(context.Background(), alice)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, client.joinLinkCalls)
+	})
+}
+
 func TestMeetingService_UpdateMeeting_StampsUpdatedByNotCreatedBy(t *testing.T) {
 	baseReq := func() *models.CreateITXMeetingRequest {
 		return &models.CreateITXMeetingRequest{
@@ -238,3 +396,187 @@ func TestMeetingService_UpdateMeeting_StampsUpdatedByNotCreatedBy(t *testing.T)
 		assert.Empty(t, reader.calls, "resolver should not be called without a principal")
 	})
 }
+
+func TestMeetingService_GetMeetingJoinLink_Occurrence(t *testing.T) {
+	meeting := &itx.ZoomMeetingResponse{
+		Occurrences: []itx.Occurrence{
+			{OccurrenceID: "1640995200", StartTime: "2022-01-01T00:00:00Z", Duration: 60, Status: itx.OccurrenceStatusAvailable},
+			{OccurrenceID: "1641600000", StartTime: "2022-01-08T00:00:00Z", Duration: 60, Status: itx.OccurrenceStatusCancel},
+		},
+	}
+
+	t.Run("without occurrence returns link only", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		resp, err := svc.GetMeetingJoinLink(context.Background(), &itx.GetJoinLinkRequest{MeetingID: "123"})
+		require.NoError(t, err)
+		assert.Equal(t, "https://zoom.us/j/123", resp.Link)
+		assert.Empty(t, resp.OccurrenceID)
+	})
+
+	t.Run("attaches occurrence metadata", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		resp, err := svc.GetMeetingJoinLink(context.Background(), &itx.GetJoinLinkRequest{MeetingID: "123", OccurrenceID: "1640995200"})
+		require.NoError(t, err)
+		assert.Equal(t, "1640995200", resp.OccurrenceID)
+		assert.Equal(t, "2022-01-01T00:00:00Z", resp.OccurrenceStartTime)
+		assert.Equal(t, 60, resp.OccurrenceDuration)
+	})
+
+	t.Run("unknown occurrence is not found", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		_, err := svc.GetMeetingJoinLink(context.Background(), &itx.GetJoinLinkRequest{MeetingID: "123", OccurrenceID: "1"})
+		require.Error(t, err)
+		assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+		assert.Zero(t, client.joinLinkCalls)
+	})
+
+	t.Run("cancelled occurrence is a conflict", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: meeting}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		_, err := svc.GetMeetingJoinLink(context.Background(), &itx.GetJoinLinkRequest{MeetingID: "123", OccurrenceID: "1641600000"})
+		require.Error(t, err)
+		assert.Equal(t, domain.ErrorTypeConflict, domain.GetErrorType(err))
+		assert.Zero(t, client.joinLinkCalls)
+	})
+}
+
+func TestMeetingService_CloneMeeting(t *testing.T) {
+	source := func() *itx.ZoomMeetingResponse {
+		return &itx.ZoomMeetingResponse{
+			ID:               "123",
+			Project:          "project-uid",
+			Topic:            "Q1 TSC Meeting",
+			StartTime:        "2025-01-07T16:00:00Z",
+			Duration:         60,
+			Timezone:         "America/New_York",
+			Visibility:       itx.MeetingVisibilityPublic,
+			Agenda:           "Quarterly review",
+			RecordingEnabled: true,
+			RecordingAccess:  itx.ArtifactAccessParticipants,
+			Recurrence: &itx.Recurrence{
+				Type:           itx.RecurrenceTypeWeekly,
+				RepeatInterval: 1,
+				WeeklyDays:     "3",
+				EndDateTime:    "2025-03-25T16:00:00Z",
+			},
+			Passcode: "secret",
+		}
+	}
+
+	t.Run("copies settings and shifts recurrence end", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: source()}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		_, err := svc.CloneMeeting(context.Background(), "123", &models.CloneITXMeetingRequest{StartTime: "2025-04-08T16:00:00Z"})
+		require.NoError(t, err)
+
+		req := client.lastCreateReq
+		require.NotNil(t, req)
+		assert.Empty(t, req.ID)
+		assert.Equal(t, "project-uid", req.Project)
+		assert.Equal(t, "Q1 TSC Meeting", req.Topic)
+		assert.Equal(t, "2025-04-08T16:00:00Z", req.StartTime)
+		assert.Equal(t, "America/New_York", req.Timezone)
+		assert.Equal(t, "Quarterly review", req.Agenda)
+		assert.Equal(t, itx.ArtifactAccessParticipants, req.RecordingAccess)
+		require.NotNil(t, req.Recurrence)
+		assert.Equal(t, "2025-06-24T16:00:00Z", req.Recurrence.EndDateTime)
+	})
+
+	t.Run("copies each artifact access level", func(t *testing.T) {
+		meeting := source()
+		meeting.TranscriptEnabled = true
+		meeting.TranscriptAccess = itx.ArtifactAccessHosts
+		client := &fakeMeetingClient{getResp: meeting}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		_, err := svc.CloneMeeting(context.Background(), "123", &models.CloneITXMeetingRequest{StartTime: "2025-04-08T16:00:00Z"})
+		require.NoError(t, err)
+		assert.Equal(t, itx.ArtifactAccessParticipants, client.lastCreateReq.RecordingAccess)
+		assert.Equal(t, itx.ArtifactAccessHosts, client.lastCreateReq.TranscriptAccess)
+	})
+
+	t.Run("unmapped committee fails the clone", func(t *testing.T) {
+		meeting := source()
+		meeting.Committees = []itx.Committee{{ID: "committee-sfid"}}
+		client := &fakeMeetingClient{getResp: meeting}
+		svc := NewMeetingService(client, unmappedCommitteeIDMapper{}, nil)
+
+		_, err := svc.CloneMeeting(context.Background(), "123", &models.CloneITXMeetingRequest{StartTime: "2025-04-08T16:00:00Z"})
+		require.Error(t, err)
+		assert.Equal(t, domain.ErrorTypeInternal, domain.GetErrorType(err))
+		assert.Nil(t, client.lastCreateReq)
+	})
+
+	t.Run("title override", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: source()}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		_, err := svc.CloneMeeting(context.Background(), "123", &models.CloneITXMeetingRequest{StartTime: "2025-04-08T16:00:00Z", Title: "Q2 TSC Meeting"})
+		require.NoError(t, err)
+		assert.Equal(t, "Q2 TSC Meeting", client.lastCreateReq.Topic)
+	})
+
+	t.Run("invalid start time is a validation error", func(t *testing.T) {
+		client := &fakeMeetingClient{getResp: source()}
+		svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+		_, err := svc.CloneMeeting(context.Background(), "123", &models.CloneITXMeetingRequest{StartTime: "next tuesday"})
+		require.Error(t, err)
+		assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+		assert.Nil(t, client.lastCreateReq)
+	})
+}
+
+func TestMeetingService_ListOccurrences(t *testing.T) {
+	client := &fakeMeetingClient{getResp: &itx.ZoomMeetingResponse{
+		ID:       "123",
+		Timezone: "America/New_York",
+		Occurrences: []itx.Occurrence{
+			{OccurrenceID: "1711382400", StartTime: "2024-03-25T16:00:00Z", Duration: 30, Status: itx.OccurrenceStatusAvailable},
+			{OccurrenceID: "1710172800", StartTime: "2024-03-11T16:00:00Z", Duration: 30, Status: itx.OccurrenceStatusAvailable},
+			{OccurrenceID: "1710777600", StartTime: "2024-03-18T16:00:00Z", Duration: 30, Status: itx.OccurrenceStatusCancel},
+		},
+	}}
+	svc := NewMeetingService(client, noOpIDMapper{}, nil)
+
+	t.Run("defaults to the meeting timezone and sorts", func(t *testing.T) {
+		list, err := svc.ListOccurrences(context.Background(), &models.ListITXOccurrencesRequest{MeetingID: "123"})
+		require.NoError(t, err)
+		assert.Equal(t, "America/New_York", list.Timezone)
+		require.Len(t, list.Occurrences, 3)
+		assert.Equal(t, "1710172800", list.Occurrences[0].OccurrenceID)
+		assert.Equal(t, "2024-03-11T12:00:00-04:00", list.Occurrences[0].StartTime.Format(time.RFC3339))
+		assert.Equal(t, "2024-03-11T12:30:00-04:00", list.Occurrences[0].EndTime.Format(time.RFC3339))
+		assert.Equal(t, itx.OccurrenceStatusCancel, list.Occurrences[1].Status)
+	})
+
+	t.Run("filters by range in the requested timezone", func(t *testing.T) {
+		list, err := svc.ListOccurrences(context.Background(), &models.ListITXOccurrencesRequest{
+			MeetingID: "123",
+			Timezone:  "Europe/Berlin",
+			From:      time.Date(2024, 3, 18, 16, 0, 0, 0, time.UTC),
+			To:        time.Date(2024, 3, 25, 16, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/Berlin", list.Timezone)
+		require.Len(t, list.Occurrences, 1, "from is inclusive and to is exclusive")
+		assert.Equal(t, "2024-03-18T17:00:00+01:00", list.Occurrences[0].StartTime.Format(time.RFC3339))
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		_, err := svc.ListOccurrences(context.Background(), &models.ListITXOccurrencesRequest{MeetingID: "123", Timezone: "Nowhere/Special"})
+		assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+
+		at := time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)
+		_, err = svc.ListOccurrences(context.Background(), &models.ListITXOccurrencesRequest{MeetingID: "123", From: at, To: at})
+		assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"fmt"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+// meetingTypeProfile describes the settings a meeting type requires and the defaults
+// applied when a request leaves them unset.
+type meetingTypeProfile struct {
+	requirePrivate            bool
+	requireRestricted         bool
+	requireRecording          bool
+	defaultArtifactVisibility itx.ArtifactAccess
+}
+
+// meetingTypeProfiles holds the profiles for meeting types with stricter requirements.
+// Types without an entry are validated only by the generic rules. The keys are ITX's meeting
+// types; committee meetings are not a type of their own (they are any type with committees
+// attached), and ITX has no webinar or office hours type, so there is nothing to key those on.
+//
+// Required settings are enforced when a meeting is created; defaults are applied on
+// create and update.
+var meetingTypeProfiles = map[itx.MeetingType]meetingTypeProfile{
+	// Board meetings are governance records: invite-only, recorded, and artifacts
+	// limited to hosts unless the organizer chooses otherwise.
+	itx.MeetingTypeBoard: {
+		requirePrivate:            true,
+		requireRestricted:         true,
+		requireRecording:          true,
+		defaultArtifactVisibility: itx.ArtifactAccessHosts,
+	},
+	// Legal meetings are invite-only and, when recorded, default to host-only artifacts.
+	itx.MeetingTypeLegal: {
+		requirePrivate:            true,
+		requireRestricted:         true,
+		defaultArtifactVisibility: itx.ArtifactAccessHosts,
+	},
+}
+
+// applyMeetingTypeDefaults fills in profile defaults for settings the request leaves unset
+func applyMeetingTypeDefaults(req *models.CreateITXMeetingRequest) {
+	profile, ok := meetingTypeProfiles[req.MeetingType]
+	if !ok {
+		return
+	}
+	anyFeatureEnabled := req.RecordingEnabled || req.TranscriptEnabled || req.AISummaryEnabled
+	if anyFeatureEnabled && req.ArtifactVisibility == "" {
+		req.ArtifactVisibility = profile.defaultArtifactVisibility
+	}
+}
+
+// validateMeetingTypeProfile checks a create request against its meeting type's profile
+func validateMeetingTypeProfile(req *models.CreateITXMeetingRequest) error {
+	profile, ok := meetingTypeProfiles[req.MeetingType]
+	if !ok {
+		return nil
+	}
+	if profile.requirePrivate && req.Visibility != itx.MeetingVisibilityPrivate {
+		return domain.NewValidationError(fmt.Sprintf("%s meetings must have private visibility", req.MeetingType))
+	}
+	if profile.requireRestricted && !req.Restricted {
+		return domain.NewValidationError(fmt.Sprintf("%s meetings must be restricted to invited participants", req.MeetingType))
+	}
+	if profile.requireRecording && !req.RecordingEnabled {
+		return domain.NewValidationError(fmt.Sprintf("%s meetings must have recording enabled", req.MeetingType))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+func TestValidateMeetingRequest_MeetingTypeProfiles(t *testing.T) {
+	validBoard := func() *models.CreateITXMeetingRequest {
+		return &models.CreateITXMeetingRequest{
+			MeetingType:      itx.MeetingTypeBoard,
+			Visibility:       itx.MeetingVisibilityPrivate,
+			Restricted:       true,
+			RecordingEnabled: true,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*models.CreateITXMeetingRequest)
+		wantErr string
+	}{
+		{name: "valid board meeting", modify: func(*models.CreateITXMeetingRequest) {}},
+		{
+			name:    "board meeting must be private",
+			modify:  func(r *models.CreateITXMeetingRequest) { r.Visibility = itx.MeetingVisibilityPublic },
+			wantErr: "Board meetings must have private visibility",
+		},
+		{
+			name:    "board meeting must be restricted",
+			modify:  func(r *models.CreateITXMeetingRequest) { r.Restricted = false },
+			wantErr: "Board meetings must be restricted to invited participants",
+		},
+		{
+			name:    "board meeting must be recorded",
+			modify:  func(r *models.CreateITXMeetingRequest) { r.RecordingEnabled = false },
+			wantErr: "Board meetings must have recording enabled",
+		},
+		{
+			name: "legal meeting need not be recorded",
+			modify: func(r *models.CreateITXMeetingRequest) {
+				r.MeetingType = itx.MeetingTypeLegal
+				r.RecordingEnabled = false
+			},
+		},
+		{
+			name: "technical meeting has no profile",
+			modify: func(r *models.CreateITXMeetingRequest) {
+				r.MeetingType = itx.MeetingTypeTechnical
+				r.Visibility = itx.MeetingVisibilityPublic
+				r.Restricted = false
+				r.RecordingEnabled = false
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validBoard()
+			tt.modify(req)
+
+			err := validateCreateMeetingRequest(req)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateMeetingRequest_ProfilesNotEnforcedOnUpdate(t *testing.T) {
+	// A board meeting created before the profiles existed can still be edited.
+	req := &models.CreateITXMeetingRequest{
+		MeetingType:        itx.MeetingTypeBoard,
+		Visibility:         itx.MeetingVisibilityPublic,
+		RecordingEnabled:   false,
+		ArtifactVisibility: "",
+	}
+	require.NoError(t, validateMeetingRequest(req))
+}
+
+func TestValidateMeetingRequest_MeetingTypeDefaults(t *testing.T) {
+	t.Run("board meeting defaults artifact visibility to hosts", func(t *testing.T) {
+		req := &models.CreateITXMeetingRequest{
+			MeetingType:      itx.MeetingTypeBoard,
+			Visibility:       itx.MeetingVisibilityPrivate,
+			Restricted:       true,
+			RecordingEnabled: true,
+		}
+		require.NoError(t, validateMeetingRequest(req))
+		assert.Equal(t, itx.ArtifactAccessHosts, req.ArtifactVisibility)
+	})
+
+	t.Run("explicit artifact visibility is kept", func(t *testing.T) {
+		req := &models.CreateITXMeetingRequest{
+			MeetingType:        itx.MeetingTypeBoard,
+			Visibility:         itx.MeetingVisibilityPrivate,
+			Restricted:         true,
+			RecordingEnabled:   true,
+			ArtifactVisibility: itx.ArtifactAccessParticipants,
+		}
+		require.NoError(t, validateMeetingRequest(req))
+		assert.Equal(t, itx.ArtifactAccessParticipants, req.ArtifactVisibility)
+	})
+
+	t.Run("types without a profile still require artifact visibility", func(t *testing.T) {
+		req := &models.CreateITXMeetingRequest{
+			MeetingType:      itx.MeetingTypeTechnical,
+			RecordingEnabled: true,
+		}
+		err := validateMeetingRequest(req)
+		require.Error(t, err)
+		assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+	})
+}
@@ -13,12 +13,14 @@ import (
 // PastMeetingAttachmentService handles ITX past meeting attachment operations
 type PastMeetingAttachmentService struct {
 	attachmentClient domain.ITXPastMeetingAttachmentClient
+	policy           *AttachmentPolicy
 }
 
-// NewPastMeetingAttachmentService creates a new ITX past meeting attachment service
-func NewPastMeetingAttachmentService(attachmentClient domain.ITXPastMeetingAttachmentClient) *PastMeetingAttachmentService {
+// NewPastMeetingAttachmentService creates a new ITX past meeting attachment service; a nil policy places no limits on uploads
+func NewPastMeetingAttachmentService(attachmentClient domain.ITXPastMeetingAttachmentClient, policy *AttachmentPolicy) *PastMeetingAttachmentService {
 	return &PastMeetingAttachmentService{
 		attachmentClient: attachmentClient,
+		policy:           policy,
 	}
 }
 
@@ -42,8 +44,11 @@ func (s *PastMeetingAttachmentService) DeletePastMeetingAttachment(ctx context.C
 	return s.attachmentClient.DeletePastMeetingAttachment(ctx, meetingAndOccurrenceID, attachmentID)
 }
 
-// CreatePastMeetingAttachmentPresignURL generates a presigned URL for past meeting attachment upload via ITX proxy
+// CreatePastMeetingAttachmentPresignURL checks the upload against the attachment policy and generates a presigned URL for it via ITX proxy
 func (s *PastMeetingAttachmentService) CreatePastMeetingAttachmentPresignURL(ctx context.Context, meetingAndOccurrenceID string, req *itx.CreateAttachmentPresignRequest) (*itx.PastMeetingAttachmentPresignResponse, error) {
+	if err := s.policy.Validate(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.attachmentClient.CreatePastMeetingAttachmentPresignURL(ctx, meetingAndOccurrenceID, req)
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/logging"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/utils"
 )
@@ -18,6 +19,7 @@ import (
 type PastMeetingParticipantService struct {
 	participantClient domain.ITXPastMeetingParticipantClient
 	idMapper          domain.IDMapper
+	logger            *slog.Logger
 }
 
 // NewPastMeetingParticipantService creates a new participant service
@@ -25,6 +27,7 @@ func NewPastMeetingParticipantService(participantClient domain.ITXPastMeetingPar
 	return &PastMeetingParticipantService{
 		participantClient: participantClient,
 		idMapper:          idMapper,
+		logger:            logging.Subsystem(logging.SubsystemITX),
 	}
 }
 
@@ -112,14 +115,22 @@ func (s *PastMeetingParticipantService) CreateParticipant(
 	return mergeParticipantResponses(pastMeetingID, inviteeResp, attendeeResp, isInvited, isAttended), nil
 }
 
+// UpdateParticipant applies an update to the participant's invitee and attendee records.
+// The invitee is written first; a failed ITX write is returned without attempting the rest.
 func (s *PastMeetingParticipantService) UpdateParticipant(
 	ctx context.Context,
 	p *models.UpdatePastMeetingParticipant,
 	inviteeReq *itx.UpdateInviteeRequest,
 	attendeeReq *itx.UpdateAttendeeRequest,
 ) (*ParticipantResponse, error) {
-	inviteeResp, inviteeExists := s.handleInviteeOperation(ctx, p.PastMeetingID, p.ParticipantID, p.InviteeID, p.IsInvited, inviteeReq)
-	attendeeResp, attendeeExists := s.handleAttendeeOperation(ctx, p.PastMeetingID, p.ParticipantID, p.AttendeeID, p.IsAttended, attendeeReq)
+	inviteeResp, inviteeExists, err := s.handleInviteeOperation(ctx, p.PastMeetingID, p.ParticipantID, p.InviteeID, p.IsInvited, inviteeReq)
+	if err != nil {
+		return nil, err
+	}
+	attendeeResp, attendeeExists, err := s.handleAttendeeOperation(ctx, p.PastMeetingID, p.ParticipantID, p.AttendeeID, p.IsAttended, attendeeReq)
+	if err != nil {
+		return nil, err
+	}
 	return mergeParticipantResponses(p.PastMeetingID, inviteeResp, attendeeResp, inviteeExists, attendeeExists), nil
 }
 
@@ -128,9 +139,9 @@ func (s *PastMeetingParticipantService) handleInviteeOperation(
 	pastMeetingID, participantID, inviteeID string,
 	isInvited *bool,
 	inviteeReq *itx.UpdateInviteeRequest,
-) (*itx.InviteeResponse, bool) {
+) (*itx.InviteeResponse, bool, error) {
 	if isInvited == nil {
-		return nil, false
+		return nil, false, nil
 	}
 
 	var actualInviteeID string
@@ -147,20 +158,24 @@ func (s *PastMeetingParticipantService) handleInviteeOperation(
 
 	if !*isInvited {
 		if inviteeExists && actualInviteeID != "" {
-			s.deleteInvitee(ctx, pastMeetingID, actualInviteeID, participantID)
+			if err := s.deleteInvitee(ctx, pastMeetingID, actualInviteeID, participantID); err != nil {
+				return nil, false, err
+			}
 		}
-		return nil, false
+		return nil, false, nil
 	}
 
 	if !inviteeExists && inviteeReq != nil {
-		return s.createInviteeFromUpdate(ctx, pastMeetingID, inviteeReq), true
+		resp, err := s.createInviteeFromUpdate(ctx, pastMeetingID, inviteeReq)
+		return resp, err == nil, err
 	}
 
 	if inviteeExists && inviteeReq != nil && actualInviteeID != "" {
-		return s.updateInvitee(ctx, pastMeetingID, actualInviteeID, participantID, inviteeReq), true
+		resp, err := s.updateInvitee(ctx, pastMeetingID, actualInviteeID, participantID, inviteeReq)
+		return resp, err == nil, err
 	}
 
-	return nil, inviteeExists
+	return nil, inviteeExists, nil
 }
 
 func (s *PastMeetingParticipantService) handleAttendeeOperation(
@@ -168,9 +183,9 @@ func (s *PastMeetingParticipantService) handleAttendeeOperation(
 	pastMeetingID, participantID, attendeeID string,
 	isAttended *bool,
 	attendeeReq *itx.UpdateAttendeeRequest,
-) (*itx.AttendeeResponse, bool) {
+) (*itx.AttendeeResponse, bool, error) {
 	if isAttended == nil {
-		return nil, false
+		return nil, false, nil
 	}
 
 	var actualAttendeeID string
@@ -187,20 +202,24 @@ func (s *PastMeetingParticipantService) handleAttendeeOperation(
 
 	if !*isAttended {
 		if attendeeExists && actualAttendeeID != "" {
-			s.deleteAttendee(ctx, pastMeetingID, actualAttendeeID, participantID)
+			if err := s.deleteAttendee(ctx, pastMeetingID, actualAttendeeID, participantID); err != nil {
+				return nil, false, err
+			}
 		}
-		return nil, false
+		return nil, false, nil
 	}
 
 	if !attendeeExists && attendeeReq != nil {
-		return s.createAttendeeFromUpdate(ctx, pastMeetingID, attendeeReq), true
+		resp, err := s.createAttendeeFromUpdate(ctx, pastMeetingID, attendeeReq)
+		return resp, err == nil, err
 	}
 
 	if attendeeExists && attendeeReq != nil && actualAttendeeID != "" {
-		return s.updateAttendee(ctx, pastMeetingID, actualAttendeeID, participantID, attendeeReq), true
+		resp, err := s.updateAttendee(ctx, pastMeetingID, actualAttendeeID, participantID, attendeeReq)
+		return resp, err == nil, err
 	}
 
-	return nil, attendeeExists
+	return nil, attendeeExists, nil
 }
 
 // checkInviteeExists checks if invitee exists by attempting ID mapping
@@ -208,13 +227,13 @@ func (s *PastMeetingParticipantService) handleAttendeeOperation(
 func (s *PastMeetingParticipantService) checkInviteeExists(ctx context.Context, participantID string) (string, bool) {
 	inviteeID, err := s.idMapper.MapParticipantV2ToInviteeID(ctx, participantID)
 	if err != nil || inviteeID == "" {
-		slog.DebugContext(ctx, "Invitee does not exist (ID mapping failed or empty)",
+		s.logger.DebugContext(ctx, "Invitee does not exist (ID mapping failed or empty)",
 			"participant_id", participantID,
 			"error", err)
 		return participantID, false
 	}
 
-	slog.DebugContext(ctx, "Invitee exists - mapped participant ID to invitee ID",
+	s.logger.DebugContext(ctx, "Invitee exists - mapped participant ID to invitee ID",
 		"participant_id", participantID,
 		"invitee_id", inviteeID)
 	return inviteeID, true
@@ -223,13 +242,13 @@ func (s *PastMeetingParticipantService) checkInviteeExists(ctx context.Context,
 func (s *PastMeetingParticipantService) checkAttendeeExists(ctx context.Context, participantID string) (string, bool) {
 	attendeeID, err := s.idMapper.MapParticipantV2ToAttendeeID(ctx, participantID)
 	if err != nil || attendeeID == "" {
-		slog.DebugContext(ctx, "Attendee does not exist (ID mapping failed or empty)",
+		s.logger.DebugContext(ctx, "Attendee does not exist (ID mapping failed or empty)",
 			"participant_id", participantID,
 			"error", err)
 		return participantID, false
 	}
 
-	slog.DebugContext(ctx, "Attendee exists - mapped participant ID to attendee ID",
+	s.logger.DebugContext(ctx, "Attendee exists - mapped participant ID to attendee ID",
 		"participant_id", participantID,
 		"attendee_id", attendeeID)
 	return attendeeID, true
@@ -238,7 +257,7 @@ func (s *PastMeetingParticipantService) checkAttendeeExists(ctx context.Context,
 func (s *PastMeetingParticipantService) checkInviteeExistsFromInviteeID(ctx context.Context, inviteeID string) bool {
 	inviteeID, err := s.idMapper.MapInviteeIDToParticipantV2(ctx, inviteeID)
 	exists := inviteeID != "" && err == nil
-	slog.DebugContext(ctx, "Checked invitee existence from invitee ID",
+	s.logger.DebugContext(ctx, "Checked invitee existence from invitee ID",
 		"invitee_id", inviteeID,
 		"exists", exists,
 		"error", err)
@@ -248,7 +267,7 @@ func (s *PastMeetingParticipantService) checkInviteeExistsFromInviteeID(ctx cont
 func (s *PastMeetingParticipantService) checkAttendeeExistsFromAttendeeID(ctx context.Context, attendeeID string) bool {
 	attendeeID, err := s.idMapper.MapAttendeeIDToParticipantV2(ctx, attendeeID)
 	exists := attendeeID != "" && err == nil
-	slog.DebugContext(ctx, "Checked attendee existence from attendee ID",
+	s.logger.DebugContext(ctx, "Checked attendee existence from attendee ID",
 		"attendee_id", attendeeID,
 		"exists", exists,
 		"error", err)
@@ -259,28 +278,32 @@ func (s *PastMeetingParticipantService) checkAttendeeExistsFromAttendeeID(ctx co
 func (s *PastMeetingParticipantService) deleteInvitee(
 	ctx context.Context,
 	pastMeetingID, inviteeID, participantID string,
-) {
+) error {
 	if err := s.participantClient.DeleteInvitee(ctx, pastMeetingID, inviteeID); err != nil {
-		slog.WarnContext(ctx, "Failed to delete invitee during update",
+		s.logger.WarnContext(ctx, "Failed to delete invitee during update",
 			"participant_id", participantID,
 			"invitee_id", inviteeID,
 			"past_meeting_id", pastMeetingID,
 			"error", err)
+		return err
 	}
+	return nil
 }
 
 // deleteAttendee deletes attendee record
 func (s *PastMeetingParticipantService) deleteAttendee(
 	ctx context.Context,
 	pastMeetingID, attendeeID, participantID string,
-) {
+) error {
 	if err := s.participantClient.DeleteAttendee(ctx, pastMeetingID, attendeeID); err != nil {
-		slog.WarnContext(ctx, "Failed to delete attendee during update",
+		s.logger.WarnContext(ctx, "Failed to delete attendee during update",
 			"participant_id", participantID,
 			"attendee_id", attendeeID,
 			"past_meeting_id", pastMeetingID,
 			"error", err)
+		return err
 	}
+	return nil
 }
 
 // createInviteeFromUpdate creates a new invitee from update request
@@ -288,7 +311,7 @@ func (s *PastMeetingParticipantService) createInviteeFromUpdate(
 	ctx context.Context,
 	pastMeetingID string,
 	updateReq *itx.UpdateInviteeRequest,
-) *itx.InviteeResponse {
+) (*itx.InviteeResponse, error) {
 	// Convert UpdateInviteeRequest to CreateInviteeRequest
 	createReq := &itx.CreateInviteeRequest{
 		// Identity fields
@@ -306,13 +329,13 @@ func (s *PastMeetingParticipantService) createInviteeFromUpdate(
 
 	resp, err := s.participantClient.CreateInvitee(ctx, pastMeetingID, createReq)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to create invitee during update",
+		s.logger.ErrorContext(ctx, "Failed to create invitee during update",
 			"past_meeting_id", pastMeetingID,
 			"error", err)
-		return nil
+		return nil, err
 	}
 
-	return resp
+	return resp, nil
 }
 
 // createAttendeeFromUpdate creates a new attendee from update request
@@ -320,7 +343,7 @@ func (s *PastMeetingParticipantService) createAttendeeFromUpdate(
 	ctx context.Context,
 	pastMeetingID string,
 	updateReq *itx.UpdateAttendeeRequest,
-) *itx.AttendeeResponse {
+) (*itx.AttendeeResponse, error) {
 	// Convert UpdateAttendeeRequest to CreateAttendeeRequest
 	createReq := &itx.CreateAttendeeRequest{
 		Org:                   updateReq.Org,
@@ -332,13 +355,13 @@ func (s *PastMeetingParticipantService) createAttendeeFromUpdate(
 
 	resp, err := s.participantClient.CreateAttendee(ctx, pastMeetingID, createReq)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to create attendee during update",
+		s.logger.ErrorContext(ctx, "Failed to create attendee during update",
 			"past_meeting_id", pastMeetingID,
 			"error", err)
-		return nil
+		return nil, err
 	}
 
-	return resp
+	return resp, nil
 }
 
 // updateInvitee updates invitee record
@@ -346,19 +369,19 @@ func (s *PastMeetingParticipantService) updateInvitee(
 	ctx context.Context,
 	pastMeetingID, inviteeID, participantID string,
 	updateReq *itx.UpdateInviteeRequest,
-) *itx.InviteeResponse {
+) (*itx.InviteeResponse, error) {
 	resp, err := s.participantClient.UpdateInvitee(ctx, pastMeetingID, inviteeID, updateReq)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to update invitee",
+		s.logger.ErrorContext(ctx, "Failed to update invitee",
 			"participant_id", participantID,
 			"invitee_id", inviteeID,
 			"past_meeting_id", pastMeetingID,
 			"error", err)
-		return nil
+		return nil, err
 	}
 
 	// resp may be nil if ITX returns 204 No Content
-	return resp
+	return resp, nil
 }
 
 // updateAttendee updates attendee record
@@ -366,19 +389,19 @@ func (s *PastMeetingParticipantService) updateAttendee(
 	ctx context.Context,
 	pastMeetingID, attendeeID, participantID string,
 	updateReq *itx.UpdateAttendeeRequest,
-) *itx.AttendeeResponse {
+) (*itx.AttendeeResponse, error) {
 	resp, err := s.participantClient.UpdateAttendee(ctx, pastMeetingID, attendeeID, updateReq)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to update attendee",
+		s.logger.ErrorContext(ctx, "Failed to update attendee",
 			"participant_id", participantID,
 			"attendee_id", attendeeID,
 			"past_meeting_id", pastMeetingID,
 			"error", err)
-		return nil
+		return nil, err
 	}
 
 	// resp may be nil if ITX returns 204 No Content
-	return resp
+	return resp, nil
 }
 
 // DeleteParticipant deletes a participant
@@ -399,7 +422,7 @@ func (s *PastMeetingParticipantService) DeleteParticipant(
 
 	inviteeErr := s.participantClient.DeleteInvitee(ctx, pastMeetingID, idToUseInvitee)
 	if inviteeErr != nil {
-		slog.ErrorContext(ctx, "Failed to delete invitee",
+		s.logger.ErrorContext(ctx, "Failed to delete invitee",
 			"participant_id", participantID,
 			"invitee_id", idToUseInvitee,
 			"past_meeting_id", pastMeetingID,
@@ -417,7 +440,7 @@ func (s *PastMeetingParticipantService) DeleteParticipant(
 
 	attendeeErr := s.participantClient.DeleteAttendee(ctx, pastMeetingID, idToUseAttendee)
 	if attendeeErr != nil {
-		slog.ErrorContext(ctx, "Failed to delete attendee",
+		s.logger.ErrorContext(ctx, "Failed to delete attendee",
 			"participant_id", participantID,
 			"attendee_id", idToUseAttendee,
 			"past_meeting_id", pastMeetingID,
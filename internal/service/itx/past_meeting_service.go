@@ -8,6 +8,7 @@ import (
 	"log/slog"
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/logging"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
 )
 
@@ -15,6 +16,7 @@ import (
 type PastMeetingService struct {
 	pastMeetingClient domain.ITXPastMeetingClient
 	idMapper          domain.IDMapper
+	logger            *slog.Logger
 }
 
 // NewPastMeetingService creates a new ITX past meeting service
@@ -22,6 +24,7 @@ func NewPastMeetingService(pastMeetingClient domain.ITXPastMeetingClient, idMapp
 	return &PastMeetingService{
 		pastMeetingClient: pastMeetingClient,
 		idMapper:          idMapper,
+		logger:            logging.Subsystem(logging.SubsystemITX),
 	}
 }
 
@@ -67,7 +70,7 @@ func (s *PastMeetingService) CreatePastMeeting(ctx context.Context, req *itx.Cre
 		if resp.Committees[i].ID != "" {
 			v2UID, err := s.idMapper.MapCommitteeV1ToV2(ctx, resp.Committees[i].ID)
 			if err != nil {
-				slog.WarnContext(ctx, "failed to map committee ID in past meeting response; returning empty committee UID",
+				s.logger.WarnContext(ctx, "failed to map committee ID in past meeting response; returning empty committee UID",
 					"v1_id", resp.Committees[i].ID, "err", err)
 				resp.Committees[i].ID = ""
 				continue
@@ -101,7 +104,7 @@ func (s *PastMeetingService) GetPastMeeting(ctx context.Context, pastMeetingID s
 		if resp.Committees[i].ID != "" {
 			v2UID, err := s.idMapper.MapCommitteeV1ToV2(ctx, resp.Committees[i].ID)
 			if err != nil {
-				slog.WarnContext(ctx, "failed to map committee ID in past meeting response; returning empty committee UID",
+				s.logger.WarnContext(ctx, "failed to map committee ID in past meeting response; returning empty committee UID",
 					"v1_id", resp.Committees[i].ID, "err", err)
 				resp.Committees[i].ID = ""
 				continue
@@ -5,6 +5,7 @@ package itx
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
@@ -31,3 +32,19 @@ func (s *PastMeetingSummaryService) GetPastMeetingSummary(ctx context.Context, p
 func (s *PastMeetingSummaryService) UpdatePastMeetingSummary(ctx context.Context, pastMeetingID, summaryID string, req *itx.UpdatePastMeetingSummaryRequest) (*itx.PastMeetingSummaryResponse, error) {
 	return s.summaryClient.UpdatePastMeetingSummary(ctx, pastMeetingID, summaryID, req)
 }
+
+// SetPastMeetingSummaryApproval approves or rejects a past meeting summary via ITX proxy.
+// Only summaries that require approval can be approved or rejected.
+func (s *PastMeetingSummaryService) SetPastMeetingSummaryApproval(ctx context.Context, pastMeetingID, summaryID string, approved bool) (*itx.PastMeetingSummaryResponse, error) {
+	summary, err := s.summaryClient.GetPastMeetingSummary(ctx, pastMeetingID, summaryID)
+	if err != nil {
+		return nil, err
+	}
+	if !summary.RequiresApproval {
+		return nil, domain.NewConflictError(fmt.Sprintf("summary %s does not require approval", summaryID))
+	}
+
+	return s.summaryClient.UpdatePastMeetingSummary(ctx, pastMeetingID, summaryID, &itx.UpdatePastMeetingSummaryRequest{
+		Approved: &approved,
+	})
+}
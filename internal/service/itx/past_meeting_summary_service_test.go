@@ -0,0 +1,58 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+// fakeSummaryClient returns a canned summary and captures the update request it receives.
+type fakeSummaryClient struct {
+	summary   *itx.PastMeetingSummaryResponse
+	lastReq   *itx.UpdatePastMeetingSummaryRequest
+	updateCnt int
+}
+
+func (f *fakeSummaryClient) GetPastMeetingSummary(_ context.Context, _, _ string) (*itx.PastMeetingSummaryResponse, error) {
+	return f.summary, nil
+}
+
+func (f *fakeSummaryClient) UpdatePastMeetingSummary(_ context.Context, _, _ string, req *itx.UpdatePastMeetingSummaryRequest) (*itx.PastMeetingSummaryResponse, error) {
+	f.updateCnt++
+	f.lastReq = req
+	updated := *f.summary
+	updated.Approved = *req.Approved
+	return &updated, nil
+}
+
+func TestPastMeetingSummaryService_SetPastMeetingSummaryApproval(t *testing.T) {
+	for _, approved := range []bool{true, false} {
+		client := &fakeSummaryClient{summary: &itx.PastMeetingSummaryResponse{ID: "s1", RequiresApproval: true, Approved: !approved}}
+		svc := NewPastMeetingSummaryService(client)
+
+		resp, err := svc.SetPastMeetingSummaryApproval(context.Background(), "123-456", "s1", approved)
+		require.NoError(t, err)
+		assert.Equal(t, approved, resp.Approved)
+		require.NotNil(t, client.lastReq.Approved)
+		assert.Equal(t, approved, *client.lastReq.Approved)
+		assert.Empty(t, client.lastReq.EditedSummaryOverview, "approval must not touch edited content")
+	}
+
+	t.Run("summary not requiring approval is a conflict", func(t *testing.T) {
+		client := &fakeSummaryClient{summary: &itx.PastMeetingSummaryResponse{ID: "s1"}}
+		svc := NewPastMeetingSummaryService(client)
+
+		_, err := svc.SetPastMeetingSummaryApproval(context.Background(), "123-456", "s1", true)
+		require.Error(t, err)
+		assert.Equal(t, domain.ErrorTypeConflict, domain.GetErrorType(err))
+		assert.Zero(t, client.updateCnt)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 
 	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/logging"
 	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
 )
 
@@ -15,13 +16,32 @@ import (
 type RegistrantService struct {
 	registrantClient domain.ITXRegistrantClient
 	idMapper         domain.IDMapper
+	joinLinks        *JoinLinkCache
+	logger           *slog.Logger
 }
 
 // NewRegistrantService creates a new ITX registrant service
-func NewRegistrantService(registrantClient domain.ITXRegistrantClient, idMapper domain.IDMapper) *RegistrantService {
-	return &RegistrantService{
+func NewRegistrantService(registrantClient domain.ITXRegistrantClient, idMapper domain.IDMapper, opts ...RegistrantServiceOption) *RegistrantService {
+	s := &RegistrantService{
 		registrantClient: registrantClient,
 		idMapper:         idMapper,
+		logger:           logging.Subsystem(logging.SubsystemITX),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// RegistrantServiceOption is a functional option for RegistrantService.
+type RegistrantServiceOption func(*RegistrantService)
+
+// WithRegistrantJoinLinkCache drops a meeting's cached join links whenever one of its
+// registrants is changed, so a removed registrant or revoked host does not keep a cached
+// personal link. cache should be the one given to the MeetingService.
+func WithRegistrantJoinLinkCache(cache *JoinLinkCache) RegistrantServiceOption {
+	return func(s *RegistrantService) {
+		s.joinLinks = cache
 	}
 }
 
@@ -40,13 +60,14 @@ func (s *RegistrantService) CreateRegistrant(ctx context.Context, meetingID stri
 	if err != nil {
 		return nil, err
 	}
+	s.joinLinks.invalidate(meetingID)
 
 	// Map committee SFID back to committee UID if present. On any mapping failure, log a warning
 	// and leave the committee UID empty so the caller still receives the full registrant response.
 	if resp.CommitteeID != "" {
 		v2UID, err := s.idMapper.MapCommitteeV1ToV2(ctx, resp.CommitteeID)
 		if err != nil {
-			slog.WarnContext(ctx, "failed to map committee ID in registrant response; returning empty committee UID",
+			s.logger.WarnContext(ctx, "failed to map committee ID in registrant response; returning empty committee UID",
 				"v1_id", resp.CommitteeID, "err", err)
 			resp.CommitteeID = ""
 		} else {
@@ -69,7 +90,7 @@ func (s *RegistrantService) GetRegistrant(ctx context.Context, meetingID, regist
 	if resp.CommitteeID != "" {
 		v2UID, err := s.idMapper.MapCommitteeV1ToV2(ctx, resp.CommitteeID)
 		if err != nil {
-			slog.WarnContext(ctx, "failed to map committee ID in registrant response; returning empty committee UID",
+			s.logger.WarnContext(ctx, "failed to map committee ID in registrant response; returning empty committee UID",
 				"v1_id", resp.CommitteeID, "err", err)
 			resp.CommitteeID = ""
 		} else {
@@ -91,12 +112,74 @@ func (s *RegistrantService) UpdateRegistrant(ctx context.Context, meetingID, reg
 		req.CommitteeID = v1SFID
 	}
 
-	return s.registrantClient.UpdateRegistrant(ctx, meetingID, registrantID, req)
+	if err := s.registrantClient.UpdateRegistrant(ctx, meetingID, registrantID, req); err != nil {
+		return err
+	}
+	s.joinLinks.invalidate(meetingID)
+
+	return nil
+}
+
+// SetRegistrantHost grants (host=true) or revokes host access for a meeting registrant via
+// ITX proxy. ITX replaces the whole registrant on update, so the current registrant is
+// fetched and written back with only the host flag changed. The registrant is returned
+// unchanged, without an update, when it already has the requested access.
+func (s *RegistrantService) SetRegistrantHost(ctx context.Context, meetingID, registrantID string, host bool) (*itx.ZoomMeetingRegistrant, error) {
+	// Work on the raw ITX registrant so its committee SFID is written back as-is.
+	current, err := s.registrantClient.GetRegistrant(ctx, meetingID, registrantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Host != host {
+		req := writableRegistrant(current)
+		req.Host = host
+		if err := s.registrantClient.UpdateRegistrant(ctx, meetingID, registrantID, req); err != nil {
+			return nil, err
+		}
+		s.joinLinks.invalidate(meetingID)
+		current.Host = host
+	}
+
+	if current.CommitteeID != "" {
+		v2UID, err := s.idMapper.MapCommitteeV1ToV2(ctx, current.CommitteeID)
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to map committee ID in registrant response; returning empty committee UID",
+				"v1_id", current.CommitteeID, "err", err)
+			current.CommitteeID = ""
+		} else {
+			current.CommitteeID = v2UID
+		}
+	}
+
+	return current, nil
+}
+
+// writableRegistrant returns a copy of a registrant with only the fields ITX accepts on update
+func writableRegistrant(r *itx.ZoomMeetingRegistrant) *itx.ZoomMeetingRegistrant {
+	return &itx.ZoomMeetingRegistrant{
+		CommitteeID:    r.CommitteeID,
+		UserID:         r.UserID,
+		Email:          r.Email,
+		Username:       r.Username,
+		FirstName:      r.FirstName,
+		LastName:       r.LastName,
+		Org:            r.Org,
+		JobTitle:       r.JobTitle,
+		ProfilePicture: r.ProfilePicture,
+		Host:           r.Host,
+		Occurrence:     r.Occurrence,
+	}
 }
 
 // DeleteRegistrant deletes a meeting registrant via ITX proxy
 func (s *RegistrantService) DeleteRegistrant(ctx context.Context, meetingID, registrantID string) error {
-	return s.registrantClient.DeleteRegistrant(ctx, meetingID, registrantID)
+	if err := s.registrantClient.DeleteRegistrant(ctx, meetingID, registrantID); err != nil {
+		return err
+	}
+	s.joinLinks.invalidate(meetingID)
+
+	return nil
 }
 
 // GetRegistrantICS retrieves an ICS calendar file for a meeting registrant via ITX proxy
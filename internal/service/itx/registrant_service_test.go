@@ -0,0 +1,102 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package itx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-meeting-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-meeting-service/pkg/models/itx"
+)
+
+type fakeRegistrantClient struct {
+	domain.ITXRegistrantClient
+	getResp       *itx.ZoomMeetingRegistrant
+	lastUpdateReq *itx.ZoomMeetingRegistrant
+	updateCalls   int
+}
+
+func (f *fakeRegistrantClient) GetRegistrant(_ context.Context, _, _ string) (*itx.ZoomMeetingRegistrant, error) {
+	r := *f.getResp
+	return &r, nil
+}
+
+func (f *fakeRegistrantClient) UpdateRegistrant(_ context.Context, _, _ string, req *itx.ZoomMeetingRegistrant) error {
+	f.updateCalls++
+	f.lastUpdateReq = req
+	return nil
+}
+
+func (f *fakeRegistrantClient) DeleteRegistrant(_ context.Context, _, _ string) error {
+	return nil
+}
+
+// committeeIDMapper maps committee SFIDs to UIDs by prefixing them.
+type committeeIDMapper struct{ domain.IDMapper }
+
+func (committeeIDMapper) MapCommitteeV1ToV2(_ context.Context, v1SFID string) (string, error) {
+	return "uid-" + v1SFID, nil
+}
+
+func TestRegistrantService_SetRegistrantHost(t *testing.T) {
+	registrant := func(host bool) *itx.ZoomMeetingRegistrant {
+		return &itx.ZoomMeetingRegistrant{
+			ID:                      "reg-1",
+			Type:                    itx.RegistrantTypeCommittee,
+			CommitteeID:             "sfid-1",
+			Email:                   "jane@example.com",
+			FirstName:               "Jane",
+			LastName:                "Doe",
+			Host:                    host,
+			Occurrence:              "1710172800",
+			AttendedOccurrenceCount: 4,
+			CreatedAt:               "2024-01-01T00:00:00Z",
+		}
+	}
+
+	t.Run("promote writes back the registrant with host set", func(t *testing.T) {
+		client := &fakeRegistrantClient{getResp: registrant(false)}
+		svc := NewRegistrantService(client, committeeIDMapper{})
+
+		resp, err := svc.SetRegistrantHost(context.Background(), "123", "reg-1", true)
+		require.NoError(t, err)
+		assert.True(t, resp.Host)
+		assert.Equal(t, "uid-sfid-1", resp.CommitteeID)
+
+		require.Equal(t, 1, client.updateCalls)
+		assert.Equal(t, &itx.ZoomMeetingRegistrant{
+			CommitteeID: "sfid-1",
+			Email:       "jane@example.com",
+			FirstName:   "Jane",
+			LastName:    "Doe",
+			Host:        true,
+			Occurrence:  "1710172800",
+		}, client.lastUpdateReq, "only writable fields are sent, with the committee SFID unchanged")
+	})
+
+	t.Run("demote clears host", func(t *testing.T) {
+		client := &fakeRegistrantClient{getResp: registrant(true)}
+		svc := NewRegistrantService(client, committeeIDMapper{})
+
+		resp, err := svc.SetRegistrantHost(context.Background(), "123", "reg-1", false)
+		require.NoError(t, err)
+		assert.False(t, resp.Host)
+		require.Equal(t, 1, client.updateCalls)
+		assert.False(t, client.lastUpdateReq.Host)
+	})
+
+	t.Run("no update when access is unchanged", func(t *testing.T) {
+		client := &fakeRegistrantClient{getResp: registrant(true)}
+		svc := NewRegistrantService(client, committeeIDMapper{})
+
+		resp, err := svc.SetRegistrantHost(context.Background(), "123", "reg-1", true)
+		require.NoError(t, err)
+		assert.True(t, resp.Host)
+		assert.Zero(t, client.updateCalls)
+	})
+}
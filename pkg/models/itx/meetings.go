@@ -231,11 +231,18 @@ type GetJoinLinkRequest struct {
 	Name      string
 	Email     string
 	Register  bool
+	// OccurrenceID optionally scopes the link to a single occurrence. It is resolved by the
+	// proxy and is not forwarded to ITX.
+	OccurrenceID string
 }
 
 // ZoomMeetingJoinLink represents a join link response from ITX
 type ZoomMeetingJoinLink struct {
 	Link string `json:"link"` // Zoom meeting join URL
+	// Occurrence context populated by the proxy when the link was requested for a specific occurrence
+	OccurrenceID        string `json:"occurrence_id,omitempty"`
+	OccurrenceStartTime string `json:"occurrence_start_time,omitempty"` // RFC3339
+	OccurrenceDuration  int    `json:"occurrence_duration,omitempty"`   // Minutes
 }
 
 // UpdateOccurrenceRequest represents the request to update a meeting occurrence
@@ -0,0 +1,126 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import "strings"
+
+// DiffOperation describes how a line changed between the original and edited text.
+type DiffOperation string
+
+const (
+	DiffOperationEqual  DiffOperation = "equal"
+	DiffOperationInsert DiffOperation = "insert"
+	DiffOperationDelete DiffOperation = "delete"
+)
+
+// DiffLine is a single line of a line-oriented diff. OriginalLine and EditedLine are
+// 1-based line numbers in the respective inputs; zero means the line does not exist on
+// that side (inserted lines have no original line, deleted lines have no edited line).
+type DiffLine struct {
+	Operation    DiffOperation
+	Text         string
+	OriginalLine int
+	EditedLine   int
+}
+
+// maxDiffCells bounds the LCS table DiffLines builds (about 8MB), so two large, mostly
+// different inputs cannot exhaust memory.
+var maxDiffCells = 1 << 20
+
+// DiffLines computes a line-oriented diff between original and edited using the
+// longest common subsequence of lines. Deletions are emitted before insertions at
+// each change point so replaced lines read naturally as "removed, then added".
+//
+// Lines shared at the start and end of both inputs are matched directly. When the
+// changed region between them is too large to diff, its original lines are reported
+// as deleted and its edited lines as inserted, and approximate is true.
+func DiffLines(original, edited string) (lines []DiffLine, approximate bool) {
+	a := splitLines(original)
+	b := splitLines(edited)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	result := make([]DiffLine, 0, max(len(a), len(b)))
+	for k := 0; k < prefix; k++ {
+		result = append(result, DiffLine{Operation: DiffOperationEqual, Text: a[k], OriginalLine: k + 1, EditedLine: k + 1})
+	}
+
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	approximate = (len(midA)+1)*(len(midB)+1) > maxDiffCells
+	if approximate {
+		result = appendReplacement(result, midA, midB, prefix, prefix)
+	} else {
+		result = appendLCSDiff(result, midA, midB, prefix)
+	}
+
+	for k := 0; k < suffix; k++ {
+		i, j := len(a)-suffix+k, len(b)-suffix+k
+		result = append(result, DiffLine{Operation: DiffOperationEqual, Text: a[i], OriginalLine: i + 1, EditedLine: j + 1})
+	}
+
+	return result, approximate
+}
+
+// appendLCSDiff appends the LCS diff of a and b, whose first lines are line offset+1 of
+// their inputs.
+func appendLCSDiff(result []DiffLine, a, b []string, offset int) []DiffLine {
+	// lcs[i][j] holds the LCS length of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, DiffLine{Operation: DiffOperationEqual, Text: a[i], OriginalLine: offset + i + 1, EditedLine: offset + j + 1})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, DiffLine{Operation: DiffOperationDelete, Text: a[i], OriginalLine: offset + i + 1})
+			i++
+		default:
+			result = append(result, DiffLine{Operation: DiffOperationInsert, Text: b[j], EditedLine: offset + j + 1})
+			j++
+		}
+	}
+	return appendReplacement(result, a[i:], b[j:], offset+i, offset+j)
+}
+
+// appendReplacement appends every line of a as deleted and then every line of b as
+// inserted, numbering them from originalOffset+1 and editedOffset+1.
+func appendReplacement(result []DiffLine, a, b []string, originalOffset, editedOffset int) []DiffLine {
+	for i, line := range a {
+		result = append(result, DiffLine{Operation: DiffOperationDelete, Text: line, OriginalLine: originalOffset + i + 1})
+	}
+	for j, line := range b {
+		result = append(result, DiffLine{Operation: DiffOperationInsert, Text: line, EditedLine: editedOffset + j + 1})
+	}
+	return result
+}
+
+// splitLines splits s into lines, treating an empty string as having no lines.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		edited   string
+		expected []DiffLine
+	}{
+		{
+			name:     "both empty",
+			original: "",
+			edited:   "",
+			expected: []DiffLine{},
+		},
+		{
+			name:     "identical text",
+			original: "a\nb",
+			edited:   "a\nb",
+			expected: []DiffLine{
+				{Operation: DiffOperationEqual, Text: "a", OriginalLine: 1, EditedLine: 1},
+				{Operation: DiffOperationEqual, Text: "b", OriginalLine: 2, EditedLine: 2},
+			},
+		},
+		{
+			name:     "all inserted",
+			original: "",
+			edited:   "a\nb",
+			expected: []DiffLine{
+				{Operation: DiffOperationInsert, Text: "a", EditedLine: 1},
+				{Operation: DiffOperationInsert, Text: "b", EditedLine: 2},
+			},
+		},
+		{
+			name:     "all deleted",
+			original: "a\nb",
+			edited:   "",
+			expected: []DiffLine{
+				{Operation: DiffOperationDelete, Text: "a", OriginalLine: 1},
+				{Operation: DiffOperationDelete, Text: "b", OriginalLine: 2},
+			},
+		},
+		{
+			name:     "replaced middle line emits delete before insert",
+			original: "a\nb\nc",
+			edited:   "a\nx\nc",
+			expected: []DiffLine{
+				{Operation: DiffOperationEqual, Text: "a", OriginalLine: 1, EditedLine: 1},
+				{Operation: DiffOperationDelete, Text: "b", OriginalLine: 2},
+				{Operation: DiffOperationInsert, Text: "x", EditedLine: 2},
+				{Operation: DiffOperationEqual, Text: "c", OriginalLine: 3, EditedLine: 3},
+			},
+		},
+		{
+			name:     "changes between a shared prefix and suffix",
+			original: "a\nb\nc\nd\ne",
+			edited:   "a\nc\nx\ne",
+			expected: []DiffLine{
+				{Operation: DiffOperationEqual, Text: "a", OriginalLine: 1, EditedLine: 1},
+				{Operation: DiffOperationDelete, Text: "b", OriginalLine: 2},
+				{Operation: DiffOperationEqual, Text: "c", OriginalLine: 3, EditedLine: 2},
+				{Operation: DiffOperationDelete, Text: "d", OriginalLine: 4},
+				{Operation: DiffOperationInsert, Text: "x", EditedLine: 3},
+				{Operation: DiffOperationEqual, Text: "e", OriginalLine: 5, EditedLine: 4},
+			},
+		},
+		{
+			name:     "appended line and CRLF normalization",
+			original: "a\r\nb",
+			edited:   "a\nb\nc",
+			expected: []DiffLine{
+				{Operation: DiffOperationEqual, Text: "a", OriginalLine: 1, EditedLine: 1},
+				{Operation: DiffOperationEqual, Text: "b", OriginalLine: 2, EditedLine: 2},
+				{Operation: DiffOperationInsert, Text: "c", EditedLine: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, approximate := DiffLines(tt.original, tt.edited)
+			if approximate {
+				t.Errorf("DiffLines(%q, %q) unexpectedly approximate", tt.original, tt.edited)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("DiffLines(%q, %q) = %+v, expected %+v", tt.original, tt.edited, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffLines_TooLargeToDiff(t *testing.T) {
+	prev := maxDiffCells
+	maxDiffCells = 8
+	t.Cleanup(func() { maxDiffCells = prev })
+
+	result, approximate := DiffLines("a\nb\nc\nd\nz", "a\nc\nb\nx\nz")
+	if !approximate {
+		t.Error("expected an approximate diff for a changed region over the limit")
+	}
+	expected := []DiffLine{
+		{Operation: DiffOperationEqual, Text: "a", OriginalLine: 1, EditedLine: 1},
+		{Operation: DiffOperationDelete, Text: "b", OriginalLine: 2},
+		{Operation: DiffOperationDelete, Text: "c", OriginalLine: 3},
+		{Operation: DiffOperationDelete, Text: "d", OriginalLine: 4},
+		{Operation: DiffOperationInsert, Text: "c", EditedLine: 2},
+		{Operation: DiffOperationInsert, Text: "b", EditedLine: 3},
+		{Operation: DiffOperationInsert, Text: "x", EditedLine: 4},
+		{Operation: DiffOperationEqual, Text: "z", OriginalLine: 5, EditedLine: 5},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("DiffLines = %+v, expected %+v", result, expected)
+	}
+}